@@ -259,21 +259,11 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*v1beta2.IBMVPCClusterSpec)(nil), (*IBMVPCClusterSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1beta2_IBMVPCClusterSpec_To_v1beta1_IBMVPCClusterSpec(a.(*v1beta2.IBMVPCClusterSpec), b.(*IBMVPCClusterSpec), scope)
-	}); err != nil {
-		return err
-	}
 	if err := s.AddGeneratedConversionFunc((*IBMVPCClusterStatus)(nil), (*v1beta2.IBMVPCClusterStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_IBMVPCClusterStatus_To_v1beta2_IBMVPCClusterStatus(a.(*IBMVPCClusterStatus), b.(*v1beta2.IBMVPCClusterStatus), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*v1beta2.IBMVPCClusterStatus)(nil), (*IBMVPCClusterStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1beta2_IBMVPCClusterStatus_To_v1beta1_IBMVPCClusterStatus(a.(*v1beta2.IBMVPCClusterStatus), b.(*IBMVPCClusterStatus), scope)
-	}); err != nil {
-		return err
-	}
 	if err := s.AddGeneratedConversionFunc((*IBMVPCMachine)(nil), (*v1beta2.IBMVPCMachine)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_IBMVPCMachine_To_v1beta2_IBMVPCMachine(a.(*IBMVPCMachine), b.(*v1beta2.IBMVPCMachine), scope)
 	}); err != nil {
@@ -299,11 +289,6 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*v1beta2.IBMVPCMachineStatus)(nil), (*IBMVPCMachineStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1beta2_IBMVPCMachineStatus_To_v1beta1_IBMVPCMachineStatus(a.(*v1beta2.IBMVPCMachineStatus), b.(*IBMVPCMachineStatus), scope)
-	}); err != nil {
-		return err
-	}
 	if err := s.AddGeneratedConversionFunc((*IBMVPCMachineTemplate)(nil), (*v1beta2.IBMVPCMachineTemplate)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_IBMVPCMachineTemplate_To_v1beta2_IBMVPCMachineTemplate(a.(*IBMVPCMachineTemplate), b.(*v1beta2.IBMVPCMachineTemplate), scope)
 	}); err != nil {
@@ -354,11 +339,6 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*v1beta2.NetworkInterface)(nil), (*NetworkInterface)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1beta2_NetworkInterface_To_v1beta1_NetworkInterface(a.(*v1beta2.NetworkInterface), b.(*NetworkInterface), scope)
-	}); err != nil {
-		return err
-	}
 	if err := s.AddGeneratedConversionFunc((*Subnet)(nil), (*v1beta2.Subnet)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_Subnet_To_v1beta2_Subnet(a.(*Subnet), b.(*v1beta2.Subnet), scope)
 	}); err != nil {
@@ -444,16 +424,36 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddConversionFunc((*v1beta2.IBMVPCClusterSpec)(nil), (*IBMVPCClusterSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta2_IBMVPCClusterSpec_To_v1beta1_IBMVPCClusterSpec(a.(*v1beta2.IBMVPCClusterSpec), b.(*IBMVPCClusterSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddConversionFunc((*v1beta2.IBMVPCClusterStatus)(nil), (*IBMVPCClusterStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta2_IBMVPCClusterStatus_To_v1beta1_IBMVPCClusterStatus(a.(*v1beta2.IBMVPCClusterStatus), b.(*IBMVPCClusterStatus), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddConversionFunc((*v1beta2.IBMVPCMachineSpec)(nil), (*IBMVPCMachineSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta2_IBMVPCMachineSpec_To_v1beta1_IBMVPCMachineSpec(a.(*v1beta2.IBMVPCMachineSpec), b.(*IBMVPCMachineSpec), scope)
 	}); err != nil {
 		return err
 	}
+	if err := s.AddConversionFunc((*v1beta2.IBMVPCMachineStatus)(nil), (*IBMVPCMachineStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta2_IBMVPCMachineStatus_To_v1beta1_IBMVPCMachineStatus(a.(*v1beta2.IBMVPCMachineStatus), b.(*IBMVPCMachineStatus), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddConversionFunc((*v1beta2.IBMVPCMachineTemplateStatus)(nil), (*IBMVPCMachineTemplateStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta2_IBMVPCMachineTemplateStatus_To_v1beta1_IBMVPCMachineTemplateStatus(a.(*v1beta2.IBMVPCMachineTemplateStatus), b.(*IBMVPCMachineTemplateStatus), scope)
 	}); err != nil {
 		return err
 	}
+	if err := s.AddConversionFunc((*v1beta2.NetworkInterface)(nil), (*NetworkInterface)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta2_NetworkInterface_To_v1beta1_NetworkInterface(a.(*v1beta2.NetworkInterface), b.(*NetworkInterface), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddConversionFunc((*v1beta2.VPCLoadBalancerSpec)(nil), (*VPCLoadBalancerSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta2_VPCLoadBalancerSpec_To_v1beta1_VPCLoadBalancerSpec(a.(*v1beta2.VPCLoadBalancerSpec), b.(*VPCLoadBalancerSpec), scope)
 	}); err != nil {
@@ -590,7 +590,9 @@ func autoConvert_v1beta2_IBMPowerVSClusterStatus_To_v1beta1_IBMPowerVSClusterSta
 	// WARNING: in.VPCSubnet requires manual conversion: does not exist in peer-type
 	// WARNING: in.VPCSecurityGroups requires manual conversion: does not exist in peer-type
 	// WARNING: in.TransitGateway requires manual conversion: does not exist in peer-type
+	// WARNING: in.TransitGatewayConnections requires manual conversion: does not exist in peer-type
 	// WARNING: in.COSInstance requires manual conversion: does not exist in peer-type
+	// WARNING: in.COSBucket requires manual conversion: does not exist in peer-type
 	// WARNING: in.LoadBalancers requires manual conversion: does not exist in peer-type
 	// WARNING: in.Conditions requires manual conversion: does not exist in peer-type
 	return nil
@@ -944,6 +946,7 @@ func autoConvert_v1beta2_IBMPowerVSMachineSpec_To_v1beta1_IBMPowerVSMachineSpec(
 	// WARNING: in.ProcessorType requires manual conversion: does not exist in peer-type
 	// WARNING: in.Processors requires manual conversion: inconvertible types (k8s.io/apimachinery/pkg/util/intstr.IntOrString vs string)
 	// WARNING: in.MemoryGiB requires manual conversion: does not exist in peer-type
+	// WARNING: in.PlacementGroup requires manual conversion: does not exist in peer-type
 	if err := Convert_v1beta2_IBMPowerVSResourceReference_To_v1beta1_IBMPowerVSResourceReference(&in.Network, &out.Network, s); err != nil {
 		return err
 	}
@@ -1246,6 +1249,7 @@ func autoConvert_v1beta1_IBMVPCClusterSpec_To_v1beta2_IBMVPCClusterSpec(in *IBMV
 	} else {
 		out.ControlPlaneLoadBalancer = nil
 	}
+	// WARNING: in.Network requires manual conversion: does not exist in peer-type
 	return nil
 }
 
@@ -1272,11 +1276,6 @@ func autoConvert_v1beta2_IBMVPCClusterSpec_To_v1beta1_IBMVPCClusterSpec(in *v1be
 	return nil
 }
 
-// Convert_v1beta2_IBMVPCClusterSpec_To_v1beta1_IBMVPCClusterSpec is an autogenerated conversion function.
-func Convert_v1beta2_IBMVPCClusterSpec_To_v1beta1_IBMVPCClusterSpec(in *v1beta2.IBMVPCClusterSpec, out *IBMVPCClusterSpec, s conversion.Scope) error {
-	return autoConvert_v1beta2_IBMVPCClusterSpec_To_v1beta1_IBMVPCClusterSpec(in, out, s)
-}
-
 func autoConvert_v1beta1_IBMVPCClusterStatus_To_v1beta2_IBMVPCClusterStatus(in *IBMVPCClusterStatus, out *v1beta2.IBMVPCClusterStatus, s conversion.Scope) error {
 	if err := Convert_v1beta1_VPC_To_v1beta2_VPC(&in.VPC, &out.VPC, s); err != nil {
 		return err
@@ -1310,15 +1309,12 @@ func autoConvert_v1beta2_IBMVPCClusterStatus_To_v1beta1_IBMVPCClusterStatus(in *
 		return err
 	}
 	out.ControlPlaneLoadBalancerState = VPCLoadBalancerState(in.ControlPlaneLoadBalancerState)
+	// WARNING: in.Network requires manual conversion: does not exist in peer-type
+	// WARNING: in.FailureDomains requires manual conversion: does not exist in peer-type
 	out.Conditions = *(*apiv1beta1.Conditions)(unsafe.Pointer(&in.Conditions))
 	return nil
 }
 
-// Convert_v1beta2_IBMVPCClusterStatus_To_v1beta1_IBMVPCClusterStatus is an autogenerated conversion function.
-func Convert_v1beta2_IBMVPCClusterStatus_To_v1beta1_IBMVPCClusterStatus(in *v1beta2.IBMVPCClusterStatus, out *IBMVPCClusterStatus, s conversion.Scope) error {
-	return autoConvert_v1beta2_IBMVPCClusterStatus_To_v1beta1_IBMVPCClusterStatus(in, out, s)
-}
-
 func autoConvert_v1beta1_IBMVPCMachine_To_v1beta2_IBMVPCMachine(in *IBMVPCMachine, out *v1beta2.IBMVPCMachine, s conversion.Scope) error {
 	out.ObjectMeta = in.ObjectMeta
 	if err := Convert_v1beta1_IBMVPCMachineSpec_To_v1beta2_IBMVPCMachineSpec(&in.Spec, &out.Spec, s); err != nil {
@@ -1414,16 +1410,37 @@ func autoConvert_v1beta1_IBMVPCMachineSpec_To_v1beta2_IBMVPCMachineSpec(in *IBMV
 func autoConvert_v1beta2_IBMVPCMachineSpec_To_v1beta1_IBMVPCMachineSpec(in *v1beta2.IBMVPCMachineSpec, out *IBMVPCMachineSpec, s conversion.Scope) error {
 	out.Name = in.Name
 	// WARNING: in.Image requires manual conversion: inconvertible types (*sigs.k8s.io/cluster-api-provider-ibmcloud/api/v1beta2.IBMVPCResourceReference vs string)
+	// WARNING: in.CatalogOffering requires manual conversion: does not exist in peer-type
 	out.Zone = in.Zone
 	out.Profile = in.Profile
+	// WARNING: in.TotalVolumeBandwidth requires manual conversion: does not exist in peer-type
 	out.BootVolume = (*VPCVolume)(unsafe.Pointer(in.BootVolume))
+	// WARNING: in.DataVolumes requires manual conversion: does not exist in peer-type
+	// WARNING: in.PlacementTarget requires manual conversion: does not exist in peer-type
+	// WARNING: in.DedicatedHost requires manual conversion: does not exist in peer-type
+	// WARNING: in.CapacityReservation requires manual conversion: does not exist in peer-type
 	out.ProviderID = (*string)(unsafe.Pointer(in.ProviderID))
 	if err := Convert_v1beta2_NetworkInterface_To_v1beta1_NetworkInterface(&in.PrimaryNetworkInterface, &out.PrimaryNetworkInterface, s); err != nil {
 		return err
 	}
+	// WARNING: in.NetworkInterfaces requires manual conversion: does not exist in peer-type
 	if err := Convert_Slice_Pointer_v1beta2_IBMVPCResourceReference_To_Slice_Pointer_string(&in.SSHKeys, &out.SSHKeys, s); err != nil {
 		return err
 	}
+	// WARNING: in.BootstrapFormat requires manual conversion: does not exist in peer-type
+	// WARNING: in.MetadataService requires manual conversion: does not exist in peer-type
+	// WARNING: in.ConfidentialCompute requires manual conversion: does not exist in peer-type
+	// WARNING: in.PublicIP requires manual conversion: does not exist in peer-type
+	// WARNING: in.Tags requires manual conversion: does not exist in peer-type
+	// WARNING: in.PruneTags requires manual conversion: does not exist in peer-type
+	// WARNING: in.AdoptExistingInstance requires manual conversion: does not exist in peer-type
+	// WARNING: in.StopBeforeDelete requires manual conversion: does not exist in peer-type
+	// WARNING: in.FailureDomain requires manual conversion: does not exist in peer-type
+	// WARNING: in.LoadBalancerPoolMembers requires manual conversion: does not exist in peer-type
+	// WARNING: in.InstanceTemplate requires manual conversion: does not exist in peer-type
+	// WARNING: in.StartOnCreate requires manual conversion: does not exist in peer-type
+	// WARNING: in.ResourceGroup requires manual conversion: does not exist in peer-type
+	// WARNING: in.DeletionProtection requires manual conversion: does not exist in peer-type
 	return nil
 }
 
@@ -1445,14 +1462,15 @@ func autoConvert_v1beta2_IBMVPCMachineStatus_To_v1beta1_IBMVPCMachineStatus(in *
 	out.Ready = in.Ready
 	out.Addresses = *(*[]v1.NodeAddress)(unsafe.Pointer(&in.Addresses))
 	out.InstanceStatus = in.InstanceStatus
+	// WARNING: in.LoadBalancerPoolMemberID requires manual conversion: does not exist in peer-type
+	// WARNING: in.LoadBalancerPoolMembers requires manual conversion: does not exist in peer-type
+	// WARNING: in.Zone requires manual conversion: does not exist in peer-type
+	// WARNING: in.Profile requires manual conversion: does not exist in peer-type
+	// WARNING: in.PowerState requires manual conversion: does not exist in peer-type
+	// WARNING: in.Conditions requires manual conversion: does not exist in peer-type
 	return nil
 }
 
-// Convert_v1beta2_IBMVPCMachineStatus_To_v1beta1_IBMVPCMachineStatus is an autogenerated conversion function.
-func Convert_v1beta2_IBMVPCMachineStatus_To_v1beta1_IBMVPCMachineStatus(in *v1beta2.IBMVPCMachineStatus, out *IBMVPCMachineStatus, s conversion.Scope) error {
-	return autoConvert_v1beta2_IBMVPCMachineStatus_To_v1beta1_IBMVPCMachineStatus(in, out, s)
-}
-
 func autoConvert_v1beta1_IBMVPCMachineTemplate_To_v1beta2_IBMVPCMachineTemplate(in *IBMVPCMachineTemplate, out *v1beta2.IBMVPCMachineTemplate, s conversion.Scope) error {
 	out.ObjectMeta = in.ObjectMeta
 	if err := Convert_v1beta1_IBMVPCMachineTemplateSpec_To_v1beta2_IBMVPCMachineTemplateSpec(&in.Spec, &out.Spec, s); err != nil {
@@ -1601,14 +1619,12 @@ func Convert_v1beta1_NetworkInterface_To_v1beta2_NetworkInterface(in *NetworkInt
 
 func autoConvert_v1beta2_NetworkInterface_To_v1beta1_NetworkInterface(in *v1beta2.NetworkInterface, out *NetworkInterface, s conversion.Scope) error {
 	out.Subnet = in.Subnet
+	// WARNING: in.SecurityGroups requires manual conversion: does not exist in peer-type
+	// WARNING: in.AllowIPSpoofing requires manual conversion: does not exist in peer-type
+	// WARNING: in.PrimaryIP requires manual conversion: does not exist in peer-type
 	return nil
 }
 
-// Convert_v1beta2_NetworkInterface_To_v1beta1_NetworkInterface is an autogenerated conversion function.
-func Convert_v1beta2_NetworkInterface_To_v1beta1_NetworkInterface(in *v1beta2.NetworkInterface, out *NetworkInterface, s conversion.Scope) error {
-	return autoConvert_v1beta2_NetworkInterface_To_v1beta1_NetworkInterface(in, out, s)
-}
-
 func autoConvert_v1beta1_Subnet_To_v1beta2_Subnet(in *Subnet, out *v1beta2.Subnet, s conversion.Scope) error {
 	out.Ipv4CidrBlock = (*string)(unsafe.Pointer(in.Ipv4CidrBlock))
 	out.Name = (*string)(unsafe.Pointer(in.Name))
@@ -1696,6 +1712,7 @@ func autoConvert_v1beta2_VPCLoadBalancerSpec_To_v1beta1_VPCLoadBalancerSpec(in *
 	// WARNING: in.ID requires manual conversion: does not exist in peer-type
 	// WARNING: in.Public requires manual conversion: does not exist in peer-type
 	// WARNING: in.AdditionalListeners requires manual conversion: does not exist in peer-type
+	// WARNING: in.Pool requires manual conversion: does not exist in peer-type
 	return nil
 }
 
@@ -17,6 +17,9 @@ limitations under the License.
 package v1beta2
 
 import (
+	"math"
+	"net"
+	"regexp"
 	"strconv"
 
 	"k8s.io/apimachinery/pkg/util/intstr"
@@ -66,18 +69,73 @@ func validateIBMPowerVSProcessorValues(resValue intstr.IntOrString) bool {
 			return false
 		}
 	case intstr.String:
-		if val, err := strconv.ParseFloat(resValue.StrVal, 64); err != nil || val < 0.25 {
+		val, err := strconv.ParseFloat(resValue.StrVal, 64)
+		if err != nil || val < 0.25 {
 			return false
 		}
+		// Fractional processors are allocated in increments of 0.25.
+		if math.Mod(val, 0.25) != 0 {
+			return false
+		}
+	}
+
+	return true
+}
+
+// validateIBMPowerVSDedicatedProcessorValues validates that the processors of the Dedicated processor type, which are
+// allocated in whole cores, are not fractional.
+func validateIBMPowerVSDedicatedProcessorValues(processorType PowerVSProcessorType, resValue intstr.IntOrString) bool {
+	if processorType != PowerVSProcessorTypeDedicated || resValue.Type != intstr.String {
+		return true
+	}
+	val, err := strconv.ParseFloat(resValue.StrVal, 64)
+	if err != nil || val < 1 || val != math.Trunc(val) {
+		return false
 	}
 
 	return true
 }
 
+// vpcInstanceProfileRegex matches the VPC instance profile names, made of the family and generation, optional
+// qualifiers and the vCPU x memory (x accelerator) sizing, e.g. bx2-4x16, bx2d-metal-96x384 or gx3-16x80x1l4.
+var vpcInstanceProfileRegex = regexp.MustCompile(`^[a-z]+[0-9]+[a-z]*(-[a-z]+)*-[0-9]+x[0-9]+(x[0-9]+[a-z][a-z0-9]*)?$`)
+
+// encryptionKeyCRNRegex matches the CRN of a Key Protect or Hyper Protect Crypto Services root key, e.g.
+// crn:v1:bluemix:public:kms:us-south:a/<account-id>:<instance-id>:key:<key-id>.
+var encryptionKeyCRNRegex = regexp.MustCompile(`^crn:v1:[^:]+:[^:]+:(kms|hs-crypto):[^:]*:a/[^:]+:[^:]+:key:[^:]+$`)
+
+// securityGroupCRNRegex matches the CRN of a VPC security group, e.g.
+// crn:v1:bluemix:public:is:us-south:a/<account-id>::security-group:<security-group-id>.
+var securityGroupCRNRegex = regexp.MustCompile(`^crn:v1:[^:]*:[^:]*:is:[^:]*:[^:]*:[^:]*:security-group:[^:]+$`)
+
 func defaultIBMVPCMachineSpec(spec *IBMVPCMachineSpec) {
-	if spec.Profile == "" {
+	// The profile of an instance created from an instance template is provided by the template.
+	if spec.Profile == "" && spec.InstanceTemplate == nil {
 		spec.Profile = "bx2-2x8"
 	}
+	if spec.BootstrapFormat == "" {
+		spec.BootstrapFormat = BootstrapFormatCloudInit
+	}
+}
+
+// defaultIBMVPCMachineSubnet defaults an unset primary network interface subnet from the network status of the cluster.
+// Control plane machines are placed in the control plane subnet of the cluster, they are left for the controller to
+// spread with the subnet selection strategy of the cluster when it has several. Worker machines are placed in the
+// cluster subnet.
+func defaultIBMVPCMachineSubnet(spec *IBMVPCMachineSpec, controlPlane bool, status IBMVPCClusterStatus) {
+	if spec.PrimaryNetworkInterface.Subnet != "" {
+		return
+	}
+
+	if controlPlane && status.Network != nil && len(status.Network.ControlPlaneSubnets) > 0 {
+		if len(status.Network.ControlPlaneSubnets) == 1 && status.Network.ControlPlaneSubnets[0].ID != nil {
+			spec.PrimaryNetworkInterface.Subnet = *status.Network.ControlPlaneSubnets[0].ID
+		}
+		return
+	}
+	if status.Subnet.ID != nil {
+		spec.PrimaryNetworkInterface.Subnet = *status.Subnet.ID
+	}
 }
 
 func validateBootVolume(spec IBMVPCMachineSpec) field.ErrorList {
@@ -95,7 +153,171 @@ func validateBootVolume(spec IBMVPCMachineSpec) field.ErrorList {
 		allErrs = append(allErrs, field.Invalid(field.NewPath("spec.bootVolume.iops"), spec, "iops applicable only to volumes using a profile of type `custom`"))
 	}
 
-	//TODO: Add validation for the spec.BootVolume.EncryptionKeyCRN to ensure its in proper IBM Cloud CRN format
+	if spec.BootVolume.EncryptionKeyCRN != "" && !encryptionKeyCRNRegex.MatchString(spec.BootVolume.EncryptionKeyCRN) {
+		allErrs = append(allErrs, field.Invalid(field.NewPath("spec.bootVolume.encryptionKeyCRN"), spec.BootVolume.EncryptionKeyCRN, "must be the CRN of a Key Protect or Hyper Protect Crypto Services root key"))
+	}
+
+	return allErrs
+}
+
+func validateIBMVPCMachinePlacement(spec IBMVPCMachineSpec) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if spec.PlacementTarget != nil && spec.DedicatedHost != nil {
+		allErrs = append(allErrs, field.Forbidden(field.NewPath("spec", "dedicatedHost"), "only one of placementTarget or dedicatedHost may be specified"))
+	}
+
+	if reservation := spec.CapacityReservation; reservation != nil {
+		if spec.DedicatedHost != nil {
+			allErrs = append(allErrs, field.Forbidden(field.NewPath("spec", "capacityReservation"), "capacityReservation may not be specified with dedicatedHost"))
+		}
+		if reservation.Policy == VPCReservationAffinityPolicyManual && reservation.Reservation == nil {
+			allErrs = append(allErrs, field.Required(field.NewPath("spec", "capacityReservation", "reservation"), "reservation is required with the manual policy"))
+		}
+		if reservation.Policy != VPCReservationAffinityPolicyManual && reservation.Reservation != nil {
+			allErrs = append(allErrs, field.Forbidden(field.NewPath("spec", "capacityReservation", "reservation"), "reservation may be specified only with the manual policy"))
+		}
+	}
+
+	return allErrs
+}
+
+func validateIBMVPCMachineImage(spec IBMVPCMachineSpec) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if spec.CatalogOffering != nil && spec.Image != nil && (spec.Image.ID != nil || spec.Image.Name != nil) {
+		allErrs = append(allErrs, field.Forbidden(field.NewPath("spec", "catalogOffering"), "only one of image or catalogOffering may be specified"))
+	}
+
+	return allErrs
+}
+
+func validateIBMVPCMachineProfile(spec IBMVPCMachineSpec) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if spec.InstanceTemplate != nil {
+		return allErrs
+	}
+
+	if spec.Profile == "" {
+		allErrs = append(allErrs, field.Required(field.NewPath("spec", "profile"), "profile must be specified"))
+	} else if !vpcInstanceProfileRegex.MatchString(spec.Profile) {
+		allErrs = append(allErrs, field.Invalid(field.NewPath("spec", "profile"), spec.Profile, "must be a valid VPC instance profile name, e.g. bx2-4x16"))
+	}
+
+	return allErrs
+}
+
+// validateIBMVPCMachineImageReference validates that the machine references an image to be provisioned from,
+// either through image, catalogOffering or instanceTemplate.
+func validateIBMVPCMachineImageReference(spec IBMVPCMachineSpec) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if spec.CatalogOffering != nil || spec.InstanceTemplate != nil {
+		return allErrs
+	}
+
+	if spec.Image == nil {
+		allErrs = append(allErrs, field.Required(field.NewPath("spec", "image"), "one of image or catalogOffering must be specified"))
+		return allErrs
+	}
+
+	if (spec.Image.ID == nil || *spec.Image.ID == "") && (spec.Image.Name == nil || *spec.Image.Name == "") {
+		allErrs = append(allErrs, field.Invalid(field.NewPath("spec", "image"), spec.Image, "one of id or name must be specified"))
+	}
+
+	return allErrs
+}
+
+// validateIBMVPCMachineInstanceTemplate validates that the fields provided by the instance template are not
+// also specified on the machine.
+func validateIBMVPCMachineInstanceTemplate(spec IBMVPCMachineSpec) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if spec.InstanceTemplate == nil {
+		return allErrs
+	}
+
+	if (spec.InstanceTemplate.ID == nil || *spec.InstanceTemplate.ID == "") && (spec.InstanceTemplate.Name == nil || *spec.InstanceTemplate.Name == "") {
+		allErrs = append(allErrs, field.Invalid(field.NewPath("spec", "instanceTemplate"), spec.InstanceTemplate, "one of id or name must be specified"))
+	}
+
+	conflicts := []struct {
+		name string
+		set  bool
+	}{
+		{"image", spec.Image != nil},
+		{"catalogOffering", spec.CatalogOffering != nil},
+		{"profile", spec.Profile != ""},
+		{"networkInterfaces", len(spec.NetworkInterfaces) > 0},
+		{"sshKeys", len(spec.SSHKeys) > 0},
+		{"bootVolume", spec.BootVolume != nil},
+		{"dataVolumes", len(spec.DataVolumes) > 0},
+		{"placementTarget", spec.PlacementTarget != nil},
+		{"dedicatedHost", spec.DedicatedHost != nil},
+		{"totalVolumeBandwidth", spec.TotalVolumeBandwidth != nil},
+		{"confidentialCompute", spec.ConfidentialCompute != nil},
+	}
+	for _, conflict := range conflicts {
+		if conflict.set {
+			allErrs = append(allErrs, field.Forbidden(field.NewPath("spec", conflict.name), "may not be specified with instanceTemplate, it is provided by the instance template"))
+		}
+	}
+
+	return allErrs
+}
+
+func validateIBMVPCMachineNetworkInterfaces(spec IBMVPCMachineSpec) field.ErrorList {
+	var allErrs field.ErrorList
+
+	allErrs = append(allErrs, validateVPCReservedIP(spec.PrimaryNetworkInterface.PrimaryIP, field.NewPath("spec", "primaryNetworkInterface", "primaryIP"))...)
+	allErrs = append(allErrs, validateVPCSecurityGroupReferences(spec.PrimaryNetworkInterface.SecurityGroups, field.NewPath("spec", "primaryNetworkInterface", "securityGroups"))...)
+	for i, networkInterface := range spec.NetworkInterfaces {
+		allErrs = append(allErrs, validateVPCReservedIP(networkInterface.PrimaryIP, field.NewPath("spec", "networkInterfaces").Index(i).Child("primaryIP"))...)
+		allErrs = append(allErrs, validateVPCSecurityGroupReferences(networkInterface.SecurityGroups, field.NewPath("spec", "networkInterfaces").Index(i).Child("securityGroups"))...)
+	}
+
+	return allErrs
+}
+
+// validateVPCSecurityGroupReferences validates that each security group is referenced by exactly one of its ID, name
+// or a well-formed CRN.
+func validateVPCSecurityGroupReferences(securityGroups []VPCSecurityGroupReference, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	for i, securityGroup := range securityGroups {
+		set := 0
+		for _, value := range []*string{securityGroup.ID, securityGroup.Name, securityGroup.CRN} {
+			if value != nil {
+				set++
+			}
+		}
+		if set != 1 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Index(i), securityGroup, "exactly one of id, name or crn must be specified"))
+		}
+
+		if securityGroup.CRN != nil && !securityGroupCRNRegex.MatchString(*securityGroup.CRN) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Index(i).Child("crn"), *securityGroup.CRN, "must be the CRN of a VPC security group"))
+		}
+	}
+
+	return allErrs
+}
+
+func validateVPCReservedIP(reservedIP *VPCReservedIP, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if reservedIP == nil {
+		return allErrs
+	}
+
+	if reservedIP.ID != nil && reservedIP.Address != nil {
+		allErrs = append(allErrs, field.Invalid(fldPath, reservedIP, "only one of id or address may be specified"))
+	}
+
+	if reservedIP.Address != nil && net.ParseIP(*reservedIP.Address) == nil {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("address"), *reservedIP.Address, "must be a valid IP address"))
+	}
 
 	return allErrs
 }
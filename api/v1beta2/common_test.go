@@ -110,6 +110,56 @@ func TestValidateIBMPowerVSProcessorValues(t *testing.T) {
 	}
 }
 
+func TestValidateIBMPowerVSDedicatedProcessorValues(t *testing.T) {
+	type args struct {
+		processorType PowerVSProcessorType
+		n             intstr.IntOrString
+	}
+	tests := []struct {
+		name string
+		args args
+		want bool
+	}{
+		{
+			name: "N is 0.25 for the Shared processor type",
+			args: args{processorType: PowerVSProcessorTypeShared, n: intstr.FromString("0.25")},
+			want: true,
+		},
+		{
+			name: "N is 1.75 for the Capped processor type",
+			args: args{processorType: PowerVSProcessorTypeCapped, n: intstr.FromString("1.75")},
+			want: true,
+		},
+		{
+			name: "N is 2 for the Dedicated processor type",
+			args: args{processorType: PowerVSProcessorTypeDedicated, n: intstr.FromInt(2)},
+			want: true,
+		},
+		{
+			name: "N is \"2\" for the Dedicated processor type",
+			args: args{processorType: PowerVSProcessorTypeDedicated, n: intstr.FromString("2")},
+			want: true,
+		},
+		{
+			name: "N is 1.5 for the Dedicated processor type",
+			args: args{processorType: PowerVSProcessorTypeDedicated, n: intstr.FromString("1.5")},
+			want: false,
+		},
+		{
+			name: "N is 0.5 for the Dedicated processor type",
+			args: args{processorType: PowerVSProcessorTypeDedicated, n: intstr.FromString("0.5")},
+			want: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := validateIBMPowerVSDedicatedProcessorValues(tt.args.processorType, tt.args.n); got != tt.want {
+				t.Errorf("validateIBMPowerVSDedicatedProcessorValues() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
 func Test_validateBootVolume(t *testing.T) {
 	tests := []struct {
 		name      string
@@ -167,3 +217,51 @@ func Test_validateBootVolume(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateIBMVPCMachineProfile(t *testing.T) {
+	tests := []struct {
+		name    string
+		profile string
+		wantErr bool
+	}{
+		{
+			name:    "Profile is bx2-4x16",
+			profile: "bx2-4x16",
+		},
+		{
+			name:    "Profile is bx2d-metal-96x384",
+			profile: "bx2d-metal-96x384",
+		},
+		{
+			name:    "Profile is gx3-16x80x1l4",
+			profile: "gx3-16x80x1l4",
+		},
+		{
+			name:    "Profile is empty",
+			profile: "",
+			wantErr: true,
+		},
+		{
+			name:    "Profile is bx2_4x16",
+			profile: "bx2_4x16",
+			wantErr: true,
+		},
+		{
+			name:    "Profile is BX2-4X16",
+			profile: "BX2-4X16",
+			wantErr: true,
+		},
+		{
+			name:    "Profile is bx2-4",
+			profile: "bx2-4",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if errs := validateIBMVPCMachineProfile(IBMVPCMachineSpec{Profile: tt.profile}); (len(errs) != 0) != tt.wantErr {
+				t.Errorf("validateIBMVPCMachineProfile() errors = %v, wantErr %v", errs, tt.wantErr)
+			}
+		})
+	}
+}
@@ -48,6 +48,48 @@ const (
 	InstanceReadyCondition capiv1beta1.ConditionType = "InstanceReady"
 )
 
+const (
+	// InstanceProvisionedCondition reports on the provisioning of the VPC instance.
+	InstanceProvisionedCondition capiv1beta1.ConditionType = "InstanceProvisioned"
+
+	// BootstrapDataReadyCondition reports on the availability of the bootstrap data for the machine.
+	BootstrapDataReadyCondition capiv1beta1.ConditionType = "BootstrapDataReady"
+
+	// LoadBalancerPoolMemberReadyCondition reports on the control plane machine being an active member of the load balancer pool.
+	LoadBalancerPoolMemberReadyCondition capiv1beta1.ConditionType = "LoadBalancerPoolMemberReady"
+
+	// LoadBalancerPoolMemberHealthyCondition reports on the health checks of the load balancer pool member of the control plane machine.
+	LoadBalancerPoolMemberHealthyCondition capiv1beta1.ConditionType = "LoadBalancerPoolMemberHealthy"
+
+	// InstanceDeletedCondition reports on the deletion of the VPC instance.
+	InstanceDeletedCondition capiv1beta1.ConditionType = "InstanceDeleted"
+)
+
+const (
+	// InstanceDeletionProtectedReason used when the deletion of the instance is refused because deletion protection is enabled.
+	InstanceDeletionProtectedReason = "InstanceDeletionProtected"
+
+	// InstanceStoppingReason used when the deletion of the instance waits for it to be stopped.
+	InstanceStoppingReason = "InstanceStopping"
+
+	// InstanceDeletingReason used when the deletion of the machine waits for the instance to be gone.
+	InstanceDeletingReason = "InstanceDeleting"
+)
+
+const (
+	// LoadBalancerPoolMemberCreationFailedReason used when the load balancer pool member creation failed.
+	LoadBalancerPoolMemberCreationFailedReason = "LoadBalancerPoolMemberCreationFailed"
+
+	// LoadBalancerPoolMemberNotReadyReason used when the load balancer pool member is not yet active.
+	LoadBalancerPoolMemberNotReadyReason = "LoadBalancerPoolMemberNotReady"
+
+	// LoadBalancerPoolMemberUnhealthyReason used when the load balancer pool member is failing its health checks.
+	LoadBalancerPoolMemberUnhealthyReason = "LoadBalancerPoolMemberUnhealthy"
+
+	// LoadBalancerPoolMemberHealthUnknownReason used when the health of the load balancer pool member is not yet known.
+	LoadBalancerPoolMemberHealthUnknownReason = "LoadBalancerPoolMemberHealthUnknown"
+)
+
 const (
 	// WaitingForIBMPowerVSImageReason used when machine is waiting for powervs image to be ready before proceeding.
 	WaitingForIBMPowerVSImageReason = "WaitingForIBMPowerVSImage"
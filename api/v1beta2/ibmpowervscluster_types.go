@@ -210,9 +210,16 @@ type IBMPowerVSClusterStatus struct {
 	// transitGateway is reference to IBM Cloud TransitGateway.
 	TransitGateway *ResourceReference `json:"transitGateway,omitempty"`
 
+	// transitGatewayConnections are references to the connections of the transit gateway attaching the Power VS
+	// workspace and the VPC, keyed by network type.
+	TransitGatewayConnections map[string]ResourceReference `json:"transitGatewayConnections,omitempty"`
+
 	// cosInstance is reference to IBM Cloud COS Instance resource.
 	COSInstance *ResourceReference `json:"cosInstance,omitempty"`
 
+	// cosBucket is reference to IBM Cloud COS bucket, the id holds the bucket name.
+	COSBucket *ResourceReference `json:"cosBucket,omitempty"`
+
 	// loadBalancers reference to IBM Cloud VPC Loadbalancer.
 	LoadBalancers map[string]VPCLoadBalancerStatus `json:"loadBalancers,omitempty"`
 
@@ -303,6 +310,12 @@ type CosInstance struct {
 
 	// bucketRegion is IBM cloud COS bucket region
 	BucketRegion string `json:"bucketRegion,omitempty"`
+
+	// bucketStorageClass is the storage class of the IBM cloud COS bucket to be created.
+	// Defaults to standard when not set.
+	// +kubebuilder:validation:Enum=standard;vault;cold;smart
+	// +optional
+	BucketStorageClass string `json:"bucketStorageClass,omitempty"`
 }
 
 // GetConditions returns the observations of the operational state of the IBMPowerVSCluster resource.
@@ -44,6 +44,16 @@ const (
 	DefaultIgnitionVersion = "2.3"
 )
 
+// PowerVSPlacementGroupPolicy enum attribute to identify the affinity policy of a PowerVS placement group.
+type PowerVSPlacementGroupPolicy string
+
+const (
+	// PowerVSPlacementGroupPolicyAffinity enum property to identify a placement group placing its instances on the same host.
+	PowerVSPlacementGroupPolicyAffinity PowerVSPlacementGroupPolicy = "affinity"
+	// PowerVSPlacementGroupPolicyAntiAffinity enum property to identify a placement group placing its instances on different hosts.
+	PowerVSPlacementGroupPolicyAntiAffinity PowerVSPlacementGroupPolicy = "anti-affinity"
+)
+
 // IBMPowerVSMachineSpec defines the desired state of IBMPowerVSMachine.
 type IBMPowerVSMachineSpec struct {
 	// INSERT ADDITIONAL SPEC FIELDS - desired state of cluster
@@ -130,6 +140,11 @@ type IBMPowerVSMachineSpec struct {
 	// supported network identifier in IBMPowerVSResourceReference are Name, ID and RegEx and that can be obtained from IBM Cloud UI or IBM Cloud cli.
 	Network IBMPowerVSResourceReference `json:"network"`
 
+	// placementGroup is the placement group the instance is created in, control plane machines can be spread
+	// across hosts with an anti-affinity placement group.
+	// +optional
+	PlacementGroup *IBMPowerVSPlacementGroup `json:"placementGroup,omitempty"`
+
 	// ProviderID is the unique identifier as specified by the cloud provider.
 	// +optional
 	ProviderID *string `json:"providerID,omitempty"`
@@ -156,6 +171,19 @@ type IBMPowerVSResourceReference struct {
 	RegEx *string `json:"regex,omitempty"`
 }
 
+// IBMPowerVSPlacementGroup is a reference to an existing PowerVS placement group by name.
+type IBMPowerVSPlacementGroup struct {
+	// name of the placement group.
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+
+	// policy is the affinity policy the placement group is expected to have.
+	// When omitted, the placement group is used whatever its policy.
+	// +kubebuilder:validation:Enum:="affinity";"anti-affinity"
+	// +optional
+	Policy PowerVSPlacementGroupPolicy `json:"policy,omitempty"`
+}
+
 // IBMPowerVSMachineStatus defines the observed state of IBMPowerVSMachine.
 type IBMPowerVSMachineStatus struct {
 	// INSERT ADDITIONAL STATUS FIELD - define observed state of cluster
@@ -125,7 +125,10 @@ func (r *IBMPowerVSMachine) validateIBMPowerVSMachineMemory() *field.Error {
 
 func (r *IBMPowerVSMachine) validateIBMPowerVSMachineProcessors() *field.Error {
 	if res := validateIBMPowerVSProcessorValues(r.Spec.Processors); !res {
-		return field.Invalid(field.NewPath("spec", "processors"), r.Spec.Processors, "Invalid Processors value - must be non-empty and positive floating-point number no lesser than 0.25")
+		return field.Invalid(field.NewPath("spec", "processors"), r.Spec.Processors, "Invalid Processors value - must be non-empty and positive floating-point number no lesser than 0.25, in increments of 0.25")
+	}
+	if res := validateIBMPowerVSDedicatedProcessorValues(r.Spec.ProcessorType, r.Spec.Processors); !res {
+		return field.Invalid(field.NewPath("spec", "processors"), r.Spec.Processors, "Invalid Processors value - must be a whole number no lesser than 1 for the Dedicated processor type")
 	}
 	return nil
 }
@@ -136,6 +136,63 @@ func TestIBMPowerVSMachine_create(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "Should fail to validate IBMPowerVSMachine - processors not in increments of 0.25",
+			powervsMachine: &IBMPowerVSMachine{
+				Spec: IBMPowerVSMachineSpec{
+					ServiceInstanceID: "capi-si-id",
+					SystemType:        "s922",
+					ProcessorType:     PowerVSProcessorTypeShared,
+					Network: IBMPowerVSResourceReference{
+						Name: ptr.To("capi-net"),
+					},
+					Image: &IBMPowerVSResourceReference{
+						ID: ptr.To("capi-image-id"),
+					},
+					Processors: intstr.FromString("0.3"),
+					MemoryGiB:  4,
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Should fail to validate IBMPowerVSMachine - fractional processors for the Dedicated processor type",
+			powervsMachine: &IBMPowerVSMachine{
+				Spec: IBMPowerVSMachineSpec{
+					ServiceInstanceID: "capi-si-id",
+					SystemType:        "s922",
+					ProcessorType:     PowerVSProcessorTypeDedicated,
+					Network: IBMPowerVSResourceReference{
+						Name: ptr.To("capi-net"),
+					},
+					Image: &IBMPowerVSResourceReference{
+						ID: ptr.To("capi-image-id"),
+					},
+					Processors: intstr.FromString("1.5"),
+					MemoryGiB:  4,
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Should successfully validate IBMPowerVSMachine - whole processors for the Dedicated processor type",
+			powervsMachine: &IBMPowerVSMachine{
+				Spec: IBMPowerVSMachineSpec{
+					ServiceInstanceID: "capi-si-id",
+					SystemType:        "s922",
+					ProcessorType:     PowerVSProcessorTypeDedicated,
+					Network: IBMPowerVSResourceReference{
+						Name: ptr.To("capi-net"),
+					},
+					Image: &IBMPowerVSResourceReference{
+						ID: ptr.To("capi-image-id"),
+					},
+					Processors: intstr.FromString("2"),
+					MemoryGiB:  4,
+				},
+			},
+			wantErr: false,
+		},
 		{
 			name: "Should successfully validate IBMPowerVSMachine - valid spec",
 			powervsMachine: &IBMPowerVSMachine{
@@ -128,7 +128,10 @@ func (r *IBMPowerVSMachineTemplate) validateIBMPowerVSMachineTemplateMemory() *f
 
 func (r *IBMPowerVSMachineTemplate) validateIBMPowerVSMachineTemplateProcessors() *field.Error {
 	if res := validateIBMPowerVSProcessorValues(r.Spec.Template.Spec.Processors); !res {
-		return field.Invalid(field.NewPath("spec", "template", "spec", "processors"), r.Spec.Template.Spec.Processors, "Invalid Processors value - must be non-empty and positive floating-point number no lesser than 0.25")
+		return field.Invalid(field.NewPath("spec", "template", "spec", "processors"), r.Spec.Template.Spec.Processors, "Invalid Processors value - must be non-empty and positive floating-point number no lesser than 0.25, in increments of 0.25")
+	}
+	if res := validateIBMPowerVSDedicatedProcessorValues(r.Spec.Template.Spec.ProcessorType, r.Spec.Template.Spec.Processors); !res {
+		return field.Invalid(field.NewPath("spec", "template", "spec", "processors"), r.Spec.Template.Spec.Processors, "Invalid Processors value - must be a whole number no lesser than 1 for the Dedicated processor type")
 	}
 	return nil
 }
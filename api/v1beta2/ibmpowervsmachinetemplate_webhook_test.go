@@ -160,6 +160,52 @@ func TestIBMPowerVSMachineTemplate_create(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "Should fail to validate IBMPowerVSMachineTemplate - fractional processors for the Dedicated processor type",
+			powervsMachineTemplate: &IBMPowerVSMachineTemplate{
+				Spec: IBMPowerVSMachineTemplateSpec{
+					Template: IBMPowerVSMachineTemplateResource{
+						Spec: IBMPowerVSMachineSpec{
+							ServiceInstanceID: "capi-si-id",
+							SystemType:        "s922",
+							ProcessorType:     PowerVSProcessorTypeDedicated,
+							Network: IBMPowerVSResourceReference{
+								Name: ptr.To("capi-net"),
+							},
+							Image: &IBMPowerVSResourceReference{
+								ID: ptr.To("capi-image-id"),
+							},
+							Processors: intstr.FromString("0.5"),
+							MemoryGiB:  4,
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Should successfully validate IBMPowerVSMachineTemplate - whole processors for the Dedicated processor type",
+			powervsMachineTemplate: &IBMPowerVSMachineTemplate{
+				Spec: IBMPowerVSMachineTemplateSpec{
+					Template: IBMPowerVSMachineTemplateResource{
+						Spec: IBMPowerVSMachineSpec{
+							ServiceInstanceID: "capi-si-id",
+							SystemType:        "s922",
+							ProcessorType:     PowerVSProcessorTypeDedicated,
+							Network: IBMPowerVSResourceReference{
+								Name: ptr.To("capi-net"),
+							},
+							Image: &IBMPowerVSResourceReference{
+								ID: ptr.To("capi-image-id"),
+							},
+							Processors: intstr.FromInt(2),
+							MemoryGiB:  4,
+						},
+					},
+				},
+			},
+			wantErr: false,
+		},
 		{
 			name: "Should successfully validate IBMPowerVSMachineTemplate - valid spec",
 			powervsMachineTemplate: &IBMPowerVSMachineTemplate{
@@ -54,6 +54,141 @@ type IBMVPCClusterSpec struct {
 	// ControlPlaneLoadBalancer is optional configuration for customizing control plane behavior.
 	// +optional
 	ControlPlaneLoadBalancer *VPCLoadBalancerSpec `json:"controlPlaneLoadBalancer,omitempty"`
+
+	// Network is optional configuration of the network resources managed for the cluster.
+	// +optional
+	Network *VPCNetworkSpec `json:"network,omitempty"`
+}
+
+// VPCNetworkSpec defines the desired state of the network resources of an IBMVPCCluster.
+type VPCNetworkSpec struct {
+	// SecurityGroupRules are the security group rules managed by the controller.
+	// Rules without a securityGroupID are added to the default security group of the VPC.
+	// Rules removed from the list are deleted, rules which existed before they were added to the list are left untouched.
+	// +optional
+	SecurityGroupRules []*VPCSecurityGroupRule `json:"securityGroupRules,omitempty"`
+
+	// PublicGateway controls whether a public gateway is created in the zone of the cluster subnet and attached to it,
+	// giving workloads outbound access to the internet. Defaults to true.
+	// +optional
+	PublicGateway *bool `json:"publicGateway,omitempty"`
+
+	// NetworkACL is a network ACL created for the cluster and attached to the cluster subnet in place of the VPC
+	// default network ACL. Removing it re-attaches the VPC default network ACL and deletes the created one.
+	// +optional
+	NetworkACL *VPCNetworkACLSpec `json:"networkACL,omitempty"`
+
+	// FlowLogs is a flow log collector created for the cluster, writing the flow logs of the cluster VPC or subnet to
+	// a Cloud Object Storage bucket. Removing it deletes the created flow log collector.
+	// +optional
+	FlowLogs *VPCFlowLogsSpec `json:"flowLogs,omitempty"`
+
+	// AddressPrefixes are the address prefixes of the VPC. When set, a VPC created by the controller has no default
+	// address prefixes, and the cluster subnet is created within the address prefixes of its zone.
+	// +optional
+	AddressPrefixes []VPCAddressPrefix `json:"addressPrefixes,omitempty"`
+
+	// SubnetCIDR is the CIDR of the cluster subnet, it must be within one of the address prefixes of the zone of the
+	// subnet. Defaults to the CIDR of the first address prefix of the zone.
+	// +optional
+	SubnetCIDR string `json:"subnetCIDR,omitempty"`
+
+	// SubnetSelectionStrategy is how machines without a subnet are spread across the control plane subnets of the cluster.
+	// +kubebuilder:default=RoundRobin
+	// +optional
+	SubnetSelectionStrategy SubnetSelectionStrategy `json:"subnetSelectionStrategy,omitempty"`
+}
+
+// SubnetSelectionStrategy describes how a subnet is selected for a machine without a subnet.
+// +kubebuilder:validation:Enum=RoundRobin;LeastLoaded
+type SubnetSelectionStrategy string
+
+const (
+	// SubnetSelectionStrategyRoundRobin selects the control plane subnets of the cluster in turn.
+	SubnetSelectionStrategyRoundRobin SubnetSelectionStrategy = "RoundRobin"
+
+	// SubnetSelectionStrategyLeastLoaded selects the control plane subnet of the cluster with the most available IP addresses.
+	SubnetSelectionStrategyLeastLoaded SubnetSelectionStrategy = "LeastLoaded"
+)
+
+// VPCAddressPrefix defines an address prefix of a VPC.
+type VPCAddressPrefix struct {
+	// Name of the address prefix.
+	// +optional
+	Name string `json:"name,omitempty"`
+
+	// Zone of the address prefix.
+	Zone string `json:"zone"`
+
+	// CIDR of the address prefix.
+	CIDR string `json:"cidr"`
+}
+
+// VPCNetworkACLSpec defines a network ACL managed for the cluster.
+type VPCNetworkACLSpec struct {
+	// Name of the network ACL, defaults to the name of the cluster suffixed with -acl.
+	// +optional
+	Name string `json:"name,omitempty"`
+
+	// Rules of the network ACL, evaluated in order. Traffic not matched by any rule is denied.
+	// +optional
+	Rules []VPCNetworkACLRule `json:"rules,omitempty"`
+}
+
+// VPCNetworkACLRule defines a rule of a network ACL.
+type VPCNetworkACLRule struct {
+	// Action is whether the traffic matched by the rule is allowed or denied.
+	Action VPCSecurityGroupRuleAction `json:"action"`
+
+	// Direction of the traffic matched by the rule.
+	Direction VPCSecurityGroupRuleDirection `json:"direction"`
+
+	// Protocol of the traffic matched by the rule.
+	// +kubebuilder:default=all
+	// +optional
+	Protocol VPCSecurityGroupRuleProtocol `json:"protocol,omitempty"`
+
+	// Source CIDR of the traffic matched by the rule, defaults to 0.0.0.0/0.
+	// +optional
+	Source string `json:"source,omitempty"`
+
+	// Destination CIDR of the traffic matched by the rule, defaults to 0.0.0.0/0.
+	// +optional
+	Destination string `json:"destination,omitempty"`
+
+	// PortRange is the range of destination ports matched by a tcp or udp rule, defaults to all ports.
+	// +optional
+	PortRange *VPCSecurityGroupPortRange `json:"portRange,omitempty"`
+}
+
+// VPCFlowLogsTarget describes the resource a flow log collector collects flow logs for.
+// +kubebuilder:validation:Enum=VPC;Subnet
+type VPCFlowLogsTarget string
+
+const (
+	// VPCFlowLogsTargetVPC collects the flow logs of all the subnets of the cluster VPC.
+	VPCFlowLogsTargetVPC VPCFlowLogsTarget = "VPC"
+
+	// VPCFlowLogsTargetSubnet collects the flow logs of the cluster subnet.
+	VPCFlowLogsTargetSubnet VPCFlowLogsTarget = "Subnet"
+)
+
+// VPCFlowLogsSpec defines a flow log collector managed for the cluster.
+type VPCFlowLogsSpec struct {
+	// Name of the flow log collector, defaults to the name of the cluster suffixed with -flowlogs.
+	// A flow log collector with this name already in the VPC is reused instead of creating another one.
+	// +optional
+	Name string `json:"name,omitempty"`
+
+	// COSBucketName is the name of the Cloud Object Storage bucket the flow logs are written to. The bucket must exist
+	// and the VPC flow logs service must be authorized to write to it.
+	// +kubebuilder:validation:MinLength=1
+	COSBucketName string `json:"cosBucketName"`
+
+	// Target is the resource flow logs are collected for, either the cluster VPC or the cluster subnet.
+	// +kubebuilder:default=VPC
+	// +optional
+	Target VPCFlowLogsTarget `json:"target,omitempty"`
 }
 
 // VPCLoadBalancerSpec defines the desired state of an VPC load balancer.
@@ -82,6 +217,57 @@ type VPCLoadBalancerSpec struct {
 	// +listMapKey=port
 	// +optional
 	AdditionalListeners []AdditionalListenerSpec `json:"additionalListeners,omitempty"`
+
+	// Pool sets the configuration of the control plane load balancer pool.
+	// +optional
+	Pool *VPCLoadBalancerPoolSpec `json:"pool,omitempty"`
+}
+
+// VPCLoadBalancerPoolSpec defines the desired state of a VPC load balancer pool.
+type VPCLoadBalancerPoolSpec struct {
+	// HealthMonitor sets the health check performed on the pool members.
+	// +optional
+	HealthMonitor *VPCLoadBalancerHealthMonitorSpec `json:"healthMonitor,omitempty"`
+}
+
+// VPCLoadBalancerHealthMonitorSpec defines the health check of a VPC load balancer pool.
+type VPCLoadBalancerHealthMonitorSpec struct {
+	// Type is the protocol used for the health check.
+	// +kubebuilder:validation:Enum=tcp;http;https
+	// +kubebuilder:default=tcp
+	// +optional
+	Type string `json:"type,omitempty"`
+
+	// Port is the port used for the health check, defaults to the port of the pool members.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=65535
+	// +optional
+	Port *int64 `json:"port,omitempty"`
+
+	// Delay is the interval in seconds between health checks.
+	// +kubebuilder:validation:Minimum=2
+	// +kubebuilder:validation:Maximum=60
+	// +kubebuilder:default=5
+	// +optional
+	Delay int64 `json:"delay,omitempty"`
+
+	// MaxRetries is the number of failed health checks before a member is marked unhealthy.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=10
+	// +kubebuilder:default=2
+	// +optional
+	MaxRetries int64 `json:"maxRetries,omitempty"`
+
+	// Timeout is the time in seconds to wait for a health check response, must be less than Delay.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=59
+	// +kubebuilder:default=2
+	// +optional
+	Timeout int64 `json:"timeout,omitempty"`
+
+	// URLPath is the path used for http and https health checks.
+	// +optional
+	URLPath string `json:"urlPath,omitempty"`
 }
 
 // AdditionalListenerSpec defines the desired state of an
@@ -104,6 +290,29 @@ type VPCSecurityGroupStatus struct {
 	ControllerCreated *bool `json:"controllerCreated,omitempty"`
 }
 
+// VPCNetworkStatus defines the observed state of the network resources of an IBMVPCCluster.
+type VPCNetworkStatus struct {
+	// SecurityGroups are the security groups holding rules managed by the controller, with the IDs of those rules.
+	// +optional
+	SecurityGroups []VPCSecurityGroupStatus `json:"securityGroups,omitempty"`
+
+	// PublicGateways are the public gateways attached to the cluster subnets, keyed by zone.
+	// +optional
+	PublicGateways map[string]ResourceReference `json:"publicGateways,omitempty"`
+
+	// NetworkACL is the network ACL attached to the cluster subnet.
+	// +optional
+	NetworkACL *ResourceReference `json:"networkACL,omitempty"`
+
+	// FlowLogCollector is the flow log collector created for the cluster.
+	// +optional
+	FlowLogCollector *ResourceReference `json:"flowLogCollector,omitempty"`
+
+	// ControlPlaneSubnets are the subnets of the cluster across zones, machines without a subnet are spread across them.
+	// +optional
+	ControlPlaneSubnets []Subnet `json:"controlPlaneSubnets,omitempty"`
+}
+
 // VPCLoadBalancerStatus defines the status VPC load balancer.
 type VPCLoadBalancerStatus struct {
 	// id of VPC load balancer.
@@ -135,6 +344,15 @@ type IBMVPCClusterStatus struct {
 	// +optional
 	ControlPlaneLoadBalancerState VPCLoadBalancerState `json:"controlPlaneLoadBalancerState,omitempty"`
 
+	// Network is the status of the network resources managed for the cluster.
+	// +optional
+	Network *VPCNetworkStatus `json:"network,omitempty"`
+
+	// FailureDomains are the zones machines of the cluster can be placed in.
+	// Only the zone of the cluster subnet is eligible for control plane machines.
+	// +optional
+	FailureDomains capiv1beta1.FailureDomains `json:"failureDomains,omitempty"`
+
 	// Conditions defines current service state of the load balancer.
 	// +optional
 	Conditions capiv1beta1.Conditions `json:"conditions,omitempty"`
@@ -19,6 +19,8 @@ package v1beta2
 import (
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	capiv1beta1 "sigs.k8s.io/cluster-api/api/v1beta1"
 )
 
 // NOTE: json tags are required.  Any new fields you add must have json tags for the fields to be serialized.
@@ -28,6 +30,43 @@ const (
 	MachineFinalizer = "ibmvpcmachine.infrastructure.cluster.x-k8s.io"
 )
 
+// BootstrapFormat describes the format of the bootstrap data passed to the instance as user data.
+// +kubebuilder:validation:Enum=cloud-init;ignition
+type BootstrapFormat string
+
+const (
+	// BootstrapFormatCloudInit indicates the bootstrap data is a cloud-init config.
+	BootstrapFormatCloudInit BootstrapFormat = "cloud-init"
+
+	// BootstrapFormatIgnition indicates the bootstrap data is an ignition config.
+	BootstrapFormatIgnition BootstrapFormat = "ignition"
+)
+
+// VPCInstancePowerState describes the power state of an instance.
+type VPCInstancePowerState string
+
+const (
+	// VPCInstancePowerStateRunning indicates the instance is running.
+	VPCInstancePowerStateRunning VPCInstancePowerState = "running"
+
+	// VPCInstancePowerStateStopped indicates the instance is stopped.
+	VPCInstancePowerStateStopped VPCInstancePowerState = "stopped"
+)
+
+// VPCReservationAffinityPolicy describes how an instance consumes capacity reservations.
+type VPCReservationAffinityPolicy string
+
+const (
+	// VPCReservationAffinityPolicyAutomatic indicates the instance consumes any matching active reservation.
+	VPCReservationAffinityPolicyAutomatic VPCReservationAffinityPolicy = "automatic"
+
+	// VPCReservationAffinityPolicyManual indicates the instance consumes only the specified reservation.
+	VPCReservationAffinityPolicyManual VPCReservationAffinityPolicy = "manual"
+
+	// VPCReservationAffinityPolicyDisabled indicates the instance does not consume reservations.
+	VPCReservationAffinityPolicyDisabled VPCReservationAffinityPolicy = "disabled"
+)
+
 // IBMVPCMachineSpec defines the desired state of IBMVPCMachine.
 type IBMVPCMachineSpec struct {
 	// INSERT ADDITIONAL SPEC FIELDS - desired state of cluster
@@ -38,7 +77,14 @@ type IBMVPCMachineSpec struct {
 
 	// Image is the OS image which would be install on the instance.
 	// ID will take higher precedence over Name if both specified.
-	Image *IBMVPCResourceReference `json:"image"`
+	// Image is not required when CatalogOffering is specified.
+	// +optional
+	Image *IBMVPCResourceReference `json:"image,omitempty"`
+
+	// CatalogOffering is the catalog offering version the instance should be provisioned from.
+	// Only one of Image or CatalogOffering may be specified.
+	// +optional
+	CatalogOffering *IBMVPCCatalogOffering `json:"catalogOffering,omitempty"`
 
 	// Zone is the place where the instance should be created. Example: us-south-3
 	// TODO: Actually zone is transparent to user. The field user can access is location. Example: Dallas 2
@@ -49,20 +95,155 @@ type IBMVPCMachineSpec struct {
 	// +optional
 	Profile string `json:"profile,omitempty"`
 
+	// TotalVolumeBandwidth is the amount of bandwidth in megabits per second allocated exclusively to the volumes
+	// of the instance. It must be allowed by the profile, the default of the profile is used when not set.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	TotalVolumeBandwidth *int64 `json:"totalVolumeBandwidth,omitempty"`
+
 	// BootVolume contains machines's boot volume configurations like size, iops etc..
 	// +optional
 	BootVolume *VPCVolume `json:"bootVolume,omitempty"`
 
+	// DataVolumes contains the additional volumes to be created and attached to the instance.
+	// +optional
+	DataVolumes []VPCVolume `json:"dataVolumes,omitempty"`
+
+	// PlacementTarget is the placement group the instance should be created in.
+	// ID will take higher precedence over Name if both specified.
+	// +optional
+	PlacementTarget *IBMVPCResourceReference `json:"placementTarget,omitempty"`
+
+	// DedicatedHost is the dedicated host the instance should be created on.
+	// ID will take higher precedence over Name if both specified.
+	// Only one of PlacementTarget or DedicatedHost may be specified.
+	// +optional
+	DedicatedHost *IBMVPCResourceReference `json:"dedicatedHost,omitempty"`
+
+	// CapacityReservation configures the capacity reservations the instance consumes.
+	// It may not be specified with DedicatedHost.
+	// +optional
+	CapacityReservation *VPCCapacityReservation `json:"capacityReservation,omitempty"`
+
 	// ProviderID is the unique identifier as specified by the cloud provider.
 	// +optional
 	ProviderID *string `json:"providerID,omitempty"`
 
 	// PrimaryNetworkInterface is required to specify subnet.
+	// When the subnet is not set, it defaults to the subnet of the IBMVPCCluster.
 	PrimaryNetworkInterface NetworkInterface `json:"primaryNetworkInterface,omitempty"`
 
+	// NetworkInterfaces are the secondary network interfaces to attach to the instance.
+	// Subnet may be specified by ID or Name.
+	// +optional
+	NetworkInterfaces []NetworkInterface `json:"networkInterfaces,omitempty"`
+
 	// SSHKeys is the SSH pub keys that will be used to access VM.
 	// ID will take higher precedence over Name if both specified.
 	SSHKeys []*IBMVPCResourceReference `json:"sshKeys,omitempty"`
+
+	// BootstrapFormat is the format of the bootstrap data passed to the instance as user data.
+	// When set to ignition, the instance metadata service is enabled on the instance unless MetadataService is set.
+	// +kubebuilder:default=cloud-init
+	// +optional
+	BootstrapFormat BootstrapFormat `json:"bootstrapFormat,omitempty"`
+
+	// MetadataService configures the metadata service of the instance.
+	// When not set, the metadata service is enabled for ignition bootstrap data and disabled otherwise.
+	// +optional
+	MetadataService *VPCMetadataService `json:"metadataService,omitempty"`
+
+	// ConfidentialCompute configures secure boot and the default trusted profile of the instance.
+	// Secure boot is supported only by the third generation instance profiles and later.
+	// +optional
+	ConfidentialCompute *VPCConfidentialCompute `json:"confidentialCompute,omitempty"`
+
+	// PublicIP indicates whether a floating IP should be reserved and bound to the instance's primary network interface.
+	// +optional
+	PublicIP bool `json:"publicIP,omitempty"`
+
+	// Tags are the user tags to attach to the instance using IBM Cloud Global Tagging.
+	// Tags removed from the instance outside of the provider are re-attached on the next reconcile.
+	// +optional
+	Tags []string `json:"tags,omitempty"`
+
+	// PruneTags indicates whether the user tags attached to the instance which are not in Tags are detached from it
+	// on reconcile, so that the tags of the instance are managed only by the provider.
+	// +optional
+	PruneTags bool `json:"pruneTags,omitempty"`
+
+	// AdoptExistingInstance indicates whether an existing instance with the name of the machine should be adopted
+	// instead of creating one. No instance is created when it does not exist, and the adopted instance is not
+	// deleted when the machine is deleted.
+	// +optional
+	AdoptExistingInstance bool `json:"adoptExistingInstance,omitempty"`
+
+	// StopBeforeDelete indicates whether the instance should be stopped before it is deleted, giving the workloads
+	// on it a chance to shut down gracefully. The instance is deleted anyway when it does not stop in time.
+	// +optional
+	StopBeforeDelete bool `json:"stopBeforeDelete,omitempty"`
+
+	// FailureDomain is the failure domain the instance is running in, it is set to the zone of the instance.
+	// +optional
+	FailureDomain *string `json:"failureDomain,omitempty"`
+
+	// LoadBalancerPoolMembers sets the load balancer pools the instance is registered in as a member.
+	// Control plane instances are registered in the first pool on the API server port when it is not set.
+	// +optional
+	LoadBalancerPoolMembers []VPCLoadBalancerPoolMemberTarget `json:"loadBalancerPoolMembers,omitempty"`
+
+	// InstanceTemplate is the VPC instance template the instance is created from.
+	// The template provides the image, profile, network interfaces, SSH keys, volumes, placement and confidential computing
+	// settings of the instance, so none of Image, CatalogOffering, Profile, NetworkInterfaces, SSHKeys, BootVolume, DataVolumes,
+	// PlacementTarget, DedicatedHost, TotalVolumeBandwidth or ConfidentialCompute may be specified with it.
+	// +optional
+	InstanceTemplate *IBMVPCResourceReference `json:"instanceTemplate,omitempty"`
+
+	// StartOnCreate indicates whether the instance is left running once it is created.
+	// When set to false, the instance is stopped right after it is created so that it can be configured before its first boot.
+	// An existing instance, like an adopted one, is left in its power state.
+	// Default is set as true
+	// +kubebuilder:default=true
+	// +optional
+	StartOnCreate *bool `json:"startOnCreate,omitempty"`
+
+	// ResourceGroup is the ID of the resource group the instance and its floating IP are created in.
+	// Defaults to the resource group of the cluster.
+	// +optional
+	ResourceGroup string `json:"resourceGroup,omitempty"`
+
+	// DeletionProtection prevents the instance from being deleted while it is set, the deletion of the machine is
+	// held until it is unset.
+	// +optional
+	DeletionProtection bool `json:"deletionProtection,omitempty"`
+}
+
+// VPCLoadBalancerPoolMemberTarget defines a load balancer pool the instance is registered in.
+type VPCLoadBalancerPoolMemberTarget struct {
+	// PoolName is the name of the load balancer pool, defaults to the first pool of the load balancer.
+	// +optional
+	PoolName string `json:"poolName,omitempty"`
+
+	// Port is the port of the instance the pool forwards traffic to.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=65535
+	Port int64 `json:"port"`
+
+	// Weight is the weight of the pool member, used by the weighted round robin algorithm of the pool.
+	// Defaults to 50 when it is not set.
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=100
+	// +optional
+	Weight *int64 `json:"weight,omitempty"`
+}
+
+// VPCLoadBalancerPoolMemberReference identifies a load balancer pool member created for the instance.
+type VPCLoadBalancerPoolMemberReference struct {
+	// PoolID is the ID of the load balancer pool the member belongs to.
+	PoolID string `json:"poolID"`
+
+	// ID is the ID of the load balancer pool member.
+	ID string `json:"id"`
 }
 
 // IBMVPCResourceReference is a reference to a specific VPC resource by ID or Name
@@ -80,6 +261,52 @@ type IBMVPCResourceReference struct {
 	Name *string `json:"name,omitempty"`
 }
 
+// IBMVPCCatalogOffering is a reference to a version of an IBM Cloud catalog offering.
+type IBMVPCCatalogOffering struct {
+	// VersionCRN is the CRN of the catalog offering version to provision the instance from.
+	// +kubebuilder:validation:MinLength=1
+	VersionCRN string `json:"versionCRN"`
+}
+
+// VPCConfidentialCompute configures secure boot and the default trusted profile of an instance.
+type VPCConfidentialCompute struct {
+	// SecureBoot indicates whether the instance is booted with secure boot, the image must support secure boot.
+	// +optional
+	SecureBoot bool `json:"secureBoot,omitempty"`
+
+	// TrustedProfile is the ID or CRN of the default IAM trusted profile of the instance.
+	// +optional
+	TrustedProfile *string `json:"trustedProfile,omitempty"`
+}
+
+// VPCCapacityReservation configures the capacity reservations an instance consumes.
+type VPCCapacityReservation struct {
+	// Policy is the reservation affinity policy of the instance.
+	// Default is set as automatic
+	// +kubebuilder:validation:Enum=automatic;manual;disabled
+	// +kubebuilder:default=automatic
+	// +optional
+	Policy VPCReservationAffinityPolicy `json:"policy,omitempty"`
+
+	// Reservation is the reservation the instance consumes, it is required with the manual policy and may not be
+	// specified with the others. The reservation must be active and have the same profile and zone as the instance.
+	// ID will take higher precedence over Name if both specified.
+	// +optional
+	Reservation *IBMVPCResourceReference `json:"reservation,omitempty"`
+}
+
+// VPCMetadataService configures the metadata service of an instance.
+type VPCMetadataService struct {
+	// Enabled indicates whether the metadata service endpoint is available to the instance.
+	Enabled bool `json:"enabled"`
+
+	// ProtocolVersion is the communication protocol of the metadata service endpoint, it applies only when the
+	// metadata service is enabled.
+	// +kubebuilder:validation:Enum=http;https
+	// +optional
+	ProtocolVersion string `json:"protocolVersion,omitempty"`
+}
+
 // VPCVolume defines the volume information for the instance.
 type VPCVolume struct {
 	// DeleteVolumeOnInstanceDelete If set to true, when deleting the instance the volume will also be deleted.
@@ -138,6 +365,30 @@ type IBMVPCMachineStatus struct {
 	// InstanceStatus is the status of the GCP instance for this machine.
 	// +optional
 	InstanceStatus string `json:"instanceState,omitempty"`
+
+	// LoadBalancerPoolMemberID is the ID of the load balancer pool member created for this machine.
+	// +optional
+	LoadBalancerPoolMemberID string `json:"loadBalancerPoolMemberID,omitempty"`
+
+	// LoadBalancerPoolMembers are the load balancer pool members created for this machine in each pool.
+	// +optional
+	LoadBalancerPoolMembers []VPCLoadBalancerPoolMemberReference `json:"loadBalancerPoolMembers,omitempty"`
+
+	// Zone is the name of the zone the instance was created in.
+	// +optional
+	Zone string `json:"zone,omitempty"`
+
+	// Profile is the name of the profile the instance was created with.
+	// +optional
+	Profile string `json:"profile,omitempty"`
+
+	// PowerState is the power state the instance was intended to be in once it was created.
+	// +optional
+	PowerState VPCInstancePowerState `json:"powerState,omitempty"`
+
+	// Conditions defines current service state of the IBMVPCMachine.
+	// +optional
+	Conditions capiv1beta1.Conditions `json:"conditions,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -155,6 +406,16 @@ type IBMVPCMachine struct {
 	Status IBMVPCMachineStatus `json:"status,omitempty"`
 }
 
+// GetConditions returns the observations of the operational state of the IBMVPCMachine resource.
+func (r *IBMVPCMachine) GetConditions() capiv1beta1.Conditions {
+	return r.Status.Conditions
+}
+
+// SetConditions sets the underlying service state of the IBMVPCMachine to the predescribed clusterv1.Conditions.
+func (r *IBMVPCMachine) SetConditions(conditions capiv1beta1.Conditions) {
+	r.Status.Conditions = conditions
+}
+
 //+kubebuilder:object:root=true
 
 // IBMVPCMachineList contains a list of IBMVPCMachine.
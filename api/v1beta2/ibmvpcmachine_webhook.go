@@ -17,10 +17,18 @@ limitations under the License.
 package v1beta2
 
 import (
+	"context"
+	"fmt"
+	"reflect"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 
+	capiv1beta1 "sigs.k8s.io/cluster-api/api/v1beta1"
+
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
 	"sigs.k8s.io/controller-runtime/pkg/webhook"
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
@@ -32,6 +40,7 @@ var ibmvpcmachinelog = logf.Log.WithName("ibmvpcmachine-resource")
 func (r *IBMVPCMachine) SetupWebhookWithManager(mgr ctrl.Manager) error {
 	return ctrl.NewWebhookManagedBy(mgr).
 		For(r).
+		WithDefaulter(&ibmVPCMachineDefaulter{client: mgr.GetClient()}).
 		Complete()
 }
 
@@ -45,6 +54,64 @@ func (r *IBMVPCMachine) Default() {
 	defaultIBMVPCMachineSpec(&r.Spec)
 }
 
+// ibmVPCMachineDefaulter defaults the IBMVPCMachines, including the fields defaulted from the status of their cluster.
+type ibmVPCMachineDefaulter struct {
+	client client.Reader
+}
+
+var _ admission.CustomDefaulter = &ibmVPCMachineDefaulter{}
+
+// Default implements admission.CustomDefaulter so a webhook will be registered for the type.
+func (d *ibmVPCMachineDefaulter) Default(ctx context.Context, obj runtime.Object) error {
+	machine, ok := obj.(*IBMVPCMachine)
+	if !ok {
+		return apierrors.NewBadRequest(fmt.Sprintf("expected an IBMVPCMachine but got a %T", obj))
+	}
+	machine.Default()
+
+	if machine.Spec.PrimaryNetworkInterface.Subnet != "" {
+		return nil
+	}
+	vpcCluster, err := d.getIBMVPCCluster(ctx, machine)
+	if err != nil {
+		return err
+	}
+	if vpcCluster == nil {
+		return nil
+	}
+	_, controlPlane := machine.Labels[capiv1beta1.MachineControlPlaneNameLabel]
+	defaultIBMVPCMachineSubnet(&machine.Spec, controlPlane, vpcCluster.Status)
+	return nil
+}
+
+// getIBMVPCCluster returns the IBMVPCCluster of the cluster the machine belongs to, or nil when it is not known yet.
+func (d *ibmVPCMachineDefaulter) getIBMVPCCluster(ctx context.Context, machine *IBMVPCMachine) (*IBMVPCCluster, error) {
+	clusterName, ok := machine.Labels[capiv1beta1.ClusterNameLabel]
+	if !ok {
+		return nil, nil
+	}
+
+	cluster := &capiv1beta1.Cluster{}
+	if err := d.client.Get(ctx, client.ObjectKey{Namespace: machine.Namespace, Name: clusterName}, cluster); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get cluster %s/%s: %w", machine.Namespace, clusterName, err)
+	}
+	if cluster.Spec.InfrastructureRef == nil {
+		return nil, nil
+	}
+
+	vpcCluster := &IBMVPCCluster{}
+	if err := d.client.Get(ctx, client.ObjectKey{Namespace: machine.Namespace, Name: cluster.Spec.InfrastructureRef.Name}, vpcCluster); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get IBMVPCCluster %s/%s: %w", machine.Namespace, cluster.Spec.InfrastructureRef.Name, err)
+	}
+	return vpcCluster, nil
+}
+
 // TODO(user): change verbs to "verbs=create;update;delete" if you want to enable deletion validation.
 //+kubebuilder:webhook:verbs=create;update,path=/validate-infrastructure-cluster-x-k8s-io-v1beta2-ibmvpcmachine,mutating=false,failurePolicy=fail,groups=infrastructure.cluster.x-k8s.io,resources=ibmvpcmachines,versions=v1beta2,name=vibmvpcmachine.kb.io,sideEffects=None,admissionReviewVersions=v1;v1beta1
 
@@ -56,14 +123,24 @@ func (r *IBMVPCMachine) ValidateCreate() (admission.Warnings, error) {
 
 	var allErrs field.ErrorList
 	allErrs = append(allErrs, r.validateIBMVPCMachineBootVolume()...)
+	allErrs = append(allErrs, r.validateIBMVPCMachinePlacement()...)
+	allErrs = append(allErrs, r.validateIBMVPCMachineImage()...)
+	allErrs = append(allErrs, r.validateIBMVPCMachineNetworkInterfaces()...)
+	allErrs = append(allErrs, r.validateIBMVPCMachineProfile()...)
+	allErrs = append(allErrs, r.validateIBMVPCMachineInstanceTemplate()...)
 
 	return nil, aggregateObjErrors(r.GroupVersionKind().GroupKind(), r.Name, allErrs)
 }
 
 // ValidateUpdate implements webhook.Validator so a webhook will be registered for the type.
-func (r *IBMVPCMachine) ValidateUpdate(_ runtime.Object) (admission.Warnings, error) {
+func (r *IBMVPCMachine) ValidateUpdate(oldRaw runtime.Object) (admission.Warnings, error) {
 	ibmvpcmachinelog.Info("validate update", "name", r.Name)
-	return nil, nil
+	old, ok := oldRaw.(*IBMVPCMachine)
+	if !ok {
+		return nil, apierrors.NewBadRequest(fmt.Sprintf("expected an IBMVPCMachine but got a %T", oldRaw))
+	}
+
+	return nil, aggregateObjErrors(r.GroupVersionKind().GroupKind(), r.Name, r.validateIBMVPCMachineImmutableFields(old))
 }
 
 // ValidateDelete implements webhook.Validator so a webhook will be registered for the type.
@@ -75,3 +152,55 @@ func (r *IBMVPCMachine) ValidateDelete() (admission.Warnings, error) {
 func (r *IBMVPCMachine) validateIBMVPCMachineBootVolume() field.ErrorList {
 	return validateBootVolume(r.Spec)
 }
+
+func (r *IBMVPCMachine) validateIBMVPCMachinePlacement() field.ErrorList {
+	return validateIBMVPCMachinePlacement(r.Spec)
+}
+
+func (r *IBMVPCMachine) validateIBMVPCMachineImage() field.ErrorList {
+	var allErrs field.ErrorList
+	allErrs = append(allErrs, validateIBMVPCMachineImageReference(r.Spec)...)
+	allErrs = append(allErrs, validateIBMVPCMachineImage(r.Spec)...)
+	return allErrs
+}
+
+func (r *IBMVPCMachine) validateIBMVPCMachineNetworkInterfaces() field.ErrorList {
+	return validateIBMVPCMachineNetworkInterfaces(r.Spec)
+}
+
+func (r *IBMVPCMachine) validateIBMVPCMachineProfile() field.ErrorList {
+	return validateIBMVPCMachineProfile(r.Spec)
+}
+
+func (r *IBMVPCMachine) validateIBMVPCMachineInstanceTemplate() field.ErrorList {
+	return validateIBMVPCMachineInstanceTemplate(r.Spec)
+}
+
+// validateIBMVPCMachineImmutableFields rejects changes to the fields which cannot be changed on the instance
+// once it is provisioned.
+func (r *IBMVPCMachine) validateIBMVPCMachineImmutableFields(old *IBMVPCMachine) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if old.Status.InstanceID == "" {
+		return allErrs
+	}
+
+	if !reflect.DeepEqual(r.Spec.Image, old.Spec.Image) {
+		allErrs = append(allErrs, field.Forbidden(field.NewPath("spec", "image"), "cannot be changed once the instance is provisioned"))
+	}
+	if !reflect.DeepEqual(r.Spec.CatalogOffering, old.Spec.CatalogOffering) {
+		allErrs = append(allErrs, field.Forbidden(field.NewPath("spec", "catalogOffering"), "cannot be changed once the instance is provisioned"))
+	}
+	if !reflect.DeepEqual(r.Spec.InstanceTemplate, old.Spec.InstanceTemplate) {
+		allErrs = append(allErrs, field.Forbidden(field.NewPath("spec", "instanceTemplate"), "cannot be changed once the instance is provisioned"))
+	}
+	if r.Spec.Profile != old.Spec.Profile {
+		allErrs = append(allErrs, field.Forbidden(field.NewPath("spec", "profile"), "cannot be changed once the instance is provisioned"))
+	}
+	// An unset subnet is defaulted to the cluster subnet during reconcile, so only a change to a set subnet is rejected.
+	if old.Spec.PrimaryNetworkInterface.Subnet != "" && r.Spec.PrimaryNetworkInterface.Subnet != old.Spec.PrimaryNetworkInterface.Subnet {
+		allErrs = append(allErrs, field.Forbidden(field.NewPath("spec", "primaryNetworkInterface", "subnet"), "cannot be changed once the instance is provisioned"))
+	}
+
+	return allErrs
+}
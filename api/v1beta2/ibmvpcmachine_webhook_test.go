@@ -21,18 +21,106 @@ import (
 	"testing"
 
 	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	capiv1beta1 "sigs.k8s.io/cluster-api/api/v1beta1"
 	"sigs.k8s.io/cluster-api/util/defaulting"
 )
 
 func TestVPCMachine_default(t *testing.T) {
 	g := NewWithT(t)
-	vpcMachine := &IBMVPCMachine{ObjectMeta: metav1.ObjectMeta{Name: "capi-machine", Namespace: "default"}}
+	vpcMachine := &IBMVPCMachine{
+		ObjectMeta: metav1.ObjectMeta{Name: "capi-machine", Namespace: "default"},
+		Spec: IBMVPCMachineSpec{
+			Image: &IBMVPCResourceReference{
+				ID: ptr.To("capi-image"),
+			},
+		},
+	}
 	t.Run("Defaults for IBMVPCMachine", defaulting.DefaultValidateTest(vpcMachine))
 	vpcMachine.Default()
 	g.Expect(vpcMachine.Spec.Profile).To(BeEquivalentTo("bx2-2x8"))
 }
 
+func TestIBMVPCMachine_defaultSubnet(t *testing.T) {
+	cluster := &capiv1beta1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{Name: "capi-cluster", Namespace: "default"},
+		Spec: capiv1beta1.ClusterSpec{
+			InfrastructureRef: &corev1.ObjectReference{Name: "capi-vpc-cluster", Namespace: "default"},
+		},
+	}
+	vpcCluster := &IBMVPCCluster{
+		ObjectMeta: metav1.ObjectMeta{Name: "capi-vpc-cluster", Namespace: "default"},
+		Status: IBMVPCClusterStatus{
+			Subnet: Subnet{ID: ptr.To("worker-subnet-id")},
+			Network: &VPCNetworkStatus{
+				ControlPlaneSubnets: []Subnet{{ID: ptr.To("control-plane-subnet-id")}},
+			},
+		},
+	}
+	newMachine := func(labels map[string]string, subnet string) *IBMVPCMachine {
+		return &IBMVPCMachine{
+			ObjectMeta: metav1.ObjectMeta{Name: "capi-machine", Namespace: "default", Labels: labels},
+			Spec: IBMVPCMachineSpec{
+				PrimaryNetworkInterface: NetworkInterface{Subnet: subnet},
+			},
+		}
+	}
+
+	tests := []struct {
+		name       string
+		machine    *IBMVPCMachine
+		objects    []client.Object
+		wantSubnet string
+	}{
+		{
+			name: "Should default control-plane machine subnet to the first control-plane subnet",
+			machine: newMachine(map[string]string{
+				capiv1beta1.ClusterNameLabel:             "capi-cluster",
+				capiv1beta1.MachineControlPlaneNameLabel: "capi-control-plane",
+			}, ""),
+			objects:    []client.Object{cluster, vpcCluster},
+			wantSubnet: "control-plane-subnet-id",
+		},
+		{
+			name:       "Should default worker machine subnet to the cluster subnet",
+			machine:    newMachine(map[string]string{capiv1beta1.ClusterNameLabel: "capi-cluster"}, ""),
+			objects:    []client.Object{cluster, vpcCluster},
+			wantSubnet: "worker-subnet-id",
+		},
+		{
+			name: "Should not override explicitly set machine subnet",
+			machine: newMachine(map[string]string{
+				capiv1beta1.ClusterNameLabel:             "capi-cluster",
+				capiv1beta1.MachineControlPlaneNameLabel: "capi-control-plane",
+			}, "machine-subnet-id"),
+			objects:    []client.Object{cluster, vpcCluster},
+			wantSubnet: "machine-subnet-id",
+		},
+		{
+			name:       "Should not default machine subnet when the cluster does not exist",
+			machine:    newMachine(map[string]string{capiv1beta1.ClusterNameLabel: "capi-cluster"}, ""),
+			wantSubnet: "",
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			defaulter := &ibmVPCMachineDefaulter{
+				client: fake.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(tc.objects...).Build(),
+			}
+			g.Expect(defaulter.Default(context.TODO(), tc.machine)).To(Succeed())
+			g.Expect(tc.machine.Spec.PrimaryNetworkInterface.Subnet).To(Equal(tc.wantSubnet))
+			g.Expect(tc.machine.Spec.Profile).To(Equal("bx2-2x8"))
+		})
+	}
+}
+
 func TestIBMVPCMachine_Create(t *testing.T) {
 	tests := []struct {
 		name    string
@@ -46,7 +134,9 @@ func TestIBMVPCMachine_Create(t *testing.T) {
 					BootVolume: &VPCVolume{
 						SizeGiB: 10,
 					},
-					Image: &IBMVPCResourceReference{},
+					Image: &IBMVPCResourceReference{
+						ID: ptr.To("foo-image-id"),
+					},
 				},
 			},
 			wantErr: false,
@@ -58,6 +148,419 @@ func TestIBMVPCMachine_Create(t *testing.T) {
 					BootVolume: &VPCVolume{
 						SizeGiB: 1,
 					},
+					Image: &IBMVPCResourceReference{
+						ID: ptr.To("foo-image-id"),
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Create a IBMVPCMachine with a root key EncryptionKeyCRN BootVolume",
+			machine: &IBMVPCMachine{
+				Spec: IBMVPCMachineSpec{
+					BootVolume: &VPCVolume{
+						SizeGiB:          10,
+						EncryptionKeyCRN: "crn:v1:bluemix:public:kms:us-south:a/foo-account:foo-kms-instance:key:foo-key",
+					},
+					Image: &IBMVPCResourceReference{
+						ID: ptr.To("foo-image-id"),
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Create a IBMVPCMachine with a key management instance EncryptionKeyCRN BootVolume",
+			machine: &IBMVPCMachine{
+				Spec: IBMVPCMachineSpec{
+					BootVolume: &VPCVolume{
+						SizeGiB:          10,
+						EncryptionKeyCRN: "crn:v1:bluemix:public:hs-crypto:us-south:a/foo-account:foo-hpcs-instance::",
+					},
+					Image: &IBMVPCResourceReference{
+						ID: ptr.To("foo-image-id"),
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Create a IBMVPCMachine with malformed EncryptionKeyCRN BootVolume",
+			machine: &IBMVPCMachine{
+				Spec: IBMVPCMachineSpec{
+					BootVolume: &VPCVolume{
+						SizeGiB:          10,
+						EncryptionKeyCRN: "foo-encryption-key-crn",
+					},
+					Image: &IBMVPCResourceReference{
+						ID: ptr.To("foo-image-id"),
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Create a IBMVPCMachine with both PlacementTarget and DedicatedHost",
+			machine: &IBMVPCMachine{
+				Spec: IBMVPCMachineSpec{
+					Image: &IBMVPCResourceReference{
+						ID: ptr.To("foo-image-id"),
+					},
+					PlacementTarget: &IBMVPCResourceReference{
+						ID: ptr.To("foo-placement-group-id"),
+					},
+					DedicatedHost: &IBMVPCResourceReference{
+						ID: ptr.To("foo-dedicated-host-id"),
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Create a IBMVPCMachine with a manual CapacityReservation",
+			machine: &IBMVPCMachine{
+				Spec: IBMVPCMachineSpec{
+					Image: &IBMVPCResourceReference{
+						ID: ptr.To("foo-image-id"),
+					},
+					CapacityReservation: &VPCCapacityReservation{
+						Policy: VPCReservationAffinityPolicyManual,
+						Reservation: &IBMVPCResourceReference{
+							ID: ptr.To("foo-reservation-id"),
+						},
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Create a IBMVPCMachine with a manual CapacityReservation without Reservation",
+			machine: &IBMVPCMachine{
+				Spec: IBMVPCMachineSpec{
+					Image: &IBMVPCResourceReference{
+						ID: ptr.To("foo-image-id"),
+					},
+					CapacityReservation: &VPCCapacityReservation{
+						Policy: VPCReservationAffinityPolicyManual,
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Create a IBMVPCMachine with an automatic CapacityReservation and Reservation",
+			machine: &IBMVPCMachine{
+				Spec: IBMVPCMachineSpec{
+					Image: &IBMVPCResourceReference{
+						ID: ptr.To("foo-image-id"),
+					},
+					CapacityReservation: &VPCCapacityReservation{
+						Policy: VPCReservationAffinityPolicyAutomatic,
+						Reservation: &IBMVPCResourceReference{
+							ID: ptr.To("foo-reservation-id"),
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Create a IBMVPCMachine with both CapacityReservation and DedicatedHost",
+			machine: &IBMVPCMachine{
+				Spec: IBMVPCMachineSpec{
+					Image: &IBMVPCResourceReference{
+						ID: ptr.To("foo-image-id"),
+					},
+					DedicatedHost: &IBMVPCResourceReference{
+						ID: ptr.To("foo-dedicated-host-id"),
+					},
+					CapacityReservation: &VPCCapacityReservation{
+						Policy: VPCReservationAffinityPolicyDisabled,
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Create a IBMVPCMachine with both Image ID and CatalogOffering",
+			machine: &IBMVPCMachine{
+				Spec: IBMVPCMachineSpec{
+					Image: &IBMVPCResourceReference{
+						ID: ptr.To("foo-image-id"),
+					},
+					CatalogOffering: &IBMVPCCatalogOffering{
+						VersionCRN: "crn:v1:bluemix:public:globalcatalog-collection:global::1dbbfb3c-0a33-4e4f-a7b4-8d8f1b1c8b5a:version:foo",
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Create a IBMVPCMachine with invalid primary IP address",
+			machine: &IBMVPCMachine{
+				Spec: IBMVPCMachineSpec{
+					Image: &IBMVPCResourceReference{
+						ID: ptr.To("foo-image-id"),
+					},
+					PrimaryNetworkInterface: NetworkInterface{
+						PrimaryIP: &VPCReservedIP{
+							Address: ptr.To("10.240.0"),
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Create a IBMVPCMachine with both primary IP ID and address",
+			machine: &IBMVPCMachine{
+				Spec: IBMVPCMachineSpec{
+					Image: &IBMVPCResourceReference{
+						ID: ptr.To("foo-image-id"),
+					},
+					PrimaryNetworkInterface: NetworkInterface{
+						PrimaryIP: &VPCReservedIP{
+							ID:      ptr.To("foo-reserved-ip-id"),
+							Address: ptr.To("10.240.0.10"),
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Create a IBMVPCMachine with a security group CRN",
+			machine: &IBMVPCMachine{
+				Spec: IBMVPCMachineSpec{
+					Image: &IBMVPCResourceReference{
+						ID: ptr.To("foo-image-id"),
+					},
+					PrimaryNetworkInterface: NetworkInterface{
+						SecurityGroups: []VPCSecurityGroupReference{
+							{
+								CRN: ptr.To("crn:v1:bluemix:public:is:us-south:a/foo-account::security-group:foo-security-group-id"),
+							},
+						},
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Create a IBMVPCMachine with a malformed security group CRN",
+			machine: &IBMVPCMachine{
+				Spec: IBMVPCMachineSpec{
+					Image: &IBMVPCResourceReference{
+						ID: ptr.To("foo-image-id"),
+					},
+					PrimaryNetworkInterface: NetworkInterface{
+						SecurityGroups: []VPCSecurityGroupReference{
+							{
+								CRN: ptr.To("foo-security-group-crn"),
+							},
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Create a IBMVPCMachine with both security group ID and CRN",
+			machine: &IBMVPCMachine{
+				Spec: IBMVPCMachineSpec{
+					Image: &IBMVPCResourceReference{
+						ID: ptr.To("foo-image-id"),
+					},
+					PrimaryNetworkInterface: NetworkInterface{
+						SecurityGroups: []VPCSecurityGroupReference{
+							{
+								ID:  ptr.To("foo-security-group-id"),
+								CRN: ptr.To("crn:v1:bluemix:public:is:us-south:a/foo-account::security-group:foo-security-group-id"),
+							},
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Create a IBMVPCMachine with both security group ID and name",
+			machine: &IBMVPCMachine{
+				Spec: IBMVPCMachineSpec{
+					Image: &IBMVPCResourceReference{
+						ID: ptr.To("foo-image-id"),
+					},
+					PrimaryNetworkInterface: NetworkInterface{
+						SecurityGroups: []VPCSecurityGroupReference{
+							{
+								ID:   ptr.To("foo-security-group-id"),
+								Name: ptr.To("foo-security-group"),
+							},
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Create a IBMVPCMachine with Image name",
+			machine: &IBMVPCMachine{
+				Spec: IBMVPCMachineSpec{
+					Image: &IBMVPCResourceReference{
+						Name: ptr.To("foo-image"),
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Create a IBMVPCMachine without Image and CatalogOffering",
+			machine: &IBMVPCMachine{
+				Spec: IBMVPCMachineSpec{},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Create a IBMVPCMachine with empty Image ID and name",
+			machine: &IBMVPCMachine{
+				Spec: IBMVPCMachineSpec{
+					Image: &IBMVPCResourceReference{
+						ID:   ptr.To(""),
+						Name: ptr.To(""),
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Create a IBMVPCMachine with Image without ID and name",
+			machine: &IBMVPCMachine{
+				Spec: IBMVPCMachineSpec{
+					Image: &IBMVPCResourceReference{},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Create a IBMVPCMachine with malformed Profile",
+			machine: &IBMVPCMachine{
+				Spec: IBMVPCMachineSpec{
+					Image: &IBMVPCResourceReference{
+						ID: ptr.To("foo-image-id"),
+					},
+					Profile: "bx2-4-16",
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Create a IBMVPCMachine with CatalogOffering",
+			machine: &IBMVPCMachine{
+				Spec: IBMVPCMachineSpec{
+					CatalogOffering: &IBMVPCCatalogOffering{
+						VersionCRN: "crn:v1:bluemix:public:globalcatalog-collection:global::1dbbfb3c-0a33-4e4f-a7b4-8d8f1b1c8b5a:version:foo",
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Create a IBMVPCMachine with InstanceTemplate",
+			machine: &IBMVPCMachine{
+				Spec: IBMVPCMachineSpec{
+					InstanceTemplate: &IBMVPCResourceReference{
+						Name: ptr.To("foo-instance-template"),
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Create a IBMVPCMachine with both InstanceTemplate and Image",
+			machine: &IBMVPCMachine{
+				Spec: IBMVPCMachineSpec{
+					InstanceTemplate: &IBMVPCResourceReference{
+						ID: ptr.To("foo-instance-template-id"),
+					},
+					Image: &IBMVPCResourceReference{
+						ID: ptr.To("foo-image-id"),
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Create a IBMVPCMachine with both InstanceTemplate and Profile",
+			machine: &IBMVPCMachine{
+				Spec: IBMVPCMachineSpec{
+					InstanceTemplate: &IBMVPCResourceReference{
+						ID: ptr.To("foo-instance-template-id"),
+					},
+					Profile: "bx2-4x16",
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Create a IBMVPCMachine with both InstanceTemplate and PlacementTarget",
+			machine: &IBMVPCMachine{
+				Spec: IBMVPCMachineSpec{
+					InstanceTemplate: &IBMVPCResourceReference{
+						ID: ptr.To("foo-instance-template-id"),
+					},
+					PlacementTarget: &IBMVPCResourceReference{
+						Name: ptr.To("foo-placement-group"),
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Create a IBMVPCMachine with both InstanceTemplate and DedicatedHost",
+			machine: &IBMVPCMachine{
+				Spec: IBMVPCMachineSpec{
+					InstanceTemplate: &IBMVPCResourceReference{
+						ID: ptr.To("foo-instance-template-id"),
+					},
+					DedicatedHost: &IBMVPCResourceReference{
+						Name: ptr.To("foo-dedicated-host"),
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Create a IBMVPCMachine with both InstanceTemplate and TotalVolumeBandwidth",
+			machine: &IBMVPCMachine{
+				Spec: IBMVPCMachineSpec{
+					InstanceTemplate: &IBMVPCResourceReference{
+						ID: ptr.To("foo-instance-template-id"),
+					},
+					TotalVolumeBandwidth: ptr.To(int64(1000)),
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Create a IBMVPCMachine with both InstanceTemplate and ConfidentialCompute",
+			machine: &IBMVPCMachine{
+				Spec: IBMVPCMachineSpec{
+					InstanceTemplate: &IBMVPCResourceReference{
+						ID: ptr.To("foo-instance-template-id"),
+					},
+					ConfidentialCompute: &VPCConfidentialCompute{
+						SecureBoot: true,
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Create a IBMVPCMachine with InstanceTemplate without ID and name",
+			machine: &IBMVPCMachine{
+				Spec: IBMVPCMachineSpec{
+					InstanceTemplate: &IBMVPCResourceReference{},
 				},
 			},
 			wantErr: true,
@@ -77,3 +580,116 @@ func TestIBMVPCMachine_Create(t *testing.T) {
 		})
 	}
 }
+
+func TestIBMVPCMachine_ValidateUpdate(t *testing.T) {
+	newMachine := func(instanceID string) *IBMVPCMachine {
+		return &IBMVPCMachine{
+			Spec: IBMVPCMachineSpec{
+				Image: &IBMVPCResourceReference{
+					ID: ptr.To("foo-image-id"),
+				},
+				Profile: "bx2-2x8",
+				PrimaryNetworkInterface: NetworkInterface{
+					Subnet: "foo-subnet-id",
+				},
+				Tags: []string{"foo-tag"},
+			},
+			Status: IBMVPCMachineStatus{
+				InstanceID: instanceID,
+			},
+		}
+	}
+
+	tests := []struct {
+		name    string
+		old     *IBMVPCMachine
+		update  func(machine *IBMVPCMachine)
+		wantErr bool
+	}{
+		{
+			name: "Update Tags of a provisioned IBMVPCMachine",
+			old:  newMachine("foo-instance-id"),
+			update: func(machine *IBMVPCMachine) {
+				machine.Spec.Tags = []string{"foo-tag", "bar-tag"}
+			},
+			wantErr: false,
+		},
+		{
+			name: "Update Profile of a provisioned IBMVPCMachine",
+			old:  newMachine("foo-instance-id"),
+			update: func(machine *IBMVPCMachine) {
+				machine.Spec.Profile = "bx2-4x16"
+			},
+			wantErr: true,
+		},
+		{
+			name: "Update Image of a provisioned IBMVPCMachine",
+			old:  newMachine("foo-instance-id"),
+			update: func(machine *IBMVPCMachine) {
+				machine.Spec.Image = &IBMVPCResourceReference{
+					ID: ptr.To("bar-image-id"),
+				}
+			},
+			wantErr: true,
+		},
+		{
+			name: "Update CatalogOffering of a provisioned IBMVPCMachine",
+			old:  newMachine("foo-instance-id"),
+			update: func(machine *IBMVPCMachine) {
+				machine.Spec.CatalogOffering = &IBMVPCCatalogOffering{
+					VersionCRN: "crn:v1:bluemix:public:globalcatalog-collection:global::1dbbfb3c-0a33-4e4f-a7b4-8d8f1b1c8b5a:version:foo",
+				}
+			},
+			wantErr: true,
+		},
+		{
+			name: "Update InstanceTemplate of a provisioned IBMVPCMachine",
+			old:  newMachine("foo-instance-id"),
+			update: func(machine *IBMVPCMachine) {
+				machine.Spec.InstanceTemplate = &IBMVPCResourceReference{
+					ID: ptr.To("foo-instance-template-id"),
+				}
+			},
+			wantErr: true,
+		},
+		{
+			name: "Update InstanceTemplate of an IBMVPCMachine which is not provisioned",
+			old:  newMachine(""),
+			update: func(machine *IBMVPCMachine) {
+				machine.Spec.InstanceTemplate = &IBMVPCResourceReference{
+					ID: ptr.To("foo-instance-template-id"),
+				}
+			},
+			wantErr: false,
+		},
+		{
+			name: "Update Subnet of a provisioned IBMVPCMachine",
+			old:  newMachine("foo-instance-id"),
+			update: func(machine *IBMVPCMachine) {
+				machine.Spec.PrimaryNetworkInterface.Subnet = "bar-subnet-id"
+			},
+			wantErr: true,
+		},
+		{
+			name: "Update Profile of an IBMVPCMachine which is not provisioned",
+			old:  newMachine(""),
+			update: func(machine *IBMVPCMachine) {
+				machine.Spec.Profile = "bx2-4x16"
+			},
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			machine := tt.old.DeepCopy()
+			tt.update(machine)
+			_, err := machine.ValidateUpdate(tt.old)
+			if tt.wantErr {
+				g.Expect(err).To(HaveOccurred())
+			} else {
+				g.Expect(err).NotTo(HaveOccurred())
+			}
+		})
+	}
+}
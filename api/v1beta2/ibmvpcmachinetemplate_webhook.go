@@ -55,6 +55,11 @@ func (r *IBMVPCMachineTemplate) ValidateCreate() (admission.Warnings, error) {
 	ibmvpcmachinetemplatelog.Info("validate create", "name", r.Name)
 	var allErrs field.ErrorList
 	allErrs = append(allErrs, r.validateIBMVPCMachineBootVolume()...)
+	allErrs = append(allErrs, r.validateIBMVPCMachinePlacement()...)
+	allErrs = append(allErrs, r.validateIBMVPCMachineImage()...)
+	allErrs = append(allErrs, r.validateIBMVPCMachineNetworkInterfaces()...)
+	allErrs = append(allErrs, r.validateIBMVPCMachineProfile()...)
+	allErrs = append(allErrs, r.validateIBMVPCMachineInstanceTemplate()...)
 
 	return nil, aggregateObjErrors(r.GroupVersionKind().GroupKind(), r.Name, allErrs)
 }
@@ -74,3 +79,26 @@ func (r *IBMVPCMachineTemplate) ValidateDelete() (admission.Warnings, error) {
 func (r *IBMVPCMachineTemplate) validateIBMVPCMachineBootVolume() field.ErrorList {
 	return validateBootVolume(r.Spec.Template.Spec)
 }
+
+func (r *IBMVPCMachineTemplate) validateIBMVPCMachinePlacement() field.ErrorList {
+	return validateIBMVPCMachinePlacement(r.Spec.Template.Spec)
+}
+
+func (r *IBMVPCMachineTemplate) validateIBMVPCMachineImage() field.ErrorList {
+	var allErrs field.ErrorList
+	allErrs = append(allErrs, validateIBMVPCMachineImageReference(r.Spec.Template.Spec)...)
+	allErrs = append(allErrs, validateIBMVPCMachineImage(r.Spec.Template.Spec)...)
+	return allErrs
+}
+
+func (r *IBMVPCMachineTemplate) validateIBMVPCMachineNetworkInterfaces() field.ErrorList {
+	return validateIBMVPCMachineNetworkInterfaces(r.Spec.Template.Spec)
+}
+
+func (r *IBMVPCMachineTemplate) validateIBMVPCMachineProfile() field.ErrorList {
+	return validateIBMVPCMachineProfile(r.Spec.Template.Spec)
+}
+
+func (r *IBMVPCMachineTemplate) validateIBMVPCMachineInstanceTemplate() field.ErrorList {
+	return validateIBMVPCMachineInstanceTemplate(r.Spec.Template.Spec)
+}
@@ -21,13 +21,268 @@ import (
 
 	. "github.com/onsi/gomega"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/cluster-api/util/defaulting"
 )
 
 func TestVPCMachineTemplate_default(t *testing.T) {
 	g := NewWithT(t)
-	vpcMachineTemplate := &IBMVPCMachineTemplate{ObjectMeta: metav1.ObjectMeta{Name: "capi-machine-template", Namespace: "default"}}
+	vpcMachineTemplate := &IBMVPCMachineTemplate{
+		ObjectMeta: metav1.ObjectMeta{Name: "capi-machine-template", Namespace: "default"},
+		Spec: IBMVPCMachineTemplateSpec{
+			Template: IBMVPCMachineTemplateResource{
+				Spec: IBMVPCMachineSpec{
+					Image: &IBMVPCResourceReference{
+						ID: ptr.To("capi-image"),
+					},
+				},
+			},
+		},
+	}
 	t.Run("Defaults for IBMVPCMachineTemplate", defaulting.DefaultValidateTest(vpcMachineTemplate))
 	vpcMachineTemplate.Default()
 	g.Expect(vpcMachineTemplate.Spec.Template.Spec.Profile).To(BeEquivalentTo("bx2-2x8"))
 }
+
+func TestIBMVPCMachineTemplate_Create(t *testing.T) {
+	tests := []struct {
+		name     string
+		template *IBMVPCMachineTemplate
+		wantErr  bool
+	}{
+		{
+			name: "Create a IBMVPCMachineTemplate with Image ID",
+			template: &IBMVPCMachineTemplate{
+				Spec: IBMVPCMachineTemplateSpec{
+					Template: IBMVPCMachineTemplateResource{
+						Spec: IBMVPCMachineSpec{
+							Image: &IBMVPCResourceReference{
+								ID: ptr.To("foo-image-id"),
+							},
+						},
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Create a IBMVPCMachineTemplate with CatalogOffering",
+			template: &IBMVPCMachineTemplate{
+				Spec: IBMVPCMachineTemplateSpec{
+					Template: IBMVPCMachineTemplateResource{
+						Spec: IBMVPCMachineSpec{
+							CatalogOffering: &IBMVPCCatalogOffering{
+								VersionCRN: "crn:v1:bluemix:public:globalcatalog-collection:global::1dbbfb3c-0a33-4e4f-a7b4-8d8f1b1c8b5a:version:foo",
+							},
+						},
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Create a IBMVPCMachineTemplate without Image or CatalogOffering",
+			template: &IBMVPCMachineTemplate{
+				Spec: IBMVPCMachineTemplateSpec{
+					Template: IBMVPCMachineTemplateResource{
+						Spec: IBMVPCMachineSpec{
+							Profile: "bx2-2x8",
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Create a IBMVPCMachineTemplate with Image without ID or Name",
+			template: &IBMVPCMachineTemplate{
+				Spec: IBMVPCMachineTemplateSpec{
+					Template: IBMVPCMachineTemplateResource{
+						Spec: IBMVPCMachineSpec{
+							Image: &IBMVPCResourceReference{},
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Create a IBMVPCMachineTemplate with valid Profile",
+			template: &IBMVPCMachineTemplate{
+				Spec: IBMVPCMachineTemplateSpec{
+					Template: IBMVPCMachineTemplateResource{
+						Spec: IBMVPCMachineSpec{
+							Image: &IBMVPCResourceReference{
+								ID: ptr.To("foo-image-id"),
+							},
+							Profile: "bx2-4x16",
+						},
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Create a IBMVPCMachineTemplate with invalid Profile",
+			template: &IBMVPCMachineTemplate{
+				Spec: IBMVPCMachineTemplateSpec{
+					Template: IBMVPCMachineTemplateResource{
+						Spec: IBMVPCMachineSpec{
+							Image: &IBMVPCResourceReference{
+								ID: ptr.To("foo-image-id"),
+							},
+							Profile: "bx2-4-16",
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Create a IBMVPCMachineTemplate with InstanceTemplate",
+			template: &IBMVPCMachineTemplate{
+				Spec: IBMVPCMachineTemplateSpec{
+					Template: IBMVPCMachineTemplateResource{
+						Spec: IBMVPCMachineSpec{
+							InstanceTemplate: &IBMVPCResourceReference{
+								ID: ptr.To("foo-instance-template-id"),
+							},
+						},
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Create a IBMVPCMachineTemplate with both InstanceTemplate and PlacementTarget",
+			template: &IBMVPCMachineTemplate{
+				Spec: IBMVPCMachineTemplateSpec{
+					Template: IBMVPCMachineTemplateResource{
+						Spec: IBMVPCMachineSpec{
+							InstanceTemplate: &IBMVPCResourceReference{
+								ID: ptr.To("foo-instance-template-id"),
+							},
+							PlacementTarget: &IBMVPCResourceReference{
+								Name: ptr.To("foo-placement-group"),
+							},
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Create a IBMVPCMachineTemplate with both InstanceTemplate and DedicatedHost",
+			template: &IBMVPCMachineTemplate{
+				Spec: IBMVPCMachineTemplateSpec{
+					Template: IBMVPCMachineTemplateResource{
+						Spec: IBMVPCMachineSpec{
+							InstanceTemplate: &IBMVPCResourceReference{
+								ID: ptr.To("foo-instance-template-id"),
+							},
+							DedicatedHost: &IBMVPCResourceReference{
+								Name: ptr.To("foo-dedicated-host"),
+							},
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Create a IBMVPCMachineTemplate with both InstanceTemplate and TotalVolumeBandwidth",
+			template: &IBMVPCMachineTemplate{
+				Spec: IBMVPCMachineTemplateSpec{
+					Template: IBMVPCMachineTemplateResource{
+						Spec: IBMVPCMachineSpec{
+							InstanceTemplate: &IBMVPCResourceReference{
+								ID: ptr.To("foo-instance-template-id"),
+							},
+							TotalVolumeBandwidth: ptr.To(int64(1000)),
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Create a IBMVPCMachineTemplate with both InstanceTemplate and ConfidentialCompute",
+			template: &IBMVPCMachineTemplate{
+				Spec: IBMVPCMachineTemplateSpec{
+					Template: IBMVPCMachineTemplateResource{
+						Spec: IBMVPCMachineSpec{
+							InstanceTemplate: &IBMVPCResourceReference{
+								ID: ptr.To("foo-instance-template-id"),
+							},
+							ConfidentialCompute: &VPCConfidentialCompute{
+								SecureBoot: true,
+							},
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Create a IBMVPCMachineTemplate with a security group CRN",
+			template: &IBMVPCMachineTemplate{
+				Spec: IBMVPCMachineTemplateSpec{
+					Template: IBMVPCMachineTemplateResource{
+						Spec: IBMVPCMachineSpec{
+							Image: &IBMVPCResourceReference{
+								ID: ptr.To("foo-image-id"),
+							},
+							NetworkInterfaces: []NetworkInterface{
+								{
+									SecurityGroups: []VPCSecurityGroupReference{
+										{
+											CRN: ptr.To("crn:v1:bluemix:public:is:us-south:a/foo-account::security-group:foo-security-group-id"),
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Create a IBMVPCMachineTemplate with both security group name and CRN",
+			template: &IBMVPCMachineTemplate{
+				Spec: IBMVPCMachineTemplateSpec{
+					Template: IBMVPCMachineTemplateResource{
+						Spec: IBMVPCMachineSpec{
+							Image: &IBMVPCResourceReference{
+								ID: ptr.To("foo-image-id"),
+							},
+							NetworkInterfaces: []NetworkInterface{
+								{
+									SecurityGroups: []VPCSecurityGroupReference{
+										{
+											Name: ptr.To("foo-security-group"),
+											CRN:  ptr.To("crn:v1:bluemix:public:is:us-south:a/foo-account::security-group:foo-security-group-id"),
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			template := tt.template.DeepCopy()
+			template.ObjectMeta = metav1.ObjectMeta{
+				GenerateName: "capi-machine-template-",
+				Namespace:    "default",
+			}
+			if err := testEnv.Create(ctx, template); (err != nil) != tt.wantErr {
+				t.Errorf("ValidateCreate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -121,6 +121,9 @@ var (
 
 	// VPCLoadBalancerStateDeletePending is the string representing the load balancer in deleting state.
 	VPCLoadBalancerStateDeletePending = VPCLoadBalancerState("delete_pending")
+
+	// VPCLoadBalancerStateUpdatePending is the string representing the load balancer in updating state.
+	VPCLoadBalancerStateUpdatePending = VPCLoadBalancerState("update_pending")
 )
 
 // VPCSubnetState describes the state of a VPC Subnet.
@@ -248,10 +251,59 @@ const (
 	VPCSecurityGroupRuleRemoteTypeSG VPCSecurityGroupRuleRemoteType = VPCSecurityGroupRuleRemoteType("sg")
 )
 
+// VPCSecurityGroupReference is a reference to a VPC security group by ID, Name or CRN.
+// Only one of ID, Name or CRN may be specified. Specifying more than one will result in
+// a validation error.
+type VPCSecurityGroupReference struct {
+	// ID of the security group.
+	// +kubebuilder:validation:MinLength=1
+	// +optional
+	ID *string `json:"id,omitempty"`
+
+	// Name of the security group.
+	// +kubebuilder:validation:MinLength=1
+	// +optional
+	Name *string `json:"name,omitempty"`
+
+	// CRN of the security group, it references a security group shared from another account without a lookup.
+	// +kubebuilder:validation:MinLength=1
+	// +optional
+	CRN *string `json:"crn,omitempty"`
+}
+
 // NetworkInterface holds the network interface information like subnet id.
 type NetworkInterface struct {
 	// Subnet ID of the network interface.
 	Subnet string `json:"subnet,omitempty"`
+
+	// SecurityGroups are the security groups to attach to the network interface.
+	// +optional
+	SecurityGroups []VPCSecurityGroupReference `json:"securityGroups,omitempty"`
+
+	// AllowIPSpoofing indicates whether source IP spoofing is allowed on the network interface,
+	// which disables the source/destination check required by NAT gateways and routing CNIs.
+	// Defaults to the VPC default of false when unset.
+	// +optional
+	AllowIPSpoofing *bool `json:"allowIPSpoofing,omitempty"`
+
+	// PrimaryIP is the primary IP of the network interface, either an explicit address
+	// within the subnet or an existing reserved IP.
+	// +optional
+	PrimaryIP *VPCReservedIP `json:"primaryIP,omitempty"`
+}
+
+// VPCReservedIP is a reference to an existing reserved IP by ID or an address to reserve.
+// Only one of ID or Address may be specified.
+type VPCReservedIP struct {
+	// ID of an existing reserved IP.
+	// +kubebuilder:validation:MinLength=1
+	// +optional
+	ID *string `json:"id,omitempty"`
+
+	// Address is the IP address to reserve, it must be within the CIDR of the network interface's subnet.
+	// +kubebuilder:validation:MinLength=1
+	// +optional
+	Address *string `json:"address,omitempty"`
 }
 
 // VPCSecurityGroupPortRange represents a range of ports, minimum to maximum.
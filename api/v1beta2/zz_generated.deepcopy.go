@@ -281,11 +281,23 @@ func (in *IBMPowerVSClusterStatus) DeepCopyInto(out *IBMPowerVSClusterStatus) {
 		*out = new(ResourceReference)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.TransitGatewayConnections != nil {
+		in, out := &in.TransitGatewayConnections, &out.TransitGatewayConnections
+		*out = make(map[string]ResourceReference, len(*in))
+		for key, val := range *in {
+			(*out)[key] = *val.DeepCopy()
+		}
+	}
 	if in.COSInstance != nil {
 		in, out := &in.COSInstance, &out.COSInstance
 		*out = new(ResourceReference)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.COSBucket != nil {
+		in, out := &in.COSBucket, &out.COSBucket
+		*out = new(ResourceReference)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.LoadBalancers != nil {
 		in, out := &in.LoadBalancers, &out.LoadBalancers
 		*out = make(map[string]VPCLoadBalancerStatus, len(*in))
@@ -598,6 +610,11 @@ func (in *IBMPowerVSMachineSpec) DeepCopyInto(out *IBMPowerVSMachineSpec) {
 	}
 	out.Processors = in.Processors
 	in.Network.DeepCopyInto(&out.Network)
+	if in.PlacementGroup != nil {
+		in, out := &in.PlacementGroup, &out.PlacementGroup
+		*out = new(IBMPowerVSPlacementGroup)
+		**out = **in
+	}
 	if in.ProviderID != nil {
 		in, out := &in.ProviderID, &out.ProviderID
 		*out = new(string)
@@ -775,6 +792,21 @@ func (in *IBMPowerVSMachineTemplateStatus) DeepCopy() *IBMPowerVSMachineTemplate
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IBMPowerVSPlacementGroup) DeepCopyInto(out *IBMPowerVSPlacementGroup) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IBMPowerVSPlacementGroup.
+func (in *IBMPowerVSPlacementGroup) DeepCopy() *IBMPowerVSPlacementGroup {
+	if in == nil {
+		return nil
+	}
+	out := new(IBMPowerVSPlacementGroup)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *IBMPowerVSResourceReference) DeepCopyInto(out *IBMPowerVSResourceReference) {
 	*out = *in
@@ -805,6 +837,21 @@ func (in *IBMPowerVSResourceReference) DeepCopy() *IBMPowerVSResourceReference {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IBMVPCCatalogOffering) DeepCopyInto(out *IBMVPCCatalogOffering) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IBMVPCCatalogOffering.
+func (in *IBMVPCCatalogOffering) DeepCopy() *IBMVPCCatalogOffering {
+	if in == nil {
+		return nil
+	}
+	out := new(IBMVPCCatalogOffering)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *IBMVPCCluster) DeepCopyInto(out *IBMVPCCluster) {
 	*out = *in
@@ -873,6 +920,11 @@ func (in *IBMVPCClusterSpec) DeepCopyInto(out *IBMVPCClusterSpec) {
 		*out = new(VPCLoadBalancerSpec)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Network != nil {
+		in, out := &in.Network, &out.Network
+		*out = new(VPCNetworkSpec)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IBMVPCClusterSpec.
@@ -891,6 +943,18 @@ func (in *IBMVPCClusterStatus) DeepCopyInto(out *IBMVPCClusterStatus) {
 	out.VPC = in.VPC
 	in.Subnet.DeepCopyInto(&out.Subnet)
 	in.VPCEndpoint.DeepCopyInto(&out.VPCEndpoint)
+	if in.Network != nil {
+		in, out := &in.Network, &out.Network
+		*out = new(VPCNetworkStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.FailureDomains != nil {
+		in, out := &in.FailureDomains, &out.FailureDomains
+		*out = make(v1beta1.FailureDomains, len(*in))
+		for key, val := range *in {
+			(*out)[key] = *val.DeepCopy()
+		}
+	}
 	if in.Conditions != nil {
 		in, out := &in.Conditions, &out.Conditions
 		*out = make(v1beta1.Conditions, len(*in))
@@ -1068,17 +1132,54 @@ func (in *IBMVPCMachineSpec) DeepCopyInto(out *IBMVPCMachineSpec) {
 		*out = new(IBMVPCResourceReference)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.CatalogOffering != nil {
+		in, out := &in.CatalogOffering, &out.CatalogOffering
+		*out = new(IBMVPCCatalogOffering)
+		**out = **in
+	}
+	if in.TotalVolumeBandwidth != nil {
+		in, out := &in.TotalVolumeBandwidth, &out.TotalVolumeBandwidth
+		*out = new(int64)
+		**out = **in
+	}
 	if in.BootVolume != nil {
 		in, out := &in.BootVolume, &out.BootVolume
 		*out = new(VPCVolume)
 		**out = **in
 	}
+	if in.DataVolumes != nil {
+		in, out := &in.DataVolumes, &out.DataVolumes
+		*out = make([]VPCVolume, len(*in))
+		copy(*out, *in)
+	}
+	if in.PlacementTarget != nil {
+		in, out := &in.PlacementTarget, &out.PlacementTarget
+		*out = new(IBMVPCResourceReference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DedicatedHost != nil {
+		in, out := &in.DedicatedHost, &out.DedicatedHost
+		*out = new(IBMVPCResourceReference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CapacityReservation != nil {
+		in, out := &in.CapacityReservation, &out.CapacityReservation
+		*out = new(VPCCapacityReservation)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.ProviderID != nil {
 		in, out := &in.ProviderID, &out.ProviderID
 		*out = new(string)
 		**out = **in
 	}
-	out.PrimaryNetworkInterface = in.PrimaryNetworkInterface
+	in.PrimaryNetworkInterface.DeepCopyInto(&out.PrimaryNetworkInterface)
+	if in.NetworkInterfaces != nil {
+		in, out := &in.NetworkInterfaces, &out.NetworkInterfaces
+		*out = make([]NetworkInterface, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.SSHKeys != nil {
 		in, out := &in.SSHKeys, &out.SSHKeys
 		*out = make([]*IBMVPCResourceReference, len(*in))
@@ -1090,6 +1191,43 @@ func (in *IBMVPCMachineSpec) DeepCopyInto(out *IBMVPCMachineSpec) {
 			}
 		}
 	}
+	if in.MetadataService != nil {
+		in, out := &in.MetadataService, &out.MetadataService
+		*out = new(VPCMetadataService)
+		**out = **in
+	}
+	if in.ConfidentialCompute != nil {
+		in, out := &in.ConfidentialCompute, &out.ConfidentialCompute
+		*out = new(VPCConfidentialCompute)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.FailureDomain != nil {
+		in, out := &in.FailureDomain, &out.FailureDomain
+		*out = new(string)
+		**out = **in
+	}
+	if in.LoadBalancerPoolMembers != nil {
+		in, out := &in.LoadBalancerPoolMembers, &out.LoadBalancerPoolMembers
+		*out = make([]VPCLoadBalancerPoolMemberTarget, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.InstanceTemplate != nil {
+		in, out := &in.InstanceTemplate, &out.InstanceTemplate
+		*out = new(IBMVPCResourceReference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.StartOnCreate != nil {
+		in, out := &in.StartOnCreate, &out.StartOnCreate
+		*out = new(bool)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IBMVPCMachineSpec.
@@ -1110,6 +1248,18 @@ func (in *IBMVPCMachineStatus) DeepCopyInto(out *IBMVPCMachineStatus) {
 		*out = make([]v1.NodeAddress, len(*in))
 		copy(*out, *in)
 	}
+	if in.LoadBalancerPoolMembers != nil {
+		in, out := &in.LoadBalancerPoolMembers, &out.LoadBalancerPoolMembers
+		*out = make([]VPCLoadBalancerPoolMemberReference, len(*in))
+		copy(*out, *in)
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make(v1beta1.Conditions, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IBMVPCMachineStatus.
@@ -1278,6 +1428,23 @@ func (in *Ignition) DeepCopy() *Ignition {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *NetworkInterface) DeepCopyInto(out *NetworkInterface) {
 	*out = *in
+	if in.SecurityGroups != nil {
+		in, out := &in.SecurityGroups, &out.SecurityGroups
+		*out = make([]VPCSecurityGroupReference, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.AllowIPSpoofing != nil {
+		in, out := &in.AllowIPSpoofing, &out.AllowIPSpoofing
+		*out = new(bool)
+		**out = **in
+	}
+	if in.PrimaryIP != nil {
+		in, out := &in.PrimaryIP, &out.PrimaryIP
+		*out = new(VPCReservedIP)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkInterface.
@@ -1395,6 +1562,61 @@ func (in *VPC) DeepCopy() *VPC {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VPCAddressPrefix) DeepCopyInto(out *VPCAddressPrefix) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VPCAddressPrefix.
+func (in *VPCAddressPrefix) DeepCopy() *VPCAddressPrefix {
+	if in == nil {
+		return nil
+	}
+	out := new(VPCAddressPrefix)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VPCCapacityReservation) DeepCopyInto(out *VPCCapacityReservation) {
+	*out = *in
+	if in.Reservation != nil {
+		in, out := &in.Reservation, &out.Reservation
+		*out = new(IBMVPCResourceReference)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VPCCapacityReservation.
+func (in *VPCCapacityReservation) DeepCopy() *VPCCapacityReservation {
+	if in == nil {
+		return nil
+	}
+	out := new(VPCCapacityReservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VPCConfidentialCompute) DeepCopyInto(out *VPCConfidentialCompute) {
+	*out = *in
+	if in.TrustedProfile != nil {
+		in, out := &in.TrustedProfile, &out.TrustedProfile
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VPCConfidentialCompute.
+func (in *VPCConfidentialCompute) DeepCopy() *VPCConfidentialCompute {
+	if in == nil {
+		return nil
+	}
+	out := new(VPCConfidentialCompute)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VPCEndpoint) DeepCopyInto(out *VPCEndpoint) {
 	*out = *in
@@ -1425,6 +1647,96 @@ func (in *VPCEndpoint) DeepCopy() *VPCEndpoint {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VPCFlowLogsSpec) DeepCopyInto(out *VPCFlowLogsSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VPCFlowLogsSpec.
+func (in *VPCFlowLogsSpec) DeepCopy() *VPCFlowLogsSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(VPCFlowLogsSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VPCLoadBalancerHealthMonitorSpec) DeepCopyInto(out *VPCLoadBalancerHealthMonitorSpec) {
+	*out = *in
+	if in.Port != nil {
+		in, out := &in.Port, &out.Port
+		*out = new(int64)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VPCLoadBalancerHealthMonitorSpec.
+func (in *VPCLoadBalancerHealthMonitorSpec) DeepCopy() *VPCLoadBalancerHealthMonitorSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(VPCLoadBalancerHealthMonitorSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VPCLoadBalancerPoolMemberReference) DeepCopyInto(out *VPCLoadBalancerPoolMemberReference) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VPCLoadBalancerPoolMemberReference.
+func (in *VPCLoadBalancerPoolMemberReference) DeepCopy() *VPCLoadBalancerPoolMemberReference {
+	if in == nil {
+		return nil
+	}
+	out := new(VPCLoadBalancerPoolMemberReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VPCLoadBalancerPoolMemberTarget) DeepCopyInto(out *VPCLoadBalancerPoolMemberTarget) {
+	*out = *in
+	if in.Weight != nil {
+		in, out := &in.Weight, &out.Weight
+		*out = new(int64)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VPCLoadBalancerPoolMemberTarget.
+func (in *VPCLoadBalancerPoolMemberTarget) DeepCopy() *VPCLoadBalancerPoolMemberTarget {
+	if in == nil {
+		return nil
+	}
+	out := new(VPCLoadBalancerPoolMemberTarget)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VPCLoadBalancerPoolSpec) DeepCopyInto(out *VPCLoadBalancerPoolSpec) {
+	*out = *in
+	if in.HealthMonitor != nil {
+		in, out := &in.HealthMonitor, &out.HealthMonitor
+		*out = new(VPCLoadBalancerHealthMonitorSpec)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VPCLoadBalancerPoolSpec.
+func (in *VPCLoadBalancerPoolSpec) DeepCopy() *VPCLoadBalancerPoolSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(VPCLoadBalancerPoolSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VPCLoadBalancerSpec) DeepCopyInto(out *VPCLoadBalancerSpec) {
 	*out = *in
@@ -1443,6 +1755,11 @@ func (in *VPCLoadBalancerSpec) DeepCopyInto(out *VPCLoadBalancerSpec) {
 		*out = make([]AdditionalListenerSpec, len(*in))
 		copy(*out, *in)
 	}
+	if in.Pool != nil {
+		in, out := &in.Pool, &out.Pool
+		*out = new(VPCLoadBalancerPoolSpec)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VPCLoadBalancerSpec.
@@ -1485,6 +1802,180 @@ func (in *VPCLoadBalancerStatus) DeepCopy() *VPCLoadBalancerStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VPCMetadataService) DeepCopyInto(out *VPCMetadataService) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VPCMetadataService.
+func (in *VPCMetadataService) DeepCopy() *VPCMetadataService {
+	if in == nil {
+		return nil
+	}
+	out := new(VPCMetadataService)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VPCNetworkACLRule) DeepCopyInto(out *VPCNetworkACLRule) {
+	*out = *in
+	if in.PortRange != nil {
+		in, out := &in.PortRange, &out.PortRange
+		*out = new(VPCSecurityGroupPortRange)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VPCNetworkACLRule.
+func (in *VPCNetworkACLRule) DeepCopy() *VPCNetworkACLRule {
+	if in == nil {
+		return nil
+	}
+	out := new(VPCNetworkACLRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VPCNetworkACLSpec) DeepCopyInto(out *VPCNetworkACLSpec) {
+	*out = *in
+	if in.Rules != nil {
+		in, out := &in.Rules, &out.Rules
+		*out = make([]VPCNetworkACLRule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VPCNetworkACLSpec.
+func (in *VPCNetworkACLSpec) DeepCopy() *VPCNetworkACLSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(VPCNetworkACLSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VPCNetworkSpec) DeepCopyInto(out *VPCNetworkSpec) {
+	*out = *in
+	if in.SecurityGroupRules != nil {
+		in, out := &in.SecurityGroupRules, &out.SecurityGroupRules
+		*out = make([]*VPCSecurityGroupRule, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(VPCSecurityGroupRule)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.PublicGateway != nil {
+		in, out := &in.PublicGateway, &out.PublicGateway
+		*out = new(bool)
+		**out = **in
+	}
+	if in.NetworkACL != nil {
+		in, out := &in.NetworkACL, &out.NetworkACL
+		*out = new(VPCNetworkACLSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.FlowLogs != nil {
+		in, out := &in.FlowLogs, &out.FlowLogs
+		*out = new(VPCFlowLogsSpec)
+		**out = **in
+	}
+	if in.AddressPrefixes != nil {
+		in, out := &in.AddressPrefixes, &out.AddressPrefixes
+		*out = make([]VPCAddressPrefix, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VPCNetworkSpec.
+func (in *VPCNetworkSpec) DeepCopy() *VPCNetworkSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(VPCNetworkSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VPCNetworkStatus) DeepCopyInto(out *VPCNetworkStatus) {
+	*out = *in
+	if in.SecurityGroups != nil {
+		in, out := &in.SecurityGroups, &out.SecurityGroups
+		*out = make([]VPCSecurityGroupStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.PublicGateways != nil {
+		in, out := &in.PublicGateways, &out.PublicGateways
+		*out = make(map[string]ResourceReference, len(*in))
+		for key, val := range *in {
+			(*out)[key] = *val.DeepCopy()
+		}
+	}
+	if in.NetworkACL != nil {
+		in, out := &in.NetworkACL, &out.NetworkACL
+		*out = new(ResourceReference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.FlowLogCollector != nil {
+		in, out := &in.FlowLogCollector, &out.FlowLogCollector
+		*out = new(ResourceReference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ControlPlaneSubnets != nil {
+		in, out := &in.ControlPlaneSubnets, &out.ControlPlaneSubnets
+		*out = make([]Subnet, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VPCNetworkStatus.
+func (in *VPCNetworkStatus) DeepCopy() *VPCNetworkStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(VPCNetworkStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VPCReservedIP) DeepCopyInto(out *VPCReservedIP) {
+	*out = *in
+	if in.ID != nil {
+		in, out := &in.ID, &out.ID
+		*out = new(string)
+		**out = **in
+	}
+	if in.Address != nil {
+		in, out := &in.Address, &out.Address
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VPCReservedIP.
+func (in *VPCReservedIP) DeepCopy() *VPCReservedIP {
+	if in == nil {
+		return nil
+	}
+	out := new(VPCReservedIP)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VPCResourceReference) DeepCopyInto(out *VPCResourceReference) {
 	*out = *in
@@ -1577,6 +2068,36 @@ func (in *VPCSecurityGroupPortRange) DeepCopy() *VPCSecurityGroupPortRange {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VPCSecurityGroupReference) DeepCopyInto(out *VPCSecurityGroupReference) {
+	*out = *in
+	if in.ID != nil {
+		in, out := &in.ID, &out.ID
+		*out = new(string)
+		**out = **in
+	}
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
+		*out = new(string)
+		**out = **in
+	}
+	if in.CRN != nil {
+		in, out := &in.CRN, &out.CRN
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VPCSecurityGroupReference.
+func (in *VPCSecurityGroupReference) DeepCopy() *VPCSecurityGroupReference {
+	if in == nil {
+		return nil
+	}
+	out := new(VPCSecurityGroupReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VPCSecurityGroupRule) DeepCopyInto(out *VPCSecurityGroupRule) {
 	*out = *in
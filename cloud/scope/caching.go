@@ -0,0 +1,49 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scope
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/utils"
+)
+
+// SecurityGroupCacheTTL is duration of time to store the security group IDs looked up by name in cache.
+// The ID of a security group only changes when the security group is recreated, the TTL bounds how long machines
+// keep being created with the ID of a security group which was deleted and recreated with the same name.
+var SecurityGroupCacheTTL = 30 * time.Second
+
+var (
+	securityGroupCacheStore     *utils.TTLCache[string]
+	securityGroupCacheStoreOnce sync.Once
+)
+
+// securityGroupCacheKey returns the cache key of a security group, the region identifies where the security group is looked up.
+func securityGroupCacheKey(region, securityGroupName string) string {
+	return fmt.Sprintf("%s/%s", region, securityGroupName)
+}
+
+// securityGroupCache returns the security group ID cache shared by all the machine scopes, it is initialised
+// with SecurityGroupCacheTTL on first use.
+func securityGroupCache() *utils.TTLCache[string] {
+	securityGroupCacheStoreOnce.Do(func() {
+		securityGroupCacheStore = utils.NewTTLCache[string](SecurityGroupCacheTTL)
+	})
+	return securityGroupCacheStore
+}
@@ -20,6 +20,8 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"net"
+	"slices"
 
 	"github.com/go-logr/logr"
 
@@ -119,6 +121,10 @@ func (s *ClusterScope) CreateVPC() (*vpcv1.VPC, error) {
 		ID: &s.IBMVPCCluster.Spec.ResourceGroup,
 	})
 	options.SetName(s.IBMVPCCluster.Spec.VPC)
+	if len(s.addressPrefixes()) > 0 {
+		// The address prefixes of the spec are created by ReconcileAddressPrefixes instead of the default ones.
+		options.SetAddressPrefixManagement(vpcv1.CreateVPCOptionsAddressPrefixManagementManualConst)
+	}
 	vpc, _, err := s.IBMVPCClient.CreateVPC(options)
 	if err != nil {
 		record.Warnf(s.IBMVPCCluster, "FailedCreateVPC", "Failed vpc creation - %v", err)
@@ -180,7 +186,7 @@ func (s *ClusterScope) ensureVPCUnique(vpcName string) (*vpcv1.VPC, error) {
 		return true, "", nil
 	}
 
-	if err := utils.PagingHelper(f); err != nil {
+	if err := utils.PagingHelper(context.TODO(), f); err != nil {
 		return nil, err
 	}
 
@@ -216,7 +222,7 @@ func (s *ClusterScope) CreateSubnet() (*vpcv1.Subnet, error) {
 	}
 
 	options := &vpcv1.CreateSubnetOptions{}
-	cidrBlock, err := s.getSubnetAddrPrefix(s.IBMVPCCluster.Status.VPC.ID, s.IBMVPCCluster.Spec.Zone)
+	cidrBlock, err := s.getSubnetCIDRBlock(s.IBMVPCCluster.Status.VPC.ID, s.IBMVPCCluster.Spec.Zone)
 	if err != nil {
 		return nil, err
 	}
@@ -238,18 +244,67 @@ func (s *ClusterScope) CreateSubnet() (*vpcv1.Subnet, error) {
 	if err != nil {
 		record.Warnf(s.IBMVPCCluster, "FailedCreateSubnet", "Failed subnet creation - %v", err)
 	}
-	if subnet != nil {
-		pgw, err := s.createPublicGateWay(s.IBMVPCCluster.Status.VPC.ID, s.IBMVPCCluster.Spec.Zone, s.IBMVPCCluster.Spec.ResourceGroup)
+	return subnet, err
+}
+
+// getSubnetCIDRBlock returns the CIDR of the cluster subnet, which is the subnet CIDR of the spec when set, and
+// otherwise the CIDR of the first address prefix of the zone. The subnet CIDR of the spec must be within one of the
+// address prefixes of the zone.
+func (s *ClusterScope) getSubnetCIDRBlock(vpcID, zone string) (string, error) {
+	var subnetCIDR string
+	if s.IBMVPCCluster.Spec.Network != nil {
+		subnetCIDR = s.IBMVPCCluster.Spec.Network.SubnetCIDR
+	}
+
+	prefixes := []string{}
+	for _, prefix := range s.addressPrefixes() {
+		if prefix.Zone == zone {
+			prefixes = append(prefixes, prefix.CIDR)
+		}
+	}
+	if subnetCIDR == "" {
+		if len(prefixes) > 0 {
+			return prefixes[0], nil
+		}
+		return s.getSubnetAddrPrefix(vpcID, zone)
+	}
+
+	if len(prefixes) == 0 {
+		addressPrefixes, err := s.listVPCAddressPrefixes(vpcID)
 		if err != nil {
-			return subnet, err
+			return "", err
 		}
-		if pgw != nil {
-			if _, err := s.attachPublicGateWay(*subnet.ID, *pgw.ID); err != nil {
-				return nil, err
+		for _, prefix := range addressPrefixes {
+			if prefix.Zone != nil && prefix.Zone.Name != nil && *prefix.Zone.Name == zone && prefix.CIDR != nil {
+				prefixes = append(prefixes, *prefix.CIDR)
 			}
 		}
 	}
-	return subnet, err
+	for _, prefix := range prefixes {
+		within, err := cidrWithin(subnetCIDR, prefix)
+		if err != nil {
+			return "", err
+		}
+		if within {
+			return subnetCIDR, nil
+		}
+	}
+	return "", fmt.Errorf("subnet CIDR %s is not within any address prefix of VPC %s in zone %s", subnetCIDR, vpcID, zone)
+}
+
+// cidrWithin returns whether the CIDR block cidr is contained in the CIDR block prefix.
+func cidrWithin(cidr, prefix string) (bool, error) {
+	_, cidrNet, err := net.ParseCIDR(cidr)
+	if err != nil {
+		return false, fmt.Errorf("error parsing CIDR %s: %w", cidr, err)
+	}
+	_, prefixNet, err := net.ParseCIDR(prefix)
+	if err != nil {
+		return false, fmt.Errorf("error parsing address prefix %s: %w", prefix, err)
+	}
+	cidrOnes, _ := cidrNet.Mask.Size()
+	prefixOnes, _ := prefixNet.Mask.Size()
+	return prefixNet.Contains(cidrNet.IP) && cidrOnes >= prefixOnes, nil
 }
 
 func (s *ClusterScope) getSubnetAddrPrefix(vpcID, zone string) (string, error) {
@@ -285,7 +340,7 @@ func (s *ClusterScope) getSubnetAddrPrefix(vpcID, zone string) (string, error) {
 		return true, "", nil
 	}
 
-	if err := utils.PagingHelper(f); err != nil {
+	if err := utils.PagingHelper(context.TODO(), f); err != nil {
 		return "", err
 	}
 
@@ -295,6 +350,132 @@ func (s *ClusterScope) getSubnetAddrPrefix(vpcID, zone string) (string, error) {
 	return "", fmt.Errorf("not found a valid CIDR for VPC %s in zone %s", vpcID, zone)
 }
 
+// ReconcileAddressPrefixes creates the address prefixes of the network spec which are missing from the VPC.
+// Address prefixes removed from the spec are left in the VPC, as subnets may still be using them.
+func (s *ClusterScope) ReconcileAddressPrefixes() error {
+	addressPrefixes := s.addressPrefixes()
+	if len(addressPrefixes) == 0 || s.IBMVPCCluster.Status.VPC.ID == "" {
+		return nil
+	}
+	vpcID := s.IBMVPCCluster.Status.VPC.ID
+
+	existing, err := s.listVPCAddressPrefixes(vpcID)
+	if err != nil {
+		return err
+	}
+	found := map[infrav1beta2.VPCAddressPrefix]bool{}
+	for _, prefix := range existing {
+		if prefix.Zone == nil || prefix.Zone.Name == nil || prefix.CIDR == nil {
+			continue
+		}
+		found[infrav1beta2.VPCAddressPrefix{Zone: *prefix.Zone.Name, CIDR: *prefix.CIDR}] = true
+	}
+
+	for _, prefix := range addressPrefixes {
+		if found[infrav1beta2.VPCAddressPrefix{Zone: prefix.Zone, CIDR: prefix.CIDR}] {
+			continue
+		}
+		if _, _, err := net.ParseCIDR(prefix.CIDR); err != nil {
+			return fmt.Errorf("error parsing address prefix %s: %w", prefix.CIDR, err)
+		}
+		options := &vpcv1.CreateVPCAddressPrefixOptions{}
+		options.SetVPCID(vpcID)
+		options.SetCIDR(prefix.CIDR)
+		options.SetZone(&vpcv1.ZoneIdentityByName{
+			Name: core.StringPtr(prefix.Zone),
+		})
+		if prefix.Name != "" {
+			options.SetName(prefix.Name)
+		}
+		if _, _, err := s.IBMVPCClient.CreateVPCAddressPrefix(options); err != nil {
+			record.Warnf(s.IBMVPCCluster, "FailedCreateVPCAddressPrefix", "Failed vpc address prefix creation - %v", err)
+			return fmt.Errorf("error creating address prefix %s in zone %s: %w", prefix.CIDR, prefix.Zone, err)
+		}
+		record.Eventf(s.IBMVPCCluster, "SuccessfulCreateVPCAddressPrefix", "Created vpc address prefix %q in zone %q", prefix.CIDR, prefix.Zone)
+	}
+	return nil
+}
+
+func (s *ClusterScope) addressPrefixes() []infrav1beta2.VPCAddressPrefix {
+	if s.IBMVPCCluster.Spec.Network == nil {
+		return nil
+	}
+	return s.IBMVPCCluster.Spec.Network.AddressPrefixes
+}
+
+// listVPCAddressPrefixes returns all the address prefixes of a VPC.
+func (s *ClusterScope) listVPCAddressPrefixes(vpcID string) ([]vpcv1.AddressPrefix, error) {
+	addressPrefixes := []vpcv1.AddressPrefix{}
+	f := func(start string) (bool, string, error) {
+		listVPCAddressPrefixesOptions := &vpcv1.ListVPCAddressPrefixesOptions{
+			VPCID: &vpcID,
+		}
+		if start != "" {
+			listVPCAddressPrefixesOptions.Start = &start
+		}
+
+		vpcAddressPrefixesList, _, err := s.IBMVPCClient.ListVPCAddressPrefixes(listVPCAddressPrefixesOptions)
+		if err != nil {
+			return false, "", err
+		}
+		if vpcAddressPrefixesList == nil {
+			return false, "", fmt.Errorf("vpcAddressPrefix list returned is nil")
+		}
+		addressPrefixes = append(addressPrefixes, vpcAddressPrefixesList.AddressPrefixes...)
+
+		if vpcAddressPrefixesList.Next != nil && *vpcAddressPrefixesList.Next.Href != "" {
+			return false, *vpcAddressPrefixesList.Next.Href, nil
+		}
+		return true, "", nil
+	}
+
+	if err := utils.PagingHelper(context.TODO(), f); err != nil {
+		return nil, fmt.Errorf("error listing address prefixes of VPC %s: %w", vpcID, err)
+	}
+	return addressPrefixes, nil
+}
+
+// SetFailureDomains sets the zones of the cluster as failure domains in the status, the zone of the cluster subnet and
+// the zones of the address prefixes of the network spec. Only the zone of the cluster subnet is marked as eligible for
+// control plane machines, as control plane machines are always created in the cluster subnet.
+func (s *ClusterScope) SetFailureDomains() {
+	controlPlaneZone := s.IBMVPCCluster.Spec.Zone
+	if s.IBMVPCCluster.Status.Subnet.Zone != nil {
+		controlPlaneZone = *s.IBMVPCCluster.Status.Subnet.Zone
+	}
+
+	failureDomains := capiv1beta1.FailureDomains{}
+	if controlPlaneZone != "" {
+		failureDomains[controlPlaneZone] = capiv1beta1.FailureDomainSpec{ControlPlane: true}
+	}
+	for _, prefix := range s.addressPrefixes() {
+		if _, ok := failureDomains[prefix.Zone]; !ok {
+			failureDomains[prefix.Zone] = capiv1beta1.FailureDomainSpec{}
+		}
+	}
+	if len(failureDomains) == 0 {
+		failureDomains = nil
+	}
+	s.IBMVPCCluster.Status.FailureDomains = failureDomains
+}
+
+// SetControlPlaneSubnets records the cluster subnet in the control plane subnets machines are spread across.
+func (s *ClusterScope) SetControlPlaneSubnets() {
+	subnet := s.IBMVPCCluster.Status.Subnet
+	if subnet.ID == nil {
+		return
+	}
+	if s.IBMVPCCluster.Status.Network == nil {
+		s.IBMVPCCluster.Status.Network = &infrav1beta2.VPCNetworkStatus{}
+	}
+	for _, controlPlaneSubnet := range s.IBMVPCCluster.Status.Network.ControlPlaneSubnets {
+		if controlPlaneSubnet.ID != nil && *controlPlaneSubnet.ID == *subnet.ID {
+			return
+		}
+	}
+	s.IBMVPCCluster.Status.Network.ControlPlaneSubnets = append(s.IBMVPCCluster.Status.Network.ControlPlaneSubnets, subnet)
+}
+
 func (s *ClusterScope) ensureSubnetUnique(subnetName string) (*vpcv1.Subnet, error) {
 	var subnet *vpcv1.Subnet
 	f := func(start string) (bool, string, error) {
@@ -326,7 +507,7 @@ func (s *ClusterScope) ensureSubnetUnique(subnetName string) (*vpcv1.Subnet, err
 		return true, "", nil
 	}
 
-	if err := utils.PagingHelper(f); err != nil {
+	if err := utils.PagingHelper(context.TODO(), f); err != nil {
 		return nil, err
 	}
 
@@ -372,29 +553,30 @@ func (s *ClusterScope) DeleteSubnet() error {
 		return true, "", nil
 	}
 
-	if err := utils.PagingHelper(f); err != nil {
+	if err := utils.PagingHelper(context.TODO(), f); err != nil {
 		return err
 	}
 
 	if !found {
 		s.Logger.V(3).Info("No subnets found with ID", "Subnet ID", subnetID)
-		return nil
+		return s.deletePublicGateways()
 	}
 
 	// get the pgw id for given subnet, so we can delete it later
-	getPGWOptions := &vpcv1.GetSubnetPublicGatewayOptions{}
-	getPGWOptions.SetID(subnetID)
-	pgw, _, err := s.IBMVPCClient.GetSubnetPublicGateway(getPGWOptions)
+	pgw, err := s.getSubnetPublicGateway(subnetID)
 	if err != nil {
 		return err
 	}
 	if pgw != nil { // public gateway found
 		// Unset the public gateway for subnet first
-		err = s.detachPublicGateway(subnetID, *pgw.ID)
+		err = s.detachPublicGateway(subnetID)
 		if err != nil {
 			return fmt.Errorf("error when detaching publicgateway for subnet %s: %w", subnetID, err)
 		}
 	}
+	if err := s.deletePublicGateways(); err != nil {
+		return fmt.Errorf("error when deleting publicgateway for subnet %s: %w", subnetID, err)
+	}
 
 	// Delete subnet
 	deleteSubnetOption := &vpcv1.DeleteSubnetOptions{}
@@ -407,6 +589,89 @@ func (s *ClusterScope) DeleteSubnet() error {
 	return err
 }
 
+// EnsurePublicGateway makes sure the cluster subnet has a public gateway attached, creating one in the zone of the
+// subnet if the cluster has none there yet. The public gateways are recorded in the status, keyed by zone.
+func (s *ClusterScope) EnsurePublicGateway() error {
+	if !s.publicGatewayEnabled() || s.IBMVPCCluster.Status.Subnet.ID == nil {
+		return nil
+	}
+	subnetID := *s.IBMVPCCluster.Status.Subnet.ID
+	zone := s.IBMVPCCluster.Spec.Zone
+	if s.IBMVPCCluster.Status.Subnet.Zone != nil {
+		zone = *s.IBMVPCCluster.Status.Subnet.Zone
+	}
+
+	attached, err := s.getSubnetPublicGateway(subnetID)
+	if err != nil {
+		return fmt.Errorf("error getting publicgateway for subnet %s: %w", subnetID, err)
+	}
+
+	pgwID := s.getPublicGatewayID(zone)
+	if pgwID == nil {
+		if attached != nil {
+			// Before public gateways were recorded in the status, the controller always attached a gateway it created
+			// to the cluster subnet, so an attached gateway is adopted as one created by the controller.
+			s.setPublicGateway(zone, infrav1beta2.ResourceReference{ID: attached.ID, ControllerCreated: core.BoolPtr(true)})
+			return nil
+		}
+		pgw, err := s.createPublicGateWay(s.IBMVPCCluster.Status.VPC.ID, zone, s.IBMVPCCluster.Spec.ResourceGroup)
+		if err != nil {
+			return err
+		}
+		s.setPublicGateway(zone, infrav1beta2.ResourceReference{ID: pgw.ID, ControllerCreated: core.BoolPtr(true)})
+		pgwID = pgw.ID
+	} else if attached != nil {
+		if *attached.ID != *pgwID {
+			s.Info("Subnet has a different publicgateway attached, skipping attachment", "subnetID", subnetID, "publicGatewayID", *attached.ID)
+		}
+		return nil
+	}
+
+	if _, err := s.attachPublicGateWay(subnetID, *pgwID); err != nil {
+		return fmt.Errorf("error attaching publicgateway %s to subnet %s: %w", *pgwID, subnetID, err)
+	}
+	return nil
+}
+
+func (s *ClusterScope) publicGatewayEnabled() bool {
+	network := s.IBMVPCCluster.Spec.Network
+	return network == nil || network.PublicGateway == nil || *network.PublicGateway
+}
+
+func (s *ClusterScope) getPublicGatewayID(zone string) *string {
+	if s.IBMVPCCluster.Status.Network == nil {
+		return nil
+	}
+	if pgw, ok := s.IBMVPCCluster.Status.Network.PublicGateways[zone]; ok {
+		return pgw.ID
+	}
+	return nil
+}
+
+func (s *ClusterScope) setPublicGateway(zone string, pgw infrav1beta2.ResourceReference) {
+	if s.IBMVPCCluster.Status.Network == nil {
+		s.IBMVPCCluster.Status.Network = &infrav1beta2.VPCNetworkStatus{}
+	}
+	if s.IBMVPCCluster.Status.Network.PublicGateways == nil {
+		s.IBMVPCCluster.Status.Network.PublicGateways = map[string]infrav1beta2.ResourceReference{}
+	}
+	s.IBMVPCCluster.Status.Network.PublicGateways[zone] = pgw
+}
+
+// getSubnetPublicGateway returns the public gateway attached to a subnet, or nil when none is attached.
+func (s *ClusterScope) getSubnetPublicGateway(subnetID string) (*vpcv1.PublicGateway, error) {
+	getPGWOptions := &vpcv1.GetSubnetPublicGatewayOptions{}
+	getPGWOptions.SetID(subnetID)
+	pgw, response, err := s.IBMVPCClient.GetSubnetPublicGateway(getPGWOptions)
+	if err != nil {
+		if errors.Is(vpc.ClassifyError(response, err), vpc.ErrNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return pgw, nil
+}
+
 func (s *ClusterScope) createPublicGateWay(vpcID string, zoneName string, resourceGroupID string) (*vpcv1.PublicGateway, error) {
 	options := &vpcv1.CreatePublicGatewayOptions{}
 	options.SetVPC(&vpcv1.VPCIdentity{
@@ -421,8 +686,10 @@ func (s *ClusterScope) createPublicGateWay(vpcID string, zoneName string, resour
 	publicGateway, _, err := s.IBMVPCClient.CreatePublicGateway(options)
 	if err != nil {
 		record.Warnf(s.IBMVPCCluster, "FailedCreatePublicGateway", "Failed publicgateway creation - %v", err)
+		return nil, err
 	}
-	return publicGateway, err
+	record.Eventf(s.IBMVPCCluster, "SuccessfulCreatePublicGateway", "Created publicgateway %q in zone %q", *publicGateway.ID, zoneName)
+	return publicGateway, nil
 }
 
 func (s *ClusterScope) attachPublicGateWay(subnetID string, pgwID string) (*vpcv1.PublicGateway, error) {
@@ -438,8 +705,7 @@ func (s *ClusterScope) attachPublicGateWay(subnetID string, pgwID string) (*vpcv
 	return publicGateway, err
 }
 
-func (s *ClusterScope) detachPublicGateway(subnetID string, pgwID string) error {
-	// Unset the publicgateway first, and then delete it
+func (s *ClusterScope) detachPublicGateway(subnetID string) error {
 	unsetPGWOption := &vpcv1.UnsetSubnetPublicGatewayOptions{}
 	unsetPGWOption.SetID(subnetID)
 	_, err := s.IBMVPCClient.UnsetSubnetPublicGateway(unsetPGWOption)
@@ -447,16 +713,460 @@ func (s *ClusterScope) detachPublicGateway(subnetID string, pgwID string) error
 		record.Warnf(s.IBMVPCCluster, "FailedDetachPublicGateway", "Failed publicgateway detachment - %v", err)
 		return fmt.Errorf("error when unsetting publicgateway for subnet %s: %w", subnetID, err)
 	}
+	return nil
+}
+
+// deletePublicGateways deletes the public gateways created by the controller, which must already be detached from
+// the subnets, and removes them from the status.
+func (s *ClusterScope) deletePublicGateways() error {
+	if s.IBMVPCCluster.Status.Network == nil {
+		return nil
+	}
+	for zone, pgw := range s.IBMVPCCluster.Status.Network.PublicGateways {
+		if pgw.ID == nil || pgw.ControllerCreated == nil || !*pgw.ControllerCreated {
+			delete(s.IBMVPCCluster.Status.Network.PublicGateways, zone)
+			continue
+		}
+		deletePGWOption := &vpcv1.DeletePublicGatewayOptions{}
+		deletePGWOption.SetID(*pgw.ID)
+		response, err := s.IBMVPCClient.DeletePublicGateway(deletePGWOption)
+		if err != nil && !errors.Is(vpc.ClassifyError(response, err), vpc.ErrNotFound) {
+			record.Warnf(s.IBMVPCCluster, "FailedDeletePublicGateway", "Failed publicgateway deletion - %v", err)
+			return fmt.Errorf("error when deleting publicgateway %s: %w", *pgw.ID, err)
+		}
+		delete(s.IBMVPCCluster.Status.Network.PublicGateways, zone)
+	}
+	return nil
+}
+
+// networkACLRuleKey identifies a network ACL rule by the attributes which are compared when diffing rules.
+type networkACLRuleKey struct {
+	action      string
+	direction   string
+	protocol    string
+	source      string
+	destination string
+	portMin     int64
+	portMax     int64
+}
+
+// existingNetworkACLRuleKey returns the ID and key of a rule fetched from a network ACL.
+func existingNetworkACLRuleKey(ruleIntf vpcv1.NetworkACLRuleItemIntf) (string, networkACLRuleKey, bool) {
+	var id, action, direction, protocol, source, destination *string
+	key := networkACLRuleKey{}
+	switch rule := ruleIntf.(type) {
+	case *vpcv1.NetworkACLRuleItemNetworkACLRuleProtocolAll:
+		id, action, direction, protocol, source, destination = rule.ID, rule.Action, rule.Direction, rule.Protocol, rule.Source, rule.Destination
+	case *vpcv1.NetworkACLRuleItemNetworkACLRuleProtocolTcpudp:
+		id, action, direction, protocol, source, destination = rule.ID, rule.Action, rule.Direction, rule.Protocol, rule.Source, rule.Destination
+		if rule.DestinationPortMin != nil {
+			key.portMin = *rule.DestinationPortMin
+		}
+		if rule.DestinationPortMax != nil {
+			key.portMax = *rule.DestinationPortMax
+		}
+	case *vpcv1.NetworkACLRuleItemNetworkACLRuleProtocolIcmp:
+		id, action, direction, protocol, source, destination = rule.ID, rule.Action, rule.Direction, rule.Protocol, rule.Source, rule.Destination
+	default:
+		return "", key, false
+	}
+	if id == nil || action == nil || direction == nil || protocol == nil || source == nil || destination == nil {
+		return "", key, false
+	}
+	key.action, key.direction, key.protocol, key.source, key.destination = *action, *direction, *protocol, *source, *destination
+	return *id, key, true
+}
+
+// desiredNetworkACLRule returns the key and prototype of a network ACL rule from the spec.
+func desiredNetworkACLRule(rule infrav1beta2.VPCNetworkACLRule) (networkACLRuleKey, *vpcv1.NetworkACLRulePrototype) {
+	key := networkACLRuleKey{
+		action:      string(rule.Action),
+		direction:   string(rule.Direction),
+		protocol:    string(rule.Protocol),
+		source:      rule.Source,
+		destination: rule.Destination,
+	}
+	if key.protocol == "" {
+		key.protocol = string(infrav1beta2.VPCSecurityGroupRuleProtocolAll)
+	}
+	if key.source == "" {
+		key.source = "0.0.0.0/0"
+	}
+	if key.destination == "" {
+		key.destination = "0.0.0.0/0"
+	}
+
+	prototype := &vpcv1.NetworkACLRulePrototype{
+		Action:      core.StringPtr(key.action),
+		Direction:   core.StringPtr(key.direction),
+		Protocol:    core.StringPtr(key.protocol),
+		Source:      core.StringPtr(key.source),
+		Destination: core.StringPtr(key.destination),
+	}
+	if key.protocol == string(infrav1beta2.VPCSecurityGroupRuleProtocolTCP) || key.protocol == string(infrav1beta2.VPCSecurityGroupRuleProtocolUDP) {
+		// Rules created without a port range match all ports.
+		key.portMin, key.portMax = 1, 65535
+		if rule.PortRange != nil {
+			key.portMin, key.portMax = rule.PortRange.MinimumPort, rule.PortRange.MaximumPort
+		}
+		prototype.DestinationPortMin = core.Int64Ptr(key.portMin)
+		prototype.DestinationPortMax = core.Int64Ptr(key.portMax)
+	}
+	return key, prototype
+}
+
+// EnsureNetworkACL makes sure the network ACL in the network spec exists with the rules of the spec and is attached
+// to the cluster subnet. When the network ACL is removed from the spec, the one created by the controller is
+// detached from the subnet and deleted.
+func (s *ClusterScope) EnsureNetworkACL() error {
+	var aclSpec *infrav1beta2.VPCNetworkACLSpec
+	if s.IBMVPCCluster.Spec.Network != nil {
+		aclSpec = s.IBMVPCCluster.Spec.Network.NetworkACL
+	}
+	if aclSpec == nil {
+		return s.DeleteNetworkACL()
+	}
+	if s.IBMVPCCluster.Status.VPC.ID == "" {
+		return nil
+	}
+
+	aclID := s.getNetworkACLID()
+	if aclID == nil {
+		acl, err := s.createNetworkACL(aclSpec)
+		if err != nil {
+			return err
+		}
+		s.setNetworkACL(&infrav1beta2.ResourceReference{ID: acl.ID, ControllerCreated: core.BoolPtr(true)})
+		aclID = acl.ID
+	}
+
+	if err := s.reconcileNetworkACLRules(*aclID, aclSpec.Rules); err != nil {
+		return err
+	}
 
-	// Delete the public gateway
-	deletePGWOption := &vpcv1.DeletePublicGatewayOptions{}
-	deletePGWOption.SetID(pgwID)
-	_, err = s.IBMVPCClient.DeletePublicGateway(deletePGWOption)
+	if s.IBMVPCCluster.Status.Subnet.ID == nil {
+		return nil
+	}
+	subnetID := *s.IBMVPCCluster.Status.Subnet.ID
+	attached, _, err := s.IBMVPCClient.GetSubnetNetworkACL(&vpcv1.GetSubnetNetworkACLOptions{ID: core.StringPtr(subnetID)})
 	if err != nil {
-		record.Warnf(s.IBMVPCCluster, "FailedDeletePublicGateway", "Failed publicgateway deletion - %v", err)
-		return fmt.Errorf("error when deleting publicgateway for subnet %s: %w", subnetID, err)
+		return fmt.Errorf("error getting network ACL of subnet %s: %w", subnetID, err)
 	}
-	return err
+	if attached != nil && attached.ID != nil && *attached.ID == *aclID {
+		return nil
+	}
+	return s.attachNetworkACL(subnetID, *aclID)
+}
+
+// DeleteNetworkACL re-attaches the VPC default network ACL to the cluster subnet in place of the network ACL in the
+// status, and deletes the network ACL if it was created by the controller.
+func (s *ClusterScope) DeleteNetworkACL() error {
+	aclID := s.getNetworkACLID()
+	if aclID == nil {
+		return nil
+	}
+
+	if s.IBMVPCCluster.Status.Subnet.ID != nil {
+		subnetID := *s.IBMVPCCluster.Status.Subnet.ID
+		attached, response, err := s.IBMVPCClient.GetSubnetNetworkACL(&vpcv1.GetSubnetNetworkACLOptions{ID: core.StringPtr(subnetID)})
+		if err != nil && !errors.Is(vpc.ClassifyError(response, err), vpc.ErrNotFound) {
+			return fmt.Errorf("error getting network ACL of subnet %s: %w", subnetID, err)
+		}
+		if err == nil && attached != nil && attached.ID != nil && *attached.ID == *aclID {
+			defaultACLID, err := s.getDefaultNetworkACLID()
+			if err != nil {
+				return err
+			}
+			if err := s.attachNetworkACL(subnetID, defaultACLID); err != nil {
+				return err
+			}
+		}
+	}
+
+	acl := s.IBMVPCCluster.Status.Network.NetworkACL
+	if acl.ControllerCreated != nil && *acl.ControllerCreated {
+		response, err := s.IBMVPCClient.DeleteNetworkACL(&vpcv1.DeleteNetworkACLOptions{ID: aclID})
+		if err != nil && !errors.Is(vpc.ClassifyError(response, err), vpc.ErrNotFound) {
+			record.Warnf(s.IBMVPCCluster, "FailedDeleteNetworkACL", "Failed network ACL deletion - %v", err)
+			return fmt.Errorf("error when deleting network ACL %s: %w", *aclID, err)
+		}
+	}
+	s.setNetworkACL(nil)
+	return nil
+}
+
+func (s *ClusterScope) getNetworkACLID() *string {
+	if s.IBMVPCCluster.Status.Network == nil || s.IBMVPCCluster.Status.Network.NetworkACL == nil {
+		return nil
+	}
+	return s.IBMVPCCluster.Status.Network.NetworkACL.ID
+}
+
+func (s *ClusterScope) setNetworkACL(acl *infrav1beta2.ResourceReference) {
+	if s.IBMVPCCluster.Status.Network == nil {
+		if acl == nil {
+			return
+		}
+		s.IBMVPCCluster.Status.Network = &infrav1beta2.VPCNetworkStatus{}
+	}
+	s.IBMVPCCluster.Status.Network.NetworkACL = acl
+}
+
+// getDefaultNetworkACLID returns the ID of the default network ACL of the cluster VPC.
+func (s *ClusterScope) getDefaultNetworkACLID() (string, error) {
+	vpc, _, err := s.IBMVPCClient.GetVPC(&vpcv1.GetVPCOptions{ID: core.StringPtr(s.IBMVPCCluster.Status.VPC.ID)})
+	if err != nil {
+		return "", fmt.Errorf("error getting VPC %s: %w", s.IBMVPCCluster.Status.VPC.ID, err)
+	}
+	if vpc == nil || vpc.DefaultNetworkACL == nil || vpc.DefaultNetworkACL.ID == nil {
+		return "", fmt.Errorf("error default network ACL not found for VPC %s", s.IBMVPCCluster.Status.VPC.ID)
+	}
+	return *vpc.DefaultNetworkACL.ID, nil
+}
+
+// createNetworkACL creates a network ACL without rules, the rules are added by reconcileNetworkACLRules before the
+// network ACL is attached to the subnet.
+func (s *ClusterScope) createNetworkACL(aclSpec *infrav1beta2.VPCNetworkACLSpec) (*vpcv1.NetworkACL, error) {
+	name := aclSpec.Name
+	if name == "" {
+		name = fmt.Sprintf("%s-acl", s.IBMVPCCluster.Name)
+	}
+	acl, _, err := s.IBMVPCClient.CreateNetworkACL(&vpcv1.CreateNetworkACLOptions{
+		NetworkACLPrototype: &vpcv1.NetworkACLPrototypeNetworkACLByRules{
+			Name: core.StringPtr(name),
+			VPC: &vpcv1.VPCIdentity{
+				ID: core.StringPtr(s.IBMVPCCluster.Status.VPC.ID),
+			},
+			ResourceGroup: &vpcv1.ResourceGroupIdentity{
+				ID: core.StringPtr(s.IBMVPCCluster.Spec.ResourceGroup),
+			},
+		},
+	})
+	if err != nil {
+		record.Warnf(s.IBMVPCCluster, "FailedCreateNetworkACL", "Failed network ACL creation - %v", err)
+		return nil, fmt.Errorf("error when creating network ACL %s: %w", name, err)
+	}
+	record.Eventf(s.IBMVPCCluster, "SuccessfulCreateNetworkACL", "Created network ACL %q", *acl.ID)
+	return acl, nil
+}
+
+func (s *ClusterScope) attachNetworkACL(subnetID string, aclID string) error {
+	_, _, err := s.IBMVPCClient.ReplaceSubnetNetworkACL(&vpcv1.ReplaceSubnetNetworkACLOptions{
+		ID:                 core.StringPtr(subnetID),
+		NetworkACLIdentity: &vpcv1.NetworkACLIdentityByID{ID: core.StringPtr(aclID)},
+	})
+	if err != nil {
+		record.Warnf(s.IBMVPCCluster, "FailedAttachNetworkACL", "Failed network ACL attachment - %v", err)
+		return fmt.Errorf("error attaching network ACL %s to subnet %s: %w", aclID, subnetID, err)
+	}
+	return nil
+}
+
+// listNetworkACLRules returns the rules of a network ACL in the order they are evaluated.
+func (s *ClusterScope) listNetworkACLRules(aclID string) ([]vpcv1.NetworkACLRuleItemIntf, error) {
+	rules := []vpcv1.NetworkACLRuleItemIntf{}
+	f := func(start string) (bool, string, error) {
+		listRulesOptions := &vpcv1.ListNetworkACLRulesOptions{NetworkACLID: core.StringPtr(aclID)}
+		if start != "" {
+			listRulesOptions.Start = &start
+		}
+
+		rulesList, _, err := s.IBMVPCClient.ListNetworkACLRules(listRulesOptions)
+		if err != nil {
+			return false, "", err
+		}
+		if rulesList == nil {
+			return false, "", fmt.Errorf("network ACL rule list returned is nil")
+		}
+		rules = append(rules, rulesList.Rules...)
+
+		if rulesList.Next != nil && *rulesList.Next.Href != "" {
+			return false, *rulesList.Next.Href, nil
+		}
+		return true, "", nil
+	}
+
+	if err := utils.PagingHelper(context.TODO(), f); err != nil {
+		return nil, fmt.Errorf("error listing rules of network ACL %s: %w", aclID, err)
+	}
+	return rules, nil
+}
+
+// reconcileNetworkACLRules makes the ordered rules of a network ACL match the rules of the spec. Rules are compared
+// by position, action, protocol, ports and CIDRs. From the first rule which differs, the rules of the spec are
+// appended to the network ACL before the remaining existing rules are deleted, so that a reorder never leaves
+// traffic unmatched in between.
+func (s *ClusterScope) reconcileNetworkACLRules(aclID string, rules []infrav1beta2.VPCNetworkACLRule) error {
+	existing, err := s.listNetworkACLRules(aclID)
+	if err != nil {
+		return err
+	}
+
+	existingIDs := make([]string, 0, len(existing))
+	existingKeys := make([]networkACLRuleKey, 0, len(existing))
+	for _, ruleIntf := range existing {
+		id, key, ok := existingNetworkACLRuleKey(ruleIntf)
+		if !ok {
+			return fmt.Errorf("error unexpected rule in network ACL %s", aclID)
+		}
+		existingIDs = append(existingIDs, id)
+		existingKeys = append(existingKeys, key)
+	}
+
+	mismatch := 0
+	for ; mismatch < len(rules) && mismatch < len(existingKeys); mismatch++ {
+		if key, _ := desiredNetworkACLRule(rules[mismatch]); key != existingKeys[mismatch] {
+			break
+		}
+	}
+	if mismatch == len(rules) && mismatch == len(existingKeys) {
+		return nil
+	}
+
+	for _, rule := range rules[mismatch:] {
+		key, prototype := desiredNetworkACLRule(rule)
+		s.V(3).Info("Creating network ACL rule", "networkACLID", aclID, "action", key.action, "direction", key.direction, "protocol", key.protocol, "source", key.source, "destination", key.destination)
+		if _, _, err := s.IBMVPCClient.CreateNetworkACLRule(&vpcv1.CreateNetworkACLRuleOptions{
+			NetworkACLID:            core.StringPtr(aclID),
+			NetworkACLRulePrototype: prototype,
+		}); err != nil {
+			record.Warnf(s.IBMVPCCluster, "FailedCreateNetworkACLRule", "Failed network ACL rule creation - %v", err)
+			return fmt.Errorf("error creating rule in network ACL %s: %w", aclID, err)
+		}
+	}
+
+	for _, id := range existingIDs[mismatch:] {
+		s.V(3).Info("Deleting network ACL rule", "networkACLID", aclID, "id", id)
+		if _, err := s.IBMVPCClient.DeleteNetworkACLRule(&vpcv1.DeleteNetworkACLRuleOptions{
+			NetworkACLID: core.StringPtr(aclID),
+			ID:           core.StringPtr(id),
+		}); err != nil {
+			record.Warnf(s.IBMVPCCluster, "FailedDeleteNetworkACLRule", "Failed network ACL rule deletion - %v", err)
+			return fmt.Errorf("error deleting rule %s in network ACL %s: %w", id, aclID, err)
+		}
+	}
+	return nil
+}
+
+// EnsureFlowLogs makes sure the flow log collector in the network spec exists for the cluster VPC or subnet. When the
+// flow logs are removed from the spec, the flow log collector created by the controller is deleted.
+func (s *ClusterScope) EnsureFlowLogs() error {
+	var flowLogsSpec *infrav1beta2.VPCFlowLogsSpec
+	if s.IBMVPCCluster.Spec.Network != nil {
+		flowLogsSpec = s.IBMVPCCluster.Spec.Network.FlowLogs
+	}
+	if flowLogsSpec == nil {
+		return s.DeleteFlowLogs()
+	}
+	if s.getFlowLogCollectorID() != nil || s.IBMVPCCluster.Status.VPC.ID == "" {
+		return nil
+	}
+
+	var target vpcv1.FlowLogCollectorTargetPrototypeIntf
+	switch flowLogsSpec.Target {
+	case infrav1beta2.VPCFlowLogsTargetSubnet:
+		if s.IBMVPCCluster.Status.Subnet.ID == nil {
+			return nil
+		}
+		target = &vpcv1.FlowLogCollectorTargetPrototypeSubnetIdentitySubnetIdentityByID{ID: s.IBMVPCCluster.Status.Subnet.ID}
+	default:
+		target = &vpcv1.FlowLogCollectorTargetPrototypeVPCIdentityVPCIdentityByID{ID: core.StringPtr(s.IBMVPCCluster.Status.VPC.ID)}
+	}
+
+	name := flowLogsSpec.Name
+	if name == "" {
+		name = fmt.Sprintf("%s-flowlogs", s.IBMVPCCluster.Name)
+	}
+	collector, err := s.getFlowLogCollectorByName(name)
+	if err != nil {
+		return err
+	}
+	if collector == nil {
+		if collector, err = s.createFlowLogCollector(name, flowLogsSpec.COSBucketName, target); err != nil {
+			return err
+		}
+	}
+	s.setFlowLogCollector(&infrav1beta2.ResourceReference{ID: collector.ID, ControllerCreated: core.BoolPtr(true)})
+	return nil
+}
+
+// DeleteFlowLogs deletes the flow log collector in the status if it was created by the controller.
+func (s *ClusterScope) DeleteFlowLogs() error {
+	collectorID := s.getFlowLogCollectorID()
+	if collectorID == nil {
+		return nil
+	}
+
+	collector := s.IBMVPCCluster.Status.Network.FlowLogCollector
+	if collector.ControllerCreated != nil && *collector.ControllerCreated {
+		response, err := s.IBMVPCClient.DeleteFlowLogCollector(&vpcv1.DeleteFlowLogCollectorOptions{ID: collectorID})
+		if err != nil && !errors.Is(vpc.ClassifyError(response, err), vpc.ErrNotFound) {
+			record.Warnf(s.IBMVPCCluster, "FailedDeleteFlowLogCollector", "Failed flow log collector deletion - %v", err)
+			return fmt.Errorf("error when deleting flow log collector %s: %w", *collectorID, err)
+		}
+		record.Eventf(s.IBMVPCCluster, "SuccessfulDeleteFlowLogCollector", "Deleted flow log collector %q", *collectorID)
+	}
+	s.setFlowLogCollector(nil)
+	return nil
+}
+
+func (s *ClusterScope) getFlowLogCollectorID() *string {
+	if s.IBMVPCCluster.Status.Network == nil || s.IBMVPCCluster.Status.Network.FlowLogCollector == nil {
+		return nil
+	}
+	return s.IBMVPCCluster.Status.Network.FlowLogCollector.ID
+}
+
+func (s *ClusterScope) setFlowLogCollector(collector *infrav1beta2.ResourceReference) {
+	if s.IBMVPCCluster.Status.Network == nil {
+		if collector == nil {
+			return
+		}
+		s.IBMVPCCluster.Status.Network = &infrav1beta2.VPCNetworkStatus{}
+	}
+	s.IBMVPCCluster.Status.Network.FlowLogCollector = collector
+}
+
+// getFlowLogCollectorByName returns the flow log collector of the cluster VPC with the name, nil when there is none.
+// It lets a flow log collector created by a previous reconcile whose status was not recorded be reused.
+func (s *ClusterScope) getFlowLogCollectorByName(name string) (*vpcv1.FlowLogCollector, error) {
+	collectors, _, err := s.IBMVPCClient.ListFlowLogCollectors(&vpcv1.ListFlowLogCollectorsOptions{
+		Name:  core.StringPtr(name),
+		VPCID: core.StringPtr(s.IBMVPCCluster.Status.VPC.ID),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error listing flow log collectors of VPC %s: %w", s.IBMVPCCluster.Status.VPC.ID, err)
+	}
+	if collectors == nil {
+		return nil, nil
+	}
+	for i, collector := range collectors.FlowLogCollectors {
+		if collector.Name != nil && *collector.Name == name {
+			return &collectors.FlowLogCollectors[i], nil
+		}
+	}
+	return nil, nil
+}
+
+// createFlowLogCollector creates an active flow log collector writing the flow logs of the target to the bucket.
+func (s *ClusterScope) createFlowLogCollector(name, bucketName string, target vpcv1.FlowLogCollectorTargetPrototypeIntf) (*vpcv1.FlowLogCollector, error) {
+	collector, _, err := s.IBMVPCClient.CreateFlowLogCollector(&vpcv1.CreateFlowLogCollectorOptions{
+		Name:   core.StringPtr(name),
+		Active: core.BoolPtr(true),
+		StorageBucket: &vpcv1.LegacyCloudObjectStorageBucketIdentityCloudObjectStorageBucketIdentityByName{
+			Name: core.StringPtr(bucketName),
+		},
+		Target: target,
+		ResourceGroup: &vpcv1.ResourceGroupIdentity{
+			ID: core.StringPtr(s.IBMVPCCluster.Spec.ResourceGroup),
+		},
+	})
+	if err != nil {
+		record.Warnf(s.IBMVPCCluster, "FailedCreateFlowLogCollector", "Failed flow log collector creation - %v", err)
+		return nil, fmt.Errorf("error when creating flow log collector %s: %w", name, err)
+	}
+	record.Eventf(s.IBMVPCCluster, "SuccessfulCreateFlowLogCollector", "Created flow log collector %q", *collector.ID)
+	return collector, nil
 }
 
 // CreateLoadBalancer creates a new IBM VPC load balancer in specified resource group.
@@ -488,7 +1198,7 @@ func (s *ClusterScope) CreateLoadBalancer() (*vpcv1.LoadBalancer, error) {
 	options.SetPools([]vpcv1.LoadBalancerPoolPrototype{
 		{
 			Algorithm:     core.StringPtr("round_robin"),
-			HealthMonitor: &vpcv1.LoadBalancerPoolHealthMonitorPrototype{Delay: core.Int64Ptr(5), MaxRetries: core.Int64Ptr(2), Timeout: core.Int64Ptr(2), Type: core.StringPtr("tcp")},
+			HealthMonitor: loadBalancerPoolHealthMonitorPrototype(s.IBMVPCCluster.Spec.ControlPlaneLoadBalancer.Pool),
 			Name:          core.StringPtr(s.IBMVPCCluster.Spec.ControlPlaneLoadBalancer.Name + "-pool"),
 			Protocol:      core.StringPtr("tcp"),
 		},
@@ -514,6 +1224,480 @@ func (s *ClusterScope) CreateLoadBalancer() (*vpcv1.LoadBalancer, error) {
 	return loadBalancer, nil
 }
 
+// loadBalancerPoolHealthMonitorPrototype returns the health monitor for a load balancer pool, filling in the
+// defaults for any values not set in the pool spec.
+func loadBalancerPoolHealthMonitorPrototype(pool *infrav1beta2.VPCLoadBalancerPoolSpec) *vpcv1.LoadBalancerPoolHealthMonitorPrototype {
+	healthMonitor := &vpcv1.LoadBalancerPoolHealthMonitorPrototype{
+		Delay:      core.Int64Ptr(5),
+		MaxRetries: core.Int64Ptr(2),
+		Timeout:    core.Int64Ptr(2),
+		Type:       core.StringPtr("tcp"),
+	}
+	if pool == nil || pool.HealthMonitor == nil {
+		return healthMonitor
+	}
+
+	spec := pool.HealthMonitor
+	if spec.Type != "" {
+		healthMonitor.Type = core.StringPtr(spec.Type)
+	}
+	if spec.Delay != 0 {
+		healthMonitor.Delay = core.Int64Ptr(spec.Delay)
+	}
+	if spec.MaxRetries != 0 {
+		healthMonitor.MaxRetries = core.Int64Ptr(spec.MaxRetries)
+	}
+	if spec.Timeout != 0 {
+		healthMonitor.Timeout = core.Int64Ptr(spec.Timeout)
+	}
+	if spec.Port != nil {
+		healthMonitor.Port = core.Int64Ptr(*spec.Port)
+	}
+	if spec.URLPath != "" && *healthMonitor.Type != "tcp" {
+		healthMonitor.URLPath = core.StringPtr(spec.URLPath)
+	}
+	return healthMonitor
+}
+
+// healthMonitorMatches reports whether the health monitor of an existing pool matches the desired one.
+func healthMonitorMatches(current *vpcv1.LoadBalancerPoolHealthMonitor, desired *vpcv1.LoadBalancerPoolHealthMonitorPrototype) bool {
+	if current == nil {
+		return false
+	}
+	if !int64PtrEqual(current.Delay, desired.Delay) || !int64PtrEqual(current.MaxRetries, desired.MaxRetries) || !int64PtrEqual(current.Timeout, desired.Timeout) {
+		return false
+	}
+	if current.Type == nil || *current.Type != *desired.Type {
+		return false
+	}
+	if desired.Port != nil && !int64PtrEqual(current.Port, desired.Port) {
+		return false
+	}
+	if desired.URLPath != nil && (current.URLPath == nil || *current.URLPath != *desired.URLPath) {
+		return false
+	}
+	return true
+}
+
+func int64PtrEqual(a, b *int64) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
+
+// ReconcileLoadBalancerPool updates the health monitor of the control plane load balancer pool when it has drifted
+// from the configuration in the spec.
+func (s *ClusterScope) ReconcileLoadBalancerPool(loadBalancer *vpcv1.LoadBalancer) error {
+	poolSpec := s.IBMVPCCluster.Spec.ControlPlaneLoadBalancer.Pool
+	if poolSpec == nil || poolSpec.HealthMonitor == nil || loadBalancer == nil {
+		return nil
+	}
+	if loadBalancer.ProvisioningStatus == nil || *loadBalancer.ProvisioningStatus != string(infrav1beta2.VPCLoadBalancerStateActive) {
+		return nil
+	}
+
+	poolName := s.IBMVPCCluster.Spec.ControlPlaneLoadBalancer.Name + "-pool"
+	var poolID *string
+	for _, pool := range loadBalancer.Pools {
+		if pool.Name != nil && *pool.Name == poolName {
+			poolID = pool.ID
+			break
+		}
+	}
+	if poolID == nil {
+		return fmt.Errorf("error pool %s not found in load balancer %s", poolName, *loadBalancer.ID)
+	}
+
+	pool, _, err := s.IBMVPCClient.GetLoadBalancerPool(&vpcv1.GetLoadBalancerPoolOptions{
+		LoadBalancerID: loadBalancer.ID,
+		ID:             poolID,
+	})
+	if err != nil {
+		return fmt.Errorf("error getting load balancer pool %s: %w", poolName, err)
+	}
+
+	desired := loadBalancerPoolHealthMonitorPrototype(poolSpec)
+	if healthMonitorMatches(pool.HealthMonitor, desired) {
+		return nil
+	}
+
+	s.Logger.Info("Updating load balancer pool health monitor", "pool", poolName)
+	poolPatch, err := (&vpcv1.LoadBalancerPoolPatch{
+		HealthMonitor: &vpcv1.LoadBalancerPoolHealthMonitorPatch{
+			Delay:      desired.Delay,
+			MaxRetries: desired.MaxRetries,
+			Port:       desired.Port,
+			Timeout:    desired.Timeout,
+			Type:       desired.Type,
+			URLPath:    desired.URLPath,
+		},
+	}).AsPatch()
+	if err != nil {
+		return fmt.Errorf("error creating patch for load balancer pool %s: %w", poolName, err)
+	}
+
+	if _, _, err := s.IBMVPCClient.UpdateLoadBalancerPool(&vpcv1.UpdateLoadBalancerPoolOptions{
+		LoadBalancerID:        loadBalancer.ID,
+		ID:                    poolID,
+		LoadBalancerPoolPatch: poolPatch,
+	}); err != nil {
+		record.Warnf(s.IBMVPCCluster, "FailedUpdateLoadBalancerPool", "Failed loadBalancer pool update - %v", err)
+		return fmt.Errorf("error updating load balancer pool %s: %w", poolName, err)
+	}
+
+	record.Eventf(s.IBMVPCCluster, "SuccessfulUpdateLoadBalancerPool", "Updated loadBalancer pool %q", poolName)
+	return nil
+}
+
+// securityGroupRuleKey identifies a security group rule by the attributes which are compared when diffing rules.
+type securityGroupRuleKey struct {
+	direction string
+	protocol  string
+	portMin   int64
+	portMax   int64
+	icmpType  int64
+	icmpCode  int64
+	remote    string
+}
+
+// securityGroupRuleRemoteKey returns the remote part of a securityGroupRuleKey.
+func securityGroupRuleRemoteKey(remote *vpcv1.SecurityGroupRuleRemote) string {
+	switch {
+	case remote == nil:
+		return ""
+	case remote.CIDRBlock != nil:
+		return "cidr:" + *remote.CIDRBlock
+	case remote.Address != nil:
+		return "address:" + *remote.Address
+	case remote.ID != nil:
+		return "sg:" + *remote.ID
+	}
+	return ""
+}
+
+// existingSecurityGroupRuleKey returns the ID and key of a rule fetched from a security group.
+func existingSecurityGroupRuleKey(ruleIntf vpcv1.SecurityGroupRuleIntf) (string, securityGroupRuleKey, bool) {
+	key := securityGroupRuleKey{icmpType: -1, icmpCode: -1}
+	var id *string
+	var remote vpcv1.SecurityGroupRuleRemoteIntf
+	switch rule := ruleIntf.(type) {
+	case *vpcv1.SecurityGroupRuleSecurityGroupRuleProtocolAll:
+		id, remote = rule.ID, rule.Remote
+		key.direction, key.protocol = *rule.Direction, *rule.Protocol
+	case *vpcv1.SecurityGroupRuleSecurityGroupRuleProtocolTcpudp:
+		id, remote = rule.ID, rule.Remote
+		key.direction, key.protocol = *rule.Direction, *rule.Protocol
+		if rule.PortMin != nil {
+			key.portMin = *rule.PortMin
+		}
+		if rule.PortMax != nil {
+			key.portMax = *rule.PortMax
+		}
+	case *vpcv1.SecurityGroupRuleSecurityGroupRuleProtocolIcmp:
+		id, remote = rule.ID, rule.Remote
+		key.direction, key.protocol = *rule.Direction, *rule.Protocol
+		if rule.Type != nil {
+			key.icmpType = *rule.Type
+		}
+		if rule.Code != nil {
+			key.icmpCode = *rule.Code
+		}
+	default:
+		return "", key, false
+	}
+	if id == nil {
+		return "", key, false
+	}
+	if r, ok := remote.(*vpcv1.SecurityGroupRuleRemote); ok {
+		key.remote = securityGroupRuleRemoteKey(r)
+	}
+	return *id, key, true
+}
+
+// desiredSecurityGroupRule is a security group rule from the spec expanded for a single remote.
+type desiredSecurityGroupRule struct {
+	key       securityGroupRuleKey
+	prototype *vpcv1.SecurityGroupRulePrototype
+}
+
+// desiredSecurityGroupRules expands a rule from the spec into one rule per remote.
+func (s *ClusterScope) desiredSecurityGroupRules(rule *infrav1beta2.VPCSecurityGroupRule) ([]desiredSecurityGroupRule, error) {
+	prototype := rule.Source
+	if rule.Direction == infrav1beta2.VPCSecurityGroupRuleDirectionOutbound {
+		prototype = rule.Destination
+	}
+	if prototype == nil {
+		return nil, fmt.Errorf("security group rule with direction %s has no remotes", rule.Direction)
+	}
+
+	key := securityGroupRuleKey{
+		direction: string(rule.Direction),
+		protocol:  string(prototype.Protocol),
+		icmpType:  -1,
+		icmpCode:  -1,
+	}
+	var portMin, portMax, icmpType, icmpCode *int64
+	switch prototype.Protocol {
+	case infrav1beta2.VPCSecurityGroupRuleProtocolTCP, infrav1beta2.VPCSecurityGroupRuleProtocolUDP:
+		// Rules created without a port range allow all ports.
+		key.portMin, key.portMax = 1, 65535
+		if prototype.PortRange != nil {
+			key.portMin, key.portMax = prototype.PortRange.MinimumPort, prototype.PortRange.MaximumPort
+			portMin, portMax = core.Int64Ptr(key.portMin), core.Int64Ptr(key.portMax)
+		}
+	case infrav1beta2.VPCSecurityGroupRuleProtocolIcmp:
+		icmpType, icmpCode = prototype.ICMPType, prototype.ICMPCode
+		if icmpType != nil {
+			key.icmpType = *icmpType
+		}
+		if icmpCode != nil {
+			key.icmpCode = *icmpCode
+		}
+	}
+
+	rules := []desiredSecurityGroupRule{}
+	for _, remote := range prototype.Remotes {
+		remotePrototype, err := s.securityGroupRuleRemotePrototype(remote)
+		if err != nil {
+			return nil, err
+		}
+		remoteKey := key
+		remoteKey.remote = securityGroupRuleRemoteKey(&vpcv1.SecurityGroupRuleRemote{
+			CIDRBlock: remotePrototype.CIDRBlock,
+			Address:   remotePrototype.Address,
+			ID:        remotePrototype.ID,
+		})
+		rules = append(rules, desiredSecurityGroupRule{
+			key: remoteKey,
+			prototype: &vpcv1.SecurityGroupRulePrototype{
+				Direction: core.StringPtr(key.direction),
+				Protocol:  core.StringPtr(key.protocol),
+				PortMin:   portMin,
+				PortMax:   portMax,
+				Type:      icmpType,
+				Code:      icmpCode,
+				Remote:    remotePrototype,
+			},
+		})
+	}
+	return rules, nil
+}
+
+// securityGroupRuleRemotePrototype resolves a remote from the spec to the remote of a security group rule.
+func (s *ClusterScope) securityGroupRuleRemotePrototype(remote infrav1beta2.VPCSecurityGroupRuleRemote) (*vpcv1.SecurityGroupRuleRemotePrototype, error) {
+	switch remote.RemoteType {
+	case infrav1beta2.VPCSecurityGroupRuleRemoteTypeCIDR:
+		if remote.CIDRSubnetName == nil {
+			return nil, fmt.Errorf("cidrSubnetName is required for remote type %s", remote.RemoteType)
+		}
+		subnet, err := s.IBMVPCClient.GetVPCSubnetByName(*remote.CIDRSubnetName)
+		if err != nil {
+			return nil, fmt.Errorf("error getting subnet %s: %w", *remote.CIDRSubnetName, err)
+		}
+		if subnet == nil {
+			return nil, fmt.Errorf("subnet %s not found", *remote.CIDRSubnetName)
+		}
+		return &vpcv1.SecurityGroupRuleRemotePrototype{CIDRBlock: subnet.Ipv4CIDRBlock}, nil
+	case infrav1beta2.VPCSecurityGroupRuleRemoteTypeAddress:
+		if remote.Address == nil {
+			return nil, fmt.Errorf("address is required for remote type %s", remote.RemoteType)
+		}
+		return &vpcv1.SecurityGroupRuleRemotePrototype{Address: remote.Address}, nil
+	case infrav1beta2.VPCSecurityGroupRuleRemoteTypeSG:
+		if remote.SecurityGroupName == nil {
+			return nil, fmt.Errorf("securityGroupName is required for remote type %s", remote.RemoteType)
+		}
+		securityGroup, err := s.IBMVPCClient.GetSecurityGroupByName(*remote.SecurityGroupName)
+		if err != nil {
+			return nil, fmt.Errorf("error getting security group %s: %w", *remote.SecurityGroupName, err)
+		}
+		if securityGroup == nil {
+			return nil, fmt.Errorf("security group %s not found", *remote.SecurityGroupName)
+		}
+		return &vpcv1.SecurityGroupRuleRemotePrototype{ID: securityGroup.ID}, nil
+	default:
+		return &vpcv1.SecurityGroupRuleRemotePrototype{CIDRBlock: core.StringPtr("0.0.0.0/0")}, nil
+	}
+}
+
+// ReconcileSecurityGroupRules creates and deletes the security group rules managed by the controller to match
+// the rules in the network spec. Rules are compared by direction, protocol, ports and remote so that unchanged
+// rules are left in place, and rules which were not created by the controller are never deleted.
+func (s *ClusterScope) ReconcileSecurityGroupRules() error {
+	managed := map[string][]*string{}
+	securityGroupIDs := []string{}
+	if s.IBMVPCCluster.Status.Network != nil {
+		for _, securityGroup := range s.IBMVPCCluster.Status.Network.SecurityGroups {
+			if securityGroup.ID == nil {
+				continue
+			}
+			managed[*securityGroup.ID] = securityGroup.RuleIDs
+			securityGroupIDs = append(securityGroupIDs, *securityGroup.ID)
+		}
+	}
+
+	desired := map[string][]desiredSecurityGroupRule{}
+	if s.IBMVPCCluster.Spec.Network != nil {
+		for _, rule := range s.IBMVPCCluster.Spec.Network.SecurityGroupRules {
+			if rule == nil {
+				continue
+			}
+			securityGroupID, err := s.securityGroupRuleGroupID(rule)
+			if err != nil {
+				return err
+			}
+			rules, err := s.desiredSecurityGroupRules(rule)
+			if err != nil {
+				return err
+			}
+			_, isDesired := desired[securityGroupID]
+			_, isManaged := managed[securityGroupID]
+			if !isDesired && !isManaged {
+				securityGroupIDs = append(securityGroupIDs, securityGroupID)
+			}
+			desired[securityGroupID] = append(desired[securityGroupID], rules...)
+		}
+	}
+
+	for _, securityGroupID := range securityGroupIDs {
+		if err := s.reconcileSecurityGroupRules(securityGroupID, desired[securityGroupID], managed[securityGroupID]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// securityGroupRuleGroupID returns the ID of the security group a rule belongs to, defaulting to the default
+// security group of the cluster VPC.
+func (s *ClusterScope) securityGroupRuleGroupID(rule *infrav1beta2.VPCSecurityGroupRule) (string, error) {
+	if rule.SecurityGroupID != nil && *rule.SecurityGroupID != "" {
+		return *rule.SecurityGroupID, nil
+	}
+	if s.IBMVPCCluster.Status.VPC.ID == "" {
+		return "", fmt.Errorf("error VPC is not yet available for security group rules")
+	}
+	vpc, _, err := s.IBMVPCClient.GetVPC(&vpcv1.GetVPCOptions{ID: core.StringPtr(s.IBMVPCCluster.Status.VPC.ID)})
+	if err != nil {
+		return "", fmt.Errorf("error getting VPC %s: %w", s.IBMVPCCluster.Status.VPC.ID, err)
+	}
+	if vpc == nil || vpc.DefaultSecurityGroup == nil || vpc.DefaultSecurityGroup.ID == nil {
+		return "", fmt.Errorf("error default security group not found for VPC %s", s.IBMVPCCluster.Status.VPC.ID)
+	}
+	return *vpc.DefaultSecurityGroup.ID, nil
+}
+
+// reconcileSecurityGroupRules creates the desired rules missing from a security group and deletes the managed
+// rules which are no longer desired, recording the rules managed in the security group in the status.
+func (s *ClusterScope) reconcileSecurityGroupRules(securityGroupID string, desired []desiredSecurityGroupRule, managedRuleIDs []*string) error {
+	securityGroup, _, err := s.IBMVPCClient.GetSecurityGroup(&vpcv1.GetSecurityGroupOptions{ID: core.StringPtr(securityGroupID)})
+	if err != nil {
+		return fmt.Errorf("error getting security group %s: %w", securityGroupID, err)
+	}
+
+	isManaged := map[string]bool{}
+	for _, id := range managedRuleIDs {
+		if id != nil {
+			isManaged[*id] = true
+		}
+	}
+
+	// Index the existing rules by key, preferring the rules managed by the controller. Managed rules which
+	// no longer exist in the security group are dropped.
+	existing := map[securityGroupRuleKey]string{}
+	managed := []string{}
+	for _, ruleIntf := range securityGroup.Rules {
+		id, key, ok := existingSecurityGroupRuleKey(ruleIntf)
+		if !ok {
+			continue
+		}
+		if isManaged[id] {
+			managed = append(managed, id)
+			existing[key] = id
+		} else if _, ok := existing[key]; !ok {
+			existing[key] = id
+		}
+	}
+	defer func() {
+		s.setSecurityGroupRuleIDs(securityGroupID, managed)
+	}()
+
+	keep := map[string]bool{}
+	for _, rule := range desired {
+		if id, ok := existing[rule.key]; ok {
+			// Rules which existed before they were declared in the spec are left unmanaged.
+			keep[id] = true
+			continue
+		}
+
+		s.V(3).Info("Creating security group rule", "securityGroupID", securityGroupID, "direction", rule.key.direction, "protocol", rule.key.protocol, "remote", rule.key.remote)
+		ruleIntf, _, err := s.IBMVPCClient.CreateSecurityGroupRule(&vpcv1.CreateSecurityGroupRuleOptions{
+			SecurityGroupID:            core.StringPtr(securityGroupID),
+			SecurityGroupRulePrototype: rule.prototype,
+		})
+		if err != nil {
+			record.Warnf(s.IBMVPCCluster, "FailedCreateSecurityGroupRule", "Failed security group rule creation - %v", err)
+			return fmt.Errorf("error creating security group rule in security group %s: %w", securityGroupID, err)
+		}
+		id, _, ok := existingSecurityGroupRuleKey(ruleIntf)
+		if !ok {
+			return fmt.Errorf("error creating security group rule in security group %s: unexpected rule returned", securityGroupID)
+		}
+		existing[rule.key] = id
+		keep[id] = true
+		managed = append(managed, id)
+	}
+
+	for _, id := range append([]string{}, managed...) {
+		if keep[id] {
+			continue
+		}
+		s.V(3).Info("Deleting security group rule", "securityGroupID", securityGroupID, "id", id)
+		if _, err := s.IBMVPCClient.DeleteSecurityGroupRule(&vpcv1.DeleteSecurityGroupRuleOptions{
+			SecurityGroupID: core.StringPtr(securityGroupID),
+			ID:              core.StringPtr(id),
+		}); err != nil {
+			record.Warnf(s.IBMVPCCluster, "FailedDeleteSecurityGroupRule", "Failed security group rule deletion - %v", err)
+			return fmt.Errorf("error deleting security group rule %s in security group %s: %w", id, securityGroupID, err)
+		}
+		managed = slices.DeleteFunc(managed, func(ruleID string) bool { return ruleID == id })
+	}
+	return nil
+}
+
+// setSecurityGroupRuleIDs records the IDs of the rules managed by the controller in a security group.
+func (s *ClusterScope) setSecurityGroupRuleIDs(securityGroupID string, ruleIDs []string) {
+	securityGroups := []infrav1beta2.VPCSecurityGroupStatus{}
+	if s.IBMVPCCluster.Status.Network != nil {
+		for _, securityGroup := range s.IBMVPCCluster.Status.Network.SecurityGroups {
+			if securityGroup.ID == nil || *securityGroup.ID != securityGroupID {
+				securityGroups = append(securityGroups, securityGroup)
+			}
+		}
+	}
+	if len(ruleIDs) > 0 {
+		status := infrav1beta2.VPCSecurityGroupStatus{
+			ID:                core.StringPtr(securityGroupID),
+			ControllerCreated: core.BoolPtr(false),
+		}
+		for _, id := range ruleIDs {
+			status.RuleIDs = append(status.RuleIDs, core.StringPtr(id))
+		}
+		securityGroups = append(securityGroups, status)
+	}
+
+	if len(securityGroups) == 0 {
+		if s.IBMVPCCluster.Status.Network != nil {
+			s.IBMVPCCluster.Status.Network.SecurityGroups = nil
+		}
+		return
+	}
+	if s.IBMVPCCluster.Status.Network == nil {
+		s.IBMVPCCluster.Status.Network = &infrav1beta2.VPCNetworkStatus{}
+	}
+	s.IBMVPCCluster.Status.Network.SecurityGroups = securityGroups
+}
+
 // GetLoadBalancerByHostname retrieves a IBM VPC load balancer with specified hostname.
 func (s *ClusterScope) GetLoadBalancerByHostname(loadBalancerHostname string) (*vpcv1.LoadBalancer, error) {
 	loadBalancer, err := s.getLoadBalancerByHostname(loadBalancerHostname)
@@ -554,7 +1738,7 @@ func (s *ClusterScope) getLoadBalancerByHostname(loadBalancerHostname string) (*
 		return true, "", nil
 	}
 
-	if err := utils.PagingHelper(f); err != nil {
+	if err := utils.PagingHelper(context.TODO(), f); err != nil {
 		return nil, err
 	}
 
@@ -592,7 +1776,7 @@ func (s *ClusterScope) ensureLoadBalancerUnique(loadBalancerName string) (*vpcv1
 		return true, "", nil
 	}
 
-	if err := utils.PagingHelper(f); err != nil {
+	if err := utils.PagingHelper(context.TODO(), f); err != nil {
 		return nil, err
 	}
 
@@ -640,7 +1824,7 @@ func (s *ClusterScope) DeleteLoadBalancer() (bool, error) {
 			return true, "", nil
 		}
 
-		if err := utils.PagingHelper(f); err != nil {
+		if err := utils.PagingHelper(context.TODO(), f); err != nil {
 			return false, err
 		}
 	}
@@ -30,6 +30,8 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
+	capiv1beta1 "sigs.k8s.io/cluster-api/api/v1beta1"
+
 	infrav1beta2 "sigs.k8s.io/cluster-api-provider-ibmcloud/api/v1beta2"
 	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/vpc/mock"
 
@@ -285,7 +287,6 @@ func TestCreateSubnet(t *testing.T) {
 				},
 			},
 		}
-		publicGateway := &vpcv1.PublicGateway{Name: core.StringPtr("foo-public-gateway"), ID: core.StringPtr("foo-public-gateway-id")}
 
 		t.Run("Should create Subnet", func(t *testing.T) {
 			g := NewWithT(t)
@@ -306,8 +307,6 @@ func TestCreateSubnet(t *testing.T) {
 			mockvpc.EXPECT().ListSubnets(gomock.AssignableToTypeOf(&vpcv1.ListSubnetsOptions{})).Return(&vpcv1.SubnetCollection{}, &core.DetailedResponse{}, nil)
 			mockvpc.EXPECT().ListVPCAddressPrefixes(gomock.AssignableToTypeOf(&vpcv1.ListVPCAddressPrefixesOptions{})).Return(addressPrefixCollection, &core.DetailedResponse{}, nil)
 			mockvpc.EXPECT().CreateSubnet(gomock.AssignableToTypeOf(&vpcv1.CreateSubnetOptions{})).Return(subnet, &core.DetailedResponse{}, nil)
-			mockvpc.EXPECT().CreatePublicGateway(gomock.AssignableToTypeOf(&vpcv1.CreatePublicGatewayOptions{})).Return(publicGateway, &core.DetailedResponse{}, nil)
-			mockvpc.EXPECT().SetSubnetPublicGateway(gomock.AssignableToTypeOf(&vpcv1.SetSubnetPublicGatewayOptions{})).Return(publicGateway, &core.DetailedResponse{}, nil)
 			out, err := scope.CreateSubnet()
 			g.Expect(err).To(BeNil())
 			require.Equal(t, expectedOutput, out)
@@ -377,54 +376,324 @@ func TestCreateSubnet(t *testing.T) {
 			g.Expect(err).To(Not(BeNil()))
 		})
 
-		t.Run("Error when creating PublicGateWay", func(t *testing.T) {
+		t.Run("Error when creating Subnet", func(t *testing.T) {
 			g := NewWithT(t)
 			mockController, mockvpc := setup(t)
 			t.Cleanup(mockController.Finish)
 			scope := setupClusterScope(clusterName, mockvpc)
 			scope.IBMVPCCluster.Spec = vpcCluster.Spec
 			scope.IBMVPCCluster.Status = vpcCluster.Status
-			subnet := &vpcv1.Subnet{}
 			mockvpc.EXPECT().ListSubnets(gomock.AssignableToTypeOf(&vpcv1.ListSubnetsOptions{})).Return(&vpcv1.SubnetCollection{}, &core.DetailedResponse{}, nil)
 			mockvpc.EXPECT().ListVPCAddressPrefixes(gomock.AssignableToTypeOf(&vpcv1.ListVPCAddressPrefixesOptions{})).Return(addressPrefixCollection, &core.DetailedResponse{}, nil)
-			mockvpc.EXPECT().CreateSubnet(gomock.AssignableToTypeOf(&vpcv1.CreateSubnetOptions{})).Return(subnet, &core.DetailedResponse{}, nil)
-			mockvpc.EXPECT().CreatePublicGateway(gomock.AssignableToTypeOf(&vpcv1.CreatePublicGatewayOptions{})).Return(&vpcv1.PublicGateway{}, &core.DetailedResponse{}, errors.New("Error when creating PublicGateWay"))
+			mockvpc.EXPECT().CreateSubnet(gomock.AssignableToTypeOf(&vpcv1.CreateSubnetOptions{})).Return(nil, &core.DetailedResponse{}, errors.New("Error when creating Subnet"))
 			_, err := scope.CreateSubnet()
 			g.Expect(err).To(Not(BeNil()))
 		})
 
-		t.Run("Error when attaching PublicGateWay", func(t *testing.T) {
+		t.Run("Should create Subnet with subnet CIDR within declared address prefix", func(t *testing.T) {
 			g := NewWithT(t)
 			mockController, mockvpc := setup(t)
 			t.Cleanup(mockController.Finish)
 			scope := setupClusterScope(clusterName, mockvpc)
 			scope.IBMVPCCluster.Spec = vpcCluster.Spec
-			scope.IBMVPCCluster.Status = vpcCluster.Status
-			subnet := &vpcv1.Subnet{
-				Name: core.StringPtr(scope.IBMVPCCluster.Name + subnetSuffix),
-				ID:   core.StringPtr(scope.IBMVPCCluster.Name + "-subnet-id"),
+			scope.IBMVPCCluster.Spec.Network = &infrav1beta2.VPCNetworkSpec{
+				AddressPrefixes: []infrav1beta2.VPCAddressPrefix{
+					{Zone: "foo-zone", CIDR: "10.240.0.0/18"},
+				},
+				SubnetCIDR: "10.240.0.0/24",
 			}
+			scope.IBMVPCCluster.Status = vpcCluster.Status
 			mockvpc.EXPECT().ListSubnets(gomock.AssignableToTypeOf(&vpcv1.ListSubnetsOptions{})).Return(&vpcv1.SubnetCollection{}, &core.DetailedResponse{}, nil)
-			mockvpc.EXPECT().ListVPCAddressPrefixes(gomock.AssignableToTypeOf(&vpcv1.ListVPCAddressPrefixesOptions{})).Return(addressPrefixCollection, &core.DetailedResponse{}, nil)
-			mockvpc.EXPECT().CreateSubnet(gomock.AssignableToTypeOf(&vpcv1.CreateSubnetOptions{})).Return(subnet, &core.DetailedResponse{}, nil)
-			mockvpc.EXPECT().CreatePublicGateway(gomock.AssignableToTypeOf(&vpcv1.CreatePublicGatewayOptions{})).Return(publicGateway, &core.DetailedResponse{}, nil)
-			mockvpc.EXPECT().SetSubnetPublicGateway(gomock.AssignableToTypeOf(&vpcv1.SetSubnetPublicGatewayOptions{})).Return(&vpcv1.PublicGateway{}, &core.DetailedResponse{}, errors.New("Error when setting SubnetPublicGateWay"))
+			mockvpc.EXPECT().CreateSubnet(gomock.AssignableToTypeOf(&vpcv1.CreateSubnetOptions{})).DoAndReturn(func(options *vpcv1.CreateSubnetOptions) (*vpcv1.Subnet, *core.DetailedResponse, error) {
+				g.Expect(*options.SubnetPrototype.(*vpcv1.SubnetPrototype).Ipv4CIDRBlock).To(Equal("10.240.0.0/24"))
+				return &vpcv1.Subnet{ID: core.StringPtr("foo-cluster-subnet-id")}, &core.DetailedResponse{}, nil
+			})
 			_, err := scope.CreateSubnet()
-			g.Expect(err).To(Not(BeNil()))
+			g.Expect(err).To(BeNil())
 		})
 
-		t.Run("Error when creating Subnet", func(t *testing.T) {
+		t.Run("Error when subnet CIDR is outside of the address prefixes", func(t *testing.T) {
 			g := NewWithT(t)
 			mockController, mockvpc := setup(t)
 			t.Cleanup(mockController.Finish)
 			scope := setupClusterScope(clusterName, mockvpc)
 			scope.IBMVPCCluster.Spec = vpcCluster.Spec
+			scope.IBMVPCCluster.Spec.Network = &infrav1beta2.VPCNetworkSpec{
+				AddressPrefixes: []infrav1beta2.VPCAddressPrefix{
+					{Zone: "foo-zone", CIDR: "10.240.0.0/18"},
+					{Zone: "bar-zone", CIDR: "10.240.64.0/18"},
+				},
+				SubnetCIDR: "10.240.64.0/24",
+			}
 			scope.IBMVPCCluster.Status = vpcCluster.Status
 			mockvpc.EXPECT().ListSubnets(gomock.AssignableToTypeOf(&vpcv1.ListSubnetsOptions{})).Return(&vpcv1.SubnetCollection{}, &core.DetailedResponse{}, nil)
-			mockvpc.EXPECT().ListVPCAddressPrefixes(gomock.AssignableToTypeOf(&vpcv1.ListVPCAddressPrefixesOptions{})).Return(addressPrefixCollection, &core.DetailedResponse{}, nil)
-			mockvpc.EXPECT().CreateSubnet(gomock.AssignableToTypeOf(&vpcv1.CreateSubnetOptions{})).Return(nil, &core.DetailedResponse{}, errors.New("Error when creating Subnet"))
 			_, err := scope.CreateSubnet()
+			g.Expect(err).To(MatchError(ContainSubstring("not within any address prefix")))
+		})
+	})
+}
+
+func TestReconcileAddressPrefixes(t *testing.T) {
+	setup := func(t *testing.T) (*gomock.Controller, *mock.MockVpc) {
+		t.Helper()
+		return gomock.NewController(t), mock.NewMockVpc(gomock.NewController(t))
+	}
+
+	vpcCluster := infrav1beta2.IBMVPCCluster{
+		Spec: infrav1beta2.IBMVPCClusterSpec{
+			Network: &infrav1beta2.VPCNetworkSpec{
+				AddressPrefixes: []infrav1beta2.VPCAddressPrefix{
+					{Name: "foo-prefix-1", Zone: "foo-zone-1", CIDR: "10.240.0.0/18"},
+					{Name: "foo-prefix-2", Zone: "foo-zone-2", CIDR: "10.240.64.0/18"},
+				},
+			},
+		},
+		Status: infrav1beta2.IBMVPCClusterStatus{
+			VPC: infrav1beta2.VPC{
+				ID: "foo-vpc-id",
+			},
+		},
+	}
+
+	t.Run("Reconcile AddressPrefixes", func(t *testing.T) {
+		t.Run("Should create missing AddressPrefixes", func(t *testing.T) {
+			g := NewWithT(t)
+			mockController, mockvpc := setup(t)
+			t.Cleanup(mockController.Finish)
+			scope := setupClusterScope(clusterName, mockvpc)
+			scope.IBMVPCCluster.Spec = vpcCluster.Spec
+			scope.IBMVPCCluster.Status = vpcCluster.Status
+			addressPrefixCollection := &vpcv1.AddressPrefixCollection{
+				AddressPrefixes: []vpcv1.AddressPrefix{
+					{
+						CIDR: core.StringPtr("10.240.0.0/18"),
+						Zone: &vpcv1.ZoneReference{
+							Name: core.StringPtr("foo-zone-1"),
+						},
+					},
+				},
+			}
+			mockvpc.EXPECT().ListVPCAddressPrefixes(gomock.AssignableToTypeOf(&vpcv1.ListVPCAddressPrefixesOptions{})).Return(addressPrefixCollection, &core.DetailedResponse{}, nil)
+			mockvpc.EXPECT().CreateVPCAddressPrefix(gomock.AssignableToTypeOf(&vpcv1.CreateVPCAddressPrefixOptions{})).DoAndReturn(func(options *vpcv1.CreateVPCAddressPrefixOptions) (*vpcv1.AddressPrefix, *core.DetailedResponse, error) {
+				g.Expect(*options.VPCID).To(Equal("foo-vpc-id"))
+				g.Expect(*options.CIDR).To(Equal("10.240.64.0/18"))
+				g.Expect(*options.Zone.(*vpcv1.ZoneIdentityByName).Name).To(Equal("foo-zone-2"))
+				g.Expect(*options.Name).To(Equal("foo-prefix-2"))
+				return &vpcv1.AddressPrefix{}, &core.DetailedResponse{}, nil
+			})
+			err := scope.ReconcileAddressPrefixes()
+			g.Expect(err).To(BeNil())
+		})
+		t.Run("Should skip when no AddressPrefixes are declared", func(t *testing.T) {
+			g := NewWithT(t)
+			mockController, mockvpc := setup(t)
+			t.Cleanup(mockController.Finish)
+			scope := setupClusterScope(clusterName, mockvpc)
+			scope.IBMVPCCluster.Status = vpcCluster.Status
+			err := scope.ReconcileAddressPrefixes()
+			g.Expect(err).To(BeNil())
+		})
+		t.Run("Error when creating AddressPrefix fails", func(t *testing.T) {
+			g := NewWithT(t)
+			mockController, mockvpc := setup(t)
+			t.Cleanup(mockController.Finish)
+			scope := setupClusterScope(clusterName, mockvpc)
+			scope.IBMVPCCluster.Spec = vpcCluster.Spec
+			scope.IBMVPCCluster.Status = vpcCluster.Status
+			mockvpc.EXPECT().ListVPCAddressPrefixes(gomock.AssignableToTypeOf(&vpcv1.ListVPCAddressPrefixesOptions{})).Return(&vpcv1.AddressPrefixCollection{}, &core.DetailedResponse{}, nil)
+			mockvpc.EXPECT().CreateVPCAddressPrefix(gomock.AssignableToTypeOf(&vpcv1.CreateVPCAddressPrefixOptions{})).Return(nil, &core.DetailedResponse{}, errors.New("failed to create address prefix"))
+			err := scope.ReconcileAddressPrefixes()
+			g.Expect(err).To(Not(BeNil()))
+		})
+	})
+}
+
+func TestSetFailureDomains(t *testing.T) {
+	t.Run("Should set the cluster subnet zone as the control plane failure domain", func(t *testing.T) {
+		g := NewWithT(t)
+		scope := setupClusterScope(clusterName, nil)
+		scope.IBMVPCCluster.Spec.Zone = "foo-zone-1"
+		scope.IBMVPCCluster.Status.Subnet.Zone = core.StringPtr("foo-zone-1")
+		scope.SetFailureDomains()
+		g.Expect(scope.IBMVPCCluster.Status.FailureDomains).To(Equal(capiv1beta1.FailureDomains{
+			"foo-zone-1": capiv1beta1.FailureDomainSpec{ControlPlane: true},
+		}))
+	})
+	t.Run("Should set the address prefix zones as worker failure domains", func(t *testing.T) {
+		g := NewWithT(t)
+		scope := setupClusterScope(clusterName, nil)
+		scope.IBMVPCCluster.Spec.Zone = "foo-zone-1"
+		scope.IBMVPCCluster.Spec.Network = &infrav1beta2.VPCNetworkSpec{
+			AddressPrefixes: []infrav1beta2.VPCAddressPrefix{
+				{Zone: "foo-zone-1", CIDR: "10.240.0.0/18"},
+				{Zone: "foo-zone-2", CIDR: "10.240.64.0/18"},
+				{Zone: "foo-zone-3", CIDR: "10.240.128.0/18"},
+			},
+		}
+		scope.SetFailureDomains()
+		g.Expect(scope.IBMVPCCluster.Status.FailureDomains).To(Equal(capiv1beta1.FailureDomains{
+			"foo-zone-1": capiv1beta1.FailureDomainSpec{ControlPlane: true},
+			"foo-zone-2": capiv1beta1.FailureDomainSpec{},
+			"foo-zone-3": capiv1beta1.FailureDomainSpec{},
+		}))
+	})
+	t.Run("Should not set failure domains when no zone is known", func(t *testing.T) {
+		g := NewWithT(t)
+		scope := setupClusterScope(clusterName, nil)
+		scope.SetFailureDomains()
+		g.Expect(scope.IBMVPCCluster.Status.FailureDomains).To(BeNil())
+	})
+}
+
+func TestSetControlPlaneSubnets(t *testing.T) {
+	subnet := infrav1beta2.Subnet{
+		ID:   core.StringPtr("foo-subnet-id"),
+		Zone: core.StringPtr("foo-zone-1"),
+	}
+
+	t.Run("Should record the cluster subnet", func(t *testing.T) {
+		g := NewWithT(t)
+		scope := setupClusterScope(clusterName, nil)
+		scope.IBMVPCCluster.Status.Subnet = subnet
+		scope.SetControlPlaneSubnets()
+		g.Expect(scope.IBMVPCCluster.Status.Network.ControlPlaneSubnets).To(Equal([]infrav1beta2.Subnet{subnet}))
+	})
+	t.Run("Should not record the cluster subnet twice", func(t *testing.T) {
+		g := NewWithT(t)
+		scope := setupClusterScope(clusterName, nil)
+		otherSubnet := infrav1beta2.Subnet{
+			ID:   core.StringPtr("bar-subnet-id"),
+			Zone: core.StringPtr("foo-zone-2"),
+		}
+		scope.IBMVPCCluster.Status.Subnet = subnet
+		scope.IBMVPCCluster.Status.Network = &infrav1beta2.VPCNetworkStatus{
+			ControlPlaneSubnets: []infrav1beta2.Subnet{otherSubnet, subnet},
+		}
+		scope.SetControlPlaneSubnets()
+		g.Expect(scope.IBMVPCCluster.Status.Network.ControlPlaneSubnets).To(Equal([]infrav1beta2.Subnet{otherSubnet, subnet}))
+	})
+	t.Run("Should not record control plane subnets when the cluster has no subnet", func(t *testing.T) {
+		g := NewWithT(t)
+		scope := setupClusterScope(clusterName, nil)
+		scope.SetControlPlaneSubnets()
+		g.Expect(scope.IBMVPCCluster.Status.Network).To(BeNil())
+	})
+}
+
+func TestEnsurePublicGateway(t *testing.T) {
+	setup := func(t *testing.T) (*gomock.Controller, *mock.MockVpc) {
+		t.Helper()
+		return gomock.NewController(t), mock.NewMockVpc(gomock.NewController(t))
+	}
+
+	vpcCluster := infrav1beta2.IBMVPCCluster{
+		Spec: infrav1beta2.IBMVPCClusterSpec{
+			ResourceGroup: "foo-resource-group",
+			Zone:          "foo-zone",
+		},
+		Status: infrav1beta2.IBMVPCClusterStatus{
+			VPC: infrav1beta2.VPC{
+				ID: "foo-vpc-id",
+			},
+			Subnet: infrav1beta2.Subnet{
+				ID:   core.StringPtr("foo-subnet-id"),
+				Zone: core.StringPtr("foo-zone"),
+			},
+		},
+	}
+	publicGateway := &vpcv1.PublicGateway{ID: core.StringPtr("foo-public-gateway-id")}
+	publicGateways := map[string]infrav1beta2.ResourceReference{
+		"foo-zone": {
+			ID:                core.StringPtr("foo-public-gateway-id"),
+			ControllerCreated: core.BoolPtr(true),
+		},
+	}
+
+	t.Run("Ensure PublicGateway", func(t *testing.T) {
+		t.Run("Should create and attach PublicGateway", func(t *testing.T) {
+			g := NewWithT(t)
+			mockController, mockvpc := setup(t)
+			t.Cleanup(mockController.Finish)
+			scope := setupClusterScope(clusterName, mockvpc)
+			scope.IBMVPCCluster.Spec = vpcCluster.Spec
+			scope.IBMVPCCluster.Status = *vpcCluster.Status.DeepCopy()
+			mockvpc.EXPECT().GetSubnetPublicGateway(gomock.AssignableToTypeOf(&vpcv1.GetSubnetPublicGatewayOptions{})).Return(nil, &core.DetailedResponse{StatusCode: 404}, errors.New("not found"))
+			mockvpc.EXPECT().CreatePublicGateway(gomock.AssignableToTypeOf(&vpcv1.CreatePublicGatewayOptions{})).DoAndReturn(func(options *vpcv1.CreatePublicGatewayOptions) (*vpcv1.PublicGateway, *core.DetailedResponse, error) {
+				g.Expect(*options.VPC.(*vpcv1.VPCIdentity).ID).To(Equal("foo-vpc-id"))
+				g.Expect(*options.Zone.(*vpcv1.ZoneIdentity).Name).To(Equal("foo-zone"))
+				return publicGateway, &core.DetailedResponse{}, nil
+			})
+			mockvpc.EXPECT().SetSubnetPublicGateway(gomock.AssignableToTypeOf(&vpcv1.SetSubnetPublicGatewayOptions{})).Return(publicGateway, &core.DetailedResponse{}, nil)
+			err := scope.EnsurePublicGateway()
+			g.Expect(err).To(BeNil())
+			g.Expect(scope.IBMVPCCluster.Status.Network.PublicGateways).To(Equal(publicGateways))
+		})
+		t.Run("Should not change PublicGateway when already attached", func(t *testing.T) {
+			g := NewWithT(t)
+			mockController, mockvpc := setup(t)
+			t.Cleanup(mockController.Finish)
+			scope := setupClusterScope(clusterName, mockvpc)
+			scope.IBMVPCCluster.Spec = vpcCluster.Spec
+			scope.IBMVPCCluster.Status = *vpcCluster.Status.DeepCopy()
+			scope.IBMVPCCluster.Status.Network = &infrav1beta2.VPCNetworkStatus{PublicGateways: publicGateways}
+			mockvpc.EXPECT().GetSubnetPublicGateway(gomock.AssignableToTypeOf(&vpcv1.GetSubnetPublicGatewayOptions{})).Return(publicGateway, &core.DetailedResponse{}, nil)
+			err := scope.EnsurePublicGateway()
+			g.Expect(err).To(BeNil())
+		})
+		t.Run("Should re-attach PublicGateway when detached from subnet", func(t *testing.T) {
+			g := NewWithT(t)
+			mockController, mockvpc := setup(t)
+			t.Cleanup(mockController.Finish)
+			scope := setupClusterScope(clusterName, mockvpc)
+			scope.IBMVPCCluster.Spec = vpcCluster.Spec
+			scope.IBMVPCCluster.Status = *vpcCluster.Status.DeepCopy()
+			scope.IBMVPCCluster.Status.Network = &infrav1beta2.VPCNetworkStatus{PublicGateways: publicGateways}
+			mockvpc.EXPECT().GetSubnetPublicGateway(gomock.AssignableToTypeOf(&vpcv1.GetSubnetPublicGatewayOptions{})).Return(nil, &core.DetailedResponse{StatusCode: 404}, errors.New("not found"))
+			mockvpc.EXPECT().SetSubnetPublicGateway(gomock.AssignableToTypeOf(&vpcv1.SetSubnetPublicGatewayOptions{})).DoAndReturn(func(options *vpcv1.SetSubnetPublicGatewayOptions) (*vpcv1.PublicGateway, *core.DetailedResponse, error) {
+				g.Expect(*options.ID).To(Equal("foo-subnet-id"))
+				g.Expect(*options.PublicGatewayIdentity.(*vpcv1.PublicGatewayIdentity).ID).To(Equal("foo-public-gateway-id"))
+				return publicGateway, &core.DetailedResponse{}, nil
+			})
+			err := scope.EnsurePublicGateway()
+			g.Expect(err).To(BeNil())
+		})
+		t.Run("Should skip when PublicGateway is disabled", func(t *testing.T) {
+			g := NewWithT(t)
+			mockController, mockvpc := setup(t)
+			t.Cleanup(mockController.Finish)
+			scope := setupClusterScope(clusterName, mockvpc)
+			scope.IBMVPCCluster.Spec = vpcCluster.Spec
+			scope.IBMVPCCluster.Spec.Network = &infrav1beta2.VPCNetworkSpec{PublicGateway: core.BoolPtr(false)}
+			scope.IBMVPCCluster.Status = *vpcCluster.Status.DeepCopy()
+			err := scope.EnsurePublicGateway()
+			g.Expect(err).To(BeNil())
+			g.Expect(scope.IBMVPCCluster.Status.Network).To(BeNil())
+		})
+		t.Run("Error when creating PublicGateway", func(t *testing.T) {
+			g := NewWithT(t)
+			mockController, mockvpc := setup(t)
+			t.Cleanup(mockController.Finish)
+			scope := setupClusterScope(clusterName, mockvpc)
+			scope.IBMVPCCluster.Spec = vpcCluster.Spec
+			scope.IBMVPCCluster.Status = *vpcCluster.Status.DeepCopy()
+			mockvpc.EXPECT().GetSubnetPublicGateway(gomock.AssignableToTypeOf(&vpcv1.GetSubnetPublicGatewayOptions{})).Return(nil, &core.DetailedResponse{StatusCode: 404}, errors.New("not found"))
+			mockvpc.EXPECT().CreatePublicGateway(gomock.AssignableToTypeOf(&vpcv1.CreatePublicGatewayOptions{})).Return(nil, &core.DetailedResponse{}, errors.New("Error when creating PublicGateway"))
+			err := scope.EnsurePublicGateway()
+			g.Expect(err).To(Not(BeNil()))
+		})
+		t.Run("Error when attaching PublicGateway", func(t *testing.T) {
+			g := NewWithT(t)
+			mockController, mockvpc := setup(t)
+			t.Cleanup(mockController.Finish)
+			scope := setupClusterScope(clusterName, mockvpc)
+			scope.IBMVPCCluster.Spec = vpcCluster.Spec
+			scope.IBMVPCCluster.Status = *vpcCluster.Status.DeepCopy()
+			mockvpc.EXPECT().GetSubnetPublicGateway(gomock.AssignableToTypeOf(&vpcv1.GetSubnetPublicGatewayOptions{})).Return(nil, &core.DetailedResponse{StatusCode: 404}, errors.New("not found"))
+			mockvpc.EXPECT().CreatePublicGateway(gomock.AssignableToTypeOf(&vpcv1.CreatePublicGatewayOptions{})).Return(publicGateway, &core.DetailedResponse{}, nil)
+			mockvpc.EXPECT().SetSubnetPublicGateway(gomock.AssignableToTypeOf(&vpcv1.SetSubnetPublicGatewayOptions{})).Return(nil, &core.DetailedResponse{}, errors.New("Error when setting SubnetPublicGateWay"))
+			err := scope.EnsurePublicGateway()
 			g.Expect(err).To(Not(BeNil()))
+			g.Expect(scope.IBMVPCCluster.Status.Network.PublicGateways).To(Equal(publicGateways))
 		})
 	})
 }
@@ -443,6 +712,14 @@ func TestDeleteSubnet(t *testing.T) {
 			Subnet: infrav1beta2.Subnet{
 				ID: core.StringPtr("foo-vpc-subnet-id"),
 			},
+			Network: &infrav1beta2.VPCNetworkStatus{
+				PublicGateways: map[string]infrav1beta2.ResourceReference{
+					"foo-zone": {
+						ID:                core.StringPtr("foo-public-gateway-id"),
+						ControllerCreated: core.BoolPtr(true),
+					},
+				},
+			},
 		},
 	}
 
@@ -462,7 +739,7 @@ func TestDeleteSubnet(t *testing.T) {
 			t.Cleanup(mockController.Finish)
 			scope := setupClusterScope(clusterName, mockvpc)
 			scope.IBMVPCCluster.Spec = vpcCluster.Spec
-			scope.IBMVPCCluster.Status = vpcCluster.Status
+			scope.IBMVPCCluster.Status = *vpcCluster.Status.DeepCopy()
 			mockvpc.EXPECT().ListSubnets(gomock.AssignableToTypeOf(&vpcv1.ListSubnetsOptions{})).Return(subnet, &core.DetailedResponse{}, nil)
 			mockvpc.EXPECT().GetSubnetPublicGateway(gomock.AssignableToTypeOf(&vpcv1.GetSubnetPublicGatewayOptions{})).Return(publicGateway, &core.DetailedResponse{}, nil)
 			mockvpc.EXPECT().UnsetSubnetPublicGateway(gomock.AssignableToTypeOf(&vpcv1.UnsetSubnetPublicGatewayOptions{})).Return(&core.DetailedResponse{}, nil)
@@ -470,6 +747,27 @@ func TestDeleteSubnet(t *testing.T) {
 			mockvpc.EXPECT().DeleteSubnet(gomock.AssignableToTypeOf(&vpcv1.DeleteSubnetOptions{})).Return(&core.DetailedResponse{}, nil)
 			err := scope.DeleteSubnet()
 			g.Expect(err).To(BeNil())
+			g.Expect(scope.IBMVPCCluster.Status.Network.PublicGateways).To(BeEmpty())
+		})
+
+		t.Run("Should not delete publicgateway which was not created by the controller", func(t *testing.T) {
+			g := NewWithT(t)
+			mockController, mockvpc := setup(t)
+			t.Cleanup(mockController.Finish)
+			scope := setupClusterScope(clusterName, mockvpc)
+			scope.IBMVPCCluster.Spec = vpcCluster.Spec
+			scope.IBMVPCCluster.Status = *vpcCluster.Status.DeepCopy()
+			scope.IBMVPCCluster.Status.Network.PublicGateways["foo-zone"] = infrav1beta2.ResourceReference{
+				ID:                core.StringPtr("foo-public-gateway-id"),
+				ControllerCreated: core.BoolPtr(false),
+			}
+			mockvpc.EXPECT().ListSubnets(gomock.AssignableToTypeOf(&vpcv1.ListSubnetsOptions{})).Return(subnet, &core.DetailedResponse{}, nil)
+			mockvpc.EXPECT().GetSubnetPublicGateway(gomock.AssignableToTypeOf(&vpcv1.GetSubnetPublicGatewayOptions{})).Return(publicGateway, &core.DetailedResponse{}, nil)
+			mockvpc.EXPECT().UnsetSubnetPublicGateway(gomock.AssignableToTypeOf(&vpcv1.UnsetSubnetPublicGatewayOptions{})).Return(&core.DetailedResponse{}, nil)
+			mockvpc.EXPECT().DeleteSubnet(gomock.AssignableToTypeOf(&vpcv1.DeleteSubnetOptions{})).Return(&core.DetailedResponse{}, nil)
+			err := scope.DeleteSubnet()
+			g.Expect(err).To(BeNil())
+			g.Expect(scope.IBMVPCCluster.Status.Network.PublicGateways).To(BeEmpty())
 		})
 
 		t.Run("Error when unsetting publicgateway for subnet", func(t *testing.T) {
@@ -478,7 +776,7 @@ func TestDeleteSubnet(t *testing.T) {
 			t.Cleanup(mockController.Finish)
 			scope := setupClusterScope(clusterName, mockvpc)
 			scope.IBMVPCCluster.Spec = vpcCluster.Spec
-			scope.IBMVPCCluster.Status = vpcCluster.Status
+			scope.IBMVPCCluster.Status = *vpcCluster.Status.DeepCopy()
 			mockvpc.EXPECT().ListSubnets(gomock.AssignableToTypeOf(&vpcv1.ListSubnetsOptions{})).Return(subnet, &core.DetailedResponse{}, nil)
 			mockvpc.EXPECT().GetSubnetPublicGateway(gomock.AssignableToTypeOf(&vpcv1.GetSubnetPublicGatewayOptions{})).Return(publicGateway, &core.DetailedResponse{}, nil)
 			mockvpc.EXPECT().UnsetSubnetPublicGateway(gomock.AssignableToTypeOf(&vpcv1.UnsetSubnetPublicGatewayOptions{})).Return(&core.DetailedResponse{}, errors.New("Error when unsetting publicgateway for subnet"))
@@ -492,7 +790,7 @@ func TestDeleteSubnet(t *testing.T) {
 			t.Cleanup(mockController.Finish)
 			scope := setupClusterScope(clusterName, mockvpc)
 			scope.IBMVPCCluster.Spec = vpcCluster.Spec
-			scope.IBMVPCCluster.Status = vpcCluster.Status
+			scope.IBMVPCCluster.Status = *vpcCluster.Status.DeepCopy()
 			mockvpc.EXPECT().ListSubnets(gomock.AssignableToTypeOf(&vpcv1.ListSubnetsOptions{})).Return(subnet, &core.DetailedResponse{}, nil)
 			mockvpc.EXPECT().GetSubnetPublicGateway(gomock.AssignableToTypeOf(&vpcv1.GetSubnetPublicGatewayOptions{})).Return(publicGateway, &core.DetailedResponse{}, nil)
 			mockvpc.EXPECT().UnsetSubnetPublicGateway(gomock.AssignableToTypeOf(&vpcv1.UnsetSubnetPublicGatewayOptions{})).Return(&core.DetailedResponse{}, nil)
@@ -507,7 +805,7 @@ func TestDeleteSubnet(t *testing.T) {
 			t.Cleanup(mockController.Finish)
 			scope := setupClusterScope(clusterName, mockvpc)
 			scope.IBMVPCCluster.Spec = vpcCluster.Spec
-			scope.IBMVPCCluster.Status = vpcCluster.Status
+			scope.IBMVPCCluster.Status = *vpcCluster.Status.DeepCopy()
 			mockvpc.EXPECT().ListSubnets(gomock.AssignableToTypeOf(&vpcv1.ListSubnetsOptions{})).Return(subnet, &core.DetailedResponse{}, nil)
 			mockvpc.EXPECT().GetSubnetPublicGateway(gomock.AssignableToTypeOf(&vpcv1.GetSubnetPublicGatewayOptions{})).Return(publicGateway, &core.DetailedResponse{}, nil)
 			mockvpc.EXPECT().UnsetSubnetPublicGateway(gomock.AssignableToTypeOf(&vpcv1.UnsetSubnetPublicGatewayOptions{})).Return(&core.DetailedResponse{}, nil)
@@ -523,7 +821,7 @@ func TestDeleteSubnet(t *testing.T) {
 			t.Cleanup(mockController.Finish)
 			scope := setupClusterScope(clusterName, mockvpc)
 			scope.IBMVPCCluster.Spec = vpcCluster.Spec
-			scope.IBMVPCCluster.Status = vpcCluster.Status
+			scope.IBMVPCCluster.Status = *vpcCluster.Status.DeepCopy()
 			mockvpc.EXPECT().ListSubnets(gomock.AssignableToTypeOf(&vpcv1.ListSubnetsOptions{})).Return(nil, &core.DetailedResponse{}, errors.New("Error listing subnets"))
 			err := scope.DeleteSubnet()
 			g.Expect(err).To(Not(BeNil()))
@@ -534,15 +832,16 @@ func TestDeleteSubnet(t *testing.T) {
 			t.Cleanup(mockController.Finish)
 			scope := setupClusterScope(clusterName, mockvpc)
 			scope.IBMVPCCluster.Spec = vpcCluster.Spec
-			scope.IBMVPCCluster.Status = vpcCluster.Status
+			scope.IBMVPCCluster.Status = *vpcCluster.Status.DeepCopy()
 			mockvpc.EXPECT().ListSubnets(gomock.AssignableToTypeOf(&vpcv1.ListSubnetsOptions{})).Return(&vpcv1.SubnetCollection{Subnets: []vpcv1.Subnet{}}, &core.DetailedResponse{}, nil)
+			mockvpc.EXPECT().DeletePublicGateway(gomock.AssignableToTypeOf(&vpcv1.DeletePublicGatewayOptions{})).Return(&core.DetailedResponse{}, nil)
 			err := scope.DeleteSubnet()
 			g.Expect(err).To(BeNil())
 		})
 	})
 }
 
-func TestCreateLoadBalancer(t *testing.T) {
+func TestEnsureNetworkACL(t *testing.T) {
 	setup := func(t *testing.T) (*gomock.Controller, *mock.MockVpc) {
 		t.Helper()
 		return gomock.NewController(t), mock.NewMockVpc(gomock.NewController(t))
@@ -550,90 +849,544 @@ func TestCreateLoadBalancer(t *testing.T) {
 
 	vpcCluster := infrav1beta2.IBMVPCCluster{
 		Spec: infrav1beta2.IBMVPCClusterSpec{
-			ControlPlaneLoadBalancer: &infrav1beta2.VPCLoadBalancerSpec{
-				Name: "foo-load-balancer",
+			ResourceGroup: "foo-resource-group",
+			Network: &infrav1beta2.VPCNetworkSpec{
+				NetworkACL: &infrav1beta2.VPCNetworkACLSpec{
+					Name: "foo-acl",
+					Rules: []infrav1beta2.VPCNetworkACLRule{
+						{
+							Action:    infrav1beta2.VPCSecurityGroupRuleActionAllow,
+							Direction: infrav1beta2.VPCSecurityGroupRuleDirectionInbound,
+							Protocol:  infrav1beta2.VPCSecurityGroupRuleProtocolTCP,
+							Source:    "10.0.0.0/8",
+							PortRange: &infrav1beta2.VPCSecurityGroupPortRange{MinimumPort: 6443, MaximumPort: 6443},
+						},
+						{
+							Action:    infrav1beta2.VPCSecurityGroupRuleActionDeny,
+							Direction: infrav1beta2.VPCSecurityGroupRuleDirectionInbound,
+							Protocol:  infrav1beta2.VPCSecurityGroupRuleProtocolAll,
+						},
+					},
+				},
 			},
 		},
 		Status: infrav1beta2.IBMVPCClusterStatus{
+			VPC: infrav1beta2.VPC{
+				ID: "foo-vpc-id",
+			},
 			Subnet: infrav1beta2.Subnet{
 				ID: core.StringPtr("foo-subnet-id"),
 			},
 		},
 	}
+	networkACL := &infrav1beta2.ResourceReference{
+		ID:                core.StringPtr("foo-acl-id"),
+		ControllerCreated: core.BoolPtr(true),
+	}
+	allowRule := &vpcv1.NetworkACLRuleItemNetworkACLRuleProtocolTcpudp{
+		ID:                 core.StringPtr("allow-rule-id"),
+		Action:             core.StringPtr("allow"),
+		Direction:          core.StringPtr("inbound"),
+		Protocol:           core.StringPtr("tcp"),
+		Source:             core.StringPtr("10.0.0.0/8"),
+		Destination:        core.StringPtr("0.0.0.0/0"),
+		DestinationPortMin: core.Int64Ptr(6443),
+		DestinationPortMax: core.Int64Ptr(6443),
+	}
+	denyRule := &vpcv1.NetworkACLRuleItemNetworkACLRuleProtocolAll{
+		ID:          core.StringPtr("deny-rule-id"),
+		Action:      core.StringPtr("deny"),
+		Direction:   core.StringPtr("inbound"),
+		Protocol:    core.StringPtr("all"),
+		Source:      core.StringPtr("0.0.0.0/0"),
+		Destination: core.StringPtr("0.0.0.0/0"),
+	}
 
-	t.Run("Create LoadBalancer", func(t *testing.T) {
-		t.Run("Error when listing LoadBalancer", func(t *testing.T) {
+	t.Run("Ensure NetworkACL", func(t *testing.T) {
+		t.Run("Should create NetworkACL with rules and attach it to subnet", func(t *testing.T) {
 			g := NewWithT(t)
 			mockController, mockvpc := setup(t)
 			t.Cleanup(mockController.Finish)
 			scope := setupClusterScope(clusterName, mockvpc)
 			scope.IBMVPCCluster.Spec = vpcCluster.Spec
-			scope.IBMVPCCluster.Status = vpcCluster.Status
-			mockvpc.EXPECT().ListLoadBalancers(gomock.AssignableToTypeOf(&vpcv1.ListLoadBalancersOptions{})).Return(&vpcv1.LoadBalancerCollection{}, &core.DetailedResponse{}, errors.New("Failed to list LoadBalancer"))
-			_, err := scope.CreateLoadBalancer()
-			g.Expect(err).To(Not(BeNil()))
+			scope.IBMVPCCluster.Status = *vpcCluster.Status.DeepCopy()
+			createdRules := []string{}
+			mockvpc.EXPECT().CreateNetworkACL(gomock.AssignableToTypeOf(&vpcv1.CreateNetworkACLOptions{})).DoAndReturn(func(options *vpcv1.CreateNetworkACLOptions) (*vpcv1.NetworkACL, *core.DetailedResponse, error) {
+				prototype := options.NetworkACLPrototype.(*vpcv1.NetworkACLPrototypeNetworkACLByRules)
+				g.Expect(*prototype.Name).To(Equal("foo-acl"))
+				g.Expect(*prototype.VPC.(*vpcv1.VPCIdentity).ID).To(Equal("foo-vpc-id"))
+				return &vpcv1.NetworkACL{ID: core.StringPtr("foo-acl-id")}, &core.DetailedResponse{}, nil
+			})
+			mockvpc.EXPECT().ListNetworkACLRules(gomock.AssignableToTypeOf(&vpcv1.ListNetworkACLRulesOptions{})).Return(&vpcv1.NetworkACLRuleCollection{}, &core.DetailedResponse{}, nil)
+			mockvpc.EXPECT().CreateNetworkACLRule(gomock.AssignableToTypeOf(&vpcv1.CreateNetworkACLRuleOptions{})).DoAndReturn(func(options *vpcv1.CreateNetworkACLRuleOptions) (vpcv1.NetworkACLRuleIntf, *core.DetailedResponse, error) {
+				createdRules = append(createdRules, *options.NetworkACLRulePrototype.(*vpcv1.NetworkACLRulePrototype).Action)
+				return &vpcv1.NetworkACLRule{}, &core.DetailedResponse{}, nil
+			}).Times(2)
+			mockvpc.EXPECT().GetSubnetNetworkACL(gomock.AssignableToTypeOf(&vpcv1.GetSubnetNetworkACLOptions{})).Return(&vpcv1.NetworkACL{ID: core.StringPtr("default-acl-id")}, &core.DetailedResponse{}, nil)
+			mockvpc.EXPECT().ReplaceSubnetNetworkACL(gomock.AssignableToTypeOf(&vpcv1.ReplaceSubnetNetworkACLOptions{})).DoAndReturn(func(options *vpcv1.ReplaceSubnetNetworkACLOptions) (*vpcv1.NetworkACL, *core.DetailedResponse, error) {
+				g.Expect(*options.ID).To(Equal("foo-subnet-id"))
+				g.Expect(*options.NetworkACLIdentity.(*vpcv1.NetworkACLIdentityByID).ID).To(Equal("foo-acl-id"))
+				return &vpcv1.NetworkACL{ID: core.StringPtr("foo-acl-id")}, &core.DetailedResponse{}, nil
+			})
+			err := scope.EnsureNetworkACL()
+			g.Expect(err).To(BeNil())
+			g.Expect(createdRules).To(Equal([]string{"allow", "deny"}))
+			g.Expect(scope.IBMVPCCluster.Status.Network.NetworkACL).To(Equal(networkACL))
 		})
-		t.Run("Return exsisting LoadBalancer", func(t *testing.T) {
+		t.Run("Should not change NetworkACL when rules match and it is attached", func(t *testing.T) {
 			g := NewWithT(t)
 			mockController, mockvpc := setup(t)
 			t.Cleanup(mockController.Finish)
 			scope := setupClusterScope(clusterName, mockvpc)
-			vpcClusterCustom := infrav1beta2.IBMVPCCluster{
-				Spec: infrav1beta2.IBMVPCClusterSpec{
-					ControlPlaneLoadBalancer: &infrav1beta2.VPCLoadBalancerSpec{
-						Name: "foo-load-balancer-1",
-					},
-				},
-				Status: infrav1beta2.IBMVPCClusterStatus{
-					Subnet: infrav1beta2.Subnet{
-						ID: core.StringPtr("foo-subnet-id"),
-					},
-				},
-			}
-			loadBalancerCollection := &vpcv1.LoadBalancerCollection{
-				LoadBalancers: []vpcv1.LoadBalancer{
-					{
-						Name: core.StringPtr("foo-load-balancer-1"),
-					},
-				},
+			scope.IBMVPCCluster.Spec = vpcCluster.Spec
+			scope.IBMVPCCluster.Status = *vpcCluster.Status.DeepCopy()
+			scope.IBMVPCCluster.Status.Network = &infrav1beta2.VPCNetworkStatus{NetworkACL: networkACL}
+			mockvpc.EXPECT().ListNetworkACLRules(gomock.AssignableToTypeOf(&vpcv1.ListNetworkACLRulesOptions{})).Return(&vpcv1.NetworkACLRuleCollection{Rules: []vpcv1.NetworkACLRuleItemIntf{allowRule, denyRule}}, &core.DetailedResponse{}, nil)
+			mockvpc.EXPECT().GetSubnetNetworkACL(gomock.AssignableToTypeOf(&vpcv1.GetSubnetNetworkACLOptions{})).Return(&vpcv1.NetworkACL{ID: core.StringPtr("foo-acl-id")}, &core.DetailedResponse{}, nil)
+			err := scope.EnsureNetworkACL()
+			g.Expect(err).To(BeNil())
+		})
+		t.Run("Should recreate rules from the first reordered rule", func(t *testing.T) {
+			g := NewWithT(t)
+			mockController, mockvpc := setup(t)
+			t.Cleanup(mockController.Finish)
+			scope := setupClusterScope(clusterName, mockvpc)
+			scope.IBMVPCCluster.Spec = *vpcCluster.Spec.DeepCopy()
+			rules := scope.IBMVPCCluster.Spec.Network.NetworkACL.Rules
+			outboundRule := infrav1beta2.VPCNetworkACLRule{
+				Action:    infrav1beta2.VPCSecurityGroupRuleActionAllow,
+				Direction: infrav1beta2.VPCSecurityGroupRuleDirectionOutbound,
 			}
-			expectedOutput := &vpcv1.LoadBalancer{
-				Name: core.StringPtr("foo-load-balancer-1"),
+			scope.IBMVPCCluster.Spec.Network.NetworkACL.Rules = []infrav1beta2.VPCNetworkACLRule{outboundRule, rules[1], rules[0]}
+			scope.IBMVPCCluster.Status = *vpcCluster.Status.DeepCopy()
+			scope.IBMVPCCluster.Status.Network = &infrav1beta2.VPCNetworkStatus{NetworkACL: networkACL}
+			outboundItem := &vpcv1.NetworkACLRuleItemNetworkACLRuleProtocolAll{
+				ID:          core.StringPtr("outbound-rule-id"),
+				Action:      core.StringPtr("allow"),
+				Direction:   core.StringPtr("outbound"),
+				Protocol:    core.StringPtr("all"),
+				Source:      core.StringPtr("0.0.0.0/0"),
+				Destination: core.StringPtr("0.0.0.0/0"),
 			}
-			scope.IBMVPCCluster.Spec = vpcClusterCustom.Spec
-			scope.IBMVPCCluster.Status = vpcClusterCustom.Status
-			mockvpc.EXPECT().ListLoadBalancers(gomock.AssignableToTypeOf(&vpcv1.ListLoadBalancersOptions{})).Return(loadBalancerCollection, &core.DetailedResponse{}, nil)
-			out, err := scope.CreateLoadBalancer()
+			mockvpc.EXPECT().ListNetworkACLRules(gomock.AssignableToTypeOf(&vpcv1.ListNetworkACLRulesOptions{})).Return(&vpcv1.NetworkACLRuleCollection{Rules: []vpcv1.NetworkACLRuleItemIntf{outboundItem, allowRule, denyRule}}, &core.DetailedResponse{}, nil)
+			createdRules := []string{}
+			deletedRules := []string{}
+			mockvpc.EXPECT().CreateNetworkACLRule(gomock.AssignableToTypeOf(&vpcv1.CreateNetworkACLRuleOptions{})).DoAndReturn(func(options *vpcv1.CreateNetworkACLRuleOptions) (vpcv1.NetworkACLRuleIntf, *core.DetailedResponse, error) {
+				g.Expect(deletedRules).To(BeEmpty())
+				createdRules = append(createdRules, *options.NetworkACLRulePrototype.(*vpcv1.NetworkACLRulePrototype).Action)
+				return &vpcv1.NetworkACLRule{}, &core.DetailedResponse{}, nil
+			}).Times(2)
+			mockvpc.EXPECT().DeleteNetworkACLRule(gomock.AssignableToTypeOf(&vpcv1.DeleteNetworkACLRuleOptions{})).DoAndReturn(func(options *vpcv1.DeleteNetworkACLRuleOptions) (*core.DetailedResponse, error) {
+				deletedRules = append(deletedRules, *options.ID)
+				return &core.DetailedResponse{}, nil
+			}).Times(2)
+			mockvpc.EXPECT().GetSubnetNetworkACL(gomock.AssignableToTypeOf(&vpcv1.GetSubnetNetworkACLOptions{})).Return(&vpcv1.NetworkACL{ID: core.StringPtr("foo-acl-id")}, &core.DetailedResponse{}, nil)
+			err := scope.EnsureNetworkACL()
 			g.Expect(err).To(BeNil())
-			require.Equal(t, expectedOutput, out)
+			g.Expect(createdRules).To(Equal([]string{"deny", "allow"}))
+			g.Expect(deletedRules).To(Equal([]string{"allow-rule-id", "deny-rule-id"}))
 		})
-		t.Run("Error when listing LoadBalancer (GetLoadBalancerByHostname)", func(t *testing.T) {
+		t.Run("Should detach and delete NetworkACL when removed from spec", func(t *testing.T) {
 			g := NewWithT(t)
 			mockController, mockvpc := setup(t)
 			t.Cleanup(mockController.Finish)
 			scope := setupClusterScope(clusterName, mockvpc)
-			scope.IBMVPCCluster.Spec = vpcCluster.Spec
-			scope.IBMVPCCluster.Status = vpcCluster.Status
-			mockvpc.EXPECT().ListLoadBalancers(gomock.AssignableToTypeOf(&vpcv1.ListLoadBalancersOptions{})).Return(&vpcv1.LoadBalancerCollection{}, &core.DetailedResponse{}, errors.New("Failed to list LoadBalancer"))
-			_, err := scope.GetLoadBalancerByHostname("foo-load-balancer-hostname")
-			g.Expect(err).To(Not(BeNil()))
+			scope.IBMVPCCluster.Status = *vpcCluster.Status.DeepCopy()
+			scope.IBMVPCCluster.Status.Network = &infrav1beta2.VPCNetworkStatus{NetworkACL: networkACL}
+			mockvpc.EXPECT().GetSubnetNetworkACL(gomock.AssignableToTypeOf(&vpcv1.GetSubnetNetworkACLOptions{})).Return(&vpcv1.NetworkACL{ID: core.StringPtr("foo-acl-id")}, &core.DetailedResponse{}, nil)
+			mockvpc.EXPECT().GetVPC(gomock.AssignableToTypeOf(&vpcv1.GetVPCOptions{})).Return(&vpcv1.VPC{DefaultNetworkACL: &vpcv1.NetworkACLReference{ID: core.StringPtr("default-acl-id")}}, &core.DetailedResponse{}, nil)
+			mockvpc.EXPECT().ReplaceSubnetNetworkACL(gomock.AssignableToTypeOf(&vpcv1.ReplaceSubnetNetworkACLOptions{})).DoAndReturn(func(options *vpcv1.ReplaceSubnetNetworkACLOptions) (*vpcv1.NetworkACL, *core.DetailedResponse, error) {
+				g.Expect(*options.NetworkACLIdentity.(*vpcv1.NetworkACLIdentityByID).ID).To(Equal("default-acl-id"))
+				return &vpcv1.NetworkACL{ID: core.StringPtr("default-acl-id")}, &core.DetailedResponse{}, nil
+			})
+			mockvpc.EXPECT().DeleteNetworkACL(gomock.AssignableToTypeOf(&vpcv1.DeleteNetworkACLOptions{})).Return(&core.DetailedResponse{}, nil)
+			err := scope.EnsureNetworkACL()
+			g.Expect(err).To(BeNil())
+			g.Expect(scope.IBMVPCCluster.Status.Network.NetworkACL).To(BeNil())
 		})
-		t.Run("Return LoadBalancer by Hostname", func(t *testing.T) {
+		t.Run("Error when creating NetworkACL rule fails", func(t *testing.T) {
 			g := NewWithT(t)
 			mockController, mockvpc := setup(t)
 			t.Cleanup(mockController.Finish)
 			scope := setupClusterScope(clusterName, mockvpc)
-			loadBalancerCollection := &vpcv1.LoadBalancerCollection{
-				LoadBalancers: []vpcv1.LoadBalancer{
-					{
-						Name:     core.StringPtr("foo-load-balancer"),
-						Hostname: core.StringPtr("foo-load-balancer-hostname"),
-					},
-				},
-			}
-			expectedOutput := &vpcv1.LoadBalancer{
-				Name:     core.StringPtr("foo-load-balancer"),
-				Hostname: core.StringPtr("foo-load-balancer-hostname"),
+			scope.IBMVPCCluster.Spec = vpcCluster.Spec
+			scope.IBMVPCCluster.Status = *vpcCluster.Status.DeepCopy()
+			scope.IBMVPCCluster.Status.Network = &infrav1beta2.VPCNetworkStatus{NetworkACL: networkACL}
+			mockvpc.EXPECT().ListNetworkACLRules(gomock.AssignableToTypeOf(&vpcv1.ListNetworkACLRulesOptions{})).Return(&vpcv1.NetworkACLRuleCollection{}, &core.DetailedResponse{}, nil)
+			mockvpc.EXPECT().CreateNetworkACLRule(gomock.AssignableToTypeOf(&vpcv1.CreateNetworkACLRuleOptions{})).Return(nil, &core.DetailedResponse{}, errors.New("failed to create rule"))
+			err := scope.EnsureNetworkACL()
+			g.Expect(err).To(Not(BeNil()))
+		})
+	})
+}
+
+func TestDeleteNetworkACL(t *testing.T) {
+	setup := func(t *testing.T) (*gomock.Controller, *mock.MockVpc) {
+		t.Helper()
+		return gomock.NewController(t), mock.NewMockVpc(gomock.NewController(t))
+	}
+
+	vpcCluster := infrav1beta2.IBMVPCCluster{
+		Status: infrav1beta2.IBMVPCClusterStatus{
+			VPC: infrav1beta2.VPC{
+				ID: "foo-vpc-id",
+			},
+			Subnet: infrav1beta2.Subnet{
+				ID: core.StringPtr("foo-subnet-id"),
+			},
+			Network: &infrav1beta2.VPCNetworkStatus{
+				NetworkACL: &infrav1beta2.ResourceReference{
+					ID:                core.StringPtr("foo-acl-id"),
+					ControllerCreated: core.BoolPtr(true),
+				},
+			},
+		},
+	}
+
+	t.Run("Delete NetworkACL", func(t *testing.T) {
+		t.Run("Should delete NetworkACL without detaching when subnet is gone", func(t *testing.T) {
+			g := NewWithT(t)
+			mockController, mockvpc := setup(t)
+			t.Cleanup(mockController.Finish)
+			scope := setupClusterScope(clusterName, mockvpc)
+			scope.IBMVPCCluster.Status = *vpcCluster.Status.DeepCopy()
+			mockvpc.EXPECT().GetSubnetNetworkACL(gomock.AssignableToTypeOf(&vpcv1.GetSubnetNetworkACLOptions{})).Return(nil, &core.DetailedResponse{StatusCode: 404}, errors.New("not found"))
+			mockvpc.EXPECT().DeleteNetworkACL(gomock.AssignableToTypeOf(&vpcv1.DeleteNetworkACLOptions{})).Return(&core.DetailedResponse{}, nil)
+			err := scope.DeleteNetworkACL()
+			g.Expect(err).To(BeNil())
+			g.Expect(scope.IBMVPCCluster.Status.Network.NetworkACL).To(BeNil())
+		})
+		t.Run("Should not delete NetworkACL when not created by controller", func(t *testing.T) {
+			g := NewWithT(t)
+			mockController, mockvpc := setup(t)
+			t.Cleanup(mockController.Finish)
+			scope := setupClusterScope(clusterName, mockvpc)
+			scope.IBMVPCCluster.Status = *vpcCluster.Status.DeepCopy()
+			scope.IBMVPCCluster.Status.Network.NetworkACL.ControllerCreated = core.BoolPtr(false)
+			mockvpc.EXPECT().GetSubnetNetworkACL(gomock.AssignableToTypeOf(&vpcv1.GetSubnetNetworkACLOptions{})).Return(&vpcv1.NetworkACL{ID: core.StringPtr("other-acl-id")}, &core.DetailedResponse{}, nil)
+			err := scope.DeleteNetworkACL()
+			g.Expect(err).To(BeNil())
+			g.Expect(scope.IBMVPCCluster.Status.Network.NetworkACL).To(BeNil())
+		})
+		t.Run("Error when re-attaching default NetworkACL fails", func(t *testing.T) {
+			g := NewWithT(t)
+			mockController, mockvpc := setup(t)
+			t.Cleanup(mockController.Finish)
+			scope := setupClusterScope(clusterName, mockvpc)
+			scope.IBMVPCCluster.Status = *vpcCluster.Status.DeepCopy()
+			mockvpc.EXPECT().GetSubnetNetworkACL(gomock.AssignableToTypeOf(&vpcv1.GetSubnetNetworkACLOptions{})).Return(&vpcv1.NetworkACL{ID: core.StringPtr("foo-acl-id")}, &core.DetailedResponse{}, nil)
+			mockvpc.EXPECT().GetVPC(gomock.AssignableToTypeOf(&vpcv1.GetVPCOptions{})).Return(&vpcv1.VPC{DefaultNetworkACL: &vpcv1.NetworkACLReference{ID: core.StringPtr("default-acl-id")}}, &core.DetailedResponse{}, nil)
+			mockvpc.EXPECT().ReplaceSubnetNetworkACL(gomock.AssignableToTypeOf(&vpcv1.ReplaceSubnetNetworkACLOptions{})).Return(nil, &core.DetailedResponse{}, errors.New("failed to replace network ACL"))
+			err := scope.DeleteNetworkACL()
+			g.Expect(err).To(Not(BeNil()))
+			g.Expect(scope.IBMVPCCluster.Status.Network.NetworkACL).To(Not(BeNil()))
+		})
+	})
+}
+
+func TestEnsureFlowLogs(t *testing.T) {
+	setup := func(t *testing.T) (*gomock.Controller, *mock.MockVpc) {
+		t.Helper()
+		return gomock.NewController(t), mock.NewMockVpc(gomock.NewController(t))
+	}
+
+	vpcCluster := infrav1beta2.IBMVPCCluster{
+		Spec: infrav1beta2.IBMVPCClusterSpec{
+			ResourceGroup: "foo-resource-group",
+			Network: &infrav1beta2.VPCNetworkSpec{
+				FlowLogs: &infrav1beta2.VPCFlowLogsSpec{
+					Name:          "foo-flowlogs",
+					COSBucketName: "foo-bucket",
+				},
+			},
+		},
+		Status: infrav1beta2.IBMVPCClusterStatus{
+			VPC: infrav1beta2.VPC{
+				ID: "foo-vpc-id",
+			},
+			Subnet: infrav1beta2.Subnet{
+				ID: core.StringPtr("foo-subnet-id"),
+			},
+		},
+	}
+
+	t.Run("Ensure FlowLogs", func(t *testing.T) {
+		t.Run("Should create flow log collector for the VPC", func(t *testing.T) {
+			g := NewWithT(t)
+			mockController, mockvpc := setup(t)
+			t.Cleanup(mockController.Finish)
+			scope := setupClusterScope(clusterName, mockvpc)
+			scope.IBMVPCCluster.Spec = vpcCluster.Spec
+			scope.IBMVPCCluster.Status = *vpcCluster.Status.DeepCopy()
+			mockvpc.EXPECT().ListFlowLogCollectors(gomock.AssignableToTypeOf(&vpcv1.ListFlowLogCollectorsOptions{})).DoAndReturn(func(options *vpcv1.ListFlowLogCollectorsOptions) (*vpcv1.FlowLogCollectorCollection, *core.DetailedResponse, error) {
+				g.Expect(*options.Name).To(Equal("foo-flowlogs"))
+				g.Expect(*options.VPCID).To(Equal("foo-vpc-id"))
+				return &vpcv1.FlowLogCollectorCollection{}, &core.DetailedResponse{}, nil
+			})
+			mockvpc.EXPECT().CreateFlowLogCollector(gomock.AssignableToTypeOf(&vpcv1.CreateFlowLogCollectorOptions{})).DoAndReturn(func(options *vpcv1.CreateFlowLogCollectorOptions) (*vpcv1.FlowLogCollector, *core.DetailedResponse, error) {
+				g.Expect(*options.Name).To(Equal("foo-flowlogs"))
+				g.Expect(*options.Active).To(BeTrue())
+				g.Expect(*options.StorageBucket.(*vpcv1.LegacyCloudObjectStorageBucketIdentityCloudObjectStorageBucketIdentityByName).Name).To(Equal("foo-bucket"))
+				g.Expect(*options.Target.(*vpcv1.FlowLogCollectorTargetPrototypeVPCIdentityVPCIdentityByID).ID).To(Equal("foo-vpc-id"))
+				return &vpcv1.FlowLogCollector{ID: core.StringPtr("foo-flowlogs-id")}, &core.DetailedResponse{}, nil
+			})
+			err := scope.EnsureFlowLogs()
+			g.Expect(err).To(BeNil())
+			g.Expect(*scope.IBMVPCCluster.Status.Network.FlowLogCollector.ID).To(Equal("foo-flowlogs-id"))
+			g.Expect(*scope.IBMVPCCluster.Status.Network.FlowLogCollector.ControllerCreated).To(BeTrue())
+		})
+		t.Run("Should create flow log collector for the cluster subnet", func(t *testing.T) {
+			g := NewWithT(t)
+			mockController, mockvpc := setup(t)
+			t.Cleanup(mockController.Finish)
+			scope := setupClusterScope(clusterName, mockvpc)
+			scope.IBMVPCCluster.Spec = *vpcCluster.Spec.DeepCopy()
+			scope.IBMVPCCluster.Spec.Network.FlowLogs.Name = ""
+			scope.IBMVPCCluster.Spec.Network.FlowLogs.Target = infrav1beta2.VPCFlowLogsTargetSubnet
+			scope.IBMVPCCluster.Status = *vpcCluster.Status.DeepCopy()
+			mockvpc.EXPECT().ListFlowLogCollectors(gomock.AssignableToTypeOf(&vpcv1.ListFlowLogCollectorsOptions{})).Return(&vpcv1.FlowLogCollectorCollection{}, &core.DetailedResponse{}, nil)
+			mockvpc.EXPECT().CreateFlowLogCollector(gomock.AssignableToTypeOf(&vpcv1.CreateFlowLogCollectorOptions{})).DoAndReturn(func(options *vpcv1.CreateFlowLogCollectorOptions) (*vpcv1.FlowLogCollector, *core.DetailedResponse, error) {
+				g.Expect(*options.Name).To(Equal(scope.IBMVPCCluster.Name + "-flowlogs"))
+				g.Expect(*options.Target.(*vpcv1.FlowLogCollectorTargetPrototypeSubnetIdentitySubnetIdentityByID).ID).To(Equal("foo-subnet-id"))
+				return &vpcv1.FlowLogCollector{ID: core.StringPtr("foo-flowlogs-id")}, &core.DetailedResponse{}, nil
+			})
+			err := scope.EnsureFlowLogs()
+			g.Expect(err).To(BeNil())
+			g.Expect(*scope.IBMVPCCluster.Status.Network.FlowLogCollector.ID).To(Equal("foo-flowlogs-id"))
+		})
+		t.Run("Should not create flow log collector again when it is in the status", func(t *testing.T) {
+			g := NewWithT(t)
+			mockController, mockvpc := setup(t)
+			t.Cleanup(mockController.Finish)
+			scope := setupClusterScope(clusterName, mockvpc)
+			scope.IBMVPCCluster.Spec = vpcCluster.Spec
+			scope.IBMVPCCluster.Status = *vpcCluster.Status.DeepCopy()
+			scope.IBMVPCCluster.Status.Network = &infrav1beta2.VPCNetworkStatus{
+				FlowLogCollector: &infrav1beta2.ResourceReference{
+					ID:                core.StringPtr("foo-flowlogs-id"),
+					ControllerCreated: core.BoolPtr(true),
+				},
+			}
+			err := scope.EnsureFlowLogs()
+			g.Expect(err).To(BeNil())
+			g.Expect(*scope.IBMVPCCluster.Status.Network.FlowLogCollector.ID).To(Equal("foo-flowlogs-id"))
+		})
+		t.Run("Should reuse existing flow log collector with the same name", func(t *testing.T) {
+			g := NewWithT(t)
+			mockController, mockvpc := setup(t)
+			t.Cleanup(mockController.Finish)
+			scope := setupClusterScope(clusterName, mockvpc)
+			scope.IBMVPCCluster.Spec = vpcCluster.Spec
+			scope.IBMVPCCluster.Status = *vpcCluster.Status.DeepCopy()
+			collectors := &vpcv1.FlowLogCollectorCollection{
+				FlowLogCollectors: []vpcv1.FlowLogCollector{
+					{
+						ID:   core.StringPtr("foo-flowlogs-id"),
+						Name: core.StringPtr("foo-flowlogs"),
+					},
+				},
+			}
+			mockvpc.EXPECT().ListFlowLogCollectors(gomock.AssignableToTypeOf(&vpcv1.ListFlowLogCollectorsOptions{})).Return(collectors, &core.DetailedResponse{}, nil)
+			err := scope.EnsureFlowLogs()
+			g.Expect(err).To(BeNil())
+			g.Expect(*scope.IBMVPCCluster.Status.Network.FlowLogCollector.ID).To(Equal("foo-flowlogs-id"))
+		})
+		t.Run("Should delete flow log collector when FlowLogs is removed", func(t *testing.T) {
+			g := NewWithT(t)
+			mockController, mockvpc := setup(t)
+			t.Cleanup(mockController.Finish)
+			scope := setupClusterScope(clusterName, mockvpc)
+			scope.IBMVPCCluster.Status = *vpcCluster.Status.DeepCopy()
+			scope.IBMVPCCluster.Status.Network = &infrav1beta2.VPCNetworkStatus{
+				FlowLogCollector: &infrav1beta2.ResourceReference{
+					ID:                core.StringPtr("foo-flowlogs-id"),
+					ControllerCreated: core.BoolPtr(true),
+				},
+			}
+			mockvpc.EXPECT().DeleteFlowLogCollector(gomock.AssignableToTypeOf(&vpcv1.DeleteFlowLogCollectorOptions{})).Return(&core.DetailedResponse{}, nil)
+			err := scope.EnsureFlowLogs()
+			g.Expect(err).To(BeNil())
+			g.Expect(scope.IBMVPCCluster.Status.Network.FlowLogCollector).To(BeNil())
+		})
+		t.Run("Error when creating flow log collector fails", func(t *testing.T) {
+			g := NewWithT(t)
+			mockController, mockvpc := setup(t)
+			t.Cleanup(mockController.Finish)
+			scope := setupClusterScope(clusterName, mockvpc)
+			scope.IBMVPCCluster.Spec = vpcCluster.Spec
+			scope.IBMVPCCluster.Status = *vpcCluster.Status.DeepCopy()
+			mockvpc.EXPECT().ListFlowLogCollectors(gomock.AssignableToTypeOf(&vpcv1.ListFlowLogCollectorsOptions{})).Return(&vpcv1.FlowLogCollectorCollection{}, &core.DetailedResponse{}, nil)
+			mockvpc.EXPECT().CreateFlowLogCollector(gomock.AssignableToTypeOf(&vpcv1.CreateFlowLogCollectorOptions{})).Return(nil, &core.DetailedResponse{}, errors.New("failed to create flow log collector"))
+			err := scope.EnsureFlowLogs()
+			g.Expect(err).To(Not(BeNil()))
+			g.Expect(scope.IBMVPCCluster.Status.Network).To(BeNil())
+		})
+	})
+}
+
+func TestDeleteFlowLogs(t *testing.T) {
+	setup := func(t *testing.T) (*gomock.Controller, *mock.MockVpc) {
+		t.Helper()
+		return gomock.NewController(t), mock.NewMockVpc(gomock.NewController(t))
+	}
+
+	vpcCluster := infrav1beta2.IBMVPCCluster{
+		Status: infrav1beta2.IBMVPCClusterStatus{
+			VPC: infrav1beta2.VPC{
+				ID: "foo-vpc-id",
+			},
+			Network: &infrav1beta2.VPCNetworkStatus{
+				FlowLogCollector: &infrav1beta2.ResourceReference{
+					ID:                core.StringPtr("foo-flowlogs-id"),
+					ControllerCreated: core.BoolPtr(true),
+				},
+			},
+		},
+	}
+
+	t.Run("Delete FlowLogs", func(t *testing.T) {
+		t.Run("Should delete flow log collector", func(t *testing.T) {
+			g := NewWithT(t)
+			mockController, mockvpc := setup(t)
+			t.Cleanup(mockController.Finish)
+			scope := setupClusterScope(clusterName, mockvpc)
+			scope.IBMVPCCluster.Status = *vpcCluster.Status.DeepCopy()
+			mockvpc.EXPECT().DeleteFlowLogCollector(gomock.AssignableToTypeOf(&vpcv1.DeleteFlowLogCollectorOptions{})).DoAndReturn(func(options *vpcv1.DeleteFlowLogCollectorOptions) (*core.DetailedResponse, error) {
+				g.Expect(*options.ID).To(Equal("foo-flowlogs-id"))
+				return &core.DetailedResponse{}, nil
+			})
+			err := scope.DeleteFlowLogs()
+			g.Expect(err).To(BeNil())
+			g.Expect(scope.IBMVPCCluster.Status.Network.FlowLogCollector).To(BeNil())
+		})
+		t.Run("Should succeed when flow log collector is already deleted", func(t *testing.T) {
+			g := NewWithT(t)
+			mockController, mockvpc := setup(t)
+			t.Cleanup(mockController.Finish)
+			scope := setupClusterScope(clusterName, mockvpc)
+			scope.IBMVPCCluster.Status = *vpcCluster.Status.DeepCopy()
+			mockvpc.EXPECT().DeleteFlowLogCollector(gomock.AssignableToTypeOf(&vpcv1.DeleteFlowLogCollectorOptions{})).Return(&core.DetailedResponse{StatusCode: 404}, errors.New("not found"))
+			err := scope.DeleteFlowLogs()
+			g.Expect(err).To(BeNil())
+			g.Expect(scope.IBMVPCCluster.Status.Network.FlowLogCollector).To(BeNil())
+		})
+		t.Run("Should not delete flow log collector when not created by controller", func(t *testing.T) {
+			g := NewWithT(t)
+			mockController, mockvpc := setup(t)
+			t.Cleanup(mockController.Finish)
+			scope := setupClusterScope(clusterName, mockvpc)
+			scope.IBMVPCCluster.Status = *vpcCluster.Status.DeepCopy()
+			scope.IBMVPCCluster.Status.Network.FlowLogCollector.ControllerCreated = core.BoolPtr(false)
+			err := scope.DeleteFlowLogs()
+			g.Expect(err).To(BeNil())
+			g.Expect(scope.IBMVPCCluster.Status.Network.FlowLogCollector).To(BeNil())
+		})
+		t.Run("Error when deleting flow log collector fails", func(t *testing.T) {
+			g := NewWithT(t)
+			mockController, mockvpc := setup(t)
+			t.Cleanup(mockController.Finish)
+			scope := setupClusterScope(clusterName, mockvpc)
+			scope.IBMVPCCluster.Status = *vpcCluster.Status.DeepCopy()
+			mockvpc.EXPECT().DeleteFlowLogCollector(gomock.AssignableToTypeOf(&vpcv1.DeleteFlowLogCollectorOptions{})).Return(&core.DetailedResponse{}, errors.New("failed to delete flow log collector"))
+			err := scope.DeleteFlowLogs()
+			g.Expect(err).To(Not(BeNil()))
+			g.Expect(scope.IBMVPCCluster.Status.Network.FlowLogCollector).To(Not(BeNil()))
+		})
+	})
+}
+
+func TestCreateLoadBalancer(t *testing.T) {
+	setup := func(t *testing.T) (*gomock.Controller, *mock.MockVpc) {
+		t.Helper()
+		return gomock.NewController(t), mock.NewMockVpc(gomock.NewController(t))
+	}
+
+	vpcCluster := infrav1beta2.IBMVPCCluster{
+		Spec: infrav1beta2.IBMVPCClusterSpec{
+			ControlPlaneLoadBalancer: &infrav1beta2.VPCLoadBalancerSpec{
+				Name: "foo-load-balancer",
+			},
+		},
+		Status: infrav1beta2.IBMVPCClusterStatus{
+			Subnet: infrav1beta2.Subnet{
+				ID: core.StringPtr("foo-subnet-id"),
+			},
+		},
+	}
+
+	t.Run("Create LoadBalancer", func(t *testing.T) {
+		t.Run("Error when listing LoadBalancer", func(t *testing.T) {
+			g := NewWithT(t)
+			mockController, mockvpc := setup(t)
+			t.Cleanup(mockController.Finish)
+			scope := setupClusterScope(clusterName, mockvpc)
+			scope.IBMVPCCluster.Spec = vpcCluster.Spec
+			scope.IBMVPCCluster.Status = vpcCluster.Status
+			mockvpc.EXPECT().ListLoadBalancers(gomock.AssignableToTypeOf(&vpcv1.ListLoadBalancersOptions{})).Return(&vpcv1.LoadBalancerCollection{}, &core.DetailedResponse{}, errors.New("Failed to list LoadBalancer"))
+			_, err := scope.CreateLoadBalancer()
+			g.Expect(err).To(Not(BeNil()))
+		})
+		t.Run("Return exsisting LoadBalancer", func(t *testing.T) {
+			g := NewWithT(t)
+			mockController, mockvpc := setup(t)
+			t.Cleanup(mockController.Finish)
+			scope := setupClusterScope(clusterName, mockvpc)
+			vpcClusterCustom := infrav1beta2.IBMVPCCluster{
+				Spec: infrav1beta2.IBMVPCClusterSpec{
+					ControlPlaneLoadBalancer: &infrav1beta2.VPCLoadBalancerSpec{
+						Name: "foo-load-balancer-1",
+					},
+				},
+				Status: infrav1beta2.IBMVPCClusterStatus{
+					Subnet: infrav1beta2.Subnet{
+						ID: core.StringPtr("foo-subnet-id"),
+					},
+				},
+			}
+			loadBalancerCollection := &vpcv1.LoadBalancerCollection{
+				LoadBalancers: []vpcv1.LoadBalancer{
+					{
+						Name: core.StringPtr("foo-load-balancer-1"),
+					},
+				},
+			}
+			expectedOutput := &vpcv1.LoadBalancer{
+				Name: core.StringPtr("foo-load-balancer-1"),
+			}
+			scope.IBMVPCCluster.Spec = vpcClusterCustom.Spec
+			scope.IBMVPCCluster.Status = vpcClusterCustom.Status
+			mockvpc.EXPECT().ListLoadBalancers(gomock.AssignableToTypeOf(&vpcv1.ListLoadBalancersOptions{})).Return(loadBalancerCollection, &core.DetailedResponse{}, nil)
+			out, err := scope.CreateLoadBalancer()
+			g.Expect(err).To(BeNil())
+			require.Equal(t, expectedOutput, out)
+		})
+		t.Run("Error when listing LoadBalancer (GetLoadBalancerByHostname)", func(t *testing.T) {
+			g := NewWithT(t)
+			mockController, mockvpc := setup(t)
+			t.Cleanup(mockController.Finish)
+			scope := setupClusterScope(clusterName, mockvpc)
+			scope.IBMVPCCluster.Spec = vpcCluster.Spec
+			scope.IBMVPCCluster.Status = vpcCluster.Status
+			mockvpc.EXPECT().ListLoadBalancers(gomock.AssignableToTypeOf(&vpcv1.ListLoadBalancersOptions{})).Return(&vpcv1.LoadBalancerCollection{}, &core.DetailedResponse{}, errors.New("Failed to list LoadBalancer"))
+			_, err := scope.GetLoadBalancerByHostname("foo-load-balancer-hostname")
+			g.Expect(err).To(Not(BeNil()))
+		})
+		t.Run("Return LoadBalancer by Hostname", func(t *testing.T) {
+			g := NewWithT(t)
+			mockController, mockvpc := setup(t)
+			t.Cleanup(mockController.Finish)
+			scope := setupClusterScope(clusterName, mockvpc)
+			loadBalancerCollection := &vpcv1.LoadBalancerCollection{
+				LoadBalancers: []vpcv1.LoadBalancer{
+					{
+						Name:     core.StringPtr("foo-load-balancer"),
+						Hostname: core.StringPtr("foo-load-balancer-hostname"),
+					},
+				},
+			}
+			expectedOutput := &vpcv1.LoadBalancer{
+				Name:     core.StringPtr("foo-load-balancer"),
+				Hostname: core.StringPtr("foo-load-balancer-hostname"),
 			}
 			mockvpc.EXPECT().ListLoadBalancers(gomock.AssignableToTypeOf(&vpcv1.ListLoadBalancersOptions{})).Return(loadBalancerCollection, &core.DetailedResponse{}, nil)
 			out, err := scope.GetLoadBalancerByHostname("foo-load-balancer-hostname")
@@ -683,6 +1436,284 @@ func TestCreateLoadBalancer(t *testing.T) {
 			g.Expect(err).To(BeNil())
 			require.Equal(t, expectedOutput, out)
 		})
+		t.Run("Should create LoadBalancer with custom pool health monitor", func(t *testing.T) {
+			g := NewWithT(t)
+			mockController, mockvpc := setup(t)
+			t.Cleanup(mockController.Finish)
+			scope := setupClusterScope(clusterName, mockvpc)
+			scope.IBMVPCCluster.Spec = vpcCluster.Spec
+			scope.IBMVPCCluster.Spec.ControlPlaneLoadBalancer = &infrav1beta2.VPCLoadBalancerSpec{
+				Name: "foo-load-balancer",
+				Pool: &infrav1beta2.VPCLoadBalancerPoolSpec{
+					HealthMonitor: &infrav1beta2.VPCLoadBalancerHealthMonitorSpec{
+						Type:       "https",
+						Port:       core.Int64Ptr(6443),
+						Delay:      10,
+						MaxRetries: 3,
+						Timeout:    5,
+						URLPath:    "/readyz",
+					},
+				},
+			}
+			scope.IBMVPCCluster.Status = vpcCluster.Status
+			expectedHealthMonitor := &vpcv1.LoadBalancerPoolHealthMonitorPrototype{
+				Delay:      core.Int64Ptr(10),
+				MaxRetries: core.Int64Ptr(3),
+				Port:       core.Int64Ptr(6443),
+				Timeout:    core.Int64Ptr(5),
+				Type:       core.StringPtr("https"),
+				URLPath:    core.StringPtr("/readyz"),
+			}
+			mockvpc.EXPECT().ListLoadBalancers(gomock.AssignableToTypeOf(&vpcv1.ListLoadBalancersOptions{})).Return(&vpcv1.LoadBalancerCollection{}, &core.DetailedResponse{}, nil)
+			mockvpc.EXPECT().CreateLoadBalancer(gomock.AssignableToTypeOf(&vpcv1.CreateLoadBalancerOptions{})).DoAndReturn(func(options *vpcv1.CreateLoadBalancerOptions) (*vpcv1.LoadBalancer, *core.DetailedResponse, error) {
+				g.Expect(options.Pools).To(HaveLen(1))
+				g.Expect(options.Pools[0].HealthMonitor).To(Equal(expectedHealthMonitor))
+				return &vpcv1.LoadBalancer{Name: core.StringPtr("foo-load-balancer")}, &core.DetailedResponse{}, nil
+			})
+			_, err := scope.CreateLoadBalancer()
+			g.Expect(err).To(BeNil())
+		})
+	})
+}
+
+func TestReconcileLoadBalancerPool(t *testing.T) {
+	setup := func(t *testing.T) (*gomock.Controller, *mock.MockVpc) {
+		t.Helper()
+		return gomock.NewController(t), mock.NewMockVpc(gomock.NewController(t))
+	}
+
+	vpcCluster := infrav1beta2.IBMVPCCluster{
+		Spec: infrav1beta2.IBMVPCClusterSpec{
+			ControlPlaneLoadBalancer: &infrav1beta2.VPCLoadBalancerSpec{
+				Name: "foo-load-balancer",
+				Pool: &infrav1beta2.VPCLoadBalancerPoolSpec{
+					HealthMonitor: &infrav1beta2.VPCLoadBalancerHealthMonitorSpec{
+						Type:       "tcp",
+						Delay:      10,
+						MaxRetries: 3,
+						Timeout:    5,
+					},
+				},
+			},
+		},
+	}
+	loadBalancer := &vpcv1.LoadBalancer{
+		ID:                 core.StringPtr("foo-load-balancer-id"),
+		Name:               core.StringPtr("foo-load-balancer"),
+		ProvisioningStatus: core.StringPtr("active"),
+		Pools: []vpcv1.LoadBalancerPoolReference{
+			{
+				ID:   core.StringPtr("foo-pool-id"),
+				Name: core.StringPtr("foo-load-balancer-pool"),
+			},
+		},
+	}
+
+	t.Run("Reconcile LoadBalancer pool", func(t *testing.T) {
+		t.Run("Should not update pool when health monitor matches", func(t *testing.T) {
+			g := NewWithT(t)
+			mockController, mockvpc := setup(t)
+			t.Cleanup(mockController.Finish)
+			scope := setupClusterScope(clusterName, mockvpc)
+			scope.IBMVPCCluster.Spec = vpcCluster.Spec
+			pool := &vpcv1.LoadBalancerPool{
+				HealthMonitor: &vpcv1.LoadBalancerPoolHealthMonitor{
+					Delay:      core.Int64Ptr(10),
+					MaxRetries: core.Int64Ptr(3),
+					Timeout:    core.Int64Ptr(5),
+					Type:       core.StringPtr("tcp"),
+				},
+			}
+			mockvpc.EXPECT().GetLoadBalancerPool(gomock.AssignableToTypeOf(&vpcv1.GetLoadBalancerPoolOptions{})).Return(pool, &core.DetailedResponse{}, nil)
+			err := scope.ReconcileLoadBalancerPool(loadBalancer)
+			g.Expect(err).To(BeNil())
+		})
+		t.Run("Should update pool when health monitor drifted", func(t *testing.T) {
+			g := NewWithT(t)
+			mockController, mockvpc := setup(t)
+			t.Cleanup(mockController.Finish)
+			scope := setupClusterScope(clusterName, mockvpc)
+			scope.IBMVPCCluster.Spec = vpcCluster.Spec
+			pool := &vpcv1.LoadBalancerPool{
+				HealthMonitor: &vpcv1.LoadBalancerPoolHealthMonitor{
+					Delay:      core.Int64Ptr(5),
+					MaxRetries: core.Int64Ptr(2),
+					Timeout:    core.Int64Ptr(2),
+					Type:       core.StringPtr("tcp"),
+				},
+			}
+			mockvpc.EXPECT().GetLoadBalancerPool(gomock.AssignableToTypeOf(&vpcv1.GetLoadBalancerPoolOptions{})).Return(pool, &core.DetailedResponse{}, nil)
+			mockvpc.EXPECT().UpdateLoadBalancerPool(gomock.AssignableToTypeOf(&vpcv1.UpdateLoadBalancerPoolOptions{})).DoAndReturn(func(options *vpcv1.UpdateLoadBalancerPoolOptions) (*vpcv1.LoadBalancerPool, *core.DetailedResponse, error) {
+				g.Expect(*options.ID).To(Equal("foo-pool-id"))
+				g.Expect(options.LoadBalancerPoolPatch["health_monitor"]).To(HaveKeyWithValue("delay", BeNumerically("==", 10)))
+				return &vpcv1.LoadBalancerPool{}, &core.DetailedResponse{}, nil
+			})
+			err := scope.ReconcileLoadBalancerPool(loadBalancer)
+			g.Expect(err).To(BeNil())
+		})
+		t.Run("Error when updating pool", func(t *testing.T) {
+			g := NewWithT(t)
+			mockController, mockvpc := setup(t)
+			t.Cleanup(mockController.Finish)
+			scope := setupClusterScope(clusterName, mockvpc)
+			scope.IBMVPCCluster.Spec = vpcCluster.Spec
+			mockvpc.EXPECT().GetLoadBalancerPool(gomock.AssignableToTypeOf(&vpcv1.GetLoadBalancerPoolOptions{})).Return(&vpcv1.LoadBalancerPool{}, &core.DetailedResponse{}, nil)
+			mockvpc.EXPECT().UpdateLoadBalancerPool(gomock.AssignableToTypeOf(&vpcv1.UpdateLoadBalancerPoolOptions{})).Return(nil, &core.DetailedResponse{}, errors.New("Failed to update LoadBalancer pool"))
+			err := scope.ReconcileLoadBalancerPool(loadBalancer)
+			g.Expect(err).To(Not(BeNil()))
+		})
+		t.Run("Should skip when LoadBalancer is not active", func(t *testing.T) {
+			g := NewWithT(t)
+			mockController, mockvpc := setup(t)
+			t.Cleanup(mockController.Finish)
+			scope := setupClusterScope(clusterName, mockvpc)
+			scope.IBMVPCCluster.Spec = vpcCluster.Spec
+			pendingLoadBalancer := *loadBalancer
+			pendingLoadBalancer.ProvisioningStatus = core.StringPtr("update_pending")
+			err := scope.ReconcileLoadBalancerPool(&pendingLoadBalancer)
+			g.Expect(err).To(BeNil())
+		})
+	})
+}
+
+func TestReconcileSecurityGroupRules(t *testing.T) {
+	setup := func(t *testing.T) (*gomock.Controller, *mock.MockVpc) {
+		t.Helper()
+		return gomock.NewController(t), mock.NewMockVpc(gomock.NewController(t))
+	}
+
+	apiServerRule := &infrav1beta2.VPCSecurityGroupRule{
+		Direction: infrav1beta2.VPCSecurityGroupRuleDirectionInbound,
+		Source: &infrav1beta2.VPCSecurityGroupRulePrototype{
+			Protocol: infrav1beta2.VPCSecurityGroupRuleProtocolTCP,
+			PortRange: &infrav1beta2.VPCSecurityGroupPortRange{
+				MinimumPort: 6443,
+				MaximumPort: 6443,
+			},
+			Remotes: []infrav1beta2.VPCSecurityGroupRuleRemote{
+				{
+					RemoteType: infrav1beta2.VPCSecurityGroupRuleRemoteTypeAny,
+				},
+			},
+		},
+	}
+	existingAPIServerRule := func(id string) *vpcv1.SecurityGroupRuleSecurityGroupRuleProtocolTcpudp {
+		return &vpcv1.SecurityGroupRuleSecurityGroupRuleProtocolTcpudp{
+			ID:        core.StringPtr(id),
+			Direction: core.StringPtr("inbound"),
+			Protocol:  core.StringPtr("tcp"),
+			PortMin:   core.Int64Ptr(6443),
+			PortMax:   core.Int64Ptr(6443),
+			Remote:    &vpcv1.SecurityGroupRuleRemote{CIDRBlock: core.StringPtr("0.0.0.0/0")},
+		}
+	}
+	managedStatus := &infrav1beta2.VPCNetworkStatus{
+		SecurityGroups: []infrav1beta2.VPCSecurityGroupStatus{
+			{
+				ID:                core.StringPtr("foo-sg-id"),
+				RuleIDs:           []*string{core.StringPtr("foo-rule-id")},
+				ControllerCreated: core.BoolPtr(false),
+			},
+		},
+	}
+
+	t.Run("Reconcile security group rules", func(t *testing.T) {
+		t.Run("Should add missing rule to the default security group", func(t *testing.T) {
+			g := NewWithT(t)
+			mockController, mockvpc := setup(t)
+			t.Cleanup(mockController.Finish)
+			scope := setupClusterScope(clusterName, mockvpc)
+			scope.IBMVPCCluster.Spec.Network = &infrav1beta2.VPCNetworkSpec{
+				SecurityGroupRules: []*infrav1beta2.VPCSecurityGroupRule{apiServerRule},
+			}
+			scope.IBMVPCCluster.Status.VPC.ID = "foo-vpc-id"
+			vpc := &vpcv1.VPC{
+				DefaultSecurityGroup: &vpcv1.SecurityGroupReference{ID: core.StringPtr("foo-sg-id")},
+			}
+			mockvpc.EXPECT().GetVPC(gomock.AssignableToTypeOf(&vpcv1.GetVPCOptions{})).Return(vpc, &core.DetailedResponse{}, nil)
+			mockvpc.EXPECT().GetSecurityGroup(gomock.AssignableToTypeOf(&vpcv1.GetSecurityGroupOptions{})).Return(&vpcv1.SecurityGroup{}, &core.DetailedResponse{}, nil)
+			mockvpc.EXPECT().CreateSecurityGroupRule(gomock.AssignableToTypeOf(&vpcv1.CreateSecurityGroupRuleOptions{})).DoAndReturn(func(options *vpcv1.CreateSecurityGroupRuleOptions) (vpcv1.SecurityGroupRuleIntf, *core.DetailedResponse, error) {
+				g.Expect(*options.SecurityGroupID).To(Equal("foo-sg-id"))
+				prototype := options.SecurityGroupRulePrototype.(*vpcv1.SecurityGroupRulePrototype)
+				g.Expect(*prototype.PortMin).To(Equal(int64(6443)))
+				g.Expect(*prototype.Remote.(*vpcv1.SecurityGroupRuleRemotePrototype).CIDRBlock).To(Equal("0.0.0.0/0"))
+				return existingAPIServerRule("foo-rule-id"), &core.DetailedResponse{}, nil
+			})
+			err := scope.ReconcileSecurityGroupRules()
+			g.Expect(err).To(BeNil())
+			g.Expect(scope.IBMVPCCluster.Status.Network).To(Equal(managedStatus))
+		})
+		t.Run("Should not change rules when they match the spec", func(t *testing.T) {
+			g := NewWithT(t)
+			mockController, mockvpc := setup(t)
+			t.Cleanup(mockController.Finish)
+			scope := setupClusterScope(clusterName, mockvpc)
+			rule := *apiServerRule
+			rule.SecurityGroupID = core.StringPtr("foo-sg-id")
+			scope.IBMVPCCluster.Spec.Network = &infrav1beta2.VPCNetworkSpec{
+				SecurityGroupRules: []*infrav1beta2.VPCSecurityGroupRule{&rule},
+			}
+			scope.IBMVPCCluster.Status.Network = managedStatus.DeepCopy()
+			securityGroup := &vpcv1.SecurityGroup{
+				Rules: []vpcv1.SecurityGroupRuleIntf{existingAPIServerRule("foo-rule-id")},
+			}
+			mockvpc.EXPECT().GetSecurityGroup(gomock.AssignableToTypeOf(&vpcv1.GetSecurityGroupOptions{})).Return(securityGroup, &core.DetailedResponse{}, nil)
+			err := scope.ReconcileSecurityGroupRules()
+			g.Expect(err).To(BeNil())
+			g.Expect(scope.IBMVPCCluster.Status.Network).To(Equal(managedStatus))
+		})
+		t.Run("Should remove managed rule dropped from the spec", func(t *testing.T) {
+			g := NewWithT(t)
+			mockController, mockvpc := setup(t)
+			t.Cleanup(mockController.Finish)
+			scope := setupClusterScope(clusterName, mockvpc)
+			scope.IBMVPCCluster.Status.Network = managedStatus.DeepCopy()
+			securityGroup := &vpcv1.SecurityGroup{
+				Rules: []vpcv1.SecurityGroupRuleIntf{existingAPIServerRule("foo-rule-id"), existingAPIServerRule("bar-rule-id")},
+			}
+			mockvpc.EXPECT().GetSecurityGroup(gomock.AssignableToTypeOf(&vpcv1.GetSecurityGroupOptions{})).Return(securityGroup, &core.DetailedResponse{}, nil)
+			mockvpc.EXPECT().DeleteSecurityGroupRule(gomock.AssignableToTypeOf(&vpcv1.DeleteSecurityGroupRuleOptions{})).DoAndReturn(func(options *vpcv1.DeleteSecurityGroupRuleOptions) (*core.DetailedResponse, error) {
+				g.Expect(*options.SecurityGroupID).To(Equal("foo-sg-id"))
+				g.Expect(*options.ID).To(Equal("foo-rule-id"))
+				return &core.DetailedResponse{}, nil
+			})
+			err := scope.ReconcileSecurityGroupRules()
+			g.Expect(err).To(BeNil())
+			g.Expect(scope.IBMVPCCluster.Status.Network.SecurityGroups).To(BeEmpty())
+		})
+		t.Run("Should not manage existing rule matching the spec", func(t *testing.T) {
+			g := NewWithT(t)
+			mockController, mockvpc := setup(t)
+			t.Cleanup(mockController.Finish)
+			scope := setupClusterScope(clusterName, mockvpc)
+			rule := *apiServerRule
+			rule.SecurityGroupID = core.StringPtr("foo-sg-id")
+			scope.IBMVPCCluster.Spec.Network = &infrav1beta2.VPCNetworkSpec{
+				SecurityGroupRules: []*infrav1beta2.VPCSecurityGroupRule{&rule},
+			}
+			securityGroup := &vpcv1.SecurityGroup{
+				Rules: []vpcv1.SecurityGroupRuleIntf{existingAPIServerRule("bar-rule-id")},
+			}
+			mockvpc.EXPECT().GetSecurityGroup(gomock.AssignableToTypeOf(&vpcv1.GetSecurityGroupOptions{})).Return(securityGroup, &core.DetailedResponse{}, nil)
+			err := scope.ReconcileSecurityGroupRules()
+			g.Expect(err).To(BeNil())
+			g.Expect(scope.IBMVPCCluster.Status.Network).To(BeNil())
+		})
+		t.Run("Error when creating rule", func(t *testing.T) {
+			g := NewWithT(t)
+			mockController, mockvpc := setup(t)
+			t.Cleanup(mockController.Finish)
+			scope := setupClusterScope(clusterName, mockvpc)
+			rule := *apiServerRule
+			rule.SecurityGroupID = core.StringPtr("foo-sg-id")
+			scope.IBMVPCCluster.Spec.Network = &infrav1beta2.VPCNetworkSpec{
+				SecurityGroupRules: []*infrav1beta2.VPCSecurityGroupRule{&rule},
+			}
+			mockvpc.EXPECT().GetSecurityGroup(gomock.AssignableToTypeOf(&vpcv1.GetSecurityGroupOptions{})).Return(&vpcv1.SecurityGroup{}, &core.DetailedResponse{}, nil)
+			mockvpc.EXPECT().CreateSecurityGroupRule(gomock.AssignableToTypeOf(&vpcv1.CreateSecurityGroupRuleOptions{})).Return(nil, &core.DetailedResponse{}, errors.New("Failed to create security group rule"))
+			err := scope.ReconcileSecurityGroupRules()
+			g.Expect(err).To(Not(BeNil()))
+		})
 	})
 }
 
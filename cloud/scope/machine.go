@@ -20,23 +20,36 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"math"
+	"net"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/go-logr/logr"
 
 	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/globaltaggingv1"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
 
+	"golang.org/x/sync/singleflight"
+
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/klog/v2"
 	"k8s.io/utils/ptr"
 
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	capiv1beta1 "sigs.k8s.io/cluster-api/api/v1beta1"
+	"sigs.k8s.io/cluster-api/util/conditions"
 	"sigs.k8s.io/cluster-api/util/patch"
 
 	infrav1beta2 "sigs.k8s.io/cluster-api-provider-ibmcloud/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/globaltagging"
 	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/utils"
 	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/vpc"
 	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/endpoints"
@@ -44,16 +57,95 @@ import (
 	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/record"
 )
 
+const (
+	// minBootVolumeSizeGiB is the minimum capacity allowed for a general-purpose boot volume.
+	minBootVolumeSizeGiB = 10
+	// maxBootVolumeSizeGiB is the maximum capacity allowed for a general-purpose boot volume.
+	maxBootVolumeSizeGiB = 250
+)
+
+const (
+	// bootstrapSecretFormatCloudConfig is the format set on bootstrap data secrets containing cloud-config.
+	bootstrapSecretFormatCloudConfig = "cloud-config"
+	// bootstrapSecretFormatIgnition is the format set on bootstrap data secrets containing ignition.
+	bootstrapSecretFormatIgnition = "ignition"
+)
+
+const (
+	// defaultWaitForRunningTimeout is the time to wait for a created instance to reach running state when no timeout is configured.
+	defaultWaitForRunningTimeout = 5 * time.Minute
+	// defaultLoadBalancerPoolMemberWeight is the weight assigned to a load balancer pool member when none is provided, matching the VPC default.
+	defaultLoadBalancerPoolMemberWeight = 50
+)
+
+// instanceProfileGenerationRegexp matches the generation of an instance profile name, e.g. 3 in bx3d-2x10.
+var instanceProfileGenerationRegexp = regexp.MustCompile(`^[a-z]+(\d+)[a-z]*-`)
+
+// minSecureBootProfileGeneration is the first instance profile generation supporting secure boot.
+const minSecureBootProfileGeneration = 3
+
+// secureBootInstancePrototype extends the instance prototype with the secure boot setting. The InstancePrototype of
+// vpc-go-sdk v0.51.0 has no EnableSecureBoot field, while the VPC API accepts enable_secure_boot on instance creation.
+// The SDK serializes the prototype with encoding/json and InstancePrototype has no MarshalJSON method, so the fields of
+// the embedded prototype are sent along with enable_secure_boot. Drop this wrapper for InstancePrototype.EnableSecureBoot
+// once the SDK is bumped to a version that models it.
+type secureBootInstancePrototype struct {
+	*vpcv1.InstancePrototype
+	EnableSecureBoot *bool `json:"enable_secure_boot,omitempty"`
+}
+
+// ErrLoadBalancerNotReady is returned when the load balancer is in a transient state and the operation should be retried later.
+var ErrLoadBalancerNotReady = errors.New("load balancer is not ready")
+
+// ErrDeletionProtected is returned when the instance is not deleted because deletion protection is enabled.
+var ErrDeletionProtected = errors.New("instance is protected from deletion")
+
+// ErrInstanceStopping is returned when the instance is being stopped before it is deleted, the deletion is requeued
+// until the instance is stopped.
+var ErrInstanceStopping = errors.New("instance is stopping")
+
+// ErrInstanceDeleting is returned while the instance is being deleted, the deletion is requeued until the instance
+// is gone so that its reserved IPs are cleaned up before the finalizer is removed.
+var ErrInstanceDeleting = errors.New("instance is deleting")
+
+// ErrInstanceNotRunning is returned when the machine waits for its instance to be running and the instance is not
+// running yet, the reconcile is requeued to check it again.
+var ErrInstanceNotRunning = errors.New("instance is not running")
+
+// instanceStopTimeout is the time to wait for an instance to stop before it is deleted.
+var instanceStopTimeout = 2 * time.Minute
+
+// LoadBalancerActiveTimeout is the maximum time to wait for the load balancer to leave a pending state before its
+// pool members are changed, the reconcile is requeued when it is still pending afterwards.
+var LoadBalancerActiveTimeout = 30 * time.Second
+
+// loadBalancerActiveBackoff is the backoff between load balancer status checks while waiting for it to be active.
+var loadBalancerActiveBackoff = wait.Backoff{
+	Duration: 2 * time.Second,
+	Factor:   2,
+	Jitter:   0.1,
+	Steps:    math.MaxInt32,
+}
+
+// createMachineGroup deduplicates concurrent CreateMachine calls for the same machine.
+var createMachineGroup singleflight.Group
+
 // MachineScopeParams defines the input parameters used to create a new MachineScope.
 type MachineScopeParams struct {
-	IBMVPCClient    vpc.Vpc
-	Client          client.Client
-	Logger          logr.Logger
-	Cluster         *capiv1beta1.Cluster
-	Machine         *capiv1beta1.Machine
-	IBMVPCCluster   *infrav1beta2.IBMVPCCluster
-	IBMVPCMachine   *infrav1beta2.IBMVPCMachine
-	ServiceEndpoint []endpoints.ServiceEndpoint
+	IBMVPCClient        vpc.Vpc
+	GlobalTaggingClient globaltagging.GlobalTagging
+	Client              client.Client
+	Logger              logr.Logger
+	Cluster             *capiv1beta1.Cluster
+	Machine             *capiv1beta1.Machine
+	IBMVPCCluster       *infrav1beta2.IBMVPCCluster
+	IBMVPCMachine       *infrav1beta2.IBMVPCMachine
+	ServiceEndpoint     []endpoints.ServiceEndpoint
+
+	// WaitForRunning makes CheckInstanceRunning report the instance until it is running.
+	WaitForRunning bool
+	// WaitForRunningTimeout is the maximum time since its creation for the instance to be running, defaults to 5 minutes.
+	WaitForRunningTimeout time.Duration
 }
 
 // MachineScope defines a scope defined around a machine and its cluster.
@@ -62,12 +154,24 @@ type MachineScope struct {
 	Client      client.Client
 	patchHelper *patch.Helper
 
-	IBMVPCClient    vpc.Vpc
-	Cluster         *capiv1beta1.Cluster
-	Machine         *capiv1beta1.Machine
-	IBMVPCCluster   *infrav1beta2.IBMVPCCluster
-	IBMVPCMachine   *infrav1beta2.IBMVPCMachine
-	ServiceEndpoint []endpoints.ServiceEndpoint
+	IBMVPCClient        vpc.Vpc
+	GlobalTaggingClient globaltagging.GlobalTagging
+	Cluster             *capiv1beta1.Cluster
+	Machine             *capiv1beta1.Machine
+	IBMVPCCluster       *infrav1beta2.IBMVPCCluster
+	IBMVPCMachine       *infrav1beta2.IBMVPCMachine
+	ServiceEndpoint     []endpoints.ServiceEndpoint
+
+	WaitForRunning        bool
+	WaitForRunningTimeout time.Duration
+
+	// instanceProfiles caches the instance profile names available in the region.
+	instanceProfiles []string
+	// securityGroupCache caches the IDs of the security groups looked up by name, nil disables caching.
+	securityGroupCache *utils.TTLCache[string]
+	// loadBalancerActiveTimeout is the time to wait for a pending load balancer before changing its pool members,
+	// zero disables waiting.
+	loadBalancerActiveTimeout time.Duration
 }
 
 // NewMachineScope creates a new MachineScope from the supplied parameters.
@@ -96,64 +200,316 @@ func NewMachineScope(params MachineScopeParams) (*MachineScope, error) {
 		return nil, fmt.Errorf("failed to create IBM VPC session: %w", err)
 	}
 
+	gtClient, err := globaltagging.NewService(nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create IBM global tagging session: %w", err)
+	}
+
 	if params.Logger.V(DEBUGLEVEL).Enabled() {
 		core.SetLoggingLevel(core.LevelDebug)
 	}
 
 	return &MachineScope{
-		Logger:        params.Logger,
-		Client:        params.Client,
-		IBMVPCClient:  vpcClient,
-		Cluster:       params.Cluster,
-		IBMVPCCluster: params.IBMVPCCluster,
-		patchHelper:   helper,
-		Machine:       params.Machine,
-		IBMVPCMachine: params.IBMVPCMachine,
+		Logger:              params.Logger,
+		Client:              params.Client,
+		IBMVPCClient:        vpcClient,
+		GlobalTaggingClient: gtClient,
+		Cluster:             params.Cluster,
+		IBMVPCCluster:       params.IBMVPCCluster,
+		patchHelper:         helper,
+		Machine:             params.Machine,
+		IBMVPCMachine:       params.IBMVPCMachine,
+
+		WaitForRunning:        params.WaitForRunning,
+		WaitForRunningTimeout: params.WaitForRunningTimeout,
+
+		securityGroupCache:        securityGroupCache(),
+		loadBalancerActiveTimeout: LoadBalancerActiveTimeout,
 	}, nil
 }
 
 // CreateMachine creates a vpc machine.
 func (m *MachineScope) CreateMachine() (*vpcv1.Instance, error) {
-	instanceReply, err := m.ensureInstanceUnique(m.IBMVPCMachine.Name)
+	// Serialize the list-then-create sequence per machine so that concurrent reconciles of the same machine
+	// share a single instance instead of creating duplicates. Only the instance is shared, the machine status
+	// is updated below by each caller on its own scope.
+	key := fmt.Sprintf("%s/%s", m.IBMVPCMachine.Namespace, m.IBMVPCMachine.Name)
+	result, err, _ := createMachineGroup.Do(key, func() (interface{}, error) {
+		instance, created, err := m.ensureInstance()
+		return ensureInstanceResult{instance: instance, created: created}, err
+	})
+	ensured, _ := result.(ensureInstanceResult)
+	if err != nil {
+		return ensured.instance, err
+	}
+
+	if err := m.reconcileStartOnCreate(ensured.instance, ensured.created); err != nil {
+		return ensured.instance, err
+	}
+	return ensured.instance, nil
+}
+
+// ensureInstanceResult is the result of ensureInstance shared by the concurrent CreateMachine calls of a machine.
+type ensureInstanceResult struct {
+	instance *vpcv1.Instance
+	created  bool
+}
+
+// DryRunCreateMachine resolves the image, SSH keys, subnet and profile of the machine into the instance prototype
+// CreateMachine would create the instance from, without creating it. Resolution errors are returned as CreateMachine
+// returns them.
+func (m *MachineScope) DryRunCreateMachine() (vpcv1.InstancePrototypeIntf, error) {
+	prototype, err := m.buildInstancePrototype()
 	if err != nil {
 		return nil, err
+	}
+	if err := m.validateZone(); err != nil {
+		return nil, err
+	}
+	return prototype, nil
+}
+
+// ensureInstance returns the instance of the machine, creating it when it does not exist yet. The second value reports
+// whether the instance was created by this call.
+func (m *MachineScope) ensureInstance() (*vpcv1.Instance, bool, error) {
+	instanceReply, err := m.getInstance()
+	if err != nil {
+		return nil, false, err
 	} else if instanceReply != nil {
 		// TODO need a reasonable wrapped error.
-		return instanceReply, nil
+		return instanceReply, false, nil
+	}
+
+	if m.IBMVPCMachine.Spec.AdoptExistingInstance {
+		record.Warnf(m.IBMVPCMachine, "FailedAdoptInstance", "No instance %q found to adopt", m.IBMVPCMachine.Name)
+		return nil, false, fmt.Errorf("instance %s to adopt does not exist", m.IBMVPCMachine.Name)
+	}
+
+	prototype, err := m.buildInstancePrototype()
+	if err != nil {
+		return nil, false, err
+	}
+
+	if err := m.validateZone(); err != nil {
+		record.Warnf(m.IBMVPCMachine, "FailedCreateInstance", "Invalid zone - %v", err)
+		return nil, false, err
+	}
+
+	options := &vpcv1.CreateInstanceOptions{}
+	options.SetInstancePrototype(prototype)
+	instance, _, err := m.IBMVPCClient.CreateInstance(options)
+	if err != nil {
+		record.Warnf(m.IBMVPCMachine, "FailedCreateInstance", "Failed instance creation - %v", err)
+		return instance, false, err
+	}
+	record.Eventf(m.IBMVPCMachine, "SuccessfulCreateInstance", "Created Instance %q", *instance.Name)
+	return instance, true, nil
+}
+
+// reconcileStartOnCreate records the power state the instance is intended to be in once it is created, and stops
+// the instance when StartOnCreate is false and the instance was just created. An instance which already existed, like
+// an adopted instance or one created before StartOnCreate was introduced, is left in its power state. The stop action
+// is queued by the VPC API until the instance has started.
+func (m *MachineScope) reconcileStartOnCreate(instance *vpcv1.Instance, created bool) error {
+	if m.IBMVPCMachine.Status.PowerState != "" {
+		return nil
+	}
+	if ptr.Deref(m.IBMVPCMachine.Spec.StartOnCreate, true) {
+		m.IBMVPCMachine.Status.PowerState = infrav1beta2.VPCInstancePowerStateRunning
+		return nil
+	}
+	if !created {
+		return nil
+	}
+
+	options := &vpcv1.CreateInstanceActionOptions{}
+	options.SetInstanceID(*instance.ID)
+	options.SetType(vpcv1.CreateInstanceActionOptionsTypeStopConst)
+	if _, _, err := m.IBMVPCClient.CreateInstanceAction(options); err != nil {
+		record.Warnf(m.IBMVPCMachine, "FailedStopInstance", "Failed to stop instance created stopped - %v", err)
+		return fmt.Errorf("failed to stop instance %s: %w", *instance.ID, err)
+	}
+	record.Eventf(m.IBMVPCMachine, "SuccessfulStopInstance", "Stopping instance %q created stopped", *instance.ID)
+	m.IBMVPCMachine.Status.PowerState = infrav1beta2.VPCInstancePowerStateStopped
+	return nil
+}
+
+// validateZone checks that the zone of the machine belongs to the region of the cluster, so that a mismatch is
+// reported with the valid zones instead of an opaque error from the VPC API. The zone is taken from the machine spec,
+// or from the primary subnet when it is not set, and the validation is skipped when neither is known.
+func (m *MachineScope) validateZone() error {
+	region := m.IBMVPCCluster.Spec.Region
+	if region == "" {
+		return nil
+	}
+	zone := m.IBMVPCMachine.Spec.Zone
+	if zone == "" && m.IBMVPCMachine.Spec.PrimaryNetworkInterface.Subnet != "" {
+		subnetZone, err := m.subnetZone(m.IBMVPCMachine.Spec.PrimaryNetworkInterface.Subnet)
+		if err != nil {
+			return err
+		}
+		zone = subnetZone
+	}
+	if zone == "" {
+		return nil
+	}
+
+	zones, err := m.ListRegionZones(region)
+	if err != nil {
+		return err
+	}
+	if slices.Contains(zones, zone) {
+		return nil
+	}
+	return fmt.Errorf("zone %s does not belong to region %s, valid zones are: %s", zone, region, strings.Join(zones, ", "))
+}
+
+// ListRegionZones returns the names of the zones of the region.
+func (m *MachineScope) ListRegionZones(region string) ([]string, error) {
+	zoneCollection, _, err := m.IBMVPCClient.ListRegionZones(&vpcv1.ListRegionZonesOptions{RegionName: &region})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list zones of region %s: %w", region, err)
+	}
+	if zoneCollection == nil {
+		return nil, fmt.Errorf("failed to list zones of region %s: zone collection is nil", region)
+	}
+	zones := make([]string, 0, len(zoneCollection.Zones))
+	for _, zone := range zoneCollection.Zones {
+		if zone.Name != nil {
+			zones = append(zones, *zone.Name)
+		}
+	}
+	return zones, nil
+}
+
+// subnetZone returns the name of the zone of the subnet, which is looked up by ID and then by name.
+func (m *MachineScope) subnetZone(subnet string) (string, error) {
+	sn, _, err := m.IBMVPCClient.GetSubnet(&vpcv1.GetSubnetOptions{ID: &subnet})
+	if err != nil || sn == nil {
+		sn, err = m.IBMVPCClient.GetVPCSubnetByName(subnet)
+		if err != nil {
+			return "", fmt.Errorf("error while fetching subnet %s: %w", subnet, err)
+		}
+	}
+	if sn == nil || sn.Zone == nil || sn.Zone.Name == nil {
+		return "", nil
 	}
+	return *sn.Zone.Name, nil
+}
+
+// resourceGroupID returns the ID of the resource group the instance is created in, the resource group of the machine
+// when it is set and the resource group of the cluster otherwise.
+func (m *MachineScope) resourceGroupID() string {
+	if m.IBMVPCMachine.Spec.ResourceGroup != "" {
+		return m.IBMVPCMachine.Spec.ResourceGroup
+	}
+	return m.IBMVPCCluster.Spec.ResourceGroup
+}
 
+// buildInstancePrototype resolves the image, profile, network interfaces, SSH keys, placement and volumes of the
+// machine spec into the prototype of the instance to create.
+func (m *MachineScope) buildInstancePrototype() (vpcv1.InstancePrototypeIntf, error) {
 	cloudInitData, err := m.GetBootstrapData()
 	if err != nil {
 		return nil, err
 	}
 
-	imageID, err := fetchImageID(m.IBMVPCMachine.Spec.Image, m)
-	if err != nil {
-		record.Warnf(m.IBMVPCMachine, "FailedRetriveImage", "Failed image retrival - %v", err)
-		return nil, fmt.Errorf("error while fetching image ID: %v", err)
+	if m.IBMVPCMachine.Spec.InstanceTemplate != nil {
+		return m.buildInstancePrototypeFromTemplate(cloudInitData)
+	}
+
+	var imageID *string
+	if m.IBMVPCMachine.Spec.CatalogOffering == nil {
+		imageID, err = fetchImageID(m.IBMVPCMachine.Spec.Image, m)
+		if err != nil {
+			record.Warnf(m.IBMVPCMachine, "FailedRetriveImage", "Failed image retrival - %v", err)
+			return nil, fmt.Errorf("error while fetching image ID: %v", err)
+		}
+	} else if image := m.IBMVPCMachine.Spec.Image; image != nil && (image.ID != nil || image.Name != nil) {
+		return nil, fmt.Errorf("only one of image or catalogOffering may be specified")
+	}
+
+	if m.IBMVPCMachine.Spec.Profile != "" {
+		if err := m.validateInstanceProfile(m.IBMVPCMachine.Spec.Profile); err != nil {
+			record.Warnf(m.IBMVPCMachine, "FailedCreateInstance", "Invalid instance profile - %v", err)
+			return nil, err
+		}
+	}
+
+	if bandwidth := m.IBMVPCMachine.Spec.TotalVolumeBandwidth; bandwidth != nil {
+		if err := m.validateTotalVolumeBandwidth(m.IBMVPCMachine.Spec.Profile, *bandwidth); err != nil {
+			record.Warnf(m.IBMVPCMachine, "FailedCreateInstance", "Invalid total volume bandwidth - %v", err)
+			return nil, err
+		}
+	}
+
+	confidentialCompute := m.IBMVPCMachine.Spec.ConfidentialCompute
+	if confidentialCompute != nil && confidentialCompute.SecureBoot {
+		if err := validateSecureBootProfile(m.IBMVPCMachine.Spec.Profile); err != nil {
+			record.Warnf(m.IBMVPCMachine, "FailedCreateInstance", "Invalid confidential compute - %v", err)
+			return nil, err
+		}
 	}
 
-	options := &vpcv1.CreateInstanceOptions{}
 	instancePrototype := &vpcv1.InstancePrototype{
 		Name: &m.IBMVPCMachine.Name,
-		Image: &vpcv1.ImageIdentity{
-			ID: imageID,
-		},
 		Profile: &vpcv1.InstanceProfileIdentity{
 			Name: &m.IBMVPCMachine.Spec.Profile,
 		},
 		Zone: &vpcv1.ZoneIdentity{
 			Name: &m.IBMVPCMachine.Spec.Zone,
 		},
-		PrimaryNetworkInterface: &vpcv1.NetworkInterfacePrototype{
-			Subnet: &vpcv1.SubnetIdentity{
-				ID: &m.IBMVPCMachine.Spec.PrimaryNetworkInterface.Subnet,
-			},
-		},
 		ResourceGroup: &vpcv1.ResourceGroupIdentity{
-			ID: &m.IBMVPCCluster.Spec.ResourceGroup,
+			ID: core.StringPtr(m.resourceGroupID()),
 		},
-		UserData: &cloudInitData,
+		UserData:             &cloudInitData,
+		TotalVolumeBandwidth: m.IBMVPCMachine.Spec.TotalVolumeBandwidth,
+	}
+
+	primaryNetworkInterface, err := m.networkInterfaceToVPCNetworkInterfacePrototype(m.IBMVPCMachine.Spec.PrimaryNetworkInterface, &m.IBMVPCMachine.Spec.PrimaryNetworkInterface.Subnet, m.reservedIPName(0))
+	if err != nil {
+		return nil, fmt.Errorf("error while building primary network interface: %w", err)
+	}
+	instancePrototype.PrimaryNetworkInterface = primaryNetworkInterface
+
+	for i, networkInterface := range m.IBMVPCMachine.Spec.NetworkInterfaces {
+		subnetID, err := fetchSubnetID(networkInterface.Subnet, m)
+		if err != nil {
+			record.Warnf(m.IBMVPCMachine, "FailedRetrieveSubnet", "Failed subnet retrieval - %v", err)
+			return nil, fmt.Errorf("error while fetching subnet %s for network interface: %w", networkInterface.Subnet, err)
+		}
+		secondaryNetworkInterface, err := m.networkInterfaceToVPCNetworkInterfacePrototype(networkInterface, subnetID, m.reservedIPName(i+1))
+		if err != nil {
+			return nil, fmt.Errorf("error while building network interface for subnet %s: %w", networkInterface.Subnet, err)
+		}
+		instancePrototype.NetworkInterfaces = append(instancePrototype.NetworkInterfaces, *secondaryNetworkInterface)
+	}
+
+	if m.IBMVPCMachine.Spec.CatalogOffering != nil {
+		instancePrototype.CatalogOffering = &vpcv1.InstanceCatalogOfferingPrototypeCatalogOfferingByVersion{
+			Version: &vpcv1.CatalogOfferingVersionIdentityCatalogOfferingVersionByCRN{
+				CRN: &m.IBMVPCMachine.Spec.CatalogOffering.VersionCRN,
+			},
+		}
+	} else {
+		instancePrototype.Image = &vpcv1.ImageIdentity{
+			ID: imageID,
+		}
+	}
+
+	switch metadataService := m.IBMVPCMachine.Spec.MetadataService; {
+	case metadataService != nil:
+		instancePrototype.MetadataService = &vpcv1.InstanceMetadataServicePrototype{
+			Enabled: core.BoolPtr(metadataService.Enabled),
+		}
+		if metadataService.Enabled && metadataService.ProtocolVersion != "" {
+			instancePrototype.MetadataService.Protocol = core.StringPtr(metadataService.ProtocolVersion)
+		}
+	case m.IBMVPCMachine.Spec.BootstrapFormat == infrav1beta2.BootstrapFormatIgnition:
+		// Ignition fetches the user data from the metadata service.
+		instancePrototype.MetadataService = &vpcv1.InstanceMetadataServicePrototype{
+			Enabled: core.BoolPtr(true),
+		}
 	}
 
 	if m.IBMVPCMachine.Spec.SSHKeys != nil {
@@ -170,74 +526,1054 @@ func (m *MachineScope) CreateMachine() (*vpcv1.Instance, error) {
 		}
 	}
 
+	if m.IBMVPCMachine.Spec.PlacementTarget != nil && m.IBMVPCMachine.Spec.DedicatedHost != nil {
+		return nil, fmt.Errorf("only one of placementTarget or dedicatedHost may be specified")
+	}
+
+	if m.IBMVPCMachine.Spec.DedicatedHost != nil {
+		dedicatedHostID, err := fetchDedicatedHostID(m.IBMVPCMachine.Spec.DedicatedHost, m)
+		if err != nil {
+			record.Warnf(m.IBMVPCMachine, "FailedRetrieveDedicatedHost", "Failed dedicated host retrieval - %v", err)
+			return nil, fmt.Errorf("error while fetching dedicated host ID: %v", err)
+		}
+		instancePrototype.PlacementTarget = &vpcv1.InstancePlacementTargetPrototypeDedicatedHostIdentity{
+			ID: dedicatedHostID,
+		}
+	}
+
+	if m.IBMVPCMachine.Spec.PlacementTarget != nil {
+		placementGroupID, err := fetchPlacementGroupID(m.IBMVPCMachine.Spec.PlacementTarget, m)
+		if err != nil {
+			record.Warnf(m.IBMVPCMachine, "FailedRetrievePlacementGroup", "Failed placement group retrieval - %v", err)
+			return nil, fmt.Errorf("error while fetching placement group ID: %v", err)
+		}
+		instancePrototype.PlacementTarget = &vpcv1.InstancePlacementTargetPrototypePlacementGroupIdentity{
+			ID: placementGroupID,
+		}
+	}
+
+	reservationAffinity, err := m.reservationAffinity()
+	if err != nil {
+		return nil, err
+	}
+	instancePrototype.ReservationAffinity = reservationAffinity
+
 	if m.IBMVPCMachine.Spec.BootVolume != nil {
+		if err := validateBootVolumeSize(m.IBMVPCMachine.Spec.BootVolume.SizeGiB); err != nil {
+			record.Warnf(m.IBMVPCMachine, "FailedCreateInstance", "Invalid boot volume - %v", err)
+			return nil, err
+		}
+		if crn := m.IBMVPCMachine.Spec.BootVolume.EncryptionKeyCRN; crn != "" {
+			if err := validateEncryptionKeyCRN(crn); err != nil {
+				record.Warnf(m.IBMVPCMachine, "FailedCreateInstance", "Invalid boot volume - %v", err)
+				return nil, err
+			}
+		}
 		instancePrototype.BootVolumeAttachment = volumeToVPCVolumeAttachment(m.IBMVPCMachine.Spec.BootVolume)
 	}
 
-	options.SetInstancePrototype(instancePrototype)
-	instance, _, err := m.IBMVPCClient.CreateInstance(options)
+	if len(m.IBMVPCMachine.Spec.DataVolumes) > 0 {
+		instancePrototype.VolumeAttachments = []vpcv1.VolumeAttachmentPrototype{}
+		for i := range m.IBMVPCMachine.Spec.DataVolumes {
+			volumeAttachment, err := dataVolumeToVPCVolumeAttachment(&m.IBMVPCMachine.Spec.DataVolumes[i])
+			if err != nil {
+				record.Warnf(m.IBMVPCMachine, "FailedCreateInstance", "Invalid data volume - %v", err)
+				return nil, err
+			}
+			instancePrototype.VolumeAttachments = append(instancePrototype.VolumeAttachments, *volumeAttachment)
+		}
+	}
+
+	var prototype vpcv1.InstancePrototypeIntf = instancePrototype
+	if confidentialCompute != nil {
+		if confidentialCompute.TrustedProfile != nil {
+			instancePrototype.DefaultTrustedProfile = trustedProfileToVPCPrototype(*confidentialCompute.TrustedProfile)
+		}
+		if confidentialCompute.SecureBoot {
+			prototype = &secureBootInstancePrototype{
+				InstancePrototype: instancePrototype,
+				EnableSecureBoot:  ptr.To(true),
+			}
+		}
+	}
+	return prototype, nil
+}
+
+// buildInstancePrototypeFromTemplate returns the prototype of an instance created from the instance template of the machine.
+// The image, profile, network interfaces, SSH keys, volumes, placement and confidential computing settings are provided
+// by the template so they are not looked up.
+func (m *MachineScope) buildInstancePrototypeFromTemplate(cloudInitData string) (vpcv1.InstancePrototypeIntf, error) {
+	spec := m.IBMVPCMachine.Spec
+	if spec.Image != nil || spec.CatalogOffering != nil || spec.Profile != "" || len(spec.NetworkInterfaces) > 0 ||
+		len(spec.SSHKeys) > 0 || spec.BootVolume != nil || len(spec.DataVolumes) > 0 || spec.PlacementTarget != nil ||
+		spec.DedicatedHost != nil || spec.TotalVolumeBandwidth != nil || spec.ConfidentialCompute != nil {
+		return nil, fmt.Errorf("image, catalogOffering, profile, networkInterfaces, sshKeys, bootVolume, dataVolumes, placementTarget, dedicatedHost, totalVolumeBandwidth and confidentialCompute may not be specified with instanceTemplate")
+	}
+
+	templateID, err := fetchInstanceTemplateID(spec.InstanceTemplate, m)
 	if err != nil {
-		record.Warnf(m.IBMVPCMachine, "FailedCreateInstance", "Failed instance creation - %v", err)
-	} else {
-		record.Eventf(m.IBMVPCMachine, "SuccessfulCreateInstance", "Created Instance %q", *instance.Name)
+		record.Warnf(m.IBMVPCMachine, "FailedRetrieveInstanceTemplate", "Failed instance template retrieval - %v", err)
+		return nil, fmt.Errorf("error while fetching instance template ID: %w", err)
+	}
+
+	instancePrototype := &vpcv1.InstancePrototypeInstanceBySourceTemplate{
+		Name: &m.IBMVPCMachine.Name,
+		SourceTemplate: &vpcv1.InstanceTemplateIdentityByID{
+			ID: templateID,
+		},
+		ResourceGroup: &vpcv1.ResourceGroupIdentity{
+			ID: core.StringPtr(m.resourceGroupID()),
+		},
+		UserData: &cloudInitData,
+	}
+
+	// The zone and subnet of the template are overridden so that a single template serves all the failure domains.
+	if spec.Zone != "" {
+		instancePrototype.Zone = &vpcv1.ZoneIdentity{
+			Name: &spec.Zone,
+		}
+	}
+	if spec.PrimaryNetworkInterface.Subnet != "" {
+		primaryNetworkInterface, err := m.networkInterfaceToVPCNetworkInterfacePrototype(spec.PrimaryNetworkInterface, &spec.PrimaryNetworkInterface.Subnet, m.reservedIPName(0))
+		if err != nil {
+			return nil, fmt.Errorf("error while building primary network interface: %w", err)
+		}
+		instancePrototype.PrimaryNetworkInterface = primaryNetworkInterface
+	}
+
+	switch metadataService := spec.MetadataService; {
+	case metadataService != nil:
+		instancePrototype.MetadataService = &vpcv1.InstanceMetadataServicePrototype{
+			Enabled: core.BoolPtr(metadataService.Enabled),
+		}
+		if metadataService.Enabled && metadataService.ProtocolVersion != "" {
+			instancePrototype.MetadataService.Protocol = core.StringPtr(metadataService.ProtocolVersion)
+		}
+	case spec.BootstrapFormat == infrav1beta2.BootstrapFormatIgnition:
+		// Ignition fetches the user data from the metadata service.
+		instancePrototype.MetadataService = &vpcv1.InstanceMetadataServicePrototype{
+			Enabled: core.BoolPtr(true),
+		}
+	}
+
+	reservationAffinity, err := m.reservationAffinity()
+	if err != nil {
+		return nil, err
 	}
-	return instance, err
+	instancePrototype.ReservationAffinity = reservationAffinity
+
+	return instancePrototype, nil
 }
 
-func volumeToVPCVolumeAttachment(volume *infrav1beta2.VPCVolume) *vpcv1.VolumeAttachmentPrototypeInstanceByImageContext {
-	bootVolume := &vpcv1.VolumeAttachmentPrototypeInstanceByImageContext{
-		DeleteVolumeOnInstanceDelete: core.BoolPtr(volume.DeleteVolumeOnInstanceDelete),
-		Volume:                       &vpcv1.VolumePrototypeInstanceByImageContext{},
+// reservationAffinity returns the reservation affinity of the instance from the capacity reservation of the spec,
+// it is nil when the capacity reservation is not set so that the VPC API default applies.
+func (m *MachineScope) reservationAffinity() (*vpcv1.InstanceReservationAffinityPrototype, error) {
+	capacityReservation := m.IBMVPCMachine.Spec.CapacityReservation
+	if capacityReservation == nil {
+		return nil, nil
+	}
+	if m.IBMVPCMachine.Spec.DedicatedHost != nil {
+		return nil, fmt.Errorf("capacityReservation may not be specified with dedicatedHost")
 	}
 
-	if volume.Name != "" {
-		bootVolume.Volume.Name = core.StringPtr(volume.Name)
+	policy := capacityReservation.Policy
+	if policy == "" {
+		policy = infrav1beta2.VPCReservationAffinityPolicyAutomatic
+	}
+	switch {
+	case policy == infrav1beta2.VPCReservationAffinityPolicyManual && capacityReservation.Reservation == nil:
+		return nil, fmt.Errorf("reservation is required with the manual reservation affinity policy")
+	case policy != infrav1beta2.VPCReservationAffinityPolicyManual && capacityReservation.Reservation != nil:
+		return nil, fmt.Errorf("reservation may be specified only with the manual reservation affinity policy")
+	}
+
+	reservationAffinity := &vpcv1.InstanceReservationAffinityPrototype{
+		Policy: core.StringPtr(string(policy)),
+	}
+	if policy == infrav1beta2.VPCReservationAffinityPolicyManual {
+		reservationID, err := fetchReservationID(capacityReservation.Reservation, m)
+		if err != nil {
+			record.Warnf(m.IBMVPCMachine, "FailedRetrieveReservation", "Failed reservation retrieval - %v", err)
+			return nil, fmt.Errorf("error while fetching reservation ID: %w", err)
+		}
+		reservationAffinity.Pool = []vpcv1.ReservationIdentityIntf{
+			&vpcv1.ReservationIdentityByID{
+				ID: reservationID,
+			},
+		}
+	}
+	return reservationAffinity, nil
+}
+
+// ReconcileTags attaches the user tags from the spec which are missing on the instance. When PruneTags is set,
+// the user tags attached to the instance which are not in the spec are detached.
+func (m *MachineScope) ReconcileTags(instance *vpcv1.Instance) error {
+	if len(m.IBMVPCMachine.Spec.Tags) == 0 && !m.IBMVPCMachine.Spec.PruneTags {
+		return nil
+	}
+	if instance.CRN == nil {
+		return fmt.Errorf("instance CRN is not set")
+	}
+
+	tagList, _, err := m.GlobalTaggingClient.ListTags(&globaltaggingv1.ListTagsOptions{
+		AttachedTo: instance.CRN,
+		TagType:    core.StringPtr(globaltaggingv1.ListTagsOptionsTagTypeUserConst),
+		Limit:      core.Int64Ptr(1000),
+	})
+	if err != nil {
+		return fmt.Errorf("error while listing tags attached to instance %s: %w", *instance.ID, err)
+	}
+
+	attachedTags := make(map[string]bool)
+	if tagList != nil {
+		for _, tag := range tagList.Items {
+			if tag.Name != nil {
+				attachedTags[*tag.Name] = true
+			}
+		}
+	}
+
+	desiredTags := make(map[string]bool)
+	var missingTags []string
+	for _, tag := range m.IBMVPCMachine.Spec.Tags {
+		desiredTags[tag] = true
+		if !attachedTags[tag] {
+			missingTags = append(missingTags, tag)
+		}
+	}
+
+	var unmanagedTags []string
+	if m.IBMVPCMachine.Spec.PruneTags && tagList != nil {
+		for _, tag := range tagList.Items {
+			if tag.Name != nil && !desiredTags[*tag.Name] {
+				unmanagedTags = append(unmanagedTags, *tag.Name)
+			}
+		}
+	}
+
+	if len(missingTags) > 0 {
+		if err := m.attachTags(instance, missingTags); err != nil {
+			return err
+		}
+	}
+	if len(unmanagedTags) > 0 {
+		return m.detachTags(instance, unmanagedTags)
+	}
+	return nil
+}
+
+// attachTags attaches the user tags to the instance.
+func (m *MachineScope) attachTags(instance *vpcv1.Instance, tags []string) error {
+	m.Info("Attaching tags to instance", "instanceID", *instance.ID, "tags", tags)
+	result, _, err := m.GlobalTaggingClient.AttachTag(&globaltaggingv1.AttachTagOptions{
+		Resources: []globaltaggingv1.Resource{
+			{
+				ResourceID: instance.CRN,
+			},
+		},
+		TagNames: tags,
+		TagType:  core.StringPtr(globaltaggingv1.AttachTagOptionsTagTypeUserConst),
+	})
+	if err != nil {
+		record.Warnf(m.IBMVPCMachine, "FailedAttachTags", "Failed to attach tags to instance - %v", err)
+		return fmt.Errorf("error while attaching tags to instance %s: %w", *instance.ID, err)
+	}
+	if result != nil {
+		for _, item := range result.Results {
+			if item.IsError != nil && *item.IsError {
+				record.Warnf(m.IBMVPCMachine, "FailedAttachTags", "Failed to attach tags to instance %q", *instance.ID)
+				return fmt.Errorf("error while attaching tags to instance %s", *instance.ID)
+			}
+		}
+	}
+	record.Eventf(m.IBMVPCMachine, "SuccessfulAttachTags", "Attached tags %v to instance %q", tags, *instance.ID)
+	return nil
+}
+
+// detachTags detaches the user tags from the instance.
+func (m *MachineScope) detachTags(instance *vpcv1.Instance, tags []string) error {
+	m.Info("Detaching tags from instance", "instanceID", *instance.ID, "tags", tags)
+	result, _, err := m.GlobalTaggingClient.DetachTag(&globaltaggingv1.DetachTagOptions{
+		Resources: []globaltaggingv1.Resource{
+			{
+				ResourceID: instance.CRN,
+			},
+		},
+		TagNames: tags,
+		TagType:  core.StringPtr(globaltaggingv1.DetachTagOptionsTagTypeUserConst),
+	})
+	if err != nil {
+		record.Warnf(m.IBMVPCMachine, "FailedDetachTags", "Failed to detach tags from instance - %v", err)
+		return fmt.Errorf("error while detaching tags from instance %s: %w", *instance.ID, err)
+	}
+	if result != nil {
+		for _, item := range result.Results {
+			if item.IsError != nil && *item.IsError {
+				record.Warnf(m.IBMVPCMachine, "FailedDetachTags", "Failed to detach tags from instance %q", *instance.ID)
+				return fmt.Errorf("error while detaching tags from instance %s", *instance.ID)
+			}
+		}
+	}
+	record.Eventf(m.IBMVPCMachine, "SuccessfulDetachTags", "Detached tags %v from instance %q", tags, *instance.ID)
+	return nil
+}
+
+// CheckInstanceRunning returns ErrInstanceNotRunning when WaitForRunning is set and the instance, intended to be
+// running, is not running yet. Once WaitForRunningTimeout has elapsed since the creation of the instance, a different
+// error is returned so that the machine reports the failure instead of waiting.
+func (m *MachineScope) CheckInstanceRunning(instance *vpcv1.Instance) error {
+	if !m.WaitForRunning || m.IBMVPCMachine.Status.PowerState != infrav1beta2.VPCInstancePowerStateRunning {
+		return nil
+	}
+	if instance.Status != nil && *instance.Status == vpcv1.InstanceStatusRunningConst {
+		return nil
+	}
+
+	timeout := m.WaitForRunningTimeout
+	if timeout == 0 {
+		timeout = defaultWaitForRunningTimeout
+	}
+	status := ptr.Deref(instance.Status, "unknown")
+	if instance.CreatedAt != nil && time.Since(time.Time(*instance.CreatedAt)) > timeout {
+		record.Warnf(m.IBMVPCMachine, "FailedWaitForInstanceRunning", "Instance %q is %s and not running after %s", *instance.ID, status, timeout)
+		return fmt.Errorf("instance %s is %s and not running after %s", *instance.ID, status, timeout)
+	}
+	return fmt.Errorf("%w: instance %s is %s", ErrInstanceNotRunning, *instance.ID, status)
+}
+
+// networkInterfaceToVPCNetworkInterfacePrototype builds the network interface prototype attached to the given subnet.
+// A reserved IP created for the primary IP address is given reservedIPName so that it is deleted along with the machine.
+func (m *MachineScope) networkInterfaceToVPCNetworkInterfacePrototype(networkInterface infrav1beta2.NetworkInterface, subnetID *string, reservedIPName string) (*vpcv1.NetworkInterfacePrototype, error) {
+	prototype := &vpcv1.NetworkInterfacePrototype{
+		Subnet: &vpcv1.SubnetIdentity{
+			ID: subnetID,
+		},
+		AllowIPSpoofing: networkInterface.AllowIPSpoofing,
+	}
+
+	if primaryIP := networkInterface.PrimaryIP; primaryIP != nil {
+		switch {
+		case primaryIP.ID != nil && primaryIP.Address != nil:
+			return nil, fmt.Errorf("only one of primary IP ID or address may be specified")
+		case primaryIP.ID != nil:
+			prototype.PrimaryIP = &vpcv1.NetworkInterfaceIPPrototypeReservedIPIdentity{
+				ID: primaryIP.ID,
+			}
+		case primaryIP.Address != nil:
+			if err := m.validateAddressInSubnet(*primaryIP.Address, subnetID); err != nil {
+				return nil, err
+			}
+			prototype.PrimaryIP = &vpcv1.NetworkInterfaceIPPrototypeReservedIPPrototypeNetworkInterfaceContext{
+				Address: primaryIP.Address,
+				Name:    &reservedIPName,
+			}
+		}
+	}
+
+	for i := range networkInterface.SecurityGroups {
+		// A security group referenced by CRN, like one shared from another account, is used without a lookup.
+		if crn := networkInterface.SecurityGroups[i].CRN; crn != nil {
+			if err := validateSecurityGroupCRN(*crn); err != nil {
+				return nil, err
+			}
+			prototype.SecurityGroups = append(prototype.SecurityGroups, &vpcv1.SecurityGroupIdentity{
+				CRN: crn,
+			})
+			continue
+		}
+		securityGroupID, err := fetchSecurityGroupID(&networkInterface.SecurityGroups[i], m)
+		if err != nil {
+			return nil, fmt.Errorf("error while fetching security group ID: %w", err)
+		}
+		prototype.SecurityGroups = append(prototype.SecurityGroups, &vpcv1.SecurityGroupIdentity{
+			ID: securityGroupID,
+		})
+	}
+	return prototype, nil
+}
+
+// validateAddressInSubnet checks that the address falls within the IPv4 CIDR block of the subnet.
+func (m *MachineScope) validateAddressInSubnet(address string, subnetID *string) error {
+	ip := net.ParseIP(address)
+	if ip == nil {
+		return fmt.Errorf("invalid primary IP address %s", address)
+	}
+
+	subnet, _, err := m.IBMVPCClient.GetSubnet(&vpcv1.GetSubnetOptions{
+		ID: subnetID,
+	})
+	if err != nil {
+		return fmt.Errorf("error while fetching subnet %s: %w", *subnetID, err)
+	}
+	if subnet == nil || subnet.Ipv4CIDRBlock == nil {
+		return fmt.Errorf("failed to find CIDR block of subnet %s", *subnetID)
+	}
+
+	_, cidr, err := net.ParseCIDR(*subnet.Ipv4CIDRBlock)
+	if err != nil {
+		return fmt.Errorf("error while parsing CIDR block of subnet %s: %w", *subnetID, err)
+	}
+	if !cidr.Contains(ip) {
+		return fmt.Errorf("primary IP address %s is not within subnet %s CIDR block %s", address, *subnetID, *subnet.Ipv4CIDRBlock)
+	}
+	return nil
+}
+
+// validateInstanceProfile checks that the profile is available in the region.
+func (m *MachineScope) validateInstanceProfile(profile string) error {
+	if m.instanceProfiles == nil {
+		profileCollection, _, err := m.IBMVPCClient.ListInstanceProfiles(&vpcv1.ListInstanceProfilesOptions{})
+		if err != nil {
+			return fmt.Errorf("error while listing instance profiles: %w", err)
+		}
+		if profileCollection == nil {
+			return fmt.Errorf("instance profile list returned is nil")
+		}
+		profiles := make([]string, 0, len(profileCollection.Profiles))
+		for _, p := range profileCollection.Profiles {
+			if p.Name != nil {
+				profiles = append(profiles, *p.Name)
+			}
+		}
+		m.instanceProfiles = profiles
+	}
+
+	for _, p := range m.instanceProfiles {
+		if p == profile {
+			return nil
+		}
+	}
+	return fmt.Errorf("instance profile %s is not available in region %s, valid profiles are: %s", profile, m.IBMVPCCluster.Spec.Region, strings.Join(m.instanceProfiles, ", "))
+}
+
+// validateTotalVolumeBandwidth checks that the total volume bandwidth is allowed by the instance profile.
+func (m *MachineScope) validateTotalVolumeBandwidth(profile string, bandwidth int64) error {
+	if profile == "" {
+		return fmt.Errorf("instance profile is required to set the total volume bandwidth")
+	}
+	instanceProfile, _, err := m.IBMVPCClient.GetInstanceProfile(&vpcv1.GetInstanceProfileOptions{Name: &profile})
+	if err != nil {
+		return fmt.Errorf("error while getting instance profile %s: %w", profile, err)
+	}
+	if instanceProfile == nil {
+		return fmt.Errorf("instance profile %s returned is nil", profile)
+	}
+
+	// The allowed bandwidth of dependent profiles is derived from other properties of the instance, it is left to the API to validate.
+	allowed, ok := instanceProfile.TotalVolumeBandwidth.(*vpcv1.InstanceProfileVolumeBandwidth)
+	if !ok || allowed.Type == nil {
+		return nil
+	}
+	switch *allowed.Type {
+	case vpcv1.InstanceProfileVolumeBandwidthFixedTypeFixedConst:
+		if allowed.Value != nil && bandwidth != *allowed.Value {
+			return fmt.Errorf("total volume bandwidth %d is not allowed by instance profile %s, it must be %d", bandwidth, profile, *allowed.Value)
+		}
+	case vpcv1.InstanceProfileVolumeBandwidthRangeTypeRangeConst:
+		if allowed.Min != nil && allowed.Max != nil && (bandwidth < *allowed.Min || bandwidth > *allowed.Max) {
+			return fmt.Errorf("total volume bandwidth %d is not allowed by instance profile %s, it must be between %d and %d", bandwidth, profile, *allowed.Min, *allowed.Max)
+		}
+		if allowed.Min != nil && allowed.Step != nil && *allowed.Step > 0 && (bandwidth-*allowed.Min)%*allowed.Step != 0 {
+			return fmt.Errorf("total volume bandwidth %d is not allowed by instance profile %s, it must be a multiple of %d from %d", bandwidth, profile, *allowed.Step, *allowed.Min)
+		}
+	case vpcv1.InstanceProfileVolumeBandwidthEnumTypeEnumConst:
+		if !slices.Contains(allowed.Values, bandwidth) {
+			return fmt.Errorf("total volume bandwidth %d is not allowed by instance profile %s, it must be one of %v", bandwidth, profile, allowed.Values)
+		}
+	}
+	return nil
+}
+
+// validateSecureBootProfile checks that the instance profile belongs to a generation supporting secure boot.
+func validateSecureBootProfile(profile string) error {
+	match := instanceProfileGenerationRegexp.FindStringSubmatch(profile)
+	if match == nil {
+		return fmt.Errorf("secure boot requires an instance profile of generation %d or later", minSecureBootProfileGeneration)
+	}
+	generation, err := strconv.Atoi(match[1])
+	if err != nil || generation < minSecureBootProfileGeneration {
+		return fmt.Errorf("instance profile %s does not support secure boot, use a profile of generation %d or later", profile, minSecureBootProfileGeneration)
+	}
+	return nil
+}
+
+// trustedProfileToVPCPrototype returns the default trusted profile prototype of the trusted profile ID or CRN.
+func trustedProfileToVPCPrototype(trustedProfile string) *vpcv1.InstanceDefaultTrustedProfilePrototype {
+	if strings.HasPrefix(trustedProfile, "crn:") {
+		return &vpcv1.InstanceDefaultTrustedProfilePrototype{
+			Target: &vpcv1.TrustedProfileIdentityTrustedProfileByCRN{CRN: &trustedProfile},
+		}
+	}
+	return &vpcv1.InstanceDefaultTrustedProfilePrototype{
+		Target: &vpcv1.TrustedProfileIdentityTrustedProfileByID{ID: &trustedProfile},
+	}
+}
+
+// validateBootVolumeSize checks the requested boot volume capacity, a zero value means the image's minimum provisioned size is used.
+func validateBootVolumeSize(sizeGiB int64) error {
+	if sizeGiB == 0 {
+		return nil
+	}
+	if sizeGiB < minBootVolumeSizeGiB || sizeGiB > maxBootVolumeSizeGiB {
+		return fmt.Errorf("invalid boot volume size %dGiB, valid size is %d - %d GiB", sizeGiB, minBootVolumeSizeGiB, maxBootVolumeSizeGiB)
+	}
+	return nil
+}
+
+// validateEncryptionKeyCRN checks that the CRN is a Key Protect or Hyper Protect Crypto Services root key CRN, which has
+// the format crn:v1:<cname>:<ctype>:<kms|hs-crypto>:<region>:a/<account-id>:<instance-id>:key:<key-id>.
+// The CRN of the key management instance itself is rejected as the volume must be encrypted with a key of the instance.
+func validateEncryptionKeyCRN(crn string) error {
+	segments := strings.Split(crn, ":")
+	if len(segments) != 10 || segments[0] != "crn" || segments[1] != "v1" || (segments[4] != "kms" && segments[4] != "hs-crypto") {
+		return fmt.Errorf("invalid encryption key CRN %s", crn)
+	}
+	if segments[7] == "" || segments[8] != "key" || segments[9] == "" {
+		return fmt.Errorf("encryption key CRN %s does not reference a root key", crn)
+	}
+	return nil
+}
+
+func volumeToVPCVolumeAttachment(volume *infrav1beta2.VPCVolume) *vpcv1.VolumeAttachmentPrototypeInstanceByImageContext {
+	bootVolume := &vpcv1.VolumeAttachmentPrototypeInstanceByImageContext{
+		DeleteVolumeOnInstanceDelete: core.BoolPtr(volume.DeleteVolumeOnInstanceDelete),
+		Volume:                       &vpcv1.VolumePrototypeInstanceByImageContext{},
+	}
+
+	if volume.Name != "" {
+		bootVolume.Volume.Name = core.StringPtr(volume.Name)
+	}
+
+	if volume.Profile != "" {
+		bootVolume.Volume.Profile = &vpcv1.VolumeProfileIdentity{
+			Name: core.StringPtr(volume.Profile),
+		}
+	}
+
+	if volume.SizeGiB != 0 {
+		bootVolume.Volume.Capacity = core.Int64Ptr(volume.SizeGiB)
+	}
+
+	if volume.Iops != 0 {
+		bootVolume.Volume.Iops = core.Int64Ptr(volume.Iops)
+	}
+
+	if volume.EncryptionKeyCRN != "" {
+		bootVolume.Volume.EncryptionKey = &vpcv1.EncryptionKeyIdentity{
+			CRN: core.StringPtr(volume.EncryptionKeyCRN),
+		}
+	}
+
+	return bootVolume
+}
+
+func dataVolumeToVPCVolumeAttachment(volume *infrav1beta2.VPCVolume) (*vpcv1.VolumeAttachmentPrototype, error) {
+	if volume.SizeGiB == 0 {
+		return nil, fmt.Errorf("size is required for data volume %q", volume.Name)
+	}
+
+	dataVolume := &vpcv1.VolumeAttachmentPrototypeVolume{
+		Capacity: core.Int64Ptr(volume.SizeGiB),
+	}
+
+	if volume.Name != "" {
+		dataVolume.Name = core.StringPtr(volume.Name)
+	}
+
+	if volume.Profile != "" {
+		dataVolume.Profile = &vpcv1.VolumeProfileIdentity{
+			Name: core.StringPtr(volume.Profile),
+		}
+	}
+
+	if volume.Iops != 0 {
+		dataVolume.Iops = core.Int64Ptr(volume.Iops)
+	}
+
+	if volume.EncryptionKeyCRN != "" {
+		dataVolume.EncryptionKey = &vpcv1.EncryptionKeyIdentity{
+			CRN: core.StringPtr(volume.EncryptionKeyCRN),
+		}
+	}
+
+	return &vpcv1.VolumeAttachmentPrototype{
+		DeleteVolumeOnInstanceDelete: core.BoolPtr(volume.DeleteVolumeOnInstanceDelete),
+		Volume:                       dataVolume,
+	}, nil
+}
+
+// SelectSubnet returns the ID of the subnet for a machine without a subnet, spreading the machines across the control
+// plane subnets of the cluster with its subnet selection strategy. It returns an empty ID when the cluster has no subnet yet.
+func (m *MachineScope) SelectSubnet() (string, error) {
+	subnetIDs := m.controlPlaneSubnetIDs()
+	switch len(subnetIDs) {
+	case 0:
+		return "", nil
+	case 1:
+		return subnetIDs[0], nil
+	}
+
+	strategy := infrav1beta2.SubnetSelectionStrategyRoundRobin
+	if network := m.IBMVPCCluster.Spec.Network; network != nil && network.SubnetSelectionStrategy != "" {
+		strategy = network.SubnetSelectionStrategy
+	}
+	if strategy == infrav1beta2.SubnetSelectionStrategyLeastLoaded {
+		return m.leastLoadedSubnet(subnetIDs)
+	}
+	return m.nextRoundRobinSubnet(subnetIDs)
+}
+
+// controlPlaneSubnetIDs returns the IDs of the control plane subnets of the cluster, falling back to the cluster subnet.
+func (m *MachineScope) controlPlaneSubnetIDs() []string {
+	var subnetIDs []string
+	if network := m.IBMVPCCluster.Status.Network; network != nil {
+		for _, subnet := range network.ControlPlaneSubnets {
+			if subnet.ID != nil {
+				subnetIDs = append(subnetIDs, *subnet.ID)
+			}
+		}
+	}
+	if len(subnetIDs) == 0 && m.IBMVPCCluster.Status.Subnet.ID != nil {
+		subnetIDs = append(subnetIDs, *m.IBMVPCCluster.Status.Subnet.ID)
+	}
+	return subnetIDs
+}
+
+// nextRoundRobinSubnet returns the subnet used by the fewest other machines of the cluster, the first subnet wins a
+// tie, so that the subnets are selected in turn. The machines are counted from their specs rather than tracked by the
+// controller, so the selection does not depend on the controller process and needs no cleanup when the cluster is deleted.
+func (m *MachineScope) nextRoundRobinSubnet(subnetIDs []string) (string, error) {
+	machineList := &infrav1beta2.IBMVPCMachineList{}
+	if err := m.Client.List(context.TODO(), machineList, client.InNamespace(m.IBMVPCMachine.Namespace), client.MatchingLabels{
+		capiv1beta1.ClusterNameLabel: m.IBMVPCMachine.Labels[capiv1beta1.ClusterNameLabel],
+	}); err != nil {
+		return "", fmt.Errorf("error while listing machines of cluster %s: %w", m.IBMVPCMachine.Labels[capiv1beta1.ClusterNameLabel], err)
+	}
+
+	machines := make(map[string]int, len(subnetIDs))
+	for _, machine := range machineList.Items {
+		if machine.Name != m.IBMVPCMachine.Name {
+			machines[machine.Spec.PrimaryNetworkInterface.Subnet]++
+		}
+	}
+	selected := subnetIDs[0]
+	for _, subnetID := range subnetIDs[1:] {
+		if machines[subnetID] < machines[selected] {
+			selected = subnetID
+		}
+	}
+	return selected, nil
+}
+
+// leastLoadedSubnet returns the subnet with the most available IPv4 addresses, the first subnet wins a tie.
+func (m *MachineScope) leastLoadedSubnet(subnetIDs []string) (string, error) {
+	selected := subnetIDs[0]
+	maxAvailable := int64(-1)
+	for _, subnetID := range subnetIDs {
+		subnet, _, err := m.IBMVPCClient.GetSubnet(&vpcv1.GetSubnetOptions{
+			ID: core.StringPtr(subnetID),
+		})
+		if err != nil {
+			return "", fmt.Errorf("error while getting subnet %s: %w", subnetID, err)
+		}
+		if subnet == nil || subnet.AvailableIpv4AddressCount == nil {
+			continue
+		}
+		if *subnet.AvailableIpv4AddressCount > maxAvailable {
+			selected = subnetID
+			maxAvailable = *subnet.AvailableIpv4AddressCount
+		}
+	}
+	return selected, nil
+}
+
+// DeleteMachine deletes the vpc machine associated with machine instance id. It returns ErrInstanceDeleting until the
+// instance is gone, and then deletes the orphaned reserved IPs of the machine.
+func (m *MachineScope) DeleteMachine() error {
+	if m.IBMVPCMachine.Status.InstanceID == "" {
+		return nil
+	}
+	if err := m.checkDeletionProtection(); err != nil {
+		return err
+	}
+	if err := m.DeleteFloatingIP(); err != nil {
+		return err
+	}
+	if m.IBMVPCMachine.Spec.AdoptExistingInstance {
+		m.Info("Skipping deletion of adopted instance", "instanceID", m.IBMVPCMachine.Status.InstanceID)
+		return nil
+	}
+	instanceID := m.IBMVPCMachine.Status.InstanceID
+	instance, response, err := m.IBMVPCClient.GetInstance(&vpcv1.GetInstanceOptions{
+		ID: &instanceID,
+	})
+	switch {
+	case errors.Is(vpc.ClassifyError(response, err), vpc.ErrNotFound):
+		m.Info("Instance is deleted", "instanceID", instanceID)
+		return m.deleteOrphanedReservedIPs()
+	case err != nil:
+		return fmt.Errorf("failed to get instance %s: %w", instanceID, err)
+	}
+	if instance.Status != nil && *instance.Status == vpcv1.InstanceStatusDeletingConst {
+		return fmt.Errorf("%w: instance %s", ErrInstanceDeleting, instanceID)
+	}
+	if m.IBMVPCMachine.Spec.StopBeforeDelete {
+		if err := m.stopInstance(instance); err != nil {
+			return err
+		}
+	}
+	options := &vpcv1.DeleteInstanceOptions{}
+	options.SetID(instanceID)
+	response, err = m.IBMVPCClient.DeleteInstance(options)
+	switch {
+	case errors.Is(vpc.ClassifyError(response, err), vpc.ErrNotFound):
+		m.Info("Instance is already deleted", "instanceID", instanceID)
+		return m.deleteOrphanedReservedIPs()
+	case err != nil:
+		record.Warnf(m.IBMVPCMachine, "FailedDeleteInstance", "Failed instance deletion - %v", err)
+		return err
+	}
+	record.Eventf(m.IBMVPCMachine, "SuccessfulDeleteInstance", "Deleted Instance %q", m.IBMVPCMachine.Name)
+	// The instance is deleted asynchronously and its reserved IPs stay bound until it is gone.
+	return fmt.Errorf("%w: instance %s", ErrInstanceDeleting, instanceID)
+}
+
+// checkDeletionProtection returns ErrDeletionProtected when deletion protection is enabled on the machine.
+func (m *MachineScope) checkDeletionProtection() error {
+	if !m.IBMVPCMachine.Spec.DeletionProtection {
+		return nil
+	}
+	record.Warnf(m.IBMVPCMachine, "DeletionProtected", "Instance %q is not deleted as deletion protection is enabled", m.IBMVPCMachine.Status.InstanceID)
+	return fmt.Errorf("%w: instance %s", ErrDeletionProtected, m.IBMVPCMachine.Status.InstanceID)
+}
+
+// stopInstance stops the given instance before it is deleted. It returns ErrInstanceStopping while the instance is stopping,
+// and nil once it is stopped, when stopping it fails or when it is not stopped within instanceStopTimeout of the stop
+// request, so that the instance is deleted anyway. The time of the stop request is the last transition of the
+// InstanceDeleted condition, which the controller marks with InstanceStoppingReason on ErrInstanceStopping.
+func (m *MachineScope) stopInstance(instance *vpcv1.Instance) error {
+	instanceID := m.IBMVPCMachine.Status.InstanceID
+	if instance.Status != nil && *instance.Status == vpcv1.InstanceStatusStoppedConst {
+		record.Eventf(m.IBMVPCMachine, "SuccessfulStopInstance", "Stopped instance %q", instanceID)
+		return nil
+	}
+
+	if deleted := conditions.Get(m.IBMVPCMachine, infrav1beta2.InstanceDeletedCondition); deleted != nil && deleted.Reason == infrav1beta2.InstanceStoppingReason {
+		if time.Since(deleted.LastTransitionTime.Time) > instanceStopTimeout {
+			record.Warnf(m.IBMVPCMachine, "FailedStopInstance", "Instance %q did not stop within %s, deleting it without stopping", instanceID, instanceStopTimeout)
+			return nil
+		}
+		return fmt.Errorf("%w: instance %s", ErrInstanceStopping, instanceID)
+	}
+
+	options := &vpcv1.CreateInstanceActionOptions{}
+	options.SetInstanceID(instanceID)
+	options.SetType(vpcv1.CreateInstanceActionOptionsTypeStopConst)
+	if _, response, err := m.IBMVPCClient.CreateInstanceAction(options); err != nil {
+		if errors.Is(vpc.ClassifyError(response, err), vpc.ErrNotFound) {
+			return nil
+		}
+		record.Warnf(m.IBMVPCMachine, "FailedStopInstance", "Failed to stop instance, deleting it without stopping - %v", err)
+		return nil
+	}
+	record.Eventf(m.IBMVPCMachine, "SuccessfulStopInstance", "Stopping instance %q before deleting it", instanceID)
+	return fmt.Errorf("%w: instance %s", ErrInstanceStopping, instanceID)
+}
+
+// deleteOrphanedReservedIPs deletes the reserved IPs created for the network interfaces of the machine, which are named
+// by reservedIPName, in the subnets of its network interfaces once the instance is gone. Reserved IPs created with auto
+// delete disabled outlive the instance and exhaust the capacity of the subnet, reserved IPs which are still bound to a
+// resource are left untouched.
+func (m *MachineScope) deleteOrphanedReservedIPs() error {
+	subnetIDs, err := m.networkInterfaceSubnetIDs()
+	if err != nil {
+		return err
+	}
+	names := []string{m.reservedIPName(0)}
+	for i := range m.IBMVPCMachine.Spec.NetworkInterfaces {
+		names = append(names, m.reservedIPName(i+1))
+	}
+
+	for _, subnetID := range subnetIDs {
+		reservedIPs, err := m.listSubnetReservedIPs(subnetID)
+		if err != nil {
+			return err
+		}
+		for _, reservedIP := range reservedIPs {
+			if reservedIP.Name == nil || !slices.Contains(names, *reservedIP.Name) {
+				continue
+			}
+			if reservedIP.Target != nil {
+				m.Logger.V(3).Info("Skipping reserved IP still bound to a resource", "subnetID", subnetID, "reservedIPID", *reservedIP.ID)
+				continue
+			}
+			options := &vpcv1.DeleteSubnetReservedIPOptions{}
+			options.SetSubnetID(subnetID)
+			options.SetID(*reservedIP.ID)
+			if _, err := m.IBMVPCClient.DeleteSubnetReservedIP(options); err != nil {
+				record.Warnf(m.IBMVPCMachine, "FailedDeleteReservedIP", "Failed reserved IP deletion - %v", err)
+				return fmt.Errorf("error deleting reserved IP %s in subnet %s: %w", *reservedIP.ID, subnetID, err)
+			}
+			record.Eventf(m.IBMVPCMachine, "SuccessfulDeleteReservedIP", "Deleted reserved IP %q", *reservedIP.ID)
+		}
+	}
+	return nil
+}
+
+// reservedIPName returns the name of the reserved IP created for the network interface at the given index, the primary
+// network interface being at index 0, so that it is found by deleteOrphanedReservedIPs.
+func (m *MachineScope) reservedIPName(index int) string {
+	if index == 0 {
+		return m.IBMVPCMachine.Name
+	}
+	return fmt.Sprintf("%s-%d", m.IBMVPCMachine.Name, index)
+}
+
+// networkInterfaceSubnetIDs returns the IDs of the distinct subnets of the machine's network interfaces.
+func (m *MachineScope) networkInterfaceSubnetIDs() ([]string, error) {
+	var subnetIDs []string
+	if m.IBMVPCMachine.Spec.PrimaryNetworkInterface.Subnet != "" {
+		subnetIDs = append(subnetIDs, m.IBMVPCMachine.Spec.PrimaryNetworkInterface.Subnet)
+	}
+	for _, networkInterface := range m.IBMVPCMachine.Spec.NetworkInterfaces {
+		subnetID, err := fetchSubnetID(networkInterface.Subnet, m)
+		if err != nil {
+			return nil, fmt.Errorf("error while fetching subnet %s for network interface: %w", networkInterface.Subnet, err)
+		}
+		if !slices.Contains(subnetIDs, *subnetID) {
+			subnetIDs = append(subnetIDs, *subnetID)
+		}
+	}
+	return subnetIDs, nil
+}
+
+// listSubnetReservedIPs returns all the reserved IPs of a subnet.
+func (m *MachineScope) listSubnetReservedIPs(subnetID string) ([]vpcv1.ReservedIP, error) {
+	reservedIPs := []vpcv1.ReservedIP{}
+	f := func(start string) (bool, string, error) {
+		listSubnetReservedIpsOptions := &vpcv1.ListSubnetReservedIpsOptions{
+			SubnetID: &subnetID,
+		}
+		if start != "" {
+			listSubnetReservedIpsOptions.Start = &start
+		}
+
+		reservedIPList, _, err := m.IBMVPCClient.ListSubnetReservedIps(listSubnetReservedIpsOptions)
+		if err != nil {
+			return false, "", err
+		}
+		if reservedIPList == nil {
+			return false, "", fmt.Errorf("reserved IP list returned is nil")
+		}
+		reservedIPs = append(reservedIPs, reservedIPList.ReservedIps...)
+
+		if reservedIPList.Next != nil && *reservedIPList.Next.Href != "" {
+			return false, *reservedIPList.Next.Href, nil
+		}
+		return true, "", nil
+	}
+
+	if err := utils.PagingHelper(context.TODO(), f); err != nil {
+		return nil, fmt.Errorf("error listing reserved IPs of subnet %s: %w", subnetID, err)
+	}
+	return reservedIPs, nil
+}
+
+// EnsureFloatingIP reserves a floating IP for the instance's primary network interface when PublicIP is set
+// and records its address in the machine status. The floating IP is tagged with the machine owning it, and an
+// existing floating IP with the same name is only reused when it carries that tag.
+func (m *MachineScope) EnsureFloatingIP(instance *vpcv1.Instance) error {
+	if !m.IBMVPCMachine.Spec.PublicIP {
+		return nil
+	}
+
+	if instance.PrimaryNetworkInterface == nil || instance.PrimaryNetworkInterface.ID == nil {
+		return fmt.Errorf("primary network interface of instance %s is not available", m.IBMVPCMachine.Name)
+	}
+
+	fipName := m.floatingIPName()
+	floatingIP, err := m.IBMVPCClient.GetFloatingIPByName(fipName)
+	if err != nil {
+		return fmt.Errorf("failed to get floating IP %s: %w", fipName, err)
+	}
+
+	if floatingIP != nil {
+		owned, err := m.isFloatingIPOwned(floatingIP)
+		if err != nil {
+			return err
+		}
+		if !owned {
+			record.Warnf(m.IBMVPCMachine, "FailedCreateFloatingIP", "Floating IP %q exists and is not owned by the machine", fipName)
+			return fmt.Errorf("floating IP %s exists and is not tagged with %s", fipName, m.floatingIPOwnerTag())
+		}
+	} else {
+		options := &vpcv1.CreateFloatingIPOptions{}
+		options.SetFloatingIPPrototype(&vpcv1.FloatingIPPrototypeFloatingIPByTarget{
+			Name: &fipName,
+			Target: &vpcv1.FloatingIPTargetPrototype{
+				ID: instance.PrimaryNetworkInterface.ID,
+			},
+			ResourceGroup: &vpcv1.ResourceGroupIdentity{
+				ID: core.StringPtr(m.resourceGroupID()),
+			},
+		})
+		floatingIP, _, err = m.IBMVPCClient.CreateFloatingIP(options)
+		if err != nil {
+			record.Warnf(m.IBMVPCMachine, "FailedCreateFloatingIP", "Failed floating IP creation - %v", err)
+			return err
+		}
+		record.Eventf(m.IBMVPCMachine, "SuccessfulCreateFloatingIP", "Created floating IP %q", fipName)
+
+		if err := m.tagFloatingIP(floatingIP); err != nil {
+			// Release the floating IP so that it is created and tagged again on the next reconcile, an untagged
+			// floating IP would not be reused.
+			if _, deleteErr := m.IBMVPCClient.DeleteFloatingIP(&vpcv1.DeleteFloatingIPOptions{ID: floatingIP.ID}); deleteErr != nil {
+				return fmt.Errorf("failed to delete untagged floating IP %s: %w", fipName, deleteErr)
+			}
+			return err
+		}
+	}
+
+	if floatingIP.Address == nil {
+		return nil
+	}
+
+	for _, address := range m.IBMVPCMachine.Status.Addresses {
+		if address.Type == corev1.NodeExternalIP && address.Address == *floatingIP.Address {
+			return nil
+		}
+	}
+	m.IBMVPCMachine.Status.Addresses = append(m.IBMVPCMachine.Status.Addresses, corev1.NodeAddress{
+		Type:    corev1.NodeExternalIP,
+		Address: *floatingIP.Address,
+	})
+	return nil
+}
+
+// DeleteFloatingIP releases the floating IP reserved for the machine, if any. A floating IP with the name of the
+// machine which is not tagged with the machine owning it is left in place.
+func (m *MachineScope) DeleteFloatingIP() error {
+	if !m.IBMVPCMachine.Spec.PublicIP {
+		return nil
+	}
+
+	fipName := m.floatingIPName()
+	floatingIP, err := m.IBMVPCClient.GetFloatingIPByName(fipName)
+	if err != nil {
+		return fmt.Errorf("failed to get floating IP %s: %w", fipName, err)
+	}
+	if floatingIP == nil {
+		return nil
+	}
+
+	owned, err := m.isFloatingIPOwned(floatingIP)
+	if err != nil {
+		return err
+	}
+	if !owned {
+		m.Info("Skipping deletion of floating IP not owned by the machine", "floatingIPID", *floatingIP.ID)
+		return nil
+	}
+
+	options := &vpcv1.DeleteFloatingIPOptions{}
+	options.SetID(*floatingIP.ID)
+	if _, err := m.IBMVPCClient.DeleteFloatingIP(options); err != nil {
+		record.Warnf(m.IBMVPCMachine, "FailedDeleteFloatingIP", "Failed floating IP deletion - %v", err)
+		return err
+	}
+	record.Eventf(m.IBMVPCMachine, "SuccessfulDeleteFloatingIP", "Deleted floating IP %q", fipName)
+	return nil
+}
+
+// floatingIPName returns the name of the floating IP created for the machine.
+func (m *MachineScope) floatingIPName() string {
+	return fmt.Sprintf("%s-fip", m.IBMVPCMachine.Name)
+}
+
+// floatingIPOwnerTag returns the user tag identifying the cluster and the machine owning the floating IP.
+func (m *MachineScope) floatingIPOwnerTag() string {
+	return fmt.Sprintf("ibmvpcmachine:%s.%s.%s", m.Machine.Spec.ClusterName, m.IBMVPCMachine.Namespace, m.IBMVPCMachine.Name)
+}
+
+// isFloatingIPOwned reports whether the floating IP is tagged with the machine owning it.
+func (m *MachineScope) isFloatingIPOwned(floatingIP *vpcv1.FloatingIP) (bool, error) {
+	if floatingIP.CRN == nil {
+		return false, nil
+	}
+	tagList, _, err := m.GlobalTaggingClient.ListTags(&globaltaggingv1.ListTagsOptions{
+		AttachedTo: floatingIP.CRN,
+		TagType:    core.StringPtr(globaltaggingv1.ListTagsOptionsTagTypeUserConst),
+		Limit:      core.Int64Ptr(1000),
+	})
+	if err != nil {
+		return false, fmt.Errorf("error while listing tags attached to floating IP %s: %w", *floatingIP.ID, err)
 	}
-
-	if volume.Profile != "" {
-		bootVolume.Volume.Profile = &vpcv1.VolumeProfileIdentity{
-			Name: core.StringPtr(volume.Profile),
-		}
+	if tagList == nil {
+		return false, nil
 	}
-
-	if volume.SizeGiB != 0 {
-		bootVolume.Volume.Capacity = core.Int64Ptr(volume.SizeGiB)
+	ownerTag := m.floatingIPOwnerTag()
+	for _, tag := range tagList.Items {
+		if tag.Name != nil && *tag.Name == ownerTag {
+			return true, nil
+		}
 	}
+	return false, nil
+}
 
-	if volume.Iops != 0 {
-		bootVolume.Volume.Iops = core.Int64Ptr(volume.Iops)
+// tagFloatingIP attaches the owner tag of the machine to the floating IP.
+func (m *MachineScope) tagFloatingIP(floatingIP *vpcv1.FloatingIP) error {
+	result, _, err := m.GlobalTaggingClient.AttachTag(&globaltaggingv1.AttachTagOptions{
+		Resources: []globaltaggingv1.Resource{
+			{
+				ResourceID: floatingIP.CRN,
+			},
+		},
+		TagNames: []string{m.floatingIPOwnerTag()},
+		TagType:  core.StringPtr(globaltaggingv1.AttachTagOptionsTagTypeUserConst),
+	})
+	if err != nil {
+		record.Warnf(m.IBMVPCMachine, "FailedAttachTags", "Failed to attach tags to floating IP - %v", err)
+		return fmt.Errorf("error while attaching tags to floating IP %s: %w", *floatingIP.ID, err)
 	}
-
-	if volume.EncryptionKeyCRN != "" {
-		bootVolume.Volume.EncryptionKey = &vpcv1.EncryptionKeyIdentity{
-			CRN: core.StringPtr(volume.EncryptionKeyCRN),
+	if result != nil {
+		for _, item := range result.Results {
+			if item.IsError != nil && *item.IsError {
+				record.Warnf(m.IBMVPCMachine, "FailedAttachTags", "Failed to attach tags to floating IP %q", *floatingIP.ID)
+				return fmt.Errorf("error while attaching tags to floating IP %s", *floatingIP.ID)
+			}
 		}
 	}
-
-	return bootVolume
+	return nil
 }
 
-// DeleteMachine deletes the vpc machine associated with machine instance id.
-func (m *MachineScope) DeleteMachine() error {
-	if m.IBMVPCMachine.Status.InstanceID == "" {
-		return nil
-	}
-	options := &vpcv1.DeleteInstanceOptions{}
-	options.SetID(m.IBMVPCMachine.Status.InstanceID)
-	_, err := m.IBMVPCClient.DeleteInstance(options)
-	if err != nil {
-		record.Warnf(m.IBMVPCMachine, "FailedDeleteInstance", "Failed instance deletion - %v", err)
-	} else {
-		record.Eventf(m.IBMVPCMachine, "SuccessfulDeleteInstance", "Deleted Instance %q", m.IBMVPCMachine.Name)
+// getInstance returns the instance of the machine, it is looked up by the provider ID when the provider ID identifies
+// an instance, and by the name of the machine otherwise.
+func (m *MachineScope) getInstance() (*vpcv1.Instance, error) {
+	if providerID := m.IBMVPCMachine.Spec.ProviderID; providerID != nil && *providerID != "" {
+		instance, err := m.IBMVPCClient.GetInstanceByProviderID(*providerID)
+		switch {
+		case errors.Is(err, vpc.ErrInvalidProviderID):
+			m.Logger.V(3).Info("Provider ID does not identify an instance, looking up the instance by name", "providerID", *providerID)
+		case err != nil:
+			return nil, fmt.Errorf("error getting instance by provider ID %s: %w", *providerID, err)
+		case instance != nil:
+			return instance, nil
+		}
 	}
-	return err
+	return m.ensureInstanceUnique(m.IBMVPCMachine.Name)
 }
 
 func (m *MachineScope) ensureInstanceUnique(instanceName string) (*vpcv1.Instance, error) {
 	var instance *vpcv1.Instance
 	f := func(start string) (bool, string, error) {
-		// check for existing instances
-		listInstancesOptions := &vpcv1.ListInstancesOptions{}
+		// check for existing instances, filtering by name server-side and paging through the results
+		listInstancesOptions := &vpcv1.ListInstancesOptions{
+			Name: &instanceName,
+		}
+		// scope the listing to the cluster VPC once it is known
+		if vpcID := m.IBMVPCCluster.Status.VPC.ID; vpcID != "" {
+			listInstancesOptions.VPCID = &vpcID
+		}
 		if start != "" {
 			listInstancesOptions.Start = &start
 		}
@@ -264,7 +1600,7 @@ func (m *MachineScope) ensureInstanceUnique(instanceName string) (*vpcv1.Instanc
 		return true, "", nil
 	}
 
-	if err := utils.PagingHelper(f); err != nil {
+	if err := utils.PagingHelper(context.TODO(), f); err != nil {
 		return nil, err
 	}
 
@@ -272,7 +1608,9 @@ func (m *MachineScope) ensureInstanceUnique(instanceName string) (*vpcv1.Instanc
 }
 
 // CreateVPCLoadBalancerPoolMember creates a new pool member and adds it to the load balancer pool.
-func (m *MachineScope) CreateVPCLoadBalancerPoolMember(internalIP *string, targetPort int64) (*vpcv1.LoadBalancerPoolMember, error) {
+// If weight is nil the member is created with the default weight of 50. If poolName is empty the
+// member is added to the first pool of the load balancer.
+func (m *MachineScope) CreateVPCLoadBalancerPoolMember(internalIP *string, targetPort int64, weight *int64, poolName string) (*vpcv1.LoadBalancerPoolMember, error) {
 	loadBalancer, _, err := m.IBMVPCClient.GetLoadBalancer(&vpcv1.GetLoadBalancerOptions{
 		ID: m.IBMVPCCluster.Status.VPCEndpoint.LBID,
 	})
@@ -280,25 +1618,34 @@ func (m *MachineScope) CreateVPCLoadBalancerPoolMember(internalIP *string, targe
 		return nil, err
 	}
 
-	if *loadBalancer.ProvisioningStatus != string(infrav1beta2.VPCLoadBalancerStateActive) {
-		return nil, fmt.Errorf("load balancer is not in active state")
+	if loadBalancer, err = m.loadBalancerActive(loadBalancer); err != nil {
+		return nil, err
 	}
 
 	if len(loadBalancer.Pools) == 0 {
 		return nil, fmt.Errorf("no pools exist for the load balancer")
 	}
 
+	poolID, err := getLoadBalancerPoolID(loadBalancer, poolName)
+	if err != nil {
+		return nil, err
+	}
+
 	options := &vpcv1.CreateLoadBalancerPoolMemberOptions{}
 	options.SetLoadBalancerID(*loadBalancer.ID)
-	options.SetPoolID(*loadBalancer.Pools[0].ID)
+	options.SetPoolID(poolID)
 	options.SetTarget(&vpcv1.LoadBalancerPoolMemberTargetPrototype{
 		Address: internalIP,
 	})
 	options.SetPort(targetPort)
+	if weight == nil {
+		weight = core.Int64Ptr(defaultLoadBalancerPoolMemberWeight)
+	}
+	options.SetWeight(*weight)
 
 	listOptions := &vpcv1.ListLoadBalancerPoolMembersOptions{}
 	listOptions.SetLoadBalancerID(*loadBalancer.ID)
-	listOptions.SetPoolID(*loadBalancer.Pools[0].ID)
+	listOptions.SetPoolID(poolID)
 	listLoadBalancerPoolMembers, _, err := m.IBMVPCClient.ListLoadBalancerPoolMembers(listOptions)
 	if err != nil {
 		return nil, fmt.Errorf("failed to bind ListLoadBalancerPoolMembers to control plane %s/%s: %w", m.IBMVPCMachine.Namespace, m.IBMVPCMachine.Name, err)
@@ -309,6 +1656,9 @@ func (m *MachineScope) CreateVPCLoadBalancerPoolMember(internalIP *string, targe
 			mtarget := member.Target.(*vpcv1.LoadBalancerPoolMemberTarget)
 			if *mtarget.Address == *internalIP && *member.Port == targetPort {
 				m.Logger.V(3).Info("PoolMember already exist")
+				if member.ID != nil {
+					m.setLoadBalancerPoolMember(loadBalancer, poolID, *member.ID)
+				}
 				return nil, nil
 			}
 		}
@@ -318,15 +1668,172 @@ func (m *MachineScope) CreateVPCLoadBalancerPoolMember(internalIP *string, targe
 	if err != nil {
 		return nil, err
 	}
+	if loadBalancerPoolMember.ID != nil {
+		m.setLoadBalancerPoolMember(loadBalancer, poolID, *loadBalancerPoolMember.ID)
+	}
 	return loadBalancerPoolMember, nil
 }
 
-// DeleteVPCLoadBalancerPoolMember deletes a pool member from the load balancer pool.
+// CreateVPCLoadBalancerPoolMembers registers the instance in each of the load balancer pool targets with the weight of
+// the target, members that already exist are left as is. It returns the pool members created by this call.
+func (m *MachineScope) CreateVPCLoadBalancerPoolMembers(internalIP *string, targets []infrav1beta2.VPCLoadBalancerPoolMemberTarget) ([]*vpcv1.LoadBalancerPoolMember, error) {
+	var members []*vpcv1.LoadBalancerPoolMember
+	for _, target := range targets {
+		member, err := m.CreateVPCLoadBalancerPoolMember(internalIP, target.Port, target.Weight, target.PoolName)
+		if err != nil {
+			return members, err
+		}
+		if member != nil {
+			members = append(members, member)
+		}
+	}
+	return members, nil
+}
+
+// LoadBalancerPoolMemberTargets returns the load balancer pools the instance should be registered in.
+// Control plane machines default to the first pool on the API server port.
+func (m *MachineScope) LoadBalancerPoolMemberTargets() []infrav1beta2.VPCLoadBalancerPoolMemberTarget {
+	if len(m.IBMVPCMachine.Spec.LoadBalancerPoolMembers) > 0 {
+		return m.IBMVPCMachine.Spec.LoadBalancerPoolMembers
+	}
+	if _, ok := m.IBMVPCMachine.Labels[capiv1beta1.MachineControlPlaneNameLabel]; ok {
+		return []infrav1beta2.VPCLoadBalancerPoolMemberTarget{
+			{
+				Port: int64(m.APIServerPort()),
+			},
+		}
+	}
+	return nil
+}
+
+// setLoadBalancerPoolMember records the member of the instance in the load balancer pool. The member of the first
+// pool is also recorded as LoadBalancerPoolMemberID, which is used to report the health of the instance.
+func (m *MachineScope) setLoadBalancerPoolMember(loadBalancer *vpcv1.LoadBalancer, poolID, memberID string) {
+	if poolID == *loadBalancer.Pools[0].ID {
+		m.IBMVPCMachine.Status.LoadBalancerPoolMemberID = memberID
+	}
+	for i := range m.IBMVPCMachine.Status.LoadBalancerPoolMembers {
+		if m.IBMVPCMachine.Status.LoadBalancerPoolMembers[i].PoolID == poolID {
+			m.IBMVPCMachine.Status.LoadBalancerPoolMembers[i].ID = memberID
+			return
+		}
+	}
+	m.IBMVPCMachine.Status.LoadBalancerPoolMembers = append(m.IBMVPCMachine.Status.LoadBalancerPoolMembers, infrav1beta2.VPCLoadBalancerPoolMemberReference{
+		PoolID: poolID,
+		ID:     memberID,
+	})
+}
+
+// GetVPCLoadBalancerPoolMember returns the load balancer pool member recorded for the machine, nil when none is recorded.
+// If poolName is empty the member is looked up in the first pool of the load balancer.
+func (m *MachineScope) GetVPCLoadBalancerPoolMember(poolName string) (*vpcv1.LoadBalancerPoolMember, error) {
+	if m.IBMVPCMachine.Status.LoadBalancerPoolMemberID == "" {
+		return nil, nil
+	}
+
+	loadBalancer, _, err := m.IBMVPCClient.GetLoadBalancer(&vpcv1.GetLoadBalancerOptions{
+		ID: m.IBMVPCCluster.Status.VPCEndpoint.LBID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(loadBalancer.Pools) == 0 {
+		return nil, fmt.Errorf("no pools exist for the load balancer")
+	}
+	poolID, err := getLoadBalancerPoolID(loadBalancer, poolName)
+	if err != nil {
+		return nil, err
+	}
+
+	options := &vpcv1.GetLoadBalancerPoolMemberOptions{}
+	options.SetLoadBalancerID(*loadBalancer.ID)
+	options.SetPoolID(poolID)
+	options.SetID(m.IBMVPCMachine.Status.LoadBalancerPoolMemberID)
+	member, _, err := m.IBMVPCClient.GetLoadBalancerPoolMember(options)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get load balancer pool member %s: %w", m.IBMVPCMachine.Status.LoadBalancerPoolMemberID, err)
+	}
+	return member, nil
+}
+
+// getLoadBalancerPoolID returns the ID of the load balancer pool with the given name, or the ID of the first pool
+// when poolName is empty.
+func getLoadBalancerPoolID(loadBalancer *vpcv1.LoadBalancer, poolName string) (string, error) {
+	if poolName == "" {
+		return *loadBalancer.Pools[0].ID, nil
+	}
+	for _, pool := range loadBalancer.Pools {
+		if pool.Name != nil && *pool.Name == poolName {
+			return *pool.ID, nil
+		}
+	}
+	return "", fmt.Errorf("pool %s not found in load balancer %s", poolName, *loadBalancer.ID)
+}
+
+// checkLoadBalancerActive returns nil when the load balancer is active, ErrLoadBalancerNotReady when it is
+// in a transient pending state and an error for any other state.
+func checkLoadBalancerActive(loadBalancer *vpcv1.LoadBalancer) error {
+	switch infrav1beta2.VPCLoadBalancerState(*loadBalancer.ProvisioningStatus) {
+	case infrav1beta2.VPCLoadBalancerStateActive:
+		return nil
+	case infrav1beta2.VPCLoadBalancerStateUpdatePending, infrav1beta2.VPCLoadBalancerStateCreatePending:
+		return fmt.Errorf("%w: load balancer is in %s state", ErrLoadBalancerNotReady, *loadBalancer.ProvisioningStatus)
+	default:
+		return fmt.Errorf("load balancer is not in active state")
+	}
+}
+
+// loadBalancerActive returns the load balancer once it is active. A load balancer in a pending state is waited for
+// up to the configured timeout, ErrLoadBalancerNotReady is returned when it is still pending afterwards.
+func (m *MachineScope) loadBalancerActive(loadBalancer *vpcv1.LoadBalancer) (*vpcv1.LoadBalancer, error) {
+	if err := checkLoadBalancerActive(loadBalancer); m.loadBalancerActiveTimeout <= 0 || !errors.Is(err, ErrLoadBalancerNotReady) {
+		return loadBalancer, err
+	}
+	return m.WaitForLoadBalancerActive(m.loadBalancerActiveTimeout)
+}
+
+// WaitForLoadBalancerActive polls the control plane load balancer with an exponential backoff until it is active.
+// ErrLoadBalancerNotReady is returned when it is still pending after the timeout, other states fail immediately.
+func (m *MachineScope) WaitForLoadBalancerActive(timeout time.Duration) (*vpcv1.LoadBalancer, error) {
+	ctx, cancel := context.WithTimeout(context.TODO(), timeout)
+	defer cancel()
+
+	var loadBalancer *vpcv1.LoadBalancer
+	var stateErr error
+	err := wait.ExponentialBackoffWithContext(ctx, loadBalancerActiveBackoff, func(_ context.Context) (bool, error) {
+		lb, _, err := m.IBMVPCClient.GetLoadBalancer(&vpcv1.GetLoadBalancerOptions{
+			ID: m.IBMVPCCluster.Status.VPCEndpoint.LBID,
+		})
+		if err != nil {
+			return false, err
+		}
+		loadBalancer = lb
+		stateErr = checkLoadBalancerActive(lb)
+		if errors.Is(stateErr, ErrLoadBalancerNotReady) {
+			m.Logger.V(3).Info("Waiting for load balancer to be active", "state", *lb.ProvisioningStatus)
+			return false, nil
+		}
+		return true, stateErr
+	})
+	if wait.Interrupted(err) && stateErr != nil {
+		return nil, stateErr
+	}
+	if err != nil {
+		return nil, err
+	}
+	return loadBalancer, nil
+}
+
+// DeleteVPCLoadBalancerPoolMember deletes the pool members of the instance from the load balancer pools.
 func (m *MachineScope) DeleteVPCLoadBalancerPoolMember() error {
 	if m.IBMVPCMachine.Status.InstanceID == "" {
 		m.Info("instance is not created, ignore deleting load balancer pool member")
 		return nil
 	}
+	// The pool members of a protected instance are kept so that it keeps serving until it is deleted.
+	if err := m.checkDeletionProtection(); err != nil {
+		return err
+	}
 
 	loadBalancer, _, err := m.IBMVPCClient.GetLoadBalancer(&vpcv1.GetLoadBalancerOptions{
 		ID: m.IBMVPCCluster.Status.VPCEndpoint.LBID,
@@ -339,12 +1846,67 @@ func (m *MachineScope) DeleteVPCLoadBalancerPoolMember() error {
 		return nil
 	}
 
-	instance, _, err := m.IBMVPCClient.GetInstance(&vpcv1.GetInstanceOptions{
-		ID: core.StringPtr(m.IBMVPCMachine.Status.InstanceID),
-	})
+	// Delete the members recorded in each pool, they are removed from the status one by one so that the
+	// progress is kept when the load balancer is busy with a previous deletion.
+	if len(m.IBMVPCMachine.Status.LoadBalancerPoolMembers) > 0 {
+		for len(m.IBMVPCMachine.Status.LoadBalancerPoolMembers) > 0 {
+			if loadBalancer, err = m.loadBalancerActive(loadBalancer); err != nil {
+				return err
+			}
+
+			member := m.IBMVPCMachine.Status.LoadBalancerPoolMembers[0]
+			deleteOptions := &vpcv1.DeleteLoadBalancerPoolMemberOptions{}
+			deleteOptions.SetLoadBalancerID(*loadBalancer.ID)
+			deleteOptions.SetPoolID(member.PoolID)
+			deleteOptions.SetID(member.ID)
+
+			response, err := m.IBMVPCClient.DeleteLoadBalancerPoolMember(deleteOptions)
+			if err != nil && !errors.Is(vpc.ClassifyError(response, err), vpc.ErrNotFound) {
+				return err
+			}
+			m.IBMVPCMachine.Status.LoadBalancerPoolMembers = m.IBMVPCMachine.Status.LoadBalancerPoolMembers[1:]
+			if len(m.IBMVPCMachine.Status.LoadBalancerPoolMembers) == 0 {
+				break
+			}
+
+			if loadBalancer, _, err = m.IBMVPCClient.GetLoadBalancer(&vpcv1.GetLoadBalancerOptions{
+				ID: m.IBMVPCCluster.Status.VPCEndpoint.LBID,
+			}); err != nil {
+				return err
+			}
+		}
+		m.IBMVPCMachine.Status.LoadBalancerPoolMembers = nil
+		m.IBMVPCMachine.Status.LoadBalancerPoolMemberID = ""
+		return nil
+	}
+
+	// Prefer deleting the pool member recorded at creation time, falling back to matching on the instance address.
+	if m.IBMVPCMachine.Status.LoadBalancerPoolMemberID != "" {
+		if loadBalancer, err = m.loadBalancerActive(loadBalancer); err != nil {
+			return err
+		}
+
+		deleteOptions := &vpcv1.DeleteLoadBalancerPoolMemberOptions{}
+		deleteOptions.SetLoadBalancerID(*loadBalancer.ID)
+		deleteOptions.SetPoolID(*loadBalancer.Pools[0].ID)
+		deleteOptions.SetID(m.IBMVPCMachine.Status.LoadBalancerPoolMemberID)
+
+		response, err := m.IBMVPCClient.DeleteLoadBalancerPoolMember(deleteOptions)
+		if err != nil && !errors.Is(vpc.ClassifyError(response, err), vpc.ErrNotFound) {
+			return err
+		}
+		m.IBMVPCMachine.Status.LoadBalancerPoolMemberID = ""
+		return nil
+	}
+
+	address, err := m.loadBalancerPoolMemberAddress()
 	if err != nil {
 		return err
 	}
+	if address == "" {
+		m.Info("instance address is not known, ignore deleting load balancer pool member")
+		return nil
+	}
 
 	listOptions := &vpcv1.ListLoadBalancerPoolMembersOptions{}
 	listOptions.SetLoadBalancerID(*loadBalancer.ID)
@@ -354,12 +1916,15 @@ func (m *MachineScope) DeleteVPCLoadBalancerPoolMember() error {
 		return err
 	}
 
+	// Only the member forwarding to the API server port is removed, the instance may be registered on other ports too.
+	port := int64(m.APIServerPort())
+
 	for _, member := range listLoadBalancerPoolMembers.Members {
 		if _, ok := member.Target.(*vpcv1.LoadBalancerPoolMemberTarget); ok {
 			mtarget := member.Target.(*vpcv1.LoadBalancerPoolMemberTarget)
-			if *mtarget.Address == *instance.PrimaryNetworkInterface.PrimaryIP.Address {
-				if *loadBalancer.ProvisioningStatus != string(infrav1beta2.VPCLoadBalancerStateActive) {
-					return fmt.Errorf("load balancer is not in active state")
+			if *mtarget.Address == address && member.Port != nil && *member.Port == port {
+				if loadBalancer, err = m.loadBalancerActive(loadBalancer); err != nil {
+					return err
 				}
 
 				deleteOptions := &vpcv1.DeleteLoadBalancerPoolMemberOptions{}
@@ -377,6 +1942,32 @@ func (m *MachineScope) DeleteVPCLoadBalancerPoolMember() error {
 	return nil
 }
 
+// loadBalancerPoolMemberAddress returns the address the machine was registered with in the load balancer pool.
+// The internal address recorded in the machine status is used so that the member can be found after the instance
+// is deleted, the instance is only fetched when no address is recorded. An empty address is returned when the
+// instance no longer exists.
+func (m *MachineScope) loadBalancerPoolMemberAddress() (string, error) {
+	for _, address := range m.IBMVPCMachine.Status.Addresses {
+		if address.Type == corev1.NodeInternalIP && address.Address != "" {
+			return address.Address, nil
+		}
+	}
+
+	instance, response, err := m.IBMVPCClient.GetInstance(&vpcv1.GetInstanceOptions{
+		ID: core.StringPtr(m.IBMVPCMachine.Status.InstanceID),
+	})
+	if err != nil {
+		if errors.Is(vpc.ClassifyError(response, err), vpc.ErrNotFound) {
+			return "", nil
+		}
+		return "", err
+	}
+	if instance.PrimaryNetworkInterface == nil || instance.PrimaryNetworkInterface.PrimaryIP == nil || instance.PrimaryNetworkInterface.PrimaryIP.Address == nil {
+		return "", nil
+	}
+	return *instance.PrimaryNetworkInterface.PrimaryIP.Address, nil
+}
+
 // PatchObject persists the cluster configuration and status.
 func (m *MachineScope) PatchObject() error {
 	return m.patchHelper.Patch(context.TODO(), m.IBMVPCMachine)
@@ -403,9 +1994,34 @@ func (m *MachineScope) GetBootstrapData() (string, error) {
 	if !ok {
 		return "", errors.New("error retrieving bootstrap data: secret value key is missing")
 	}
+
+	if err := m.validateBootstrapFormat(string(secret.Data["format"])); err != nil {
+		return "", err
+	}
 	return string(value), nil
 }
 
+// validateBootstrapFormat ensures the format of the bootstrap secret matches the format expected by the machine.
+// Secrets without a format are treated as cloud-config.
+func (m *MachineScope) validateBootstrapFormat(secretFormat string) error {
+	expectedFormat := m.IBMVPCMachine.Spec.BootstrapFormat
+	if expectedFormat == "" {
+		expectedFormat = infrav1beta2.BootstrapFormatCloudInit
+	}
+
+	if secretFormat == "" {
+		secretFormat = bootstrapSecretFormatCloudConfig
+	}
+
+	switch {
+	case expectedFormat == infrav1beta2.BootstrapFormatCloudInit && secretFormat == bootstrapSecretFormatCloudConfig:
+		return nil
+	case expectedFormat == infrav1beta2.BootstrapFormatIgnition && secretFormat == bootstrapSecretFormatIgnition:
+		return nil
+	}
+	return fmt.Errorf("bootstrap data format %q does not match the machine bootstrap format %q", secretFormat, expectedFormat)
+}
+
 func fetchKeyID(key *infrav1beta2.IBMVPCResourceReference, m *MachineScope) (*string, error) {
 	if key.ID == nil && key.Name == nil {
 		return nil, fmt.Errorf("both ID and Name can't be nil")
@@ -447,7 +2063,7 @@ func fetchKeyID(key *infrav1beta2.IBMVPCResourceReference, m *MachineScope) (*st
 		return true, "", nil
 	}
 
-	if err := utils.PagingHelper(f); err != nil {
+	if err := utils.PagingHelper(context.TODO(), f); err != nil {
 		return nil, err
 	}
 
@@ -459,6 +2075,10 @@ func fetchKeyID(key *infrav1beta2.IBMVPCResourceReference, m *MachineScope) (*st
 }
 
 func fetchImageID(image *infrav1beta2.IBMVPCResourceReference, m *MachineScope) (*string, error) {
+	if image == nil {
+		return nil, fmt.Errorf("one of image or catalogOffering must be specified")
+	}
+
 	if image.ID == nil && image.Name == nil {
 		return nil, fmt.Errorf("both ID and Name can't be nil")
 	}
@@ -501,7 +2121,7 @@ func fetchImageID(image *infrav1beta2.IBMVPCResourceReference, m *MachineScope)
 		return true, "", nil
 	}
 
-	if err := utils.PagingHelper(f); err != nil {
+	if err := utils.PagingHelper(context.TODO(), f); err != nil {
 		return nil, err
 	}
 
@@ -512,17 +2132,216 @@ func fetchImageID(image *infrav1beta2.IBMVPCResourceReference, m *MachineScope)
 	return nil, fmt.Errorf("image does not exist - failed to find an image ID")
 }
 
+func fetchInstanceTemplateID(instanceTemplate *infrav1beta2.IBMVPCResourceReference, m *MachineScope) (*string, error) {
+	if instanceTemplate.ID == nil && instanceTemplate.Name == nil {
+		return nil, fmt.Errorf("both ID and Name can't be nil")
+	}
+
+	if instanceTemplate.ID != nil {
+		return instanceTemplate.ID, nil
+	}
+
+	templatesList, _, err := m.IBMVPCClient.ListInstanceTemplates(&vpcv1.ListInstanceTemplatesOptions{})
+	if err != nil {
+		m.Logger.Error(err, "Failed to get instance templates")
+		return nil, err
+	}
+
+	if templatesList == nil {
+		return nil, fmt.Errorf("instance template list returned is nil")
+	}
+
+	for _, t := range templatesList.Templates {
+		template, ok := t.(*vpcv1.InstanceTemplate)
+		if !ok || template.Name == nil || *template.Name != *instanceTemplate.Name {
+			continue
+		}
+		m.Logger.Info("Instance template found with ID", "InstanceTemplate", *template.Name, "ID", *template.ID)
+		return template.ID, nil
+	}
+
+	return nil, fmt.Errorf("instance template does not exist - failed to find an instance template ID")
+}
+
+func fetchPlacementGroupID(placementGroup *infrav1beta2.IBMVPCResourceReference, m *MachineScope) (*string, error) {
+	if placementGroup.ID == nil && placementGroup.Name == nil {
+		return nil, fmt.Errorf("both ID and Name can't be nil")
+	}
+
+	if placementGroup.ID != nil {
+		return placementGroup.ID, nil
+	}
+
+	pg, err := m.IBMVPCClient.GetPlacementGroupByName(*placementGroup.Name)
+	if err != nil {
+		m.Logger.Error(err, "Failed to get placement group")
+		return nil, err
+	}
+
+	if pg != nil {
+		m.Logger.V(3).Info("Placement group found with ID", "PlacementGroup", *pg.Name, "ID", *pg.ID)
+		return pg.ID, nil
+	}
+
+	return nil, fmt.Errorf("placement group does not exist - failed to find placement group ID")
+}
+
+func fetchDedicatedHostID(dedicatedHost *infrav1beta2.IBMVPCResourceReference, m *MachineScope) (*string, error) {
+	if dedicatedHost.ID == nil && dedicatedHost.Name == nil {
+		return nil, fmt.Errorf("both ID and Name can't be nil")
+	}
+
+	if dedicatedHost.ID != nil {
+		return dedicatedHost.ID, nil
+	}
+
+	dh, err := m.IBMVPCClient.GetDedicatedHostByName(*dedicatedHost.Name)
+	if err != nil {
+		m.Logger.Error(err, "Failed to get dedicated host")
+		return nil, err
+	}
+
+	if dh != nil {
+		m.Logger.V(3).Info("Dedicated host found with ID", "DedicatedHost", *dh.Name, "ID", *dh.ID)
+		return dh.ID, nil
+	}
+
+	return nil, fmt.Errorf("dedicated host does not exist - failed to find dedicated host ID")
+}
+
+func fetchReservationID(reservation *infrav1beta2.IBMVPCResourceReference, m *MachineScope) (*string, error) {
+	if reservation.ID == nil && reservation.Name == nil {
+		return nil, fmt.Errorf("both ID and Name can't be nil")
+	}
+
+	if reservation.ID != nil {
+		return reservation.ID, nil
+	}
+
+	r, err := m.IBMVPCClient.GetReservationByName(*reservation.Name)
+	if err != nil {
+		m.Logger.Error(err, "Failed to get reservation")
+		return nil, err
+	}
+
+	if r != nil {
+		m.Logger.V(3).Info("Reservation found with ID", "Reservation", *r.Name, "ID", *r.ID)
+		return r.ID, nil
+	}
+
+	return nil, fmt.Errorf("reservation does not exist - failed to find reservation ID")
+}
+
+func fetchSubnetID(subnet string, m *MachineScope) (*string, error) {
+	if subnet == "" {
+		return nil, fmt.Errorf("subnet can't be empty")
+	}
+
+	if sn, _, err := m.IBMVPCClient.GetSubnet(&vpcv1.GetSubnetOptions{ID: &subnet}); err == nil && sn != nil {
+		return sn.ID, nil
+	}
+
+	sn, err := m.IBMVPCClient.GetVPCSubnetByName(subnet)
+	if err != nil {
+		m.Logger.Error(err, "Failed to get subnet")
+		return nil, err
+	}
+
+	if sn != nil {
+		m.Logger.V(3).Info("Subnet found with ID", "Subnet", *sn.Name, "ID", *sn.ID)
+		return sn.ID, nil
+	}
+
+	return nil, fmt.Errorf("subnet %s does not exist - failed to find subnet ID", subnet)
+}
+
+// validateSecurityGroupCRN checks that the CRN is a well-formed VPC security group CRN, which has the format
+// crn:v1:<cname>:<ctype>:is:<region>:a/<account-id>::security-group:<security-group-id>.
+func validateSecurityGroupCRN(crn string) error {
+	segments := strings.Split(crn, ":")
+	if len(segments) != 10 || segments[0] != "crn" || segments[1] != "v1" || segments[4] != "is" || segments[8] != "security-group" || segments[9] == "" {
+		return fmt.Errorf("invalid security group CRN %s", crn)
+	}
+	return nil
+}
+
+func fetchSecurityGroupID(securityGroup *infrav1beta2.VPCSecurityGroupReference, m *MachineScope) (*string, error) {
+	if securityGroup.ID == nil && securityGroup.Name == nil {
+		return nil, fmt.Errorf("both ID and Name can't be nil")
+	}
+
+	if securityGroup.ID != nil {
+		return securityGroup.ID, nil
+	}
+
+	key := securityGroupCacheKey(m.IBMVPCCluster.Spec.Region, *securityGroup.Name)
+	if id, found := m.securityGroupCache.Get(key); found {
+		return ptr.To(id), nil
+	}
+
+	sg, err := m.IBMVPCClient.GetSecurityGroupByName(*securityGroup.Name)
+	if err != nil {
+		m.Logger.Error(err, "Failed to get security group")
+		return nil, err
+	}
+
+	if sg != nil {
+		m.Logger.V(3).Info("Security group found with ID", "SecurityGroup", *sg.Name, "ID", *sg.ID)
+		if err := m.securityGroupCache.Add(key, *sg.ID); err != nil {
+			return nil, err
+		}
+		return sg.ID, nil
+	}
+
+	return nil, fmt.Errorf("security group does not exist - failed to find security group ID")
+}
+
+// providerIDAccountID returns the account ID of the v2 Provider ID. When ProviderIDAccountFromCRN is set it is read
+// from the CRN of the instance, so that nodes of adopted instances get their Provider ID without an account lookup.
+func (m *MachineScope) providerIDAccountID(ctx context.Context, instance *vpcv1.Instance) (string, error) {
+	if !options.ProviderIDAccountFromCRN {
+		return utils.GetAccountID(ctx)
+	}
+
+	crn := instance.CRN
+	if crn == nil || *crn == "" {
+		inst, _, err := m.IBMVPCClient.GetInstance(&vpcv1.GetInstanceOptions{
+			ID: instance.ID,
+		})
+		if err != nil {
+			return "", fmt.Errorf("failed to get instance %s: %w", *instance.ID, err)
+		}
+		crn = inst.CRN
+	}
+	if crn == nil {
+		return "", fmt.Errorf("failed to get account id: CRN is not set for instance %s", *instance.ID)
+	}
+
+	// The scope segment of the CRN holds the account, e.g. crn:v1:bluemix:public:is:us-south-1:a/<account_id>::instance:<instance_id>.
+	segments := strings.Split(*crn, ":")
+	if len(segments) != 10 || !strings.HasPrefix(segments[6], "a/") || segments[6] == "a/" {
+		return "", fmt.Errorf("failed to get account id from CRN %s of instance %s", *crn, *instance.ID)
+	}
+	return strings.TrimPrefix(segments[6], "a/"), nil
+}
+
 // SetProviderID will set the provider id for the machine.
-func (m *MachineScope) SetProviderID(id *string) error {
+func (m *MachineScope) SetProviderID(ctx context.Context, instance *vpcv1.Instance) error {
 	// Based on the ProviderIDFormat version the providerID format will be decided.
-	if options.ProviderIDFormatType(options.ProviderIDFormat) == options.ProviderIDFormatV2 {
-		accountID, err := utils.GetAccountID()
+	switch options.ProviderIDFormatType(options.ProviderIDFormat) {
+	case options.ProviderIDFormatV3:
+		if instance.Zone == nil || instance.Zone.Name == nil || *instance.Zone.Name == "" {
+			return fmt.Errorf("failed to set provider id: zone is not set for instance %s", *instance.ID)
+		}
+		m.IBMVPCMachine.Spec.ProviderID = ptr.To(fmt.Sprintf("ibmvpc://%s/%s/%s", m.IBMVPCCluster.Spec.Region, *instance.Zone.Name, *instance.ID))
+	case options.ProviderIDFormatV2:
+		accountID, err := m.providerIDAccountID(ctx, instance)
 		if err != nil {
 			m.Logger.Error(err, "failed to get cloud account id", err.Error())
 			return err
 		}
-		m.IBMVPCMachine.Spec.ProviderID = ptr.To(fmt.Sprintf("ibm://%s///%s/%s", accountID, m.Machine.Spec.ClusterName, *id))
-	} else {
+		m.IBMVPCMachine.Spec.ProviderID = ptr.To(fmt.Sprintf("ibm://%s///%s/%s", accountID, m.Machine.Spec.ClusterName, *instance.ID))
+	default:
 		m.IBMVPCMachine.Spec.ProviderID = ptr.To(fmt.Sprintf("ibmvpc://%s/%s", m.Machine.Spec.ClusterName, m.IBMVPCMachine.Name))
 	}
 	return nil
@@ -530,7 +2349,7 @@ func (m *MachineScope) SetProviderID(id *string) error {
 
 // APIServerPort returns the APIServerPort.
 func (m *MachineScope) APIServerPort() int32 {
-	if m.Cluster.Spec.ClusterNetwork != nil && m.Cluster.Spec.ClusterNetwork.APIServerPort != nil {
+	if m.Cluster != nil && m.Cluster.Spec.ClusterNetwork != nil && m.Cluster.Spec.ClusterNetwork.APIServerPort != nil {
 		return *m.Cluster.Spec.ClusterNetwork.APIServerPort
 	}
 	return infrav1beta2.DefaultAPIServerPort
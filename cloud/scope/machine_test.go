@@ -18,24 +18,40 @@ package scope
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
+	"fmt"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/globaltaggingv1"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/go-openapi/strfmt"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
 
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/client-go/kubernetes/scheme"
 	"k8s.io/klog/v2"
+	clocktesting "k8s.io/utils/clock/testing"
+	"k8s.io/utils/ptr"
 	capiv1beta1 "sigs.k8s.io/cluster-api/api/v1beta1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
 	infrav1beta2 "sigs.k8s.io/cluster-api-provider-ibmcloud/api/v1beta2"
+	gtmock "sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/globaltagging/mock"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/utils"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/vpc"
 	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/vpc/mock"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/options"
 
 	. "github.com/onsi/gomega"
 )
@@ -153,6 +169,151 @@ func TestCreateMachine(t *testing.T) {
 			require.Equal(t, expectedOutput, out)
 		})
 
+		t.Run("Should resolve instance prototype without creating Machine in dry run", func(t *testing.T) {
+			g := NewWithT(t)
+			mockController, mockvpc := setup(t)
+			t.Cleanup(mockController.Finish)
+			scope := setupMachineScope(clusterName, machineName, mockvpc)
+			scope.IBMVPCMachine.Spec = vpcMachine.Spec
+			scope.IBMVPCMachine.Spec.Profile = "bx2-2x8"
+			mockvpc.EXPECT().ListInstanceProfiles(gomock.AssignableToTypeOf(&vpcv1.ListInstanceProfilesOptions{})).Return(&vpcv1.InstanceProfileCollection{
+				Profiles: []vpcv1.InstanceProfile{{Name: core.StringPtr("bx2-2x8")}},
+			}, &core.DetailedResponse{}, nil)
+			mockvpc.EXPECT().ListInstances(gomock.Any()).Times(0)
+			mockvpc.EXPECT().CreateInstance(gomock.Any()).Times(0)
+			out, err := scope.DryRunCreateMachine()
+			g.Expect(err).To(BeNil())
+			prototype, ok := out.(*vpcv1.InstancePrototype)
+			g.Expect(ok).To(BeTrue())
+			g.Expect(*prototype.Name).To(Equal(machineName))
+			g.Expect(*prototype.Profile.(*vpcv1.InstanceProfileIdentity).Name).To(Equal("bx2-2x8"))
+			g.Expect(*prototype.Image.(*vpcv1.ImageIdentity).ID).To(Equal("foo-image-id"))
+			g.Expect(*prototype.Keys[0].(*vpcv1.KeyIdentity).ID).To(Equal("foo-ssh-key-id"))
+		})
+
+		t.Run("Error when resolving instance prototype in dry run", func(t *testing.T) {
+			g := NewWithT(t)
+			mockController, mockvpc := setup(t)
+			t.Cleanup(mockController.Finish)
+			scope := setupMachineScope(clusterName, machineName, mockvpc)
+			scope.IBMVPCMachine.Spec = vpcMachine.Spec
+			scope.IBMVPCMachine.Spec.Image = &infrav1beta2.IBMVPCResourceReference{
+				Name: core.StringPtr("foo-image"),
+			}
+			mockvpc.EXPECT().ListImages(gomock.AssignableToTypeOf(&vpcv1.ListImagesOptions{})).Return(&vpcv1.ImageCollection{}, &core.DetailedResponse{}, errors.New("Failed to list images"))
+			mockvpc.EXPECT().CreateInstance(gomock.Any()).Times(0)
+			out, err := scope.DryRunCreateMachine()
+			g.Expect(err).To(MatchError(ContainSubstring("error while fetching image ID")))
+			g.Expect(out).To(BeNil())
+		})
+
+		t.Run("Should create Machine from instance template", func(t *testing.T) {
+			g := NewWithT(t)
+			mockController, mockvpc := setup(t)
+			t.Cleanup(mockController.Finish)
+			scope := setupMachineScope(clusterName, machineName, mockvpc)
+			scope.IBMVPCMachine.Spec = infrav1beta2.IBMVPCMachineSpec{
+				InstanceTemplate: &infrav1beta2.IBMVPCResourceReference{
+					Name: core.StringPtr("foo-instance-template"),
+				},
+				Zone: "foo-zone",
+			}
+			instance := &vpcv1.Instance{
+				Name: &scope.Machine.Name,
+			}
+			mockvpc.EXPECT().ListInstances(gomock.AssignableToTypeOf(&vpcv1.ListInstancesOptions{})).Return(&vpcv1.InstanceCollection{}, &core.DetailedResponse{}, nil)
+			mockvpc.EXPECT().ListInstanceTemplates(gomock.AssignableToTypeOf(&vpcv1.ListInstanceTemplatesOptions{})).Return(&vpcv1.InstanceTemplateCollection{
+				Templates: []vpcv1.InstanceTemplateIntf{
+					&vpcv1.InstanceTemplate{
+						Name: core.StringPtr("foo-instance-template"),
+						ID:   core.StringPtr("foo-instance-template-id"),
+					},
+				},
+			}, &core.DetailedResponse{}, nil)
+			mockvpc.EXPECT().ListImages(gomock.Any()).Times(0)
+			mockvpc.EXPECT().ListKeys(gomock.Any()).Times(0)
+			mockvpc.EXPECT().ListInstanceProfiles(gomock.Any()).Times(0)
+			mockvpc.EXPECT().CreateInstance(gomock.AssignableToTypeOf(&vpcv1.CreateInstanceOptions{})).DoAndReturn(func(options *vpcv1.CreateInstanceOptions) (*vpcv1.Instance, *core.DetailedResponse, error) {
+				prototype, ok := options.InstancePrototype.(*vpcv1.InstancePrototypeInstanceBySourceTemplate)
+				g.Expect(ok).To(BeTrue())
+				g.Expect(*prototype.Name).To(Equal(machineName))
+				g.Expect(*prototype.SourceTemplate.(*vpcv1.InstanceTemplateIdentityByID).ID).To(Equal("foo-instance-template-id"))
+				g.Expect(*prototype.Zone.(*vpcv1.ZoneIdentity).Name).To(Equal("foo-zone"))
+				g.Expect(prototype.PrimaryNetworkInterface).To(BeNil())
+				return instance, &core.DetailedResponse{}, nil
+			})
+			out, err := scope.CreateMachine()
+			g.Expect(err).To(BeNil())
+			g.Expect(*out.Name).To(Equal(machineName))
+		})
+
+		t.Run("Error when instance template is specified with conflicting fields", func(t *testing.T) {
+			g := NewWithT(t)
+			mockController, mockvpc := setup(t)
+			t.Cleanup(mockController.Finish)
+			scope := setupMachineScope(clusterName, machineName, mockvpc)
+			scope.IBMVPCMachine.Spec = vpcMachine.Spec
+			scope.IBMVPCMachine.Spec.InstanceTemplate = &infrav1beta2.IBMVPCResourceReference{
+				ID: core.StringPtr("foo-instance-template-id"),
+			}
+			mockvpc.EXPECT().ListInstances(gomock.AssignableToTypeOf(&vpcv1.ListInstancesOptions{})).Return(&vpcv1.InstanceCollection{}, &core.DetailedResponse{}, nil)
+			mockvpc.EXPECT().CreateInstance(gomock.Any()).Times(0)
+			_, err := scope.CreateMachine()
+			g.Expect(err).To(MatchError(ContainSubstring("may not be specified with instanceTemplate")))
+		})
+
+		t.Run("Error when instance template is specified with placement or confidential computing fields", func(t *testing.T) {
+			conflicts := map[string]func(spec *infrav1beta2.IBMVPCMachineSpec){
+				"placementTarget": func(spec *infrav1beta2.IBMVPCMachineSpec) {
+					spec.PlacementTarget = &infrav1beta2.IBMVPCResourceReference{Name: core.StringPtr("foo-placement-group")}
+				},
+				"dedicatedHost": func(spec *infrav1beta2.IBMVPCMachineSpec) {
+					spec.DedicatedHost = &infrav1beta2.IBMVPCResourceReference{Name: core.StringPtr("foo-dedicated-host")}
+				},
+				"totalVolumeBandwidth": func(spec *infrav1beta2.IBMVPCMachineSpec) {
+					spec.TotalVolumeBandwidth = core.Int64Ptr(1000)
+				},
+				"confidentialCompute": func(spec *infrav1beta2.IBMVPCMachineSpec) {
+					spec.ConfidentialCompute = &infrav1beta2.VPCConfidentialCompute{SecureBoot: true}
+				},
+			}
+			for name, setConflict := range conflicts {
+				t.Run(name, func(t *testing.T) {
+					g := NewWithT(t)
+					mockController, mockvpc := setup(t)
+					t.Cleanup(mockController.Finish)
+					scope := setupMachineScope(clusterName, machineName, mockvpc)
+					scope.IBMVPCMachine.Spec = infrav1beta2.IBMVPCMachineSpec{
+						InstanceTemplate: &infrav1beta2.IBMVPCResourceReference{
+							ID: core.StringPtr("foo-instance-template-id"),
+						},
+					}
+					setConflict(&scope.IBMVPCMachine.Spec)
+					mockvpc.EXPECT().ListInstances(gomock.AssignableToTypeOf(&vpcv1.ListInstancesOptions{})).Return(&vpcv1.InstanceCollection{}, &core.DetailedResponse{}, nil)
+					mockvpc.EXPECT().CreateInstance(gomock.Any()).Times(0)
+					_, err := scope.CreateMachine()
+					g.Expect(err).To(MatchError(ContainSubstring("may not be specified with instanceTemplate")))
+				})
+			}
+		})
+
+		t.Run("Error when instance template does not exist", func(t *testing.T) {
+			g := NewWithT(t)
+			mockController, mockvpc := setup(t)
+			t.Cleanup(mockController.Finish)
+			scope := setupMachineScope(clusterName, machineName, mockvpc)
+			scope.IBMVPCMachine.Spec = infrav1beta2.IBMVPCMachineSpec{
+				InstanceTemplate: &infrav1beta2.IBMVPCResourceReference{
+					Name: core.StringPtr("foo-instance-template"),
+				},
+			}
+			mockvpc.EXPECT().ListInstances(gomock.AssignableToTypeOf(&vpcv1.ListInstancesOptions{})).Return(&vpcv1.InstanceCollection{}, &core.DetailedResponse{}, nil)
+			mockvpc.EXPECT().ListInstanceTemplates(gomock.AssignableToTypeOf(&vpcv1.ListInstanceTemplatesOptions{})).Return(&vpcv1.InstanceTemplateCollection{}, &core.DetailedResponse{}, nil)
+			mockvpc.EXPECT().CreateInstance(gomock.Any()).Times(0)
+			_, err := scope.CreateMachine()
+			g.Expect(err).To(MatchError(ContainSubstring("error while fetching instance template ID")))
+		})
+
 		t.Run("Return existing Machine", func(t *testing.T) {
 			g := NewWithT(t)
 			mockController, mockvpc := setup(t)
@@ -174,6 +335,156 @@ func TestCreateMachine(t *testing.T) {
 			require.Equal(t, expectedOutput, out)
 		})
 
+		t.Run("Should scope listing Instances to the cluster VPC", func(t *testing.T) {
+			g := NewWithT(t)
+			mockController, mockvpc := setup(t)
+			t.Cleanup(mockController.Finish)
+			scope := setupMachineScope(clusterName, "foo-machine-1", mockvpc)
+			scope.IBMVPCCluster.Status.VPC.ID = "foo-vpc-id"
+			mockvpc.EXPECT().ListInstances(gomock.AssignableToTypeOf(&vpcv1.ListInstancesOptions{})).DoAndReturn(func(options *vpcv1.ListInstancesOptions) (*vpcv1.InstanceCollection, *core.DetailedResponse, error) {
+				g.Expect(*options.VPCID).To(Equal("foo-vpc-id"))
+				return &vpcv1.InstanceCollection{Instances: []vpcv1.Instance{{Name: core.StringPtr("foo-machine-1")}}}, &core.DetailedResponse{}, nil
+			})
+			_, err := scope.CreateMachine()
+			g.Expect(err).To(BeNil())
+		})
+
+		t.Run("Should list Instances unscoped when the cluster VPC is unknown", func(t *testing.T) {
+			g := NewWithT(t)
+			mockController, mockvpc := setup(t)
+			t.Cleanup(mockController.Finish)
+			scope := setupMachineScope(clusterName, "foo-machine-1", mockvpc)
+			scope.IBMVPCCluster.Status.VPC.ID = ""
+			mockvpc.EXPECT().ListInstances(gomock.AssignableToTypeOf(&vpcv1.ListInstancesOptions{})).DoAndReturn(func(options *vpcv1.ListInstancesOptions) (*vpcv1.InstanceCollection, *core.DetailedResponse, error) {
+				g.Expect(options.VPCID).To(BeNil())
+				return &vpcv1.InstanceCollection{Instances: []vpcv1.Instance{{Name: core.StringPtr("foo-machine-1")}}}, &core.DetailedResponse{}, nil
+			})
+			_, err := scope.CreateMachine()
+			g.Expect(err).To(BeNil())
+		})
+
+		t.Run("Return existing Machine found on a later page", func(t *testing.T) {
+			g := NewWithT(t)
+			mockController, mockvpc := setup(t)
+			t.Cleanup(mockController.Finish)
+			scope := setupMachineScope(clusterName, "foo-machine-1", mockvpc)
+			firstPage := &vpcv1.InstanceCollection{
+				Instances: []vpcv1.Instance{
+					{
+						Name: core.StringPtr("foo-machine-0"),
+					},
+				},
+				Next: &vpcv1.InstanceCollectionNext{
+					Href: core.StringPtr("https://us-south.iaas.cloud.ibm.com/v1/instances?start=foo-start&limit=1"),
+				},
+			}
+			secondPage := &vpcv1.InstanceCollection{
+				Instances: []vpcv1.Instance{
+					{
+						Name: core.StringPtr("foo-machine-1"),
+					},
+				},
+			}
+			gomock.InOrder(
+				mockvpc.EXPECT().ListInstances(gomock.AssignableToTypeOf(&vpcv1.ListInstancesOptions{})).DoAndReturn(func(options *vpcv1.ListInstancesOptions) (*vpcv1.InstanceCollection, *core.DetailedResponse, error) {
+					g.Expect(*options.Name).To(Equal("foo-machine-1"))
+					g.Expect(options.Start).To(BeNil())
+					return firstPage, &core.DetailedResponse{}, nil
+				}),
+				mockvpc.EXPECT().ListInstances(gomock.AssignableToTypeOf(&vpcv1.ListInstancesOptions{})).DoAndReturn(func(options *vpcv1.ListInstancesOptions) (*vpcv1.InstanceCollection, *core.DetailedResponse, error) {
+					g.Expect(*options.Name).To(Equal("foo-machine-1"))
+					g.Expect(*options.Start).To(Equal("foo-start"))
+					return secondPage, &core.DetailedResponse{}, nil
+				}),
+			)
+			out, err := scope.CreateMachine()
+			g.Expect(err).To(BeNil())
+			require.Equal(t, &vpcv1.Instance{Name: core.StringPtr("foo-machine-1")}, out)
+		})
+
+		t.Run("Should create single Instance for concurrent calls", func(t *testing.T) {
+			g := NewWithT(t)
+			mockController, mockvpc := setup(t)
+			t.Cleanup(mockController.Finish)
+			listed := make(chan struct{})
+			release := make(chan struct{})
+			instance := &vpcv1.Instance{
+				Name: core.StringPtr(machineName),
+			}
+			mockvpc.EXPECT().ListInstances(gomock.AssignableToTypeOf(&vpcv1.ListInstancesOptions{})).DoAndReturn(func(_ *vpcv1.ListInstancesOptions) (*vpcv1.InstanceCollection, *core.DetailedResponse, error) {
+				close(listed)
+				<-release
+				return &vpcv1.InstanceCollection{}, &core.DetailedResponse{}, nil
+			}).Times(1)
+			mockvpc.EXPECT().CreateInstance(gomock.AssignableToTypeOf(&vpcv1.CreateInstanceOptions{})).Return(instance, &core.DetailedResponse{}, nil).Times(1)
+
+			var wg sync.WaitGroup
+			outs := make([]*vpcv1.Instance, 2)
+			errs := make([]error, 2)
+			scopes := make([]*MachineScope, 2)
+			createMachine := func(i int) {
+				defer wg.Done()
+				outs[i], errs[i] = scopes[i].CreateMachine()
+			}
+			for i := range scopes {
+				scopes[i] = setupMachineScope(clusterName, machineName, mockvpc)
+				scopes[i].IBMVPCMachine.Spec = vpcMachine.Spec
+			}
+			wg.Add(2)
+			go createMachine(0)
+			<-listed
+			go createMachine(1)
+			// Give the second call time to join the in-flight creation before releasing the first one.
+			time.Sleep(100 * time.Millisecond)
+			close(release)
+			wg.Wait()
+
+			for i := range outs {
+				g.Expect(errs[i]).To(BeNil())
+				g.Expect(outs[i]).To(Equal(instance))
+				g.Expect(scopes[i].IBMVPCMachine.Status.PowerState).To(Equal(infrav1beta2.VPCInstancePowerStateRunning))
+			}
+		})
+
+		t.Run("Should create an Instance per namespace for concurrent calls", func(t *testing.T) {
+			g := NewWithT(t)
+			mockController, mockvpc := setup(t)
+			t.Cleanup(mockController.Finish)
+			listed := make(chan struct{}, 2)
+			release := make(chan struct{})
+			instance := &vpcv1.Instance{
+				Name: core.StringPtr(machineName),
+			}
+			mockvpc.EXPECT().ListInstances(gomock.AssignableToTypeOf(&vpcv1.ListInstancesOptions{})).DoAndReturn(func(_ *vpcv1.ListInstancesOptions) (*vpcv1.InstanceCollection, *core.DetailedResponse, error) {
+				listed <- struct{}{}
+				<-release
+				return &vpcv1.InstanceCollection{}, &core.DetailedResponse{}, nil
+			}).Times(2)
+			mockvpc.EXPECT().CreateInstance(gomock.AssignableToTypeOf(&vpcv1.CreateInstanceOptions{})).Return(instance, &core.DetailedResponse{}, nil).Times(2)
+
+			var wg sync.WaitGroup
+			errs := make([]error, 2)
+			for i, namespace := range []string{"foo-namespace", "bar-namespace"} {
+				scope := setupMachineScope(clusterName, machineName, mockvpc)
+				scope.IBMVPCMachine.Spec = vpcMachine.Spec
+				scope.IBMVPCMachine.Namespace = namespace
+				wg.Add(1)
+				go func(i int, scope *MachineScope) {
+					defer wg.Done()
+					_, errs[i] = scope.CreateMachine()
+				}(i, scope)
+			}
+			// Both calls list instances before either of them is released.
+			<-listed
+			<-listed
+			close(release)
+			wg.Wait()
+
+			for i := range errs {
+				g.Expect(errs[i]).To(BeNil())
+			}
+		})
+
 		t.Run("Error when listing Instances", func(t *testing.T) {
 			g := NewWithT(t)
 			mockController, mockvpc := setup(t)
@@ -461,123 +772,2720 @@ func TestCreateMachine(t *testing.T) {
 		g.Expect(err).To(BeNil())
 		require.Equal(t, expectedOutput, out)
 	})
-}
 
-func TestDeleteMachine(t *testing.T) {
-	setup := func(t *testing.T) (*gomock.Controller, *mock.MockVpc) {
-		t.Helper()
-		return gomock.NewController(t), mock.NewMockVpc(gomock.NewController(t))
-	}
+	t.Run("Create Machine with BootVolume", func(t *testing.T) {
+		testCases := []struct {
+			name           string
+			bootVolume     *infrav1beta2.VPCVolume
+			expectedVolume *vpcv1.VolumeAttachmentPrototypeInstanceByImageContext
+			expectErr      bool
+		}{
+			{
+				name:       "Should omit BootVolumeAttachment when BootVolume is nil",
+				bootVolume: nil,
+			},
+			{
+				name: "Should set BootVolumeAttachment when BootVolume is set",
+				bootVolume: &infrav1beta2.VPCVolume{
+					DeleteVolumeOnInstanceDelete: true,
+					SizeGiB:                      100,
+					Profile:                      "10iops-tier",
+					EncryptionKeyCRN:             "crn:v1:bluemix:public:kms:us-south:a/foo-account:foo-kms-instance:key:foo-key",
+				},
+				expectedVolume: &vpcv1.VolumeAttachmentPrototypeInstanceByImageContext{
+					DeleteVolumeOnInstanceDelete: core.BoolPtr(true),
+					Volume: &vpcv1.VolumePrototypeInstanceByImageContext{
+						Capacity: core.Int64Ptr(100),
+						Profile: &vpcv1.VolumeProfileIdentity{
+							Name: core.StringPtr("10iops-tier"),
+						},
+						EncryptionKey: &vpcv1.EncryptionKeyIdentity{
+							CRN: core.StringPtr("crn:v1:bluemix:public:kms:us-south:a/foo-account:foo-kms-instance:key:foo-key"),
+						},
+					},
+				},
+			},
+			{
+				name: "Error when BootVolume EncryptionKeyCRN references a key management instance",
+				bootVolume: &infrav1beta2.VPCVolume{
+					EncryptionKeyCRN: "crn:v1:bluemix:public:kms:us-south:a/foo-account:foo-kms-instance::",
+				},
+				expectErr: true,
+			},
+			{
+				name: "Error when BootVolume EncryptionKeyCRN is malformed",
+				bootVolume: &infrav1beta2.VPCVolume{
+					EncryptionKeyCRN: "foo-encryption-key-crn",
+				},
+				expectErr: true,
+			},
+			{
+				name: "Error when BootVolume size is less than 10GiB",
+				bootVolume: &infrav1beta2.VPCVolume{
+					SizeGiB: 5,
+				},
+				expectErr: true,
+			},
+			{
+				name: "Error when BootVolume size is greater than 250GiB",
+				bootVolume: &infrav1beta2.VPCVolume{
+					SizeGiB: 300,
+				},
+				expectErr: true,
+			},
+		}
+		for _, tc := range testCases {
+			t.Run(tc.name, func(t *testing.T) {
+				g := NewWithT(t)
+				mockController, mockvpc := setup(t)
+				t.Cleanup(mockController.Finish)
+				scope := setupMachineScope(clusterName, machineName, mockvpc)
+				scope.IBMVPCMachine.Spec = vpcMachine.Spec
+				scope.IBMVPCMachine.Spec.BootVolume = tc.bootVolume
+				mockvpc.EXPECT().ListInstances(gomock.AssignableToTypeOf(&vpcv1.ListInstancesOptions{})).Return(&vpcv1.InstanceCollection{}, &core.DetailedResponse{}, nil)
+				if tc.expectErr {
+					_, err := scope.CreateMachine()
+					g.Expect(err).To(Not(BeNil()))
+					return
+				}
+				mockvpc.EXPECT().CreateInstance(gomock.AssignableToTypeOf(&vpcv1.CreateInstanceOptions{})).DoAndReturn(func(options *vpcv1.CreateInstanceOptions) (*vpcv1.Instance, *core.DetailedResponse, error) {
+					prototype := options.InstancePrototype.(*vpcv1.InstancePrototype)
+					if tc.expectedVolume == nil {
+						g.Expect(prototype.BootVolumeAttachment).To(BeNil())
+					} else {
+						g.Expect(prototype.BootVolumeAttachment).To(Equal(tc.expectedVolume))
+					}
+					return &vpcv1.Instance{Name: &scope.Machine.Name}, &core.DetailedResponse{}, nil
+				})
+				_, err := scope.CreateMachine()
+				g.Expect(err).To(BeNil())
+			})
+		}
+	})
 
-	vpcMachine := infrav1beta2.IBMVPCMachine{
-		Spec: infrav1beta2.IBMVPCMachineSpec{
-			Name: "foo-machine",
-		},
-		Status: infrav1beta2.IBMVPCMachineStatus{
-			InstanceID: "foo-instance-id",
-		},
-	}
+	t.Run("Create Machine with BootstrapFormat", func(t *testing.T) {
+		testCases := []struct {
+			name             string
+			bootstrapFormat  infrav1beta2.BootstrapFormat
+			secretFormat     string
+			metadataService  *infrav1beta2.VPCMetadataService
+			expectedMetadata *vpcv1.InstanceMetadataServicePrototype
+			expectErr        bool
+		}{
+			{
+				name:         "Should create Machine with cloud-init bootstrap data",
+				secretFormat: "cloud-config",
+			},
+			{
+				name:            "Should create Machine with ignition bootstrap data and metadata service enabled",
+				bootstrapFormat: infrav1beta2.BootstrapFormatIgnition,
+				secretFormat:    "ignition",
+				expectedMetadata: &vpcv1.InstanceMetadataServicePrototype{
+					Enabled: core.BoolPtr(true),
+				},
+			},
+			{
+				name:            "Should create Machine with metadata service explicitly enabled",
+				secretFormat:    "cloud-config",
+				metadataService: &infrav1beta2.VPCMetadataService{Enabled: true, ProtocolVersion: "https"},
+				expectedMetadata: &vpcv1.InstanceMetadataServicePrototype{
+					Enabled:  core.BoolPtr(true),
+					Protocol: core.StringPtr("https"),
+				},
+			},
+			{
+				name:            "Should create Machine with metadata service explicitly disabled",
+				bootstrapFormat: infrav1beta2.BootstrapFormatIgnition,
+				secretFormat:    "ignition",
+				metadataService: &infrav1beta2.VPCMetadataService{Enabled: false, ProtocolVersion: "https"},
+				expectedMetadata: &vpcv1.InstanceMetadataServicePrototype{
+					Enabled: core.BoolPtr(false),
+				},
+			},
+			{
+				name:            "Error when bootstrap data format does not match",
+				bootstrapFormat: infrav1beta2.BootstrapFormatIgnition,
+				secretFormat:    "cloud-config",
+				expectErr:       true,
+			},
+		}
+		for _, tc := range testCases {
+			t.Run(tc.name, func(t *testing.T) {
+				g := NewWithT(t)
+				mockController, mockvpc := setup(t)
+				t.Cleanup(mockController.Finish)
+				scope := setupMachineScope(clusterName, machineName, mockvpc)
+				scope.IBMVPCMachine.Spec = vpcMachine.Spec
+				scope.IBMVPCMachine.Spec.BootstrapFormat = tc.bootstrapFormat
+				scope.IBMVPCMachine.Spec.MetadataService = tc.metadataService
+				secret := newBootstrapSecret(clusterName, machineName)
+				secret.Data["format"] = []byte(tc.secretFormat)
+				g.Expect(scope.Client.Update(context.Background(), secret)).To(Succeed())
+				mockvpc.EXPECT().ListInstances(gomock.AssignableToTypeOf(&vpcv1.ListInstancesOptions{})).Return(&vpcv1.InstanceCollection{}, &core.DetailedResponse{}, nil)
+				if tc.expectErr {
+					_, err := scope.CreateMachine()
+					g.Expect(err).To(Not(BeNil()))
+					return
+				}
+				mockvpc.EXPECT().CreateInstance(gomock.AssignableToTypeOf(&vpcv1.CreateInstanceOptions{})).DoAndReturn(func(options *vpcv1.CreateInstanceOptions) (*vpcv1.Instance, *core.DetailedResponse, error) {
+					prototype := options.InstancePrototype.(*vpcv1.InstancePrototype)
+					g.Expect(*prototype.UserData).To(Equal("user data"))
+					if tc.expectedMetadata == nil {
+						g.Expect(prototype.MetadataService).To(BeNil())
+					} else {
+						g.Expect(prototype.MetadataService).To(Equal(tc.expectedMetadata))
+					}
+					return &vpcv1.Instance{Name: &scope.Machine.Name}, &core.DetailedResponse{}, nil
+				})
+				_, err := scope.CreateMachine()
+				g.Expect(err).To(BeNil())
+			})
+		}
+	})
 
-	t.Run("Delete Machine", func(t *testing.T) {
-		t.Run("Should delete Machine", func(t *testing.T) {
+	t.Run("Create Machine with PlacementTarget", func(t *testing.T) {
+		t.Run("Should create Machine with placement group ID", func(t *testing.T) {
 			g := NewWithT(t)
 			mockController, mockvpc := setup(t)
 			t.Cleanup(mockController.Finish)
 			scope := setupMachineScope(clusterName, machineName, mockvpc)
 			scope.IBMVPCMachine.Spec = vpcMachine.Spec
-			scope.IBMVPCMachine.Status = vpcMachine.Status
-			mockvpc.EXPECT().DeleteInstance(gomock.AssignableToTypeOf(&vpcv1.DeleteInstanceOptions{})).Return(&core.DetailedResponse{}, nil)
-			err := scope.DeleteMachine()
+			scope.IBMVPCMachine.Spec.PlacementTarget = &infrav1beta2.IBMVPCResourceReference{
+				ID: core.StringPtr("foo-placement-group-id"),
+			}
+			mockvpc.EXPECT().ListInstances(gomock.AssignableToTypeOf(&vpcv1.ListInstancesOptions{})).Return(&vpcv1.InstanceCollection{}, &core.DetailedResponse{}, nil)
+			mockvpc.EXPECT().CreateInstance(gomock.AssignableToTypeOf(&vpcv1.CreateInstanceOptions{})).DoAndReturn(func(options *vpcv1.CreateInstanceOptions) (*vpcv1.Instance, *core.DetailedResponse, error) {
+				prototype := options.InstancePrototype.(*vpcv1.InstancePrototype)
+				placementTarget := prototype.PlacementTarget.(*vpcv1.InstancePlacementTargetPrototypePlacementGroupIdentity)
+				g.Expect(*placementTarget.ID).To(Equal("foo-placement-group-id"))
+				return &vpcv1.Instance{Name: &scope.Machine.Name}, &core.DetailedResponse{}, nil
+			})
+			_, err := scope.CreateMachine()
 			g.Expect(err).To(BeNil())
 		})
 
-		t.Run("Error when deleting Machine", func(t *testing.T) {
+		t.Run("Should create Machine with placement group Name", func(t *testing.T) {
 			g := NewWithT(t)
 			mockController, mockvpc := setup(t)
 			t.Cleanup(mockController.Finish)
 			scope := setupMachineScope(clusterName, machineName, mockvpc)
 			scope.IBMVPCMachine.Spec = vpcMachine.Spec
-			scope.IBMVPCMachine.Status = vpcMachine.Status
-			mockvpc.EXPECT().DeleteInstance(gomock.AssignableToTypeOf(&vpcv1.DeleteInstanceOptions{})).Return(&core.DetailedResponse{}, errors.New("Failed instance deletion"))
-			err := scope.DeleteMachine()
+			scope.IBMVPCMachine.Spec.PlacementTarget = &infrav1beta2.IBMVPCResourceReference{
+				Name: core.StringPtr("foo-placement-group"),
+			}
+			placementGroup := &vpcv1.PlacementGroup{
+				ID:   core.StringPtr("foo-placement-group-id"),
+				Name: core.StringPtr("foo-placement-group"),
+			}
+			mockvpc.EXPECT().ListInstances(gomock.AssignableToTypeOf(&vpcv1.ListInstancesOptions{})).Return(&vpcv1.InstanceCollection{}, &core.DetailedResponse{}, nil)
+			mockvpc.EXPECT().GetPlacementGroupByName("foo-placement-group").Return(placementGroup, nil)
+			mockvpc.EXPECT().CreateInstance(gomock.AssignableToTypeOf(&vpcv1.CreateInstanceOptions{})).DoAndReturn(func(options *vpcv1.CreateInstanceOptions) (*vpcv1.Instance, *core.DetailedResponse, error) {
+				prototype := options.InstancePrototype.(*vpcv1.InstancePrototype)
+				placementTarget := prototype.PlacementTarget.(*vpcv1.InstancePlacementTargetPrototypePlacementGroupIdentity)
+				g.Expect(*placementTarget.ID).To(Equal("foo-placement-group-id"))
+				return &vpcv1.Instance{Name: &scope.Machine.Name}, &core.DetailedResponse{}, nil
+			})
+			_, err := scope.CreateMachine()
+			g.Expect(err).To(BeNil())
+		})
+
+		t.Run("Error when placement group does not exist", func(t *testing.T) {
+			g := NewWithT(t)
+			mockController, mockvpc := setup(t)
+			t.Cleanup(mockController.Finish)
+			scope := setupMachineScope(clusterName, machineName, mockvpc)
+			scope.IBMVPCMachine.Spec = vpcMachine.Spec
+			scope.IBMVPCMachine.Spec.PlacementTarget = &infrav1beta2.IBMVPCResourceReference{
+				Name: core.StringPtr("foo-placement-group"),
+			}
+			mockvpc.EXPECT().ListInstances(gomock.AssignableToTypeOf(&vpcv1.ListInstancesOptions{})).Return(&vpcv1.InstanceCollection{}, &core.DetailedResponse{}, nil)
+			mockvpc.EXPECT().GetPlacementGroupByName("foo-placement-group").Return(nil, nil)
+			_, err := scope.CreateMachine()
 			g.Expect(err).To(Not(BeNil()))
 		})
 
-		t.Run("Empty InstanceID", func(t *testing.T) {
+		t.Run("Error when fetching placement group", func(t *testing.T) {
 			g := NewWithT(t)
 			mockController, mockvpc := setup(t)
 			t.Cleanup(mockController.Finish)
 			scope := setupMachineScope(clusterName, machineName, mockvpc)
-			scope.IBMVPCMachine.Status.InstanceID = ""
-			err := scope.DeleteMachine()
-			g.Expect(err).To(BeNil())
+			scope.IBMVPCMachine.Spec = vpcMachine.Spec
+			scope.IBMVPCMachine.Spec.PlacementTarget = &infrav1beta2.IBMVPCResourceReference{
+				Name: core.StringPtr("foo-placement-group"),
+			}
+			mockvpc.EXPECT().ListInstances(gomock.AssignableToTypeOf(&vpcv1.ListInstancesOptions{})).Return(&vpcv1.InstanceCollection{}, &core.DetailedResponse{}, nil)
+			mockvpc.EXPECT().GetPlacementGroupByName("foo-placement-group").Return(nil, errors.New("Failed to list placement groups"))
+			_, err := scope.CreateMachine()
+			g.Expect(err).To(Not(BeNil()))
 		})
 	})
-}
 
-func TestCreateVPCLoadBalancerPoolMember(t *testing.T) {
-	setup := func(t *testing.T) (*gomock.Controller, *mock.MockVpc) {
-		t.Helper()
-		return gomock.NewController(t), mock.NewMockVpc(gomock.NewController(t))
-	}
+	t.Run("Create Machine with ResourceGroup", func(t *testing.T) {
+		testCases := []struct {
+			name                  string
+			clusterResourceGroup  string
+			machineResourceGroup  string
+			expectedResourceGroup string
+		}{
+			{
+				name:                  "Should create Machine in the cluster resource group when ResourceGroup is unset",
+				clusterResourceGroup:  "foo-cluster-resource-group",
+				expectedResourceGroup: "foo-cluster-resource-group",
+			},
+			{
+				name:                  "Should create Machine in the machine resource group when ResourceGroup is set",
+				clusterResourceGroup:  "foo-cluster-resource-group",
+				machineResourceGroup:  "foo-machine-resource-group",
+				expectedResourceGroup: "foo-machine-resource-group",
+			},
+		}
+		for _, tc := range testCases {
+			t.Run(tc.name, func(t *testing.T) {
+				g := NewWithT(t)
+				mockController, mockvpc := setup(t)
+				t.Cleanup(mockController.Finish)
+				scope := setupMachineScope(clusterName, machineName, mockvpc)
+				scope.IBMVPCCluster.Spec.ResourceGroup = tc.clusterResourceGroup
+				scope.IBMVPCMachine.Spec = vpcMachine.Spec
+				scope.IBMVPCMachine.Spec.ResourceGroup = tc.machineResourceGroup
+				mockvpc.EXPECT().ListInstances(gomock.AssignableToTypeOf(&vpcv1.ListInstancesOptions{})).Return(&vpcv1.InstanceCollection{}, &core.DetailedResponse{}, nil)
+				mockvpc.EXPECT().CreateInstance(gomock.AssignableToTypeOf(&vpcv1.CreateInstanceOptions{})).DoAndReturn(func(options *vpcv1.CreateInstanceOptions) (*vpcv1.Instance, *core.DetailedResponse, error) {
+					prototype := options.InstancePrototype.(*vpcv1.InstancePrototype)
+					g.Expect(*prototype.ResourceGroup.(*vpcv1.ResourceGroupIdentity).ID).To(Equal(tc.expectedResourceGroup))
+					return &vpcv1.Instance{Name: &scope.Machine.Name}, &core.DetailedResponse{}, nil
+				})
+				_, err := scope.CreateMachine()
+				g.Expect(err).To(BeNil())
+			})
+		}
+	})
 
-	vpcMachine := infrav1beta2.IBMVPCMachine{
-		Spec: infrav1beta2.IBMVPCMachineSpec{
-			Name: "foo-machine",
-		},
-		Status: infrav1beta2.IBMVPCMachineStatus{
-			Addresses: []corev1.NodeAddress{
-				{
-					Type:    corev1.NodeInternalIP,
-					Address: "192.168.1.1",
+	t.Run("Create Machine with CapacityReservation", func(t *testing.T) {
+		testCases := []struct {
+			name                string
+			capacityReservation *infrav1beta2.VPCCapacityReservation
+			expectedPolicy      string
+			expectedPool        []string
+		}{
+			{
+				name:                "Should create Machine with the automatic policy when the policy is unset",
+				capacityReservation: &infrav1beta2.VPCCapacityReservation{},
+				expectedPolicy:      "automatic",
+			},
+			{
+				name: "Should create Machine with the disabled policy",
+				capacityReservation: &infrav1beta2.VPCCapacityReservation{
+					Policy: infrav1beta2.VPCReservationAffinityPolicyDisabled,
 				},
+				expectedPolicy: vpcv1.InstanceReservationAffinityPrototypePolicyDisabledConst,
 			},
-		},
-	}
-
-	t.Run("Create VPCLoadBalancerPoolMember", func(t *testing.T) {
-		loadBalancer := &vpcv1.LoadBalancer{
-			ID:                 core.StringPtr("foo-load-balancer-id"),
-			ProvisioningStatus: core.StringPtr("active"),
-			Pools: []vpcv1.LoadBalancerPoolReference{
-				{
-					ID: core.StringPtr("foo-load-balancer-pool-id"),
+			{
+				name: "Should create Machine with the manual policy targeting the reservation",
+				capacityReservation: &infrav1beta2.VPCCapacityReservation{
+					Policy: infrav1beta2.VPCReservationAffinityPolicyManual,
+					Reservation: &infrav1beta2.IBMVPCResourceReference{
+						ID: core.StringPtr("foo-reservation-id"),
+					},
 				},
+				expectedPolicy: vpcv1.InstanceReservationAffinityPrototypePolicyManualConst,
+				expectedPool:   []string{"foo-reservation-id"},
 			},
 		}
+		for _, tc := range testCases {
+			t.Run(tc.name, func(t *testing.T) {
+				g := NewWithT(t)
+				mockController, mockvpc := setup(t)
+				t.Cleanup(mockController.Finish)
+				scope := setupMachineScope(clusterName, machineName, mockvpc)
+				scope.IBMVPCMachine.Spec = vpcMachine.Spec
+				scope.IBMVPCMachine.Spec.CapacityReservation = tc.capacityReservation
+				mockvpc.EXPECT().ListInstances(gomock.AssignableToTypeOf(&vpcv1.ListInstancesOptions{})).Return(&vpcv1.InstanceCollection{}, &core.DetailedResponse{}, nil)
+				mockvpc.EXPECT().CreateInstance(gomock.AssignableToTypeOf(&vpcv1.CreateInstanceOptions{})).DoAndReturn(func(options *vpcv1.CreateInstanceOptions) (*vpcv1.Instance, *core.DetailedResponse, error) {
+					prototype := options.InstancePrototype.(*vpcv1.InstancePrototype)
+					g.Expect(*prototype.ReservationAffinity.Policy).To(Equal(tc.expectedPolicy))
+					g.Expect(prototype.ReservationAffinity.Pool).To(HaveLen(len(tc.expectedPool)))
+					for i, id := range tc.expectedPool {
+						g.Expect(*prototype.ReservationAffinity.Pool[i].(*vpcv1.ReservationIdentityByID).ID).To(Equal(id))
+					}
+					return &vpcv1.Instance{Name: &scope.Machine.Name}, &core.DetailedResponse{}, nil
+				})
+				_, err := scope.CreateMachine()
+				g.Expect(err).To(BeNil())
+			})
+		}
 
-		t.Run("Error when fetching LoadBalancer", func(t *testing.T) {
+		t.Run("Should create Machine without reservation affinity when CapacityReservation is unset", func(t *testing.T) {
 			g := NewWithT(t)
 			mockController, mockvpc := setup(t)
 			t.Cleanup(mockController.Finish)
 			scope := setupMachineScope(clusterName, machineName, mockvpc)
 			scope.IBMVPCMachine.Spec = vpcMachine.Spec
-			scope.IBMVPCMachine.Status = vpcMachine.Status
-			mockvpc.EXPECT().GetLoadBalancer(gomock.AssignableToTypeOf(&vpcv1.GetLoadBalancerOptions{})).Return(&vpcv1.LoadBalancer{}, &core.DetailedResponse{}, errors.New("Could not fetch LoadBalancer"))
-			_, err := scope.CreateVPCLoadBalancerPoolMember(&scope.IBMVPCMachine.Status.Addresses[0].Address, int64(infrav1beta2.DefaultAPIServerPort))
-			g.Expect(err).To(Not(BeNil()))
+			mockvpc.EXPECT().ListInstances(gomock.AssignableToTypeOf(&vpcv1.ListInstancesOptions{})).Return(&vpcv1.InstanceCollection{}, &core.DetailedResponse{}, nil)
+			mockvpc.EXPECT().CreateInstance(gomock.AssignableToTypeOf(&vpcv1.CreateInstanceOptions{})).DoAndReturn(func(options *vpcv1.CreateInstanceOptions) (*vpcv1.Instance, *core.DetailedResponse, error) {
+				g.Expect(options.InstancePrototype.(*vpcv1.InstancePrototype).ReservationAffinity).To(BeNil())
+				return &vpcv1.Instance{Name: &scope.Machine.Name}, &core.DetailedResponse{}, nil
+			})
+			_, err := scope.CreateMachine()
+			g.Expect(err).To(BeNil())
 		})
-		t.Run("Error when LoadBalancer is not active", func(t *testing.T) {
+
+		t.Run("Should create Machine with the manual policy targeting the reservation by name", func(t *testing.T) {
 			g := NewWithT(t)
 			mockController, mockvpc := setup(t)
 			t.Cleanup(mockController.Finish)
 			scope := setupMachineScope(clusterName, machineName, mockvpc)
 			scope.IBMVPCMachine.Spec = vpcMachine.Spec
-			scope.IBMVPCMachine.Status = vpcMachine.Status
-			loadBalancer := &vpcv1.LoadBalancer{
-				ID:                 core.StringPtr("foo-load-balancer-id"),
-				ProvisioningStatus: core.StringPtr("pending"),
+			scope.IBMVPCMachine.Spec.CapacityReservation = &infrav1beta2.VPCCapacityReservation{
+				Policy: infrav1beta2.VPCReservationAffinityPolicyManual,
+				Reservation: &infrav1beta2.IBMVPCResourceReference{
+					Name: core.StringPtr("foo-reservation"),
+				},
 			}
-			mockvpc.EXPECT().GetLoadBalancer(gomock.AssignableToTypeOf(&vpcv1.GetLoadBalancerOptions{})).Return(loadBalancer, &core.DetailedResponse{}, nil)
-			_, err := scope.CreateVPCLoadBalancerPoolMember(&scope.IBMVPCMachine.Status.Addresses[0].Address, int64(infrav1beta2.DefaultAPIServerPort))
-			g.Expect(err).To(Not(BeNil()))
+			mockvpc.EXPECT().ListInstances(gomock.AssignableToTypeOf(&vpcv1.ListInstancesOptions{})).Return(&vpcv1.InstanceCollection{}, &core.DetailedResponse{}, nil)
+			mockvpc.EXPECT().GetReservationByName("foo-reservation").Return(&vpcv1.Reservation{Name: core.StringPtr("foo-reservation"), ID: core.StringPtr("foo-reservation-id")}, nil)
+			mockvpc.EXPECT().CreateInstance(gomock.AssignableToTypeOf(&vpcv1.CreateInstanceOptions{})).DoAndReturn(func(options *vpcv1.CreateInstanceOptions) (*vpcv1.Instance, *core.DetailedResponse, error) {
+				prototype := options.InstancePrototype.(*vpcv1.InstancePrototype)
+				g.Expect(*prototype.ReservationAffinity.Pool[0].(*vpcv1.ReservationIdentityByID).ID).To(Equal("foo-reservation-id"))
+				return &vpcv1.Instance{Name: &scope.Machine.Name}, &core.DetailedResponse{}, nil
+			})
+			_, err := scope.CreateMachine()
+			g.Expect(err).To(BeNil())
 		})
-		t.Run("Error when no pool exist", func(t *testing.T) {
-			g := NewWithT(t)
-			mockController, mockvpc := setup(t)
-			t.Cleanup(mockController.Finish)
-			scope := setupMachineScope(clusterName, machineName, mockvpc)
-			scope.IBMVPCMachine.Spec = vpcMachine.Spec
+
+		conflictCases := []struct {
+			name                string
+			capacityReservation *infrav1beta2.VPCCapacityReservation
+			dedicatedHost       *infrav1beta2.IBMVPCResourceReference
+			expectedError       string
+		}{
+			{
+				name: "Should return an error when the manual policy has no reservation",
+				capacityReservation: &infrav1beta2.VPCCapacityReservation{
+					Policy: infrav1beta2.VPCReservationAffinityPolicyManual,
+				},
+				expectedError: "reservation is required with the manual reservation affinity policy",
+			},
+			{
+				name: "Should return an error when a reservation is specified with the automatic policy",
+				capacityReservation: &infrav1beta2.VPCCapacityReservation{
+					Policy: infrav1beta2.VPCReservationAffinityPolicyAutomatic,
+					Reservation: &infrav1beta2.IBMVPCResourceReference{
+						ID: core.StringPtr("foo-reservation-id"),
+					},
+				},
+				expectedError: "reservation may be specified only with the manual reservation affinity policy",
+			},
+			{
+				name: "Should return an error when CapacityReservation is specified with DedicatedHost",
+				capacityReservation: &infrav1beta2.VPCCapacityReservation{
+					Policy: infrav1beta2.VPCReservationAffinityPolicyDisabled,
+				},
+				dedicatedHost: &infrav1beta2.IBMVPCResourceReference{
+					ID: core.StringPtr("foo-dedicated-host-id"),
+				},
+				expectedError: "capacityReservation may not be specified with dedicatedHost",
+			},
+		}
+		for _, tc := range conflictCases {
+			t.Run(tc.name, func(t *testing.T) {
+				g := NewWithT(t)
+				mockController, mockvpc := setup(t)
+				t.Cleanup(mockController.Finish)
+				scope := setupMachineScope(clusterName, machineName, mockvpc)
+				scope.IBMVPCMachine.Spec = vpcMachine.Spec
+				scope.IBMVPCMachine.Spec.CapacityReservation = tc.capacityReservation
+				scope.IBMVPCMachine.Spec.DedicatedHost = tc.dedicatedHost
+				mockvpc.EXPECT().ListInstances(gomock.AssignableToTypeOf(&vpcv1.ListInstancesOptions{})).Return(&vpcv1.InstanceCollection{}, &core.DetailedResponse{}, nil)
+				mockvpc.EXPECT().CreateInstance(gomock.Any()).Times(0)
+				_, err := scope.CreateMachine()
+				g.Expect(err).To(MatchError(ContainSubstring(tc.expectedError)))
+			})
+		}
+	})
+
+	t.Run("Create Machine with zone validation", func(t *testing.T) {
+		regionZones := &vpcv1.ZoneCollection{
+			Zones: []vpcv1.Zone{
+				{Name: core.StringPtr("us-south-1")},
+				{Name: core.StringPtr("us-south-2")},
+			},
+		}
+
+		t.Run("Should create Machine when the zone belongs to the region", func(t *testing.T) {
+			g := NewWithT(t)
+			mockController, mockvpc := setup(t)
+			t.Cleanup(mockController.Finish)
+			scope := setupMachineScope(clusterName, machineName, mockvpc)
+			scope.IBMVPCCluster.Spec.Region = "us-south"
+			scope.IBMVPCMachine.Spec = vpcMachine.Spec
+			scope.IBMVPCMachine.Spec.Zone = "us-south-2"
+			mockvpc.EXPECT().ListInstances(gomock.AssignableToTypeOf(&vpcv1.ListInstancesOptions{})).Return(&vpcv1.InstanceCollection{}, &core.DetailedResponse{}, nil)
+			mockvpc.EXPECT().ListRegionZones(gomock.AssignableToTypeOf(&vpcv1.ListRegionZonesOptions{})).DoAndReturn(func(options *vpcv1.ListRegionZonesOptions) (*vpcv1.ZoneCollection, *core.DetailedResponse, error) {
+				g.Expect(*options.RegionName).To(Equal("us-south"))
+				return regionZones, &core.DetailedResponse{}, nil
+			})
+			mockvpc.EXPECT().CreateInstance(gomock.AssignableToTypeOf(&vpcv1.CreateInstanceOptions{})).Return(&vpcv1.Instance{Name: &scope.Machine.Name}, &core.DetailedResponse{}, nil)
+			_, err := scope.CreateMachine()
+			g.Expect(err).To(BeNil())
+		})
+
+		t.Run("Should create Machine when the zone of the subnet belongs to the region", func(t *testing.T) {
+			g := NewWithT(t)
+			mockController, mockvpc := setup(t)
+			t.Cleanup(mockController.Finish)
+			scope := setupMachineScope(clusterName, machineName, mockvpc)
+			scope.IBMVPCCluster.Spec.Region = "us-south"
+			scope.IBMVPCMachine.Spec = infrav1beta2.IBMVPCMachineSpec{
+				InstanceTemplate: &infrav1beta2.IBMVPCResourceReference{
+					ID: core.StringPtr("foo-instance-template-id"),
+				},
+				PrimaryNetworkInterface: infrav1beta2.NetworkInterface{
+					Subnet: "foo-subnet-id",
+				},
+			}
+			subnet := &vpcv1.Subnet{
+				ID:   core.StringPtr("foo-subnet-id"),
+				Zone: &vpcv1.ZoneReference{Name: core.StringPtr("us-south-1")},
+			}
+			mockvpc.EXPECT().ListInstances(gomock.AssignableToTypeOf(&vpcv1.ListInstancesOptions{})).Return(&vpcv1.InstanceCollection{}, &core.DetailedResponse{}, nil)
+			mockvpc.EXPECT().GetSubnet(gomock.AssignableToTypeOf(&vpcv1.GetSubnetOptions{})).Return(subnet, &core.DetailedResponse{}, nil).AnyTimes()
+			mockvpc.EXPECT().ListRegionZones(gomock.AssignableToTypeOf(&vpcv1.ListRegionZonesOptions{})).Return(regionZones, &core.DetailedResponse{}, nil)
+			mockvpc.EXPECT().CreateInstance(gomock.AssignableToTypeOf(&vpcv1.CreateInstanceOptions{})).Return(&vpcv1.Instance{Name: &scope.Machine.Name}, &core.DetailedResponse{}, nil)
+			_, err := scope.CreateMachine()
+			g.Expect(err).To(BeNil())
+		})
+
+		t.Run("Should return an error naming the valid zones when the zone does not belong to the region", func(t *testing.T) {
+			g := NewWithT(t)
+			mockController, mockvpc := setup(t)
+			t.Cleanup(mockController.Finish)
+			scope := setupMachineScope(clusterName, machineName, mockvpc)
+			scope.IBMVPCCluster.Spec.Region = "us-south"
+			scope.IBMVPCMachine.Spec = vpcMachine.Spec
+			scope.IBMVPCMachine.Spec.Zone = "eu-de-1"
+			mockvpc.EXPECT().ListInstances(gomock.AssignableToTypeOf(&vpcv1.ListInstancesOptions{})).Return(&vpcv1.InstanceCollection{}, &core.DetailedResponse{}, nil)
+			mockvpc.EXPECT().ListRegionZones(gomock.AssignableToTypeOf(&vpcv1.ListRegionZonesOptions{})).Return(regionZones, &core.DetailedResponse{}, nil)
+			mockvpc.EXPECT().CreateInstance(gomock.Any()).Times(0)
+			_, err := scope.CreateMachine()
+			g.Expect(err).To(MatchError(ContainSubstring("zone eu-de-1 does not belong to region us-south, valid zones are: us-south-1, us-south-2")))
+		})
+
+		t.Run("Should return an error when listing the zones of the region fails", func(t *testing.T) {
+			g := NewWithT(t)
+			mockController, mockvpc := setup(t)
+			t.Cleanup(mockController.Finish)
+			scope := setupMachineScope(clusterName, machineName, mockvpc)
+			scope.IBMVPCCluster.Spec.Region = "us-south"
+			scope.IBMVPCMachine.Spec = vpcMachine.Spec
+			scope.IBMVPCMachine.Spec.Zone = "us-south-1"
+			mockvpc.EXPECT().ListInstances(gomock.AssignableToTypeOf(&vpcv1.ListInstancesOptions{})).Return(&vpcv1.InstanceCollection{}, &core.DetailedResponse{}, nil)
+			mockvpc.EXPECT().ListRegionZones(gomock.AssignableToTypeOf(&vpcv1.ListRegionZonesOptions{})).Return(nil, &core.DetailedResponse{}, errors.New("failed to list zones"))
+			mockvpc.EXPECT().CreateInstance(gomock.Any()).Times(0)
+			_, err := scope.CreateMachine()
+			g.Expect(err).To(Not(BeNil()))
+		})
+
+		t.Run("Should skip zone validation when the zone is not set", func(t *testing.T) {
+			g := NewWithT(t)
+			mockController, mockvpc := setup(t)
+			t.Cleanup(mockController.Finish)
+			scope := setupMachineScope(clusterName, machineName, mockvpc)
+			scope.IBMVPCCluster.Spec.Region = "us-south"
+			scope.IBMVPCMachine.Spec = vpcMachine.Spec
+			mockvpc.EXPECT().ListInstances(gomock.AssignableToTypeOf(&vpcv1.ListInstancesOptions{})).Return(&vpcv1.InstanceCollection{}, &core.DetailedResponse{}, nil)
+			mockvpc.EXPECT().ListRegionZones(gomock.Any()).Times(0)
+			mockvpc.EXPECT().CreateInstance(gomock.AssignableToTypeOf(&vpcv1.CreateInstanceOptions{})).Return(&vpcv1.Instance{Name: &scope.Machine.Name}, &core.DetailedResponse{}, nil)
+			_, err := scope.CreateMachine()
+			g.Expect(err).To(BeNil())
+		})
+	})
+
+	t.Run("Create Machine with StartOnCreate", func(t *testing.T) {
+		t.Run("Should leave Machine running when StartOnCreate is unset", func(t *testing.T) {
+			g := NewWithT(t)
+			mockController, mockvpc := setup(t)
+			t.Cleanup(mockController.Finish)
+			scope := setupMachineScope(clusterName, machineName, mockvpc)
+			scope.IBMVPCMachine.Spec = vpcMachine.Spec
+			mockvpc.EXPECT().ListInstances(gomock.AssignableToTypeOf(&vpcv1.ListInstancesOptions{})).Return(&vpcv1.InstanceCollection{}, &core.DetailedResponse{}, nil)
+			mockvpc.EXPECT().CreateInstance(gomock.AssignableToTypeOf(&vpcv1.CreateInstanceOptions{})).Return(&vpcv1.Instance{Name: &scope.Machine.Name, ID: core.StringPtr("foo-instance-id")}, &core.DetailedResponse{}, nil)
+			_, err := scope.CreateMachine()
+			g.Expect(err).To(BeNil())
+			g.Expect(scope.IBMVPCMachine.Status.PowerState).To(Equal(infrav1beta2.VPCInstancePowerStateRunning))
+		})
+
+		t.Run("Should stop Machine when StartOnCreate is false", func(t *testing.T) {
+			g := NewWithT(t)
+			mockController, mockvpc := setup(t)
+			t.Cleanup(mockController.Finish)
+			scope := setupMachineScope(clusterName, machineName, mockvpc)
+			scope.IBMVPCMachine.Spec = vpcMachine.Spec
+			scope.IBMVPCMachine.Spec.StartOnCreate = ptr.To(false)
+			mockvpc.EXPECT().ListInstances(gomock.AssignableToTypeOf(&vpcv1.ListInstancesOptions{})).Return(&vpcv1.InstanceCollection{}, &core.DetailedResponse{}, nil)
+			mockvpc.EXPECT().CreateInstance(gomock.AssignableToTypeOf(&vpcv1.CreateInstanceOptions{})).Return(&vpcv1.Instance{Name: &scope.Machine.Name, ID: core.StringPtr("foo-instance-id")}, &core.DetailedResponse{}, nil)
+			mockvpc.EXPECT().CreateInstanceAction(gomock.AssignableToTypeOf(&vpcv1.CreateInstanceActionOptions{})).DoAndReturn(func(options *vpcv1.CreateInstanceActionOptions) (*vpcv1.InstanceAction, *core.DetailedResponse, error) {
+				g.Expect(*options.InstanceID).To(Equal("foo-instance-id"))
+				g.Expect(*options.Type).To(Equal(vpcv1.CreateInstanceActionOptionsTypeStopConst))
+				return &vpcv1.InstanceAction{}, &core.DetailedResponse{}, nil
+			})
+			_, err := scope.CreateMachine()
+			g.Expect(err).To(BeNil())
+			g.Expect(scope.IBMVPCMachine.Status.PowerState).To(Equal(infrav1beta2.VPCInstancePowerStateStopped))
+		})
+
+		t.Run("Error when stopping Machine created with StartOnCreate false fails", func(t *testing.T) {
+			g := NewWithT(t)
+			mockController, mockvpc := setup(t)
+			t.Cleanup(mockController.Finish)
+			scope := setupMachineScope(clusterName, machineName, mockvpc)
+			scope.IBMVPCMachine.Spec = vpcMachine.Spec
+			scope.IBMVPCMachine.Spec.StartOnCreate = ptr.To(false)
+			mockvpc.EXPECT().ListInstances(gomock.AssignableToTypeOf(&vpcv1.ListInstancesOptions{})).Return(&vpcv1.InstanceCollection{}, &core.DetailedResponse{}, nil)
+			mockvpc.EXPECT().CreateInstance(gomock.AssignableToTypeOf(&vpcv1.CreateInstanceOptions{})).Return(&vpcv1.Instance{Name: &scope.Machine.Name, ID: core.StringPtr("foo-instance-id")}, &core.DetailedResponse{}, nil)
+			mockvpc.EXPECT().CreateInstanceAction(gomock.AssignableToTypeOf(&vpcv1.CreateInstanceActionOptions{})).Return(nil, &core.DetailedResponse{}, errors.New("failed to create instance action"))
+			_, err := scope.CreateMachine()
+			g.Expect(err).To(Not(BeNil()))
+			g.Expect(scope.IBMVPCMachine.Status.PowerState).To(BeEmpty())
+		})
+
+		t.Run("Should not stop existing Machine when StartOnCreate is false", func(t *testing.T) {
+			g := NewWithT(t)
+			mockController, mockvpc := setup(t)
+			t.Cleanup(mockController.Finish)
+			scope := setupMachineScope(clusterName, machineName, mockvpc)
+			scope.IBMVPCMachine.Spec = vpcMachine.Spec
+			scope.IBMVPCMachine.Spec.StartOnCreate = ptr.To(false)
+			instance := vpcv1.Instance{Name: &scope.Machine.Name, ID: core.StringPtr("foo-instance-id")}
+			mockvpc.EXPECT().ListInstances(gomock.AssignableToTypeOf(&vpcv1.ListInstancesOptions{})).Return(&vpcv1.InstanceCollection{Instances: []vpcv1.Instance{instance}}, &core.DetailedResponse{}, nil)
+			mockvpc.EXPECT().CreateInstance(gomock.Any()).Times(0)
+			mockvpc.EXPECT().CreateInstanceAction(gomock.Any()).Times(0)
+			_, err := scope.CreateMachine()
+			g.Expect(err).To(BeNil())
+			g.Expect(scope.IBMVPCMachine.Status.PowerState).To(BeEmpty())
+		})
+	})
+
+	t.Run("Create Machine with DedicatedHost", func(t *testing.T) {
+		t.Run("Should not set PlacementTarget when DedicatedHost is nil", func(t *testing.T) {
+			g := NewWithT(t)
+			mockController, mockvpc := setup(t)
+			t.Cleanup(mockController.Finish)
+			scope := setupMachineScope(clusterName, machineName, mockvpc)
+			scope.IBMVPCMachine.Spec = vpcMachine.Spec
+			mockvpc.EXPECT().ListInstances(gomock.AssignableToTypeOf(&vpcv1.ListInstancesOptions{})).Return(&vpcv1.InstanceCollection{}, &core.DetailedResponse{}, nil)
+			mockvpc.EXPECT().CreateInstance(gomock.AssignableToTypeOf(&vpcv1.CreateInstanceOptions{})).DoAndReturn(func(options *vpcv1.CreateInstanceOptions) (*vpcv1.Instance, *core.DetailedResponse, error) {
+				prototype := options.InstancePrototype.(*vpcv1.InstancePrototype)
+				g.Expect(prototype.PlacementTarget).To(BeNil())
+				return &vpcv1.Instance{Name: &scope.Machine.Name}, &core.DetailedResponse{}, nil
+			})
+			_, err := scope.CreateMachine()
+			g.Expect(err).To(BeNil())
+		})
+
+		t.Run("Should create Machine with dedicated host ID", func(t *testing.T) {
+			g := NewWithT(t)
+			mockController, mockvpc := setup(t)
+			t.Cleanup(mockController.Finish)
+			scope := setupMachineScope(clusterName, machineName, mockvpc)
+			scope.IBMVPCMachine.Spec = vpcMachine.Spec
+			scope.IBMVPCMachine.Spec.DedicatedHost = &infrav1beta2.IBMVPCResourceReference{
+				ID: core.StringPtr("foo-dedicated-host-id"),
+			}
+			mockvpc.EXPECT().ListInstances(gomock.AssignableToTypeOf(&vpcv1.ListInstancesOptions{})).Return(&vpcv1.InstanceCollection{}, &core.DetailedResponse{}, nil)
+			mockvpc.EXPECT().CreateInstance(gomock.AssignableToTypeOf(&vpcv1.CreateInstanceOptions{})).DoAndReturn(func(options *vpcv1.CreateInstanceOptions) (*vpcv1.Instance, *core.DetailedResponse, error) {
+				prototype := options.InstancePrototype.(*vpcv1.InstancePrototype)
+				placementTarget := prototype.PlacementTarget.(*vpcv1.InstancePlacementTargetPrototypeDedicatedHostIdentity)
+				g.Expect(*placementTarget.ID).To(Equal("foo-dedicated-host-id"))
+				return &vpcv1.Instance{Name: &scope.Machine.Name}, &core.DetailedResponse{}, nil
+			})
+			_, err := scope.CreateMachine()
+			g.Expect(err).To(BeNil())
+		})
+
+		t.Run("Should create Machine with dedicated host Name", func(t *testing.T) {
+			g := NewWithT(t)
+			mockController, mockvpc := setup(t)
+			t.Cleanup(mockController.Finish)
+			scope := setupMachineScope(clusterName, machineName, mockvpc)
+			scope.IBMVPCMachine.Spec = vpcMachine.Spec
+			scope.IBMVPCMachine.Spec.DedicatedHost = &infrav1beta2.IBMVPCResourceReference{
+				Name: core.StringPtr("foo-dedicated-host"),
+			}
+			dedicatedHost := &vpcv1.DedicatedHost{
+				ID:   core.StringPtr("foo-dedicated-host-id"),
+				Name: core.StringPtr("foo-dedicated-host"),
+			}
+			mockvpc.EXPECT().ListInstances(gomock.AssignableToTypeOf(&vpcv1.ListInstancesOptions{})).Return(&vpcv1.InstanceCollection{}, &core.DetailedResponse{}, nil)
+			mockvpc.EXPECT().GetDedicatedHostByName("foo-dedicated-host").Return(dedicatedHost, nil)
+			mockvpc.EXPECT().CreateInstance(gomock.AssignableToTypeOf(&vpcv1.CreateInstanceOptions{})).DoAndReturn(func(options *vpcv1.CreateInstanceOptions) (*vpcv1.Instance, *core.DetailedResponse, error) {
+				prototype := options.InstancePrototype.(*vpcv1.InstancePrototype)
+				placementTarget := prototype.PlacementTarget.(*vpcv1.InstancePlacementTargetPrototypeDedicatedHostIdentity)
+				g.Expect(*placementTarget.ID).To(Equal("foo-dedicated-host-id"))
+				return &vpcv1.Instance{Name: &scope.Machine.Name}, &core.DetailedResponse{}, nil
+			})
+			_, err := scope.CreateMachine()
+			g.Expect(err).To(BeNil())
+		})
+
+		t.Run("Error when dedicated host does not exist", func(t *testing.T) {
+			g := NewWithT(t)
+			mockController, mockvpc := setup(t)
+			t.Cleanup(mockController.Finish)
+			scope := setupMachineScope(clusterName, machineName, mockvpc)
+			scope.IBMVPCMachine.Spec = vpcMachine.Spec
+			scope.IBMVPCMachine.Spec.DedicatedHost = &infrav1beta2.IBMVPCResourceReference{
+				Name: core.StringPtr("foo-dedicated-host"),
+			}
+			mockvpc.EXPECT().ListInstances(gomock.AssignableToTypeOf(&vpcv1.ListInstancesOptions{})).Return(&vpcv1.InstanceCollection{}, &core.DetailedResponse{}, nil)
+			mockvpc.EXPECT().GetDedicatedHostByName("foo-dedicated-host").Return(nil, nil)
+			_, err := scope.CreateMachine()
+			g.Expect(err).To(Not(BeNil()))
+		})
+
+		t.Run("Error when both DedicatedHost and PlacementTarget are set", func(t *testing.T) {
+			g := NewWithT(t)
+			mockController, mockvpc := setup(t)
+			t.Cleanup(mockController.Finish)
+			scope := setupMachineScope(clusterName, machineName, mockvpc)
+			scope.IBMVPCMachine.Spec = vpcMachine.Spec
+			scope.IBMVPCMachine.Spec.DedicatedHost = &infrav1beta2.IBMVPCResourceReference{
+				ID: core.StringPtr("foo-dedicated-host-id"),
+			}
+			scope.IBMVPCMachine.Spec.PlacementTarget = &infrav1beta2.IBMVPCResourceReference{
+				ID: core.StringPtr("foo-placement-group-id"),
+			}
+			mockvpc.EXPECT().ListInstances(gomock.AssignableToTypeOf(&vpcv1.ListInstancesOptions{})).Return(&vpcv1.InstanceCollection{}, &core.DetailedResponse{}, nil)
+			_, err := scope.CreateMachine()
+			g.Expect(err).To(Not(BeNil()))
+		})
+	})
+
+	t.Run("Create Machine with DataVolumes", func(t *testing.T) {
+		t.Run("Should create Machine with DataVolumes", func(t *testing.T) {
+			g := NewWithT(t)
+			mockController, mockvpc := setup(t)
+			t.Cleanup(mockController.Finish)
+			scope := setupMachineScope(clusterName, machineName, mockvpc)
+			scope.IBMVPCMachine.Spec = vpcMachine.Spec
+			scope.IBMVPCMachine.Spec.DataVolumes = []infrav1beta2.VPCVolume{
+				{
+					Name:                         "foo-data-volume-1",
+					SizeGiB:                      50,
+					Profile:                      "general-purpose",
+					DeleteVolumeOnInstanceDelete: true,
+				},
+				{
+					Name:             "foo-data-volume-2",
+					SizeGiB:          100,
+					Profile:          "custom",
+					Iops:             1000,
+					EncryptionKeyCRN: "foo-encryption-key-crn",
+				},
+			}
+			mockvpc.EXPECT().ListInstances(gomock.AssignableToTypeOf(&vpcv1.ListInstancesOptions{})).Return(&vpcv1.InstanceCollection{}, &core.DetailedResponse{}, nil)
+			mockvpc.EXPECT().CreateInstance(gomock.AssignableToTypeOf(&vpcv1.CreateInstanceOptions{})).DoAndReturn(func(options *vpcv1.CreateInstanceOptions) (*vpcv1.Instance, *core.DetailedResponse, error) {
+				prototype := options.InstancePrototype.(*vpcv1.InstancePrototype)
+				g.Expect(prototype.VolumeAttachments).To(HaveLen(2))
+				volume := prototype.VolumeAttachments[0].Volume.(*vpcv1.VolumeAttachmentPrototypeVolume)
+				g.Expect(*volume.Name).To(Equal("foo-data-volume-1"))
+				g.Expect(*volume.Capacity).To(Equal(int64(50)))
+				g.Expect(*volume.Profile.(*vpcv1.VolumeProfileIdentity).Name).To(Equal("general-purpose"))
+				g.Expect(*prototype.VolumeAttachments[0].DeleteVolumeOnInstanceDelete).To(BeTrue())
+				volume = prototype.VolumeAttachments[1].Volume.(*vpcv1.VolumeAttachmentPrototypeVolume)
+				g.Expect(*volume.Name).To(Equal("foo-data-volume-2"))
+				g.Expect(*volume.Iops).To(Equal(int64(1000)))
+				g.Expect(*volume.EncryptionKey.(*vpcv1.EncryptionKeyIdentity).CRN).To(Equal("foo-encryption-key-crn"))
+				g.Expect(*prototype.VolumeAttachments[1].DeleteVolumeOnInstanceDelete).To(BeFalse())
+				return &vpcv1.Instance{Name: &scope.Machine.Name}, &core.DetailedResponse{}, nil
+			})
+			_, err := scope.CreateMachine()
+			g.Expect(err).To(BeNil())
+		})
+
+		t.Run("Should not set VolumeAttachments when DataVolumes is empty", func(t *testing.T) {
+			g := NewWithT(t)
+			mockController, mockvpc := setup(t)
+			t.Cleanup(mockController.Finish)
+			scope := setupMachineScope(clusterName, machineName, mockvpc)
+			scope.IBMVPCMachine.Spec = vpcMachine.Spec
+			scope.IBMVPCMachine.Spec.DataVolumes = []infrav1beta2.VPCVolume{}
+			mockvpc.EXPECT().ListInstances(gomock.AssignableToTypeOf(&vpcv1.ListInstancesOptions{})).Return(&vpcv1.InstanceCollection{}, &core.DetailedResponse{}, nil)
+			mockvpc.EXPECT().CreateInstance(gomock.AssignableToTypeOf(&vpcv1.CreateInstanceOptions{})).DoAndReturn(func(options *vpcv1.CreateInstanceOptions) (*vpcv1.Instance, *core.DetailedResponse, error) {
+				prototype := options.InstancePrototype.(*vpcv1.InstancePrototype)
+				g.Expect(prototype.VolumeAttachments).To(BeNil())
+				return &vpcv1.Instance{Name: &scope.Machine.Name}, &core.DetailedResponse{}, nil
+			})
+			_, err := scope.CreateMachine()
+			g.Expect(err).To(BeNil())
+		})
+
+		t.Run("Error when DataVolume size is not set", func(t *testing.T) {
+			g := NewWithT(t)
+			mockController, mockvpc := setup(t)
+			t.Cleanup(mockController.Finish)
+			scope := setupMachineScope(clusterName, machineName, mockvpc)
+			scope.IBMVPCMachine.Spec = vpcMachine.Spec
+			scope.IBMVPCMachine.Spec.DataVolumes = []infrav1beta2.VPCVolume{
+				{
+					Name: "foo-data-volume-1",
+				},
+			}
+			mockvpc.EXPECT().ListInstances(gomock.AssignableToTypeOf(&vpcv1.ListInstancesOptions{})).Return(&vpcv1.InstanceCollection{}, &core.DetailedResponse{}, nil)
+			_, err := scope.CreateMachine()
+			g.Expect(err).To(Not(BeNil()))
+		})
+	})
+
+	t.Run("Create Machine with CatalogOffering", func(t *testing.T) {
+		t.Run("Should create Machine with catalog offering version CRN", func(t *testing.T) {
+			g := NewWithT(t)
+			mockController, mockvpc := setup(t)
+			t.Cleanup(mockController.Finish)
+			scope := setupMachineScope(clusterName, machineName, mockvpc)
+			scope.IBMVPCMachine.Spec = vpcMachine.Spec
+			scope.IBMVPCMachine.Spec.Image = nil
+			scope.IBMVPCMachine.Spec.CatalogOffering = &infrav1beta2.IBMVPCCatalogOffering{
+				VersionCRN: "foo-catalog-offering-version-crn",
+			}
+			mockvpc.EXPECT().ListInstances(gomock.AssignableToTypeOf(&vpcv1.ListInstancesOptions{})).Return(&vpcv1.InstanceCollection{}, &core.DetailedResponse{}, nil)
+			mockvpc.EXPECT().CreateInstance(gomock.AssignableToTypeOf(&vpcv1.CreateInstanceOptions{})).DoAndReturn(func(options *vpcv1.CreateInstanceOptions) (*vpcv1.Instance, *core.DetailedResponse, error) {
+				prototype := options.InstancePrototype.(*vpcv1.InstancePrototype)
+				g.Expect(prototype.Image).To(BeNil())
+				catalogOffering := prototype.CatalogOffering.(*vpcv1.InstanceCatalogOfferingPrototypeCatalogOfferingByVersion)
+				version := catalogOffering.Version.(*vpcv1.CatalogOfferingVersionIdentityCatalogOfferingVersionByCRN)
+				g.Expect(*version.CRN).To(Equal("foo-catalog-offering-version-crn"))
+				return &vpcv1.Instance{Name: &scope.Machine.Name}, &core.DetailedResponse{}, nil
+			})
+			_, err := scope.CreateMachine()
+			g.Expect(err).To(BeNil())
+		})
+
+		t.Run("Should use Image when CatalogOffering is nil", func(t *testing.T) {
+			g := NewWithT(t)
+			mockController, mockvpc := setup(t)
+			t.Cleanup(mockController.Finish)
+			scope := setupMachineScope(clusterName, machineName, mockvpc)
+			scope.IBMVPCMachine.Spec = vpcMachine.Spec
+			mockvpc.EXPECT().ListInstances(gomock.AssignableToTypeOf(&vpcv1.ListInstancesOptions{})).Return(&vpcv1.InstanceCollection{}, &core.DetailedResponse{}, nil)
+			mockvpc.EXPECT().CreateInstance(gomock.AssignableToTypeOf(&vpcv1.CreateInstanceOptions{})).DoAndReturn(func(options *vpcv1.CreateInstanceOptions) (*vpcv1.Instance, *core.DetailedResponse, error) {
+				prototype := options.InstancePrototype.(*vpcv1.InstancePrototype)
+				g.Expect(prototype.CatalogOffering).To(BeNil())
+				g.Expect(*prototype.Image.(*vpcv1.ImageIdentity).ID).To(Equal(*vpcMachine.Spec.Image.ID))
+				return &vpcv1.Instance{Name: &scope.Machine.Name}, &core.DetailedResponse{}, nil
+			})
+			_, err := scope.CreateMachine()
+			g.Expect(err).To(BeNil())
+		})
+
+		t.Run("Error when both Image ID and CatalogOffering are set", func(t *testing.T) {
+			g := NewWithT(t)
+			mockController, mockvpc := setup(t)
+			t.Cleanup(mockController.Finish)
+			scope := setupMachineScope(clusterName, machineName, mockvpc)
+			scope.IBMVPCMachine.Spec = vpcMachine.Spec
+			scope.IBMVPCMachine.Spec.CatalogOffering = &infrav1beta2.IBMVPCCatalogOffering{
+				VersionCRN: "foo-catalog-offering-version-crn",
+			}
+			mockvpc.EXPECT().ListInstances(gomock.AssignableToTypeOf(&vpcv1.ListInstancesOptions{})).Return(&vpcv1.InstanceCollection{}, &core.DetailedResponse{}, nil)
+			_, err := scope.CreateMachine()
+			g.Expect(err).To(Not(BeNil()))
+		})
+	})
+
+	t.Run("Create Machine with NetworkInterfaces", func(t *testing.T) {
+		t.Run("Should create Machine with one secondary network interface", func(t *testing.T) {
+			g := NewWithT(t)
+			mockController, mockvpc := setup(t)
+			t.Cleanup(mockController.Finish)
+			scope := setupMachineScope(clusterName, machineName, mockvpc)
+			scope.IBMVPCMachine.Spec = vpcMachine.Spec
+			scope.IBMVPCMachine.Spec.PrimaryNetworkInterface = infrav1beta2.NetworkInterface{
+				Subnet: "foo-primary-subnet-id",
+			}
+			scope.IBMVPCMachine.Spec.NetworkInterfaces = []infrav1beta2.NetworkInterface{
+				{
+					Subnet: "foo-subnet-id",
+					SecurityGroups: []infrav1beta2.VPCSecurityGroupReference{
+						{
+							ID: core.StringPtr("foo-security-group-id"),
+						},
+					},
+				},
+			}
+			mockvpc.EXPECT().ListInstances(gomock.AssignableToTypeOf(&vpcv1.ListInstancesOptions{})).Return(&vpcv1.InstanceCollection{}, &core.DetailedResponse{}, nil)
+			mockvpc.EXPECT().GetSubnet(gomock.AssignableToTypeOf(&vpcv1.GetSubnetOptions{})).Return(&vpcv1.Subnet{ID: core.StringPtr("foo-subnet-id")}, &core.DetailedResponse{}, nil)
+			mockvpc.EXPECT().CreateInstance(gomock.AssignableToTypeOf(&vpcv1.CreateInstanceOptions{})).DoAndReturn(func(options *vpcv1.CreateInstanceOptions) (*vpcv1.Instance, *core.DetailedResponse, error) {
+				prototype := options.InstancePrototype.(*vpcv1.InstancePrototype)
+				g.Expect(*prototype.PrimaryNetworkInterface.Subnet.(*vpcv1.SubnetIdentity).ID).To(Equal("foo-primary-subnet-id"))
+				g.Expect(prototype.NetworkInterfaces).To(HaveLen(1))
+				g.Expect(*prototype.NetworkInterfaces[0].Subnet.(*vpcv1.SubnetIdentity).ID).To(Equal("foo-subnet-id"))
+				g.Expect(*prototype.NetworkInterfaces[0].SecurityGroups[0].(*vpcv1.SecurityGroupIdentity).ID).To(Equal("foo-security-group-id"))
+				return &vpcv1.Instance{Name: &scope.Machine.Name}, &core.DetailedResponse{}, nil
+			})
+			_, err := scope.CreateMachine()
+			g.Expect(err).To(BeNil())
+		})
+
+		t.Run("Should create Machine with two secondary network interfaces", func(t *testing.T) {
+			g := NewWithT(t)
+			mockController, mockvpc := setup(t)
+			t.Cleanup(mockController.Finish)
+			scope := setupMachineScope(clusterName, machineName, mockvpc)
+			scope.IBMVPCMachine.Spec = vpcMachine.Spec
+			scope.IBMVPCMachine.Spec.NetworkInterfaces = []infrav1beta2.NetworkInterface{
+				{
+					Subnet: "foo-subnet-id",
+				},
+				{
+					Subnet: "bar-subnet",
+					SecurityGroups: []infrav1beta2.VPCSecurityGroupReference{
+						{
+							Name: core.StringPtr("bar-security-group"),
+						},
+					},
+				},
+			}
+			mockvpc.EXPECT().ListInstances(gomock.AssignableToTypeOf(&vpcv1.ListInstancesOptions{})).Return(&vpcv1.InstanceCollection{}, &core.DetailedResponse{}, nil)
+			mockvpc.EXPECT().GetSubnet(&vpcv1.GetSubnetOptions{ID: core.StringPtr("foo-subnet-id")}).Return(&vpcv1.Subnet{ID: core.StringPtr("foo-subnet-id")}, &core.DetailedResponse{}, nil)
+			mockvpc.EXPECT().GetSubnet(&vpcv1.GetSubnetOptions{ID: core.StringPtr("bar-subnet")}).Return(nil, &core.DetailedResponse{}, errors.New("subnet not found"))
+			mockvpc.EXPECT().GetVPCSubnetByName("bar-subnet").Return(&vpcv1.Subnet{ID: core.StringPtr("bar-subnet-id"), Name: core.StringPtr("bar-subnet")}, nil)
+			mockvpc.EXPECT().GetSecurityGroupByName("bar-security-group").Return(&vpcv1.SecurityGroup{ID: core.StringPtr("bar-security-group-id"), Name: core.StringPtr("bar-security-group")}, nil)
+			mockvpc.EXPECT().CreateInstance(gomock.AssignableToTypeOf(&vpcv1.CreateInstanceOptions{})).DoAndReturn(func(options *vpcv1.CreateInstanceOptions) (*vpcv1.Instance, *core.DetailedResponse, error) {
+				prototype := options.InstancePrototype.(*vpcv1.InstancePrototype)
+				g.Expect(prototype.NetworkInterfaces).To(HaveLen(2))
+				g.Expect(*prototype.NetworkInterfaces[0].Subnet.(*vpcv1.SubnetIdentity).ID).To(Equal("foo-subnet-id"))
+				g.Expect(*prototype.NetworkInterfaces[1].Subnet.(*vpcv1.SubnetIdentity).ID).To(Equal("bar-subnet-id"))
+				g.Expect(*prototype.NetworkInterfaces[1].SecurityGroups[0].(*vpcv1.SecurityGroupIdentity).ID).To(Equal("bar-security-group-id"))
+				return &vpcv1.Instance{Name: &scope.Machine.Name}, &core.DetailedResponse{}, nil
+			})
+			_, err := scope.CreateMachine()
+			g.Expect(err).To(BeNil())
+		})
+
+		t.Run("Error when secondary network interface subnet does not exist", func(t *testing.T) {
+			g := NewWithT(t)
+			mockController, mockvpc := setup(t)
+			t.Cleanup(mockController.Finish)
+			scope := setupMachineScope(clusterName, machineName, mockvpc)
+			scope.IBMVPCMachine.Spec = vpcMachine.Spec
+			scope.IBMVPCMachine.Spec.NetworkInterfaces = []infrav1beta2.NetworkInterface{
+				{
+					Subnet: "foo-subnet",
+				},
+			}
+			mockvpc.EXPECT().ListInstances(gomock.AssignableToTypeOf(&vpcv1.ListInstancesOptions{})).Return(&vpcv1.InstanceCollection{}, &core.DetailedResponse{}, nil)
+			mockvpc.EXPECT().GetSubnet(gomock.AssignableToTypeOf(&vpcv1.GetSubnetOptions{})).Return(nil, &core.DetailedResponse{}, errors.New("subnet not found"))
+			mockvpc.EXPECT().GetVPCSubnetByName("foo-subnet").Return(nil, nil)
+			_, err := scope.CreateMachine()
+			g.Expect(err).To(Not(BeNil()))
+			g.Expect(err.Error()).To(ContainSubstring("foo-subnet"))
+		})
+
+		t.Run("Should create Machine with security group referenced by CRN", func(t *testing.T) {
+			g := NewWithT(t)
+			mockController, mockvpc := setup(t)
+			t.Cleanup(mockController.Finish)
+			scope := setupMachineScope(clusterName, machineName, mockvpc)
+			scope.IBMVPCMachine.Spec = vpcMachine.Spec
+			securityGroupCRN := "crn:v1:bluemix:public:is:us-south:a/bar-account-id::security-group:bar-security-group-id"
+			scope.IBMVPCMachine.Spec.NetworkInterfaces = []infrav1beta2.NetworkInterface{
+				{
+					Subnet: "foo-subnet-id",
+					SecurityGroups: []infrav1beta2.VPCSecurityGroupReference{
+						{
+							CRN: core.StringPtr(securityGroupCRN),
+						},
+					},
+				},
+			}
+			mockvpc.EXPECT().ListInstances(gomock.AssignableToTypeOf(&vpcv1.ListInstancesOptions{})).Return(&vpcv1.InstanceCollection{}, &core.DetailedResponse{}, nil)
+			mockvpc.EXPECT().GetSubnet(&vpcv1.GetSubnetOptions{ID: core.StringPtr("foo-subnet-id")}).Return(&vpcv1.Subnet{ID: core.StringPtr("foo-subnet-id")}, &core.DetailedResponse{}, nil)
+			mockvpc.EXPECT().CreateInstance(gomock.AssignableToTypeOf(&vpcv1.CreateInstanceOptions{})).DoAndReturn(func(options *vpcv1.CreateInstanceOptions) (*vpcv1.Instance, *core.DetailedResponse, error) {
+				prototype := options.InstancePrototype.(*vpcv1.InstancePrototype)
+				securityGroup := prototype.NetworkInterfaces[0].SecurityGroups[0].(*vpcv1.SecurityGroupIdentity)
+				g.Expect(*securityGroup.CRN).To(Equal(securityGroupCRN))
+				g.Expect(securityGroup.ID).To(BeNil())
+				return &vpcv1.Instance{Name: &scope.Machine.Name}, &core.DetailedResponse{}, nil
+			})
+			_, err := scope.CreateMachine()
+			g.Expect(err).To(BeNil())
+		})
+
+		t.Run("Error when security group CRN is malformed", func(t *testing.T) {
+			g := NewWithT(t)
+			mockController, mockvpc := setup(t)
+			t.Cleanup(mockController.Finish)
+			scope := setupMachineScope(clusterName, machineName, mockvpc)
+			scope.IBMVPCMachine.Spec = vpcMachine.Spec
+			scope.IBMVPCMachine.Spec.NetworkInterfaces = []infrav1beta2.NetworkInterface{
+				{
+					Subnet: "foo-subnet-id",
+					SecurityGroups: []infrav1beta2.VPCSecurityGroupReference{
+						{
+							CRN: core.StringPtr("crn:v1:bluemix:public:is:us-south:a/bar-account-id::subnet:bar-subnet-id"),
+						},
+					},
+				},
+			}
+			mockvpc.EXPECT().ListInstances(gomock.AssignableToTypeOf(&vpcv1.ListInstancesOptions{})).Return(&vpcv1.InstanceCollection{}, &core.DetailedResponse{}, nil)
+			mockvpc.EXPECT().GetSubnet(&vpcv1.GetSubnetOptions{ID: core.StringPtr("foo-subnet-id")}).Return(&vpcv1.Subnet{ID: core.StringPtr("foo-subnet-id")}, &core.DetailedResponse{}, nil)
+			_, err := scope.CreateMachine()
+			g.Expect(err).To(Not(BeNil()))
+			g.Expect(err.Error()).To(ContainSubstring("invalid security group CRN"))
+		})
+	})
+
+	t.Run("Create Machine with AllowIPSpoofing", func(t *testing.T) {
+		t.Run("Should not set AllowIPSpoofing when unset", func(t *testing.T) {
+			g := NewWithT(t)
+			mockController, mockvpc := setup(t)
+			t.Cleanup(mockController.Finish)
+			scope := setupMachineScope(clusterName, machineName, mockvpc)
+			scope.IBMVPCMachine.Spec = vpcMachine.Spec
+			mockvpc.EXPECT().ListInstances(gomock.AssignableToTypeOf(&vpcv1.ListInstancesOptions{})).Return(&vpcv1.InstanceCollection{}, &core.DetailedResponse{}, nil)
+			mockvpc.EXPECT().CreateInstance(gomock.AssignableToTypeOf(&vpcv1.CreateInstanceOptions{})).DoAndReturn(func(options *vpcv1.CreateInstanceOptions) (*vpcv1.Instance, *core.DetailedResponse, error) {
+				prototype := options.InstancePrototype.(*vpcv1.InstancePrototype)
+				g.Expect(prototype.PrimaryNetworkInterface.AllowIPSpoofing).To(BeNil())
+				return &vpcv1.Instance{Name: &scope.Machine.Name}, &core.DetailedResponse{}, nil
+			})
+			_, err := scope.CreateMachine()
+			g.Expect(err).To(BeNil())
+		})
+
+		t.Run("Should set AllowIPSpoofing on primary and secondary network interfaces", func(t *testing.T) {
+			g := NewWithT(t)
+			mockController, mockvpc := setup(t)
+			t.Cleanup(mockController.Finish)
+			scope := setupMachineScope(clusterName, machineName, mockvpc)
+			scope.IBMVPCMachine.Spec = vpcMachine.Spec
+			scope.IBMVPCMachine.Spec.PrimaryNetworkInterface = infrav1beta2.NetworkInterface{
+				Subnet:          "foo-primary-subnet-id",
+				AllowIPSpoofing: ptr.To(true),
+			}
+			scope.IBMVPCMachine.Spec.NetworkInterfaces = []infrav1beta2.NetworkInterface{
+				{
+					Subnet:          "foo-subnet-id",
+					AllowIPSpoofing: ptr.To(false),
+				},
+			}
+			mockvpc.EXPECT().ListInstances(gomock.AssignableToTypeOf(&vpcv1.ListInstancesOptions{})).Return(&vpcv1.InstanceCollection{}, &core.DetailedResponse{}, nil)
+			mockvpc.EXPECT().GetSubnet(gomock.AssignableToTypeOf(&vpcv1.GetSubnetOptions{})).Return(&vpcv1.Subnet{ID: core.StringPtr("foo-subnet-id")}, &core.DetailedResponse{}, nil)
+			mockvpc.EXPECT().CreateInstance(gomock.AssignableToTypeOf(&vpcv1.CreateInstanceOptions{})).DoAndReturn(func(options *vpcv1.CreateInstanceOptions) (*vpcv1.Instance, *core.DetailedResponse, error) {
+				prototype := options.InstancePrototype.(*vpcv1.InstancePrototype)
+				g.Expect(*prototype.PrimaryNetworkInterface.AllowIPSpoofing).To(BeTrue())
+				g.Expect(*prototype.NetworkInterfaces[0].AllowIPSpoofing).To(BeFalse())
+				return &vpcv1.Instance{Name: &scope.Machine.Name}, &core.DetailedResponse{}, nil
+			})
+			_, err := scope.CreateMachine()
+			g.Expect(err).To(BeNil())
+		})
+	})
+
+	t.Run("Create Machine with PrimaryIP", func(t *testing.T) {
+		subnet := &vpcv1.Subnet{
+			ID:            core.StringPtr("foo-subnet-id"),
+			Ipv4CIDRBlock: core.StringPtr("10.240.0.0/24"),
+		}
+
+		t.Run("Should create Machine with explicit primary IP address", func(t *testing.T) {
+			g := NewWithT(t)
+			mockController, mockvpc := setup(t)
+			t.Cleanup(mockController.Finish)
+			scope := setupMachineScope(clusterName, machineName, mockvpc)
+			scope.IBMVPCMachine.Spec = vpcMachine.Spec
+			scope.IBMVPCMachine.Spec.PrimaryNetworkInterface = infrav1beta2.NetworkInterface{
+				Subnet: "foo-subnet-id",
+				PrimaryIP: &infrav1beta2.VPCReservedIP{
+					Address: core.StringPtr("10.240.0.10"),
+				},
+			}
+			mockvpc.EXPECT().ListInstances(gomock.AssignableToTypeOf(&vpcv1.ListInstancesOptions{})).Return(&vpcv1.InstanceCollection{}, &core.DetailedResponse{}, nil)
+			mockvpc.EXPECT().GetSubnet(gomock.AssignableToTypeOf(&vpcv1.GetSubnetOptions{})).Return(subnet, &core.DetailedResponse{}, nil)
+			mockvpc.EXPECT().CreateInstance(gomock.AssignableToTypeOf(&vpcv1.CreateInstanceOptions{})).DoAndReturn(func(options *vpcv1.CreateInstanceOptions) (*vpcv1.Instance, *core.DetailedResponse, error) {
+				prototype := options.InstancePrototype.(*vpcv1.InstancePrototype)
+				primaryIP := prototype.PrimaryNetworkInterface.PrimaryIP.(*vpcv1.NetworkInterfaceIPPrototypeReservedIPPrototypeNetworkInterfaceContext)
+				g.Expect(*primaryIP.Address).To(Equal("10.240.0.10"))
+				g.Expect(*primaryIP.Name).To(Equal(machineName))
+				return &vpcv1.Instance{Name: &scope.Machine.Name}, &core.DetailedResponse{}, nil
+			})
+			_, err := scope.CreateMachine()
+			g.Expect(err).To(BeNil())
+		})
+
+		t.Run("Should create Machine with existing reserved IP ID", func(t *testing.T) {
+			g := NewWithT(t)
+			mockController, mockvpc := setup(t)
+			t.Cleanup(mockController.Finish)
+			scope := setupMachineScope(clusterName, machineName, mockvpc)
+			scope.IBMVPCMachine.Spec = vpcMachine.Spec
+			scope.IBMVPCMachine.Spec.PrimaryNetworkInterface = infrav1beta2.NetworkInterface{
+				Subnet: "foo-subnet-id",
+				PrimaryIP: &infrav1beta2.VPCReservedIP{
+					ID: core.StringPtr("foo-reserved-ip-id"),
+				},
+			}
+			mockvpc.EXPECT().ListInstances(gomock.AssignableToTypeOf(&vpcv1.ListInstancesOptions{})).Return(&vpcv1.InstanceCollection{}, &core.DetailedResponse{}, nil)
+			mockvpc.EXPECT().CreateInstance(gomock.AssignableToTypeOf(&vpcv1.CreateInstanceOptions{})).DoAndReturn(func(options *vpcv1.CreateInstanceOptions) (*vpcv1.Instance, *core.DetailedResponse, error) {
+				prototype := options.InstancePrototype.(*vpcv1.InstancePrototype)
+				primaryIP := prototype.PrimaryNetworkInterface.PrimaryIP.(*vpcv1.NetworkInterfaceIPPrototypeReservedIPIdentity)
+				g.Expect(*primaryIP.ID).To(Equal("foo-reserved-ip-id"))
+				return &vpcv1.Instance{Name: &scope.Machine.Name}, &core.DetailedResponse{}, nil
+			})
+			_, err := scope.CreateMachine()
+			g.Expect(err).To(BeNil())
+		})
+
+		t.Run("Error when primary IP address is outside subnet CIDR", func(t *testing.T) {
+			g := NewWithT(t)
+			mockController, mockvpc := setup(t)
+			t.Cleanup(mockController.Finish)
+			scope := setupMachineScope(clusterName, machineName, mockvpc)
+			scope.IBMVPCMachine.Spec = vpcMachine.Spec
+			scope.IBMVPCMachine.Spec.PrimaryNetworkInterface = infrav1beta2.NetworkInterface{
+				Subnet: "foo-subnet-id",
+				PrimaryIP: &infrav1beta2.VPCReservedIP{
+					Address: core.StringPtr("10.241.0.10"),
+				},
+			}
+			mockvpc.EXPECT().ListInstances(gomock.AssignableToTypeOf(&vpcv1.ListInstancesOptions{})).Return(&vpcv1.InstanceCollection{}, &core.DetailedResponse{}, nil)
+			mockvpc.EXPECT().GetSubnet(gomock.AssignableToTypeOf(&vpcv1.GetSubnetOptions{})).Return(subnet, &core.DetailedResponse{}, nil)
+			_, err := scope.CreateMachine()
+			g.Expect(err).To(Not(BeNil()))
+		})
+	})
+
+	t.Run("Create Machine with Profile", func(t *testing.T) {
+		profileCollection := &vpcv1.InstanceProfileCollection{
+			Profiles: []vpcv1.InstanceProfile{
+				{
+					Name: core.StringPtr("bx2-2x8"),
+				},
+				{
+					Name: core.StringPtr("cx2-4x8"),
+				},
+			},
+		}
+
+		t.Run("Should create Machine when profile is available", func(t *testing.T) {
+			g := NewWithT(t)
+			mockController, mockvpc := setup(t)
+			t.Cleanup(mockController.Finish)
+			scope := setupMachineScope(clusterName, machineName, mockvpc)
+			scope.IBMVPCMachine.Spec = vpcMachine.Spec
+			scope.IBMVPCMachine.Spec.Profile = "bx2-2x8"
+			mockvpc.EXPECT().ListInstances(gomock.AssignableToTypeOf(&vpcv1.ListInstancesOptions{})).Return(&vpcv1.InstanceCollection{}, &core.DetailedResponse{}, nil)
+			mockvpc.EXPECT().ListInstanceProfiles(gomock.AssignableToTypeOf(&vpcv1.ListInstanceProfilesOptions{})).Return(profileCollection, &core.DetailedResponse{}, nil)
+			mockvpc.EXPECT().CreateInstance(gomock.AssignableToTypeOf(&vpcv1.CreateInstanceOptions{})).DoAndReturn(func(options *vpcv1.CreateInstanceOptions) (*vpcv1.Instance, *core.DetailedResponse, error) {
+				prototype := options.InstancePrototype.(*vpcv1.InstancePrototype)
+				g.Expect(*prototype.Profile.(*vpcv1.InstanceProfileIdentity).Name).To(Equal("bx2-2x8"))
+				return &vpcv1.Instance{Name: &scope.Machine.Name}, &core.DetailedResponse{}, nil
+			})
+			_, err := scope.CreateMachine()
+			g.Expect(err).To(BeNil())
+		})
+
+		t.Run("Error when profile is not available in the region", func(t *testing.T) {
+			g := NewWithT(t)
+			mockController, mockvpc := setup(t)
+			t.Cleanup(mockController.Finish)
+			scope := setupMachineScope(clusterName, machineName, mockvpc)
+			scope.IBMVPCMachine.Spec = vpcMachine.Spec
+			scope.IBMVPCMachine.Spec.Profile = "mx2-2x16"
+			mockvpc.EXPECT().ListInstances(gomock.AssignableToTypeOf(&vpcv1.ListInstancesOptions{})).Return(&vpcv1.InstanceCollection{}, &core.DetailedResponse{}, nil)
+			mockvpc.EXPECT().ListInstanceProfiles(gomock.AssignableToTypeOf(&vpcv1.ListInstanceProfilesOptions{})).Return(profileCollection, &core.DetailedResponse{}, nil)
+			_, err := scope.CreateMachine()
+			g.Expect(err).To(Not(BeNil()))
+			g.Expect(err.Error()).To(ContainSubstring("bx2-2x8, cx2-4x8"))
+		})
+
+		t.Run("Should list instance profiles only once", func(t *testing.T) {
+			g := NewWithT(t)
+			mockController, mockvpc := setup(t)
+			t.Cleanup(mockController.Finish)
+			scope := setupMachineScope(clusterName, machineName, mockvpc)
+			mockvpc.EXPECT().ListInstanceProfiles(gomock.AssignableToTypeOf(&vpcv1.ListInstanceProfilesOptions{})).Return(profileCollection, &core.DetailedResponse{}, nil).Times(1)
+			g.Expect(scope.validateInstanceProfile("bx2-2x8")).To(Succeed())
+			g.Expect(scope.validateInstanceProfile("cx2-4x8")).To(Succeed())
+			g.Expect(scope.validateInstanceProfile("mx2-2x16")).To(Not(Succeed()))
+		})
+
+		t.Run("Error when listing instance profiles fails", func(t *testing.T) {
+			g := NewWithT(t)
+			mockController, mockvpc := setup(t)
+			t.Cleanup(mockController.Finish)
+			scope := setupMachineScope(clusterName, machineName, mockvpc)
+			scope.IBMVPCMachine.Spec = vpcMachine.Spec
+			scope.IBMVPCMachine.Spec.Profile = "bx2-2x8"
+			mockvpc.EXPECT().ListInstances(gomock.AssignableToTypeOf(&vpcv1.ListInstancesOptions{})).Return(&vpcv1.InstanceCollection{}, &core.DetailedResponse{}, nil)
+			mockvpc.EXPECT().ListInstanceProfiles(gomock.AssignableToTypeOf(&vpcv1.ListInstanceProfilesOptions{})).Return(nil, &core.DetailedResponse{}, errors.New("failed to list instance profiles"))
+			_, err := scope.CreateMachine()
+			g.Expect(err).To(Not(BeNil()))
+		})
+	})
+
+	t.Run("Create Machine with TotalVolumeBandwidth", func(t *testing.T) {
+		profileCollection := &vpcv1.InstanceProfileCollection{
+			Profiles: []vpcv1.InstanceProfile{
+				{
+					Name: core.StringPtr("bx2-2x8"),
+				},
+			},
+		}
+		instanceProfile := &vpcv1.InstanceProfile{
+			Name: core.StringPtr("bx2-2x8"),
+			TotalVolumeBandwidth: &vpcv1.InstanceProfileVolumeBandwidth{
+				Type:    core.StringPtr(vpcv1.InstanceProfileVolumeBandwidthRangeTypeRangeConst),
+				Default: core.Int64Ptr(1000),
+				Min:     core.Int64Ptr(500),
+				Max:     core.Int64Ptr(3200),
+				Step:    core.Int64Ptr(1),
+			},
+		}
+
+		t.Run("Should create Machine with total volume bandwidth within the profile range", func(t *testing.T) {
+			g := NewWithT(t)
+			mockController, mockvpc := setup(t)
+			t.Cleanup(mockController.Finish)
+			scope := setupMachineScope(clusterName, machineName, mockvpc)
+			scope.IBMVPCMachine.Spec = vpcMachine.Spec
+			scope.IBMVPCMachine.Spec.Profile = "bx2-2x8"
+			scope.IBMVPCMachine.Spec.TotalVolumeBandwidth = core.Int64Ptr(2000)
+			mockvpc.EXPECT().ListInstances(gomock.AssignableToTypeOf(&vpcv1.ListInstancesOptions{})).Return(&vpcv1.InstanceCollection{}, &core.DetailedResponse{}, nil)
+			mockvpc.EXPECT().ListInstanceProfiles(gomock.AssignableToTypeOf(&vpcv1.ListInstanceProfilesOptions{})).Return(profileCollection, &core.DetailedResponse{}, nil)
+			mockvpc.EXPECT().GetInstanceProfile(gomock.AssignableToTypeOf(&vpcv1.GetInstanceProfileOptions{})).Return(instanceProfile, &core.DetailedResponse{}, nil)
+			mockvpc.EXPECT().CreateInstance(gomock.AssignableToTypeOf(&vpcv1.CreateInstanceOptions{})).DoAndReturn(func(options *vpcv1.CreateInstanceOptions) (*vpcv1.Instance, *core.DetailedResponse, error) {
+				prototype := options.InstancePrototype.(*vpcv1.InstancePrototype)
+				g.Expect(prototype.TotalVolumeBandwidth).To(Equal(core.Int64Ptr(2000)))
+				return &vpcv1.Instance{Name: &scope.Machine.Name}, &core.DetailedResponse{}, nil
+			})
+			_, err := scope.CreateMachine()
+			g.Expect(err).To(BeNil())
+		})
+
+		t.Run("Error when total volume bandwidth is outside the profile range", func(t *testing.T) {
+			g := NewWithT(t)
+			mockController, mockvpc := setup(t)
+			t.Cleanup(mockController.Finish)
+			scope := setupMachineScope(clusterName, machineName, mockvpc)
+			scope.IBMVPCMachine.Spec = vpcMachine.Spec
+			scope.IBMVPCMachine.Spec.Profile = "bx2-2x8"
+			scope.IBMVPCMachine.Spec.TotalVolumeBandwidth = core.Int64Ptr(5000)
+			mockvpc.EXPECT().ListInstances(gomock.AssignableToTypeOf(&vpcv1.ListInstancesOptions{})).Return(&vpcv1.InstanceCollection{}, &core.DetailedResponse{}, nil)
+			mockvpc.EXPECT().ListInstanceProfiles(gomock.AssignableToTypeOf(&vpcv1.ListInstanceProfilesOptions{})).Return(profileCollection, &core.DetailedResponse{}, nil)
+			mockvpc.EXPECT().GetInstanceProfile(gomock.AssignableToTypeOf(&vpcv1.GetInstanceProfileOptions{})).Return(instanceProfile, &core.DetailedResponse{}, nil)
+			_, err := scope.CreateMachine()
+			g.Expect(err).To(Not(BeNil()))
+			g.Expect(err.Error()).To(ContainSubstring("between 500 and 3200"))
+		})
+
+		t.Run("Should not set total volume bandwidth when unset", func(t *testing.T) {
+			g := NewWithT(t)
+			mockController, mockvpc := setup(t)
+			t.Cleanup(mockController.Finish)
+			scope := setupMachineScope(clusterName, machineName, mockvpc)
+			scope.IBMVPCMachine.Spec = vpcMachine.Spec
+			mockvpc.EXPECT().ListInstances(gomock.AssignableToTypeOf(&vpcv1.ListInstancesOptions{})).Return(&vpcv1.InstanceCollection{}, &core.DetailedResponse{}, nil)
+			mockvpc.EXPECT().CreateInstance(gomock.AssignableToTypeOf(&vpcv1.CreateInstanceOptions{})).DoAndReturn(func(options *vpcv1.CreateInstanceOptions) (*vpcv1.Instance, *core.DetailedResponse, error) {
+				prototype := options.InstancePrototype.(*vpcv1.InstancePrototype)
+				g.Expect(prototype.TotalVolumeBandwidth).To(BeNil())
+				return &vpcv1.Instance{Name: &scope.Machine.Name}, &core.DetailedResponse{}, nil
+			})
+			_, err := scope.CreateMachine()
+			g.Expect(err).To(BeNil())
+		})
+	})
+
+	t.Run("Create Machine with ConfidentialCompute", func(t *testing.T) {
+		profileCollection := &vpcv1.InstanceProfileCollection{
+			Profiles: []vpcv1.InstanceProfile{
+				{
+					Name: core.StringPtr("bx2-2x8"),
+				},
+				{
+					Name: core.StringPtr("bx3d-2x10"),
+				},
+			},
+		}
+
+		t.Run("Should create Machine with secure boot on a supported profile", func(t *testing.T) {
+			g := NewWithT(t)
+			mockController, mockvpc := setup(t)
+			t.Cleanup(mockController.Finish)
+			scope := setupMachineScope(clusterName, machineName, mockvpc)
+			scope.IBMVPCMachine.Spec = vpcMachine.Spec
+			scope.IBMVPCMachine.Spec.Profile = "bx3d-2x10"
+			scope.IBMVPCMachine.Spec.ConfidentialCompute = &infrav1beta2.VPCConfidentialCompute{
+				SecureBoot:     true,
+				TrustedProfile: core.StringPtr("foo-trusted-profile-id"),
+			}
+			mockvpc.EXPECT().ListInstances(gomock.AssignableToTypeOf(&vpcv1.ListInstancesOptions{})).Return(&vpcv1.InstanceCollection{}, &core.DetailedResponse{}, nil)
+			mockvpc.EXPECT().ListInstanceProfiles(gomock.AssignableToTypeOf(&vpcv1.ListInstanceProfilesOptions{})).Return(profileCollection, &core.DetailedResponse{}, nil)
+			mockvpc.EXPECT().CreateInstance(gomock.AssignableToTypeOf(&vpcv1.CreateInstanceOptions{})).DoAndReturn(func(options *vpcv1.CreateInstanceOptions) (*vpcv1.Instance, *core.DetailedResponse, error) {
+				prototype := options.InstancePrototype.(*secureBootInstancePrototype)
+				g.Expect(*prototype.EnableSecureBoot).To(BeTrue())
+				g.Expect(*prototype.DefaultTrustedProfile.Target.(*vpcv1.TrustedProfileIdentityTrustedProfileByID).ID).To(Equal("foo-trusted-profile-id"))
+				body, err := json.Marshal(prototype)
+				g.Expect(err).To(BeNil())
+				g.Expect(string(body)).To(ContainSubstring(`"enable_secure_boot":true`))
+				g.Expect(string(body)).To(ContainSubstring(`"name":"foo-machine"`))
+				return &vpcv1.Instance{Name: &scope.Machine.Name}, &core.DetailedResponse{}, nil
+			})
+			_, err := scope.CreateMachine()
+			g.Expect(err).To(BeNil())
+		})
+
+		t.Run("Should send secure boot in the create instance request body", func(t *testing.T) {
+			g := NewWithT(t)
+			mockController, mockvpc := setup(t)
+			t.Cleanup(mockController.Finish)
+			var body map[string]interface{}
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				g.Expect(json.NewDecoder(r.Body).Decode(&body)).To(Succeed())
+				w.Header().Set("Content-Type", "application/json")
+				fmt.Fprint(w, `{"id": "foo-instance-id", "name": "foo-machine"}`)
+			}))
+			t.Cleanup(server.Close)
+			vpcService, err := vpcv1.NewVpcV1(&vpcv1.VpcV1Options{
+				Authenticator: &core.NoAuthAuthenticator{},
+				URL:           server.URL,
+			})
+			g.Expect(err).To(BeNil())
+
+			scope := setupMachineScope(clusterName, machineName, mockvpc)
+			scope.IBMVPCMachine.Spec = vpcMachine.Spec
+			scope.IBMVPCMachine.Spec.Profile = "bx3d-2x10"
+			scope.IBMVPCMachine.Spec.ConfidentialCompute = &infrav1beta2.VPCConfidentialCompute{
+				SecureBoot: true,
+			}
+			mockvpc.EXPECT().ListInstances(gomock.AssignableToTypeOf(&vpcv1.ListInstancesOptions{})).Return(&vpcv1.InstanceCollection{}, &core.DetailedResponse{}, nil)
+			mockvpc.EXPECT().ListInstanceProfiles(gomock.AssignableToTypeOf(&vpcv1.ListInstanceProfilesOptions{})).Return(profileCollection, &core.DetailedResponse{}, nil)
+			// Send the options through the VPC SDK so the request body it serializes is checked.
+			mockvpc.EXPECT().CreateInstance(gomock.AssignableToTypeOf(&vpcv1.CreateInstanceOptions{})).DoAndReturn(vpcService.CreateInstance)
+			_, err = scope.CreateMachine()
+			g.Expect(err).To(BeNil())
+			g.Expect(body).To(HaveKeyWithValue("enable_secure_boot", true))
+			g.Expect(body).To(HaveKeyWithValue("name", "foo-machine"))
+			g.Expect(body).To(HaveKey("profile"))
+		})
+
+		t.Run("Should create Machine with trusted profile CRN without secure boot", func(t *testing.T) {
+			g := NewWithT(t)
+			mockController, mockvpc := setup(t)
+			t.Cleanup(mockController.Finish)
+			scope := setupMachineScope(clusterName, machineName, mockvpc)
+			scope.IBMVPCMachine.Spec = vpcMachine.Spec
+			scope.IBMVPCMachine.Spec.ConfidentialCompute = &infrav1beta2.VPCConfidentialCompute{
+				TrustedProfile: core.StringPtr("crn:v1:bluemix:public:iam-identity::a/foo-account::profile:foo-trusted-profile-id"),
+			}
+			mockvpc.EXPECT().ListInstances(gomock.AssignableToTypeOf(&vpcv1.ListInstancesOptions{})).Return(&vpcv1.InstanceCollection{}, &core.DetailedResponse{}, nil)
+			mockvpc.EXPECT().CreateInstance(gomock.AssignableToTypeOf(&vpcv1.CreateInstanceOptions{})).DoAndReturn(func(options *vpcv1.CreateInstanceOptions) (*vpcv1.Instance, *core.DetailedResponse, error) {
+				prototype := options.InstancePrototype.(*vpcv1.InstancePrototype)
+				g.Expect(*prototype.DefaultTrustedProfile.Target.(*vpcv1.TrustedProfileIdentityTrustedProfileByCRN).CRN).To(HavePrefix("crn:"))
+				return &vpcv1.Instance{Name: &scope.Machine.Name}, &core.DetailedResponse{}, nil
+			})
+			_, err := scope.CreateMachine()
+			g.Expect(err).To(BeNil())
+		})
+
+		t.Run("Error when secure boot is enabled on an unsupported profile", func(t *testing.T) {
+			g := NewWithT(t)
+			mockController, mockvpc := setup(t)
+			t.Cleanup(mockController.Finish)
+			scope := setupMachineScope(clusterName, machineName, mockvpc)
+			scope.IBMVPCMachine.Spec = vpcMachine.Spec
+			scope.IBMVPCMachine.Spec.Profile = "bx2-2x8"
+			scope.IBMVPCMachine.Spec.ConfidentialCompute = &infrav1beta2.VPCConfidentialCompute{
+				SecureBoot: true,
+			}
+			mockvpc.EXPECT().ListInstances(gomock.AssignableToTypeOf(&vpcv1.ListInstancesOptions{})).Return(&vpcv1.InstanceCollection{}, &core.DetailedResponse{}, nil)
+			mockvpc.EXPECT().ListInstanceProfiles(gomock.AssignableToTypeOf(&vpcv1.ListInstanceProfilesOptions{})).Return(profileCollection, &core.DetailedResponse{}, nil)
+			_, err := scope.CreateMachine()
+			g.Expect(err).To(Not(BeNil()))
+			g.Expect(err.Error()).To(ContainSubstring("does not support secure boot"))
+		})
+	})
+
+	t.Run("Create Machine with ProviderID", func(t *testing.T) {
+		t.Run("Should return the instance identified by the ProviderID", func(t *testing.T) {
+			g := NewWithT(t)
+			mockController, mockvpc := setup(t)
+			t.Cleanup(mockController.Finish)
+			scope := setupMachineScope(clusterName, machineName, mockvpc)
+			scope.IBMVPCMachine.Spec = vpcMachine.Spec
+			scope.IBMVPCMachine.Spec.ProviderID = core.StringPtr("ibmvpc://us-south/us-south-1/foo-instance-id")
+			instance := &vpcv1.Instance{
+				Name: core.StringPtr(machineName),
+				ID:   core.StringPtr("foo-instance-id"),
+			}
+			mockvpc.EXPECT().GetInstanceByProviderID("ibmvpc://us-south/us-south-1/foo-instance-id").Return(instance, nil)
+			out, err := scope.CreateMachine()
+			g.Expect(err).To(BeNil())
+			g.Expect(out).To(Equal(instance))
+		})
+
+		t.Run("Should fall back to the name when the ProviderID does not identify an instance", func(t *testing.T) {
+			g := NewWithT(t)
+			mockController, mockvpc := setup(t)
+			t.Cleanup(mockController.Finish)
+			scope := setupMachineScope(clusterName, machineName, mockvpc)
+			scope.IBMVPCMachine.Spec = vpcMachine.Spec
+			scope.IBMVPCMachine.Spec.ProviderID = core.StringPtr(fmt.Sprintf("ibmvpc://%s/%s", clusterName, machineName))
+			instanceCollection := &vpcv1.InstanceCollection{
+				Instances: []vpcv1.Instance{
+					{
+						Name: core.StringPtr(machineName),
+						ID:   core.StringPtr("foo-instance-id"),
+					},
+				},
+			}
+			mockvpc.EXPECT().GetInstanceByProviderID(gomock.Any()).Return(nil, vpc.ErrInvalidProviderID)
+			mockvpc.EXPECT().ListInstances(gomock.AssignableToTypeOf(&vpcv1.ListInstancesOptions{})).Return(instanceCollection, &core.DetailedResponse{}, nil)
+			out, err := scope.CreateMachine()
+			g.Expect(err).To(BeNil())
+			g.Expect(*out.ID).To(Equal("foo-instance-id"))
+		})
+
+		t.Run("Error when getting the instance by ProviderID fails", func(t *testing.T) {
+			g := NewWithT(t)
+			mockController, mockvpc := setup(t)
+			t.Cleanup(mockController.Finish)
+			scope := setupMachineScope(clusterName, machineName, mockvpc)
+			scope.IBMVPCMachine.Spec = vpcMachine.Spec
+			scope.IBMVPCMachine.Spec.ProviderID = core.StringPtr("ibmvpc://us-south/us-south-1/foo-instance-id")
+			mockvpc.EXPECT().GetInstanceByProviderID(gomock.Any()).Return(nil, errors.New("failed to get instance"))
+			_, err := scope.CreateMachine()
+			g.Expect(err).To(Not(BeNil()))
+		})
+	})
+
+	t.Run("Create Machine with AdoptExistingInstance", func(t *testing.T) {
+		t.Run("Should adopt the existing instance", func(t *testing.T) {
+			g := NewWithT(t)
+			mockController, mockvpc := setup(t)
+			t.Cleanup(mockController.Finish)
+			scope := setupMachineScope(clusterName, machineName, mockvpc)
+			scope.IBMVPCMachine.Spec = vpcMachine.Spec
+			scope.IBMVPCMachine.Spec.AdoptExistingInstance = true
+			instanceCollection := &vpcv1.InstanceCollection{
+				Instances: []vpcv1.Instance{
+					{
+						Name: core.StringPtr(machineName),
+						ID:   core.StringPtr("foo-instance-id"),
+					},
+				},
+			}
+			mockvpc.EXPECT().ListInstances(gomock.AssignableToTypeOf(&vpcv1.ListInstancesOptions{})).Return(instanceCollection, &core.DetailedResponse{}, nil)
+			out, err := scope.CreateMachine()
+			g.Expect(err).To(BeNil())
+			g.Expect(*out.ID).To(Equal("foo-instance-id"))
+		})
+
+		t.Run("Should not stop the adopted instance when StartOnCreate is false", func(t *testing.T) {
+			g := NewWithT(t)
+			mockController, mockvpc := setup(t)
+			t.Cleanup(mockController.Finish)
+			scope := setupMachineScope(clusterName, machineName, mockvpc)
+			scope.IBMVPCMachine.Spec = vpcMachine.Spec
+			scope.IBMVPCMachine.Spec.AdoptExistingInstance = true
+			scope.IBMVPCMachine.Spec.StartOnCreate = ptr.To(false)
+			instanceCollection := &vpcv1.InstanceCollection{
+				Instances: []vpcv1.Instance{
+					{
+						Name: core.StringPtr(machineName),
+						ID:   core.StringPtr("foo-instance-id"),
+					},
+				},
+			}
+			mockvpc.EXPECT().ListInstances(gomock.AssignableToTypeOf(&vpcv1.ListInstancesOptions{})).Return(instanceCollection, &core.DetailedResponse{}, nil)
+			mockvpc.EXPECT().CreateInstanceAction(gomock.Any()).Times(0)
+			out, err := scope.CreateMachine()
+			g.Expect(err).To(BeNil())
+			g.Expect(*out.ID).To(Equal("foo-instance-id"))
+		})
+
+		t.Run("Error when the instance to adopt does not exist", func(t *testing.T) {
+			g := NewWithT(t)
+			mockController, mockvpc := setup(t)
+			t.Cleanup(mockController.Finish)
+			scope := setupMachineScope(clusterName, machineName, mockvpc)
+			scope.IBMVPCMachine.Spec = vpcMachine.Spec
+			scope.IBMVPCMachine.Spec.AdoptExistingInstance = true
+			mockvpc.EXPECT().ListInstances(gomock.AssignableToTypeOf(&vpcv1.ListInstancesOptions{})).Return(&vpcv1.InstanceCollection{}, &core.DetailedResponse{}, nil)
+			_, err := scope.CreateMachine()
+			g.Expect(err).To(Not(BeNil()))
+		})
+	})
+}
+
+func TestCheckInstanceRunning(t *testing.T) {
+	setup := func(t *testing.T) (*gomock.Controller, *mock.MockVpc) {
+		t.Helper()
+		return gomock.NewController(t), mock.NewMockVpc(gomock.NewController(t))
+	}
+
+	newInstance := func(status string, createdAt time.Time) *vpcv1.Instance {
+		return &vpcv1.Instance{
+			ID:        core.StringPtr("foo-instance-id"),
+			Status:    core.StringPtr(status),
+			CreatedAt: ptr.To(strfmt.DateTime(createdAt)),
+		}
+	}
+
+	t.Run("Should not wait when WaitForRunning is not set", func(t *testing.T) {
+		g := NewWithT(t)
+		mockController, mockvpc := setup(t)
+		t.Cleanup(mockController.Finish)
+		scope := setupMachineScope(clusterName, machineName, mockvpc)
+		scope.IBMVPCMachine.Status.PowerState = infrav1beta2.VPCInstancePowerStateRunning
+		err := scope.CheckInstanceRunning(newInstance(vpcv1.InstanceStatusStartingConst, time.Now()))
+		g.Expect(err).To(BeNil())
+	})
+
+	t.Run("Should not wait for an instance created stopped", func(t *testing.T) {
+		g := NewWithT(t)
+		mockController, mockvpc := setup(t)
+		t.Cleanup(mockController.Finish)
+		scope := setupMachineScope(clusterName, machineName, mockvpc)
+		scope.WaitForRunning = true
+		scope.IBMVPCMachine.Status.PowerState = infrav1beta2.VPCInstancePowerStateStopped
+		err := scope.CheckInstanceRunning(newInstance(vpcv1.InstanceStatusStoppingConst, time.Now()))
+		g.Expect(err).To(BeNil())
+	})
+
+	t.Run("Should succeed when the instance is running", func(t *testing.T) {
+		g := NewWithT(t)
+		mockController, mockvpc := setup(t)
+		t.Cleanup(mockController.Finish)
+		scope := setupMachineScope(clusterName, machineName, mockvpc)
+		scope.WaitForRunning = true
+		scope.IBMVPCMachine.Status.PowerState = infrav1beta2.VPCInstancePowerStateRunning
+		err := scope.CheckInstanceRunning(newInstance(vpcv1.InstanceStatusRunningConst, time.Now()))
+		g.Expect(err).To(BeNil())
+	})
+
+	t.Run("Should report the instance while it is starting", func(t *testing.T) {
+		g := NewWithT(t)
+		mockController, mockvpc := setup(t)
+		t.Cleanup(mockController.Finish)
+		scope := setupMachineScope(clusterName, machineName, mockvpc)
+		scope.WaitForRunning = true
+		scope.IBMVPCMachine.Status.PowerState = infrav1beta2.VPCInstancePowerStateRunning
+		err := scope.CheckInstanceRunning(newInstance(vpcv1.InstanceStatusStartingConst, time.Now()))
+		g.Expect(errors.Is(err, ErrInstanceNotRunning)).To(BeTrue())
+	})
+
+	t.Run("Error when the instance is not running before timeout", func(t *testing.T) {
+		g := NewWithT(t)
+		mockController, mockvpc := setup(t)
+		t.Cleanup(mockController.Finish)
+		scope := setupMachineScope(clusterName, machineName, mockvpc)
+		scope.WaitForRunning = true
+		scope.WaitForRunningTimeout = time.Minute
+		scope.IBMVPCMachine.Status.PowerState = infrav1beta2.VPCInstancePowerStateRunning
+		err := scope.CheckInstanceRunning(newInstance(vpcv1.InstanceStatusStartingConst, time.Now().Add(-2*time.Minute)))
+		g.Expect(err).To(Not(BeNil()))
+		g.Expect(errors.Is(err, ErrInstanceNotRunning)).To(BeFalse())
+	})
+}
+
+func TestDeleteMachine(t *testing.T) {
+	setup := func(t *testing.T) (*gomock.Controller, *mock.MockVpc) {
+		t.Helper()
+		return gomock.NewController(t), mock.NewMockVpc(gomock.NewController(t))
+	}
+
+	vpcMachine := infrav1beta2.IBMVPCMachine{
+		Spec: infrav1beta2.IBMVPCMachineSpec{
+			Name: "foo-machine",
+		},
+		Status: infrav1beta2.IBMVPCMachineStatus{
+			InstanceID: "foo-instance-id",
+		},
+	}
+
+	runningInstance := &vpcv1.Instance{Status: core.StringPtr(vpcv1.InstanceStatusRunningConst)}
+	deletingInstance := &vpcv1.Instance{Status: core.StringPtr(vpcv1.InstanceStatusDeletingConst)}
+	notFound := &core.DetailedResponse{StatusCode: http.StatusNotFound}
+
+	t.Run("Delete Machine", func(t *testing.T) {
+		t.Run("Should delete Machine and requeue until it is gone", func(t *testing.T) {
+			g := NewWithT(t)
+			mockController, mockvpc := setup(t)
+			t.Cleanup(mockController.Finish)
+			scope := setupMachineScope(clusterName, machineName, mockvpc)
+			scope.IBMVPCMachine.Spec = vpcMachine.Spec
+			scope.IBMVPCMachine.Status = vpcMachine.Status
+			mockvpc.EXPECT().GetInstance(gomock.AssignableToTypeOf(&vpcv1.GetInstanceOptions{})).Return(runningInstance, &core.DetailedResponse{}, nil)
+			mockvpc.EXPECT().DeleteInstance(gomock.AssignableToTypeOf(&vpcv1.DeleteInstanceOptions{})).Return(&core.DetailedResponse{}, nil)
+			err := scope.DeleteMachine()
+			g.Expect(errors.Is(err, ErrInstanceDeleting)).To(BeTrue())
+		})
+
+		t.Run("Should requeue while Machine is deleting", func(t *testing.T) {
+			g := NewWithT(t)
+			mockController, mockvpc := setup(t)
+			t.Cleanup(mockController.Finish)
+			scope := setupMachineScope(clusterName, machineName, mockvpc)
+			scope.IBMVPCMachine.Spec = vpcMachine.Spec
+			scope.IBMVPCMachine.Status = vpcMachine.Status
+			mockvpc.EXPECT().GetInstance(gomock.AssignableToTypeOf(&vpcv1.GetInstanceOptions{})).Return(deletingInstance, &core.DetailedResponse{}, nil)
+			mockvpc.EXPECT().DeleteInstance(gomock.Any()).Times(0)
+			err := scope.DeleteMachine()
+			g.Expect(errors.Is(err, ErrInstanceDeleting)).To(BeTrue())
+		})
+
+		t.Run("Error when getting Machine fails", func(t *testing.T) {
+			g := NewWithT(t)
+			mockController, mockvpc := setup(t)
+			t.Cleanup(mockController.Finish)
+			scope := setupMachineScope(clusterName, machineName, mockvpc)
+			scope.IBMVPCMachine.Spec = vpcMachine.Spec
+			scope.IBMVPCMachine.Status = vpcMachine.Status
+			mockvpc.EXPECT().GetInstance(gomock.AssignableToTypeOf(&vpcv1.GetInstanceOptions{})).Return(nil, &core.DetailedResponse{}, errors.New("failed to get instance"))
+			mockvpc.EXPECT().DeleteInstance(gomock.Any()).Times(0)
+			err := scope.DeleteMachine()
+			g.Expect(err).To(Not(BeNil()))
+			g.Expect(errors.Is(err, ErrInstanceDeleting)).To(BeFalse())
+		})
+
+		t.Run("Error when deleting Machine", func(t *testing.T) {
+			g := NewWithT(t)
+			mockController, mockvpc := setup(t)
+			t.Cleanup(mockController.Finish)
+			scope := setupMachineScope(clusterName, machineName, mockvpc)
+			scope.IBMVPCMachine.Spec = vpcMachine.Spec
+			scope.IBMVPCMachine.Status = vpcMachine.Status
+			mockvpc.EXPECT().GetInstance(gomock.AssignableToTypeOf(&vpcv1.GetInstanceOptions{})).Return(runningInstance, &core.DetailedResponse{}, nil)
+			mockvpc.EXPECT().DeleteInstance(gomock.AssignableToTypeOf(&vpcv1.DeleteInstanceOptions{})).Return(&core.DetailedResponse{}, errors.New("Failed instance deletion"))
+			err := scope.DeleteMachine()
+			g.Expect(err).To(Not(BeNil()))
+		})
+
+		t.Run("Should succeed when Machine is already deleted", func(t *testing.T) {
+			g := NewWithT(t)
+			mockController, mockvpc := setup(t)
+			t.Cleanup(mockController.Finish)
+			scope := setupMachineScope(clusterName, machineName, mockvpc)
+			scope.IBMVPCMachine.Spec = vpcMachine.Spec
+			scope.IBMVPCMachine.Status = vpcMachine.Status
+			mockvpc.EXPECT().GetInstance(gomock.AssignableToTypeOf(&vpcv1.GetInstanceOptions{})).Return(nil, notFound, errors.New("Instance not found"))
+			mockvpc.EXPECT().DeleteInstance(gomock.Any()).Times(0)
+			err := scope.DeleteMachine()
+			g.Expect(err).To(BeNil())
+		})
+
+		t.Run("Should succeed when Machine is deleted before the delete request", func(t *testing.T) {
+			g := NewWithT(t)
+			mockController, mockvpc := setup(t)
+			t.Cleanup(mockController.Finish)
+			scope := setupMachineScope(clusterName, machineName, mockvpc)
+			scope.IBMVPCMachine.Spec = vpcMachine.Spec
+			scope.IBMVPCMachine.Status = vpcMachine.Status
+			mockvpc.EXPECT().GetInstance(gomock.AssignableToTypeOf(&vpcv1.GetInstanceOptions{})).Return(runningInstance, &core.DetailedResponse{}, nil)
+			mockvpc.EXPECT().DeleteInstance(gomock.AssignableToTypeOf(&vpcv1.DeleteInstanceOptions{})).Return(notFound, errors.New("Instance not found"))
+			err := scope.DeleteMachine()
+			g.Expect(err).To(BeNil())
+		})
+
+		t.Run("Error when deleting Machine is forbidden", func(t *testing.T) {
+			g := NewWithT(t)
+			mockController, mockvpc := setup(t)
+			t.Cleanup(mockController.Finish)
+			scope := setupMachineScope(clusterName, machineName, mockvpc)
+			scope.IBMVPCMachine.Spec = vpcMachine.Spec
+			scope.IBMVPCMachine.Status = vpcMachine.Status
+			mockvpc.EXPECT().GetInstance(gomock.AssignableToTypeOf(&vpcv1.GetInstanceOptions{})).Return(runningInstance, &core.DetailedResponse{}, nil)
+			mockvpc.EXPECT().DeleteInstance(gomock.AssignableToTypeOf(&vpcv1.DeleteInstanceOptions{})).Return(&core.DetailedResponse{StatusCode: http.StatusForbidden}, errors.New("Not authorized"))
+			err := scope.DeleteMachine()
+			g.Expect(err).To(Not(BeNil()))
+		})
+
+		t.Run("Empty InstanceID", func(t *testing.T) {
+			g := NewWithT(t)
+			mockController, mockvpc := setup(t)
+			t.Cleanup(mockController.Finish)
+			scope := setupMachineScope(clusterName, machineName, mockvpc)
+			scope.IBMVPCMachine.Status.InstanceID = ""
+			err := scope.DeleteMachine()
+			g.Expect(err).To(BeNil())
+		})
+
+		t.Run("Should delete orphaned reserved IP of Machine", func(t *testing.T) {
+			g := NewWithT(t)
+			mockController, mockvpc := setup(t)
+			t.Cleanup(mockController.Finish)
+			scope := setupMachineScope(clusterName, machineName, mockvpc)
+			scope.IBMVPCMachine.Spec = vpcMachine.Spec
+			scope.IBMVPCMachine.Spec.PrimaryNetworkInterface.Subnet = "foo-subnet-id"
+			scope.IBMVPCMachine.Status = vpcMachine.Status
+			reservedIPCollection := &vpcv1.ReservedIPCollection{
+				ReservedIps: []vpcv1.ReservedIP{
+					{
+						ID:   core.StringPtr("foo-reserved-ip-id"),
+						Name: core.StringPtr(machineName),
+					},
+					{
+						ID:   core.StringPtr("bar-reserved-ip-id"),
+						Name: core.StringPtr("bar-machine"),
+					},
+				},
+			}
+			mockvpc.EXPECT().GetInstance(gomock.AssignableToTypeOf(&vpcv1.GetInstanceOptions{})).Return(nil, notFound, errors.New("Instance not found"))
+			mockvpc.EXPECT().ListSubnetReservedIps(gomock.AssignableToTypeOf(&vpcv1.ListSubnetReservedIpsOptions{})).Return(reservedIPCollection, &core.DetailedResponse{}, nil)
+			mockvpc.EXPECT().DeleteSubnetReservedIP(&vpcv1.DeleteSubnetReservedIPOptions{SubnetID: core.StringPtr("foo-subnet-id"), ID: core.StringPtr("foo-reserved-ip-id")}).Return(&core.DetailedResponse{}, nil)
+			err := scope.DeleteMachine()
+			g.Expect(err).To(BeNil())
+		})
+
+		t.Run("Should delete reserved IP of Machine only once the instance is gone", func(t *testing.T) {
+			g := NewWithT(t)
+			mockController, mockvpc := setup(t)
+			t.Cleanup(mockController.Finish)
+			scope := setupMachineScope(clusterName, machineName, mockvpc)
+			scope.IBMVPCMachine.Spec = vpcMachine.Spec
+			scope.IBMVPCMachine.Spec.PrimaryNetworkInterface.Subnet = "foo-subnet-id"
+			scope.IBMVPCMachine.Status = vpcMachine.Status
+
+			// The reserved IP is bound to the network interface of the instance until the instance is gone.
+			mockvpc.EXPECT().GetInstance(gomock.AssignableToTypeOf(&vpcv1.GetInstanceOptions{})).Return(runningInstance, &core.DetailedResponse{}, nil)
+			mockvpc.EXPECT().DeleteInstance(gomock.AssignableToTypeOf(&vpcv1.DeleteInstanceOptions{})).Return(&core.DetailedResponse{}, nil)
+			mockvpc.EXPECT().ListSubnetReservedIps(gomock.Any()).Times(0)
+			err := scope.DeleteMachine()
+			g.Expect(errors.Is(err, ErrInstanceDeleting)).To(BeTrue())
+
+			mockvpc.EXPECT().GetInstance(gomock.AssignableToTypeOf(&vpcv1.GetInstanceOptions{})).Return(deletingInstance, &core.DetailedResponse{}, nil)
+			err = scope.DeleteMachine()
+			g.Expect(errors.Is(err, ErrInstanceDeleting)).To(BeTrue())
+
+			reservedIPCollection := &vpcv1.ReservedIPCollection{
+				ReservedIps: []vpcv1.ReservedIP{
+					{
+						ID:   core.StringPtr("foo-reserved-ip-id"),
+						Name: core.StringPtr(machineName),
+					},
+				},
+			}
+			mockvpc.EXPECT().GetInstance(gomock.AssignableToTypeOf(&vpcv1.GetInstanceOptions{})).Return(nil, notFound, errors.New("Instance not found"))
+			mockvpc.EXPECT().ListSubnetReservedIps(gomock.AssignableToTypeOf(&vpcv1.ListSubnetReservedIpsOptions{})).Return(reservedIPCollection, &core.DetailedResponse{}, nil)
+			mockvpc.EXPECT().DeleteSubnetReservedIP(&vpcv1.DeleteSubnetReservedIPOptions{SubnetID: core.StringPtr("foo-subnet-id"), ID: core.StringPtr("foo-reserved-ip-id")}).Return(&core.DetailedResponse{}, nil)
+			err = scope.DeleteMachine()
+			g.Expect(err).To(BeNil())
+		})
+
+		t.Run("Should delete Machine when no reserved IP exists", func(t *testing.T) {
+			g := NewWithT(t)
+			mockController, mockvpc := setup(t)
+			t.Cleanup(mockController.Finish)
+			scope := setupMachineScope(clusterName, machineName, mockvpc)
+			scope.IBMVPCMachine.Spec = vpcMachine.Spec
+			scope.IBMVPCMachine.Spec.PrimaryNetworkInterface.Subnet = "foo-subnet-id"
+			scope.IBMVPCMachine.Status = vpcMachine.Status
+			mockvpc.EXPECT().GetInstance(gomock.AssignableToTypeOf(&vpcv1.GetInstanceOptions{})).Return(nil, notFound, errors.New("Instance not found"))
+			mockvpc.EXPECT().ListSubnetReservedIps(gomock.AssignableToTypeOf(&vpcv1.ListSubnetReservedIpsOptions{})).Return(&vpcv1.ReservedIPCollection{}, &core.DetailedResponse{}, nil)
+			err := scope.DeleteMachine()
+			g.Expect(err).To(BeNil())
+		})
+
+		t.Run("Should skip reserved IP still bound to a resource", func(t *testing.T) {
+			g := NewWithT(t)
+			mockController, mockvpc := setup(t)
+			t.Cleanup(mockController.Finish)
+			scope := setupMachineScope(clusterName, machineName, mockvpc)
+			scope.IBMVPCMachine.Spec = vpcMachine.Spec
+			scope.IBMVPCMachine.Spec.PrimaryNetworkInterface.Subnet = "foo-subnet-id"
+			scope.IBMVPCMachine.Status = vpcMachine.Status
+			reservedIPCollection := &vpcv1.ReservedIPCollection{
+				ReservedIps: []vpcv1.ReservedIP{
+					{
+						ID:   core.StringPtr("foo-reserved-ip-id"),
+						Name: core.StringPtr(machineName),
+						Target: &vpcv1.ReservedIPTarget{
+							ID: core.StringPtr("foo-network-interface-id"),
+						},
+					},
+				},
+			}
+			mockvpc.EXPECT().GetInstance(gomock.AssignableToTypeOf(&vpcv1.GetInstanceOptions{})).Return(nil, notFound, errors.New("Instance not found"))
+			mockvpc.EXPECT().ListSubnetReservedIps(gomock.AssignableToTypeOf(&vpcv1.ListSubnetReservedIpsOptions{})).Return(reservedIPCollection, &core.DetailedResponse{}, nil)
+			err := scope.DeleteMachine()
+			g.Expect(err).To(BeNil())
+		})
+
+		t.Run("Error when listing reserved IPs fails", func(t *testing.T) {
+			g := NewWithT(t)
+			mockController, mockvpc := setup(t)
+			t.Cleanup(mockController.Finish)
+			scope := setupMachineScope(clusterName, machineName, mockvpc)
+			scope.IBMVPCMachine.Spec = vpcMachine.Spec
+			scope.IBMVPCMachine.Spec.PrimaryNetworkInterface.Subnet = "foo-subnet-id"
+			scope.IBMVPCMachine.Status = vpcMachine.Status
+			mockvpc.EXPECT().GetInstance(gomock.AssignableToTypeOf(&vpcv1.GetInstanceOptions{})).Return(nil, notFound, errors.New("Instance not found"))
+			mockvpc.EXPECT().ListSubnetReservedIps(gomock.AssignableToTypeOf(&vpcv1.ListSubnetReservedIpsOptions{})).Return(nil, &core.DetailedResponse{}, errors.New("failed to list reserved IPs"))
+			err := scope.DeleteMachine()
+			g.Expect(err).To(Not(BeNil()))
+		})
+	})
+
+	t.Run("Should not delete adopted instance", func(t *testing.T) {
+		g := NewWithT(t)
+		mockController, mockvpc := setup(t)
+		t.Cleanup(mockController.Finish)
+		scope := setupMachineScope(clusterName, machineName, mockvpc)
+		scope.IBMVPCMachine.Spec = vpcMachine.Spec
+		scope.IBMVPCMachine.Spec.AdoptExistingInstance = true
+		scope.IBMVPCMachine.Spec.PrimaryNetworkInterface.Subnet = "foo-subnet-id"
+		scope.IBMVPCMachine.Status = vpcMachine.Status
+		err := scope.DeleteMachine()
+		g.Expect(err).To(BeNil())
+	})
+
+	t.Run("Delete Machine with DeletionProtection", func(t *testing.T) {
+		t.Run("Should refuse to delete Machine when DeletionProtection is set", func(t *testing.T) {
+			g := NewWithT(t)
+			mockController, mockvpc := setup(t)
+			t.Cleanup(mockController.Finish)
+			scope := setupMachineScope(clusterName, machineName, mockvpc)
+			scope.IBMVPCMachine.Spec = vpcMachine.Spec
+			scope.IBMVPCMachine.Spec.DeletionProtection = true
+			scope.IBMVPCMachine.Status = vpcMachine.Status
+			mockvpc.EXPECT().DeleteFloatingIP(gomock.Any()).Times(0)
+			mockvpc.EXPECT().DeleteInstance(gomock.Any()).Times(0)
+			err := scope.DeleteMachine()
+			g.Expect(errors.Is(err, ErrDeletionProtected)).To(BeTrue())
+		})
+
+		t.Run("Should delete Machine once DeletionProtection is unset", func(t *testing.T) {
+			g := NewWithT(t)
+			mockController, mockvpc := setup(t)
+			t.Cleanup(mockController.Finish)
+			scope := setupMachineScope(clusterName, machineName, mockvpc)
+			scope.IBMVPCMachine.Spec = vpcMachine.Spec
+			scope.IBMVPCMachine.Spec.DeletionProtection = true
+			scope.IBMVPCMachine.Status = vpcMachine.Status
+			err := scope.DeleteMachine()
+			g.Expect(errors.Is(err, ErrDeletionProtected)).To(BeTrue())
+
+			scope.IBMVPCMachine.Spec.DeletionProtection = false
+			mockvpc.EXPECT().GetInstance(gomock.AssignableToTypeOf(&vpcv1.GetInstanceOptions{})).Return(runningInstance, &core.DetailedResponse{}, nil)
+			mockvpc.EXPECT().DeleteInstance(gomock.AssignableToTypeOf(&vpcv1.DeleteInstanceOptions{})).Return(&core.DetailedResponse{}, nil)
+			err = scope.DeleteMachine()
+			g.Expect(errors.Is(err, ErrInstanceDeleting)).To(BeTrue())
+		})
+
+		t.Run("Should refuse to delete the load balancer pool members when DeletionProtection is set", func(t *testing.T) {
+			g := NewWithT(t)
+			mockController, mockvpc := setup(t)
+			t.Cleanup(mockController.Finish)
+			scope := setupMachineScope(clusterName, machineName, mockvpc)
+			scope.IBMVPCMachine.Spec = vpcMachine.Spec
+			scope.IBMVPCMachine.Spec.DeletionProtection = true
+			scope.IBMVPCMachine.Status = vpcMachine.Status
+			mockvpc.EXPECT().GetLoadBalancer(gomock.Any()).Times(0)
+			err := scope.DeleteVPCLoadBalancerPoolMember()
+			g.Expect(errors.Is(err, ErrDeletionProtected)).To(BeTrue())
+		})
+	})
+
+	t.Run("Delete Machine with StopBeforeDelete", func(t *testing.T) {
+		stoppingInstance := &vpcv1.Instance{Status: core.StringPtr(vpcv1.InstanceStatusStoppingConst)}
+		stoppedInstance := &vpcv1.Instance{Status: core.StringPtr(vpcv1.InstanceStatusStoppedConst)}
+		markStopping := func(scope *MachineScope, since time.Time) {
+			scope.IBMVPCMachine.Status.Conditions = capiv1beta1.Conditions{
+				{
+					Type:               infrav1beta2.InstanceDeletedCondition,
+					Status:             corev1.ConditionFalse,
+					Reason:             infrav1beta2.InstanceStoppingReason,
+					LastTransitionTime: metav1.NewTime(since),
+				},
+			}
+		}
+
+		t.Run("Should stop the instance and requeue the deletion", func(t *testing.T) {
+			g := NewWithT(t)
+			mockController, mockvpc := setup(t)
+			t.Cleanup(mockController.Finish)
+			scope := setupMachineScope(clusterName, machineName, mockvpc)
+			scope.IBMVPCMachine.Spec = vpcMachine.Spec
+			scope.IBMVPCMachine.Spec.StopBeforeDelete = true
+			scope.IBMVPCMachine.Status = vpcMachine.Status
+			mockvpc.EXPECT().GetInstance(gomock.AssignableToTypeOf(&vpcv1.GetInstanceOptions{})).Return(runningInstance, &core.DetailedResponse{}, nil)
+			mockvpc.EXPECT().CreateInstanceAction(&vpcv1.CreateInstanceActionOptions{InstanceID: core.StringPtr("foo-instance-id"), Type: core.StringPtr(vpcv1.CreateInstanceActionOptionsTypeStopConst)}).Return(&vpcv1.InstanceAction{}, &core.DetailedResponse{}, nil)
+			mockvpc.EXPECT().DeleteInstance(gomock.Any()).Times(0)
+			err := scope.DeleteMachine()
+			g.Expect(errors.Is(err, ErrInstanceStopping)).To(BeTrue())
+		})
+
+		t.Run("Should requeue the deletion while the instance is stopping", func(t *testing.T) {
+			g := NewWithT(t)
+			mockController, mockvpc := setup(t)
+			t.Cleanup(mockController.Finish)
+			scope := setupMachineScope(clusterName, machineName, mockvpc)
+			scope.IBMVPCMachine.Spec = vpcMachine.Spec
+			scope.IBMVPCMachine.Spec.StopBeforeDelete = true
+			scope.IBMVPCMachine.Status = vpcMachine.Status
+			markStopping(scope, time.Now())
+			mockvpc.EXPECT().GetInstance(gomock.AssignableToTypeOf(&vpcv1.GetInstanceOptions{})).Return(stoppingInstance, &core.DetailedResponse{}, nil)
+			mockvpc.EXPECT().CreateInstanceAction(gomock.Any()).Times(0)
+			mockvpc.EXPECT().DeleteInstance(gomock.Any()).Times(0)
+			err := scope.DeleteMachine()
+			g.Expect(errors.Is(err, ErrInstanceStopping)).To(BeTrue())
+		})
+
+		t.Run("Should delete the instance once it is stopped", func(t *testing.T) {
+			g := NewWithT(t)
+			mockController, mockvpc := setup(t)
+			t.Cleanup(mockController.Finish)
+			scope := setupMachineScope(clusterName, machineName, mockvpc)
+			scope.IBMVPCMachine.Spec = vpcMachine.Spec
+			scope.IBMVPCMachine.Spec.StopBeforeDelete = true
+			scope.IBMVPCMachine.Status = vpcMachine.Status
+			markStopping(scope, time.Now())
+			mockvpc.EXPECT().GetInstance(&vpcv1.GetInstanceOptions{ID: core.StringPtr("foo-instance-id")}).Return(stoppedInstance, &core.DetailedResponse{}, nil)
+			mockvpc.EXPECT().DeleteInstance(gomock.AssignableToTypeOf(&vpcv1.DeleteInstanceOptions{})).Return(&core.DetailedResponse{}, nil)
+			err := scope.DeleteMachine()
+			g.Expect(errors.Is(err, ErrInstanceDeleting)).To(BeTrue())
+		})
+
+		t.Run("Should delete the instance when it does not stop before timeout", func(t *testing.T) {
+			g := NewWithT(t)
+			mockController, mockvpc := setup(t)
+			t.Cleanup(mockController.Finish)
+			scope := setupMachineScope(clusterName, machineName, mockvpc)
+			scope.IBMVPCMachine.Spec = vpcMachine.Spec
+			scope.IBMVPCMachine.Spec.StopBeforeDelete = true
+			scope.IBMVPCMachine.Status = vpcMachine.Status
+			markStopping(scope, time.Now().Add(-instanceStopTimeout-time.Minute))
+			mockvpc.EXPECT().GetInstance(gomock.AssignableToTypeOf(&vpcv1.GetInstanceOptions{})).Return(stoppingInstance, &core.DetailedResponse{}, nil)
+			mockvpc.EXPECT().DeleteInstance(gomock.AssignableToTypeOf(&vpcv1.DeleteInstanceOptions{})).Return(&core.DetailedResponse{}, nil)
+			err := scope.DeleteMachine()
+			g.Expect(errors.Is(err, ErrInstanceDeleting)).To(BeTrue())
+		})
+
+		t.Run("Should delete the instance when stopping it fails", func(t *testing.T) {
+			g := NewWithT(t)
+			mockController, mockvpc := setup(t)
+			t.Cleanup(mockController.Finish)
+			scope := setupMachineScope(clusterName, machineName, mockvpc)
+			scope.IBMVPCMachine.Spec = vpcMachine.Spec
+			scope.IBMVPCMachine.Spec.StopBeforeDelete = true
+			scope.IBMVPCMachine.Status = vpcMachine.Status
+			mockvpc.EXPECT().GetInstance(gomock.AssignableToTypeOf(&vpcv1.GetInstanceOptions{})).Return(runningInstance, &core.DetailedResponse{}, nil)
+			mockvpc.EXPECT().CreateInstanceAction(gomock.AssignableToTypeOf(&vpcv1.CreateInstanceActionOptions{})).Return(nil, &core.DetailedResponse{}, errors.New("failed to stop instance"))
+			mockvpc.EXPECT().DeleteInstance(gomock.AssignableToTypeOf(&vpcv1.DeleteInstanceOptions{})).Return(&core.DetailedResponse{}, nil)
+			err := scope.DeleteMachine()
+			g.Expect(errors.Is(err, ErrInstanceDeleting)).To(BeTrue())
+		})
+	})
+}
+
+func TestFetchSecurityGroupID(t *testing.T) {
+	setup := func(t *testing.T) (*gomock.Controller, *mock.MockVpc, *MachineScope, *clocktesting.FakeClock) {
+		t.Helper()
+		mockController := gomock.NewController(t)
+		mockvpc := mock.NewMockVpc(mockController)
+		fakeClock := clocktesting.NewFakeClock(time.Now())
+		scope := setupMachineScope(clusterName, machineName, mockvpc)
+		scope.securityGroupCache = utils.NewTTLCacheWithClock[string](time.Minute, fakeClock)
+		return mockController, mockvpc, scope, fakeClock
+	}
+
+	securityGroupReference := &infrav1beta2.VPCSecurityGroupReference{Name: core.StringPtr("foo-security-group")}
+	securityGroup := &vpcv1.SecurityGroup{
+		ID:   core.StringPtr("foo-security-group-id"),
+		Name: core.StringPtr("foo-security-group"),
+	}
+
+	t.Run("Should look up security group and cache it on a cache miss", func(t *testing.T) {
+		g := NewWithT(t)
+		mockController, mockvpc, scope, _ := setup(t)
+		t.Cleanup(mockController.Finish)
+		mockvpc.EXPECT().GetSecurityGroupByName("foo-security-group").Return(securityGroup, nil)
+		securityGroupID, err := fetchSecurityGroupID(securityGroupReference, scope)
+		g.Expect(err).To(BeNil())
+		g.Expect(*securityGroupID).To(Equal("foo-security-group-id"))
+		cachedID, found := scope.securityGroupCache.Get(securityGroupCacheKey(scope.IBMVPCCluster.Spec.Region, "foo-security-group"))
+		g.Expect(found).To(BeTrue())
+		g.Expect(cachedID).To(Equal("foo-security-group-id"))
+	})
+
+	t.Run("Should return cached security group within the TTL", func(t *testing.T) {
+		g := NewWithT(t)
+		mockController, mockvpc, scope, _ := setup(t)
+		t.Cleanup(mockController.Finish)
+		mockvpc.EXPECT().GetSecurityGroupByName("foo-security-group").Return(securityGroup, nil).Times(1)
+		for i := 0; i < 2; i++ {
+			securityGroupID, err := fetchSecurityGroupID(securityGroupReference, scope)
+			g.Expect(err).To(BeNil())
+			g.Expect(*securityGroupID).To(Equal("foo-security-group-id"))
+		}
+	})
+
+	t.Run("Should look up security group again after the TTL expires", func(t *testing.T) {
+		g := NewWithT(t)
+		mockController, mockvpc, scope, fakeClock := setup(t)
+		t.Cleanup(mockController.Finish)
+		mockvpc.EXPECT().GetSecurityGroupByName("foo-security-group").Return(securityGroup, nil).Times(2)
+		_, err := fetchSecurityGroupID(securityGroupReference, scope)
+		g.Expect(err).To(BeNil())
+		fakeClock.Step(2 * time.Minute)
+		securityGroupID, err := fetchSecurityGroupID(securityGroupReference, scope)
+		g.Expect(err).To(BeNil())
+		g.Expect(*securityGroupID).To(Equal("foo-security-group-id"))
+	})
+
+	t.Run("Should not cache security group that is not found", func(t *testing.T) {
+		g := NewWithT(t)
+		mockController, mockvpc, scope, _ := setup(t)
+		t.Cleanup(mockController.Finish)
+		mockvpc.EXPECT().GetSecurityGroupByName("foo-security-group").Return(nil, vpc.SecurityGroupByNameNotFound("foo-security-group")).Times(2)
+		for i := 0; i < 2; i++ {
+			_, err := fetchSecurityGroupID(securityGroupReference, scope)
+			g.Expect(err).To(Not(BeNil()))
+		}
+	})
+}
+
+func TestSelectSubnet(t *testing.T) {
+	setup := func(t *testing.T) (*gomock.Controller, *mock.MockVpc) {
+		t.Helper()
+		return gomock.NewController(t), mock.NewMockVpc(gomock.NewController(t))
+	}
+
+	networkStatus := &infrav1beta2.VPCNetworkStatus{
+		ControlPlaneSubnets: []infrav1beta2.Subnet{
+			{
+				ID:   core.StringPtr("foo-subnet-id"),
+				Zone: core.StringPtr("foo-zone-1"),
+			},
+			{
+				ID:   core.StringPtr("bar-subnet-id"),
+				Zone: core.StringPtr("foo-zone-2"),
+			},
+		},
+	}
+
+	t.Run("Should spread machines across zones in turn", func(t *testing.T) {
+		g := NewWithT(t)
+		mockController, mockvpc := setup(t)
+		t.Cleanup(mockController.Finish)
+		c := fake.NewClientBuilder().WithScheme(scheme.Scheme).Build()
+		var selected []string
+		for i := 0; i < 4; i++ {
+			scope := setupMachineScope(clusterName, fmt.Sprintf("%s-%d", machineName, i), mockvpc)
+			scope.Client = c
+			scope.IBMVPCCluster.Status.Network = networkStatus
+			subnetID, err := scope.SelectSubnet()
+			g.Expect(err).To(BeNil())
+			selected = append(selected, subnetID)
+			// The controller records the selected subnet in the machine spec.
+			machine := newVPCMachine(clusterName, scope.IBMVPCMachine.Name)
+			machine.Spec.PrimaryNetworkInterface.Subnet = subnetID
+			g.Expect(c.Create(ctx, machine)).To(Succeed())
+		}
+		g.Expect(selected).To(Equal([]string{"foo-subnet-id", "bar-subnet-id", "foo-subnet-id", "bar-subnet-id"}))
+	})
+
+	t.Run("Should not count machines of other clusters", func(t *testing.T) {
+		g := NewWithT(t)
+		mockController, mockvpc := setup(t)
+		t.Cleanup(mockController.Finish)
+		otherMachine := newVPCMachine("bar-cluster", "bar-machine")
+		otherMachine.Spec.PrimaryNetworkInterface.Subnet = "bar-subnet-id"
+		machine := newVPCMachine(clusterName, "foo-machine-0")
+		machine.Spec.PrimaryNetworkInterface.Subnet = "foo-subnet-id"
+		c := fake.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(otherMachine, machine).Build()
+		scope := setupMachineScope(clusterName, machineName, mockvpc)
+		scope.Client = c
+		scope.IBMVPCCluster.Status.Network = networkStatus
+		subnetID, err := scope.SelectSubnet()
+		g.Expect(err).To(BeNil())
+		g.Expect(subnetID).To(Equal("bar-subnet-id"))
+	})
+
+	t.Run("Should spread machines across zones by available addresses", func(t *testing.T) {
+		g := NewWithT(t)
+		mockController, mockvpc := setup(t)
+		t.Cleanup(mockController.Finish)
+		available := map[string]int64{"foo-subnet-id": 250, "bar-subnet-id": 250}
+		mockvpc.EXPECT().GetSubnet(gomock.AssignableToTypeOf(&vpcv1.GetSubnetOptions{})).DoAndReturn(func(options *vpcv1.GetSubnetOptions) (*vpcv1.Subnet, *core.DetailedResponse, error) {
+			return &vpcv1.Subnet{ID: options.ID, AvailableIpv4AddressCount: core.Int64Ptr(available[*options.ID])}, &core.DetailedResponse{}, nil
+		}).Times(8)
+		selected := map[string]int{}
+		for i := 0; i < 4; i++ {
+			scope := setupMachineScope(clusterName, fmt.Sprintf("%s-%d", machineName, i), mockvpc)
+			scope.IBMVPCCluster.Spec.Network = &infrav1beta2.VPCNetworkSpec{
+				SubnetSelectionStrategy: infrav1beta2.SubnetSelectionStrategyLeastLoaded,
+			}
+			scope.IBMVPCCluster.Status.Network = networkStatus
+			subnetID, err := scope.SelectSubnet()
+			g.Expect(err).To(BeNil())
+			selected[subnetID]++
+			// The machine created in the subnet takes one of its addresses.
+			available[subnetID]--
+		}
+		g.Expect(selected).To(Equal(map[string]int{"foo-subnet-id": 2, "bar-subnet-id": 2}))
+	})
+
+	t.Run("Error when getting subnet fails", func(t *testing.T) {
+		g := NewWithT(t)
+		mockController, mockvpc := setup(t)
+		t.Cleanup(mockController.Finish)
+		scope := setupMachineScope(clusterName, machineName, mockvpc)
+		scope.IBMVPCCluster.Spec.Network = &infrav1beta2.VPCNetworkSpec{
+			SubnetSelectionStrategy: infrav1beta2.SubnetSelectionStrategyLeastLoaded,
+		}
+		scope.IBMVPCCluster.Status.Network = networkStatus
+		mockvpc.EXPECT().GetSubnet(gomock.AssignableToTypeOf(&vpcv1.GetSubnetOptions{})).Return(nil, &core.DetailedResponse{}, errors.New("failed to get subnet"))
+		_, err := scope.SelectSubnet()
+		g.Expect(err).To(Not(BeNil()))
+	})
+
+	t.Run("Should fall back to the cluster subnet", func(t *testing.T) {
+		g := NewWithT(t)
+		mockController, mockvpc := setup(t)
+		t.Cleanup(mockController.Finish)
+		scope := setupMachineScope(clusterName, machineName, mockvpc)
+		scope.IBMVPCCluster.Status.Subnet.ID = core.StringPtr("foo-subnet-id")
+		subnetID, err := scope.SelectSubnet()
+		g.Expect(err).To(BeNil())
+		g.Expect(subnetID).To(Equal("foo-subnet-id"))
+	})
+
+	t.Run("Should return empty subnet when the cluster has no subnet", func(t *testing.T) {
+		g := NewWithT(t)
+		mockController, mockvpc := setup(t)
+		t.Cleanup(mockController.Finish)
+		scope := setupMachineScope(clusterName, machineName, mockvpc)
+		subnetID, err := scope.SelectSubnet()
+		g.Expect(err).To(BeNil())
+		g.Expect(subnetID).To(BeEmpty())
+	})
+}
+
+func TestEnsureFloatingIP(t *testing.T) {
+	setup := func(t *testing.T) (*gomock.Controller, *mock.MockVpc, *gtmock.MockGlobalTagging) {
+		t.Helper()
+		ctrl := gomock.NewController(t)
+		return ctrl, mock.NewMockVpc(ctrl), gtmock.NewMockGlobalTagging(ctrl)
+	}
+
+	instance := &vpcv1.Instance{
+		ID: core.StringPtr("foo-instance-id"),
+		PrimaryNetworkInterface: &vpcv1.NetworkInterfaceInstanceContextReference{
+			ID: core.StringPtr("foo-network-interface-id"),
+			PrimaryIP: &vpcv1.ReservedIPReference{
+				Address: core.StringPtr("192.168.1.1"),
+			},
+		},
+	}
+	floatingIP := &vpcv1.FloatingIP{
+		ID:      core.StringPtr("foo-fip-id"),
+		CRN:     core.StringPtr("foo-fip-crn"),
+		Name:    core.StringPtr("foo-machine-fip"),
+		Address: core.StringPtr("169.48.1.1"),
+	}
+	ownerTag := fmt.Sprintf("ibmvpcmachine:%s.default.%s", clusterName, machineName)
+	ownedTagList := &globaltaggingv1.TagList{
+		Items: []globaltaggingv1.Tag{
+			{
+				Name: core.StringPtr(ownerTag),
+			},
+		},
+	}
+	unownedTagList := &globaltaggingv1.TagList{
+		Items: []globaltaggingv1.Tag{
+			{
+				Name: core.StringPtr("env:dev"),
+			},
+		},
+	}
+
+	t.Run("Should not create floating IP when PublicIP is not set", func(t *testing.T) {
+		g := NewWithT(t)
+		mockController, mockvpc, _ := setup(t)
+		t.Cleanup(mockController.Finish)
+		scope := setupMachineScope(clusterName, machineName, mockvpc)
+		err := scope.EnsureFloatingIP(instance)
+		g.Expect(err).To(BeNil())
+		g.Expect(scope.IBMVPCMachine.Status.Addresses).To(BeEmpty())
+	})
+
+	t.Run("Should create and tag floating IP", func(t *testing.T) {
+		g := NewWithT(t)
+		mockController, mockvpc, mockgt := setup(t)
+		t.Cleanup(mockController.Finish)
+		scope := setupMachineScope(clusterName, machineName, mockvpc)
+		scope.GlobalTaggingClient = mockgt
+		scope.Machine.Spec.ClusterName = clusterName
+		scope.IBMVPCMachine.Spec.PublicIP = true
+		mockvpc.EXPECT().GetFloatingIPByName("foo-machine-fip").Return(nil, nil)
+		mockvpc.EXPECT().CreateFloatingIP(gomock.AssignableToTypeOf(&vpcv1.CreateFloatingIPOptions{})).DoAndReturn(func(options *vpcv1.CreateFloatingIPOptions) (*vpcv1.FloatingIP, *core.DetailedResponse, error) {
+			prototype := options.FloatingIPPrototype.(*vpcv1.FloatingIPPrototypeFloatingIPByTarget)
+			g.Expect(*prototype.Name).To(Equal("foo-machine-fip"))
+			g.Expect(*prototype.Target.(*vpcv1.FloatingIPTargetPrototype).ID).To(Equal("foo-network-interface-id"))
+			return floatingIP, &core.DetailedResponse{}, nil
+		})
+		mockgt.EXPECT().AttachTag(gomock.AssignableToTypeOf(&globaltaggingv1.AttachTagOptions{})).DoAndReturn(func(options *globaltaggingv1.AttachTagOptions) (*globaltaggingv1.TagResults, *core.DetailedResponse, error) {
+			g.Expect(*options.Resources[0].ResourceID).To(Equal("foo-fip-crn"))
+			g.Expect(options.TagNames).To(Equal([]string{ownerTag}))
+			return &globaltaggingv1.TagResults{}, &core.DetailedResponse{}, nil
+		})
+		err := scope.EnsureFloatingIP(instance)
+		g.Expect(err).To(BeNil())
+		g.Expect(scope.IBMVPCMachine.Status.Addresses).To(ContainElement(corev1.NodeAddress{Type: corev1.NodeExternalIP, Address: "169.48.1.1"}))
+	})
+
+	t.Run("Should release floating IP when tagging fails", func(t *testing.T) {
+		g := NewWithT(t)
+		mockController, mockvpc, mockgt := setup(t)
+		t.Cleanup(mockController.Finish)
+		scope := setupMachineScope(clusterName, machineName, mockvpc)
+		scope.GlobalTaggingClient = mockgt
+		scope.Machine.Spec.ClusterName = clusterName
+		scope.IBMVPCMachine.Spec.PublicIP = true
+		mockvpc.EXPECT().GetFloatingIPByName("foo-machine-fip").Return(nil, nil)
+		mockvpc.EXPECT().CreateFloatingIP(gomock.AssignableToTypeOf(&vpcv1.CreateFloatingIPOptions{})).Return(floatingIP, &core.DetailedResponse{}, nil)
+		mockgt.EXPECT().AttachTag(gomock.AssignableToTypeOf(&globaltaggingv1.AttachTagOptions{})).Return(nil, &core.DetailedResponse{}, errors.New("Failed to attach tags"))
+		mockvpc.EXPECT().DeleteFloatingIP(gomock.AssignableToTypeOf(&vpcv1.DeleteFloatingIPOptions{})).DoAndReturn(func(options *vpcv1.DeleteFloatingIPOptions) (*core.DetailedResponse, error) {
+			g.Expect(*options.ID).To(Equal("foo-fip-id"))
+			return &core.DetailedResponse{}, nil
+		})
+		err := scope.EnsureFloatingIP(instance)
+		g.Expect(err).To(Not(BeNil()))
+		g.Expect(scope.IBMVPCMachine.Status.Addresses).To(BeEmpty())
+	})
+
+	t.Run("Should reuse existing floating IP owned by the machine", func(t *testing.T) {
+		g := NewWithT(t)
+		mockController, mockvpc, mockgt := setup(t)
+		t.Cleanup(mockController.Finish)
+		scope := setupMachineScope(clusterName, machineName, mockvpc)
+		scope.GlobalTaggingClient = mockgt
+		scope.Machine.Spec.ClusterName = clusterName
+		scope.IBMVPCMachine.Spec.PublicIP = true
+		mockvpc.EXPECT().GetFloatingIPByName("foo-machine-fip").Return(floatingIP, nil).Times(2)
+		mockgt.EXPECT().ListTags(gomock.AssignableToTypeOf(&globaltaggingv1.ListTagsOptions{})).DoAndReturn(func(options *globaltaggingv1.ListTagsOptions) (*globaltaggingv1.TagList, *core.DetailedResponse, error) {
+			g.Expect(*options.AttachedTo).To(Equal("foo-fip-crn"))
+			return ownedTagList, &core.DetailedResponse{}, nil
+		}).Times(2)
+		g.Expect(scope.EnsureFloatingIP(instance)).To(Succeed())
+		g.Expect(scope.EnsureFloatingIP(instance)).To(Succeed())
+		g.Expect(scope.IBMVPCMachine.Status.Addresses).To(HaveLen(1))
+	})
+
+	t.Run("Error when existing floating IP is not owned by the machine", func(t *testing.T) {
+		g := NewWithT(t)
+		mockController, mockvpc, mockgt := setup(t)
+		t.Cleanup(mockController.Finish)
+		scope := setupMachineScope(clusterName, machineName, mockvpc)
+		scope.GlobalTaggingClient = mockgt
+		scope.Machine.Spec.ClusterName = clusterName
+		scope.IBMVPCMachine.Spec.PublicIP = true
+		mockvpc.EXPECT().GetFloatingIPByName("foo-machine-fip").Return(floatingIP, nil)
+		mockgt.EXPECT().ListTags(gomock.AssignableToTypeOf(&globaltaggingv1.ListTagsOptions{})).Return(unownedTagList, &core.DetailedResponse{}, nil)
+		err := scope.EnsureFloatingIP(instance)
+		g.Expect(err).To(MatchError(ContainSubstring("is not tagged with")))
+		g.Expect(scope.IBMVPCMachine.Status.Addresses).To(BeEmpty())
+	})
+
+	t.Run("Error when creating floating IP", func(t *testing.T) {
+		g := NewWithT(t)
+		mockController, mockvpc, _ := setup(t)
+		t.Cleanup(mockController.Finish)
+		scope := setupMachineScope(clusterName, machineName, mockvpc)
+		scope.IBMVPCMachine.Spec.PublicIP = true
+		mockvpc.EXPECT().GetFloatingIPByName("foo-machine-fip").Return(nil, nil)
+		mockvpc.EXPECT().CreateFloatingIP(gomock.AssignableToTypeOf(&vpcv1.CreateFloatingIPOptions{})).Return(nil, &core.DetailedResponse{}, errors.New("Failed to create floating IP"))
+		err := scope.EnsureFloatingIP(instance)
+		g.Expect(err).To(Not(BeNil()))
+	})
+
+	t.Run("Should delete floating IP when deleting Machine", func(t *testing.T) {
+		g := NewWithT(t)
+		mockController, mockvpc, mockgt := setup(t)
+		t.Cleanup(mockController.Finish)
+		scope := setupMachineScope(clusterName, machineName, mockvpc)
+		scope.GlobalTaggingClient = mockgt
+		scope.Machine.Spec.ClusterName = clusterName
+		scope.IBMVPCMachine.Spec.PublicIP = true
+		scope.IBMVPCMachine.Status.InstanceID = "foo-instance-id"
+		mockvpc.EXPECT().GetFloatingIPByName("foo-machine-fip").Return(floatingIP, nil)
+		mockgt.EXPECT().ListTags(gomock.AssignableToTypeOf(&globaltaggingv1.ListTagsOptions{})).Return(ownedTagList, &core.DetailedResponse{}, nil)
+		mockvpc.EXPECT().DeleteFloatingIP(gomock.AssignableToTypeOf(&vpcv1.DeleteFloatingIPOptions{})).Return(&core.DetailedResponse{}, nil)
+		mockvpc.EXPECT().GetInstance(gomock.AssignableToTypeOf(&vpcv1.GetInstanceOptions{})).Return(nil, &core.DetailedResponse{StatusCode: http.StatusNotFound}, errors.New("Instance not found"))
+		err := scope.DeleteMachine()
+		g.Expect(err).To(BeNil())
+	})
+
+	t.Run("Should not delete floating IP not owned by the machine when deleting Machine", func(t *testing.T) {
+		g := NewWithT(t)
+		mockController, mockvpc, mockgt := setup(t)
+		t.Cleanup(mockController.Finish)
+		scope := setupMachineScope(clusterName, machineName, mockvpc)
+		scope.GlobalTaggingClient = mockgt
+		scope.Machine.Spec.ClusterName = clusterName
+		scope.IBMVPCMachine.Spec.PublicIP = true
+		scope.IBMVPCMachine.Status.InstanceID = "foo-instance-id"
+		mockvpc.EXPECT().GetFloatingIPByName("foo-machine-fip").Return(floatingIP, nil)
+		mockgt.EXPECT().ListTags(gomock.AssignableToTypeOf(&globaltaggingv1.ListTagsOptions{})).Return(unownedTagList, &core.DetailedResponse{}, nil)
+		mockvpc.EXPECT().GetInstance(gomock.AssignableToTypeOf(&vpcv1.GetInstanceOptions{})).Return(nil, &core.DetailedResponse{StatusCode: http.StatusNotFound}, errors.New("Instance not found"))
+		err := scope.DeleteMachine()
+		g.Expect(err).To(BeNil())
+	})
+
+	t.Run("Error when deleting floating IP", func(t *testing.T) {
+		g := NewWithT(t)
+		mockController, mockvpc, mockgt := setup(t)
+		t.Cleanup(mockController.Finish)
+		scope := setupMachineScope(clusterName, machineName, mockvpc)
+		scope.GlobalTaggingClient = mockgt
+		scope.Machine.Spec.ClusterName = clusterName
+		scope.IBMVPCMachine.Spec.PublicIP = true
+		scope.IBMVPCMachine.Status.InstanceID = "foo-instance-id"
+		mockvpc.EXPECT().GetFloatingIPByName("foo-machine-fip").Return(floatingIP, nil)
+		mockgt.EXPECT().ListTags(gomock.AssignableToTypeOf(&globaltaggingv1.ListTagsOptions{})).Return(ownedTagList, &core.DetailedResponse{}, nil)
+		mockvpc.EXPECT().DeleteFloatingIP(gomock.AssignableToTypeOf(&vpcv1.DeleteFloatingIPOptions{})).Return(&core.DetailedResponse{}, errors.New("Failed to delete floating IP"))
+		err := scope.DeleteMachine()
+		g.Expect(err).To(Not(BeNil()))
+	})
+}
+
+func TestSetProviderID(t *testing.T) {
+	setup := func(t *testing.T) (*gomock.Controller, *mock.MockVpc) {
+		t.Helper()
+		return gomock.NewController(t), mock.NewMockVpc(gomock.NewController(t))
+	}
+
+	instance := &vpcv1.Instance{
+		ID: core.StringPtr("foo-instance-id"),
+		Zone: &vpcv1.ZoneReference{
+			Name: core.StringPtr("us-south-1"),
+		},
+	}
+
+	t.Cleanup(func() {
+		options.ProviderIDFormat = string(options.ProviderIDFormatV1)
+		options.ProviderIDAccountFromCRN = false
+	})
+
+	t.Run("Should set v1 ProviderID", func(t *testing.T) {
+		g := NewWithT(t)
+		mockController, mockvpc := setup(t)
+		t.Cleanup(mockController.Finish)
+		scope := setupMachineScope(clusterName, machineName, mockvpc)
+		options.ProviderIDFormat = string(options.ProviderIDFormatV1)
+		err := scope.SetProviderID(ctx, instance)
+		g.Expect(err).To(BeNil())
+		g.Expect(*scope.IBMVPCMachine.Spec.ProviderID).To(Equal(fmt.Sprintf("ibmvpc://%s/%s", scope.Machine.Spec.ClusterName, scope.IBMVPCMachine.Name)))
+	})
+
+	t.Run("Should set v3 ProviderID", func(t *testing.T) {
+		g := NewWithT(t)
+		mockController, mockvpc := setup(t)
+		t.Cleanup(mockController.Finish)
+		scope := setupMachineScope(clusterName, machineName, mockvpc)
+		scope.IBMVPCCluster.Spec.Region = "us-south"
+		options.ProviderIDFormat = string(options.ProviderIDFormatV3)
+		err := scope.SetProviderID(ctx, instance)
+		g.Expect(err).To(BeNil())
+		g.Expect(*scope.IBMVPCMachine.Spec.ProviderID).To(Equal("ibmvpc://us-south/us-south-1/foo-instance-id"))
+	})
+
+	t.Run("Error when zone is not set with v3 ProviderID", func(t *testing.T) {
+		g := NewWithT(t)
+		mockController, mockvpc := setup(t)
+		t.Cleanup(mockController.Finish)
+		scope := setupMachineScope(clusterName, machineName, mockvpc)
+		scope.IBMVPCCluster.Spec.Region = "us-south"
+		options.ProviderIDFormat = string(options.ProviderIDFormatV3)
+		err := scope.SetProviderID(ctx, &vpcv1.Instance{
+			ID: core.StringPtr("foo-instance-id"),
+		})
+		g.Expect(err).To(Not(BeNil()))
+		g.Expect(scope.IBMVPCMachine.Spec.ProviderID).To(BeNil())
+	})
+
+	t.Run("Should look up account ID for v2 ProviderID by default", func(t *testing.T) {
+		g := NewWithT(t)
+		mockController, mockvpc := setup(t)
+		t.Cleanup(mockController.Finish)
+		scope := setupMachineScope(clusterName, machineName, mockvpc)
+		t.Setenv("IBMCLOUD_AUTH_TYPE", "bogus")
+		options.ProviderIDFormat = string(options.ProviderIDFormatV2)
+		options.ProviderIDAccountFromCRN = false
+		err := scope.SetProviderID(ctx, &vpcv1.Instance{
+			ID:  core.StringPtr("foo-instance-id"),
+			CRN: core.StringPtr("crn:v1:bluemix:public:is:us-south-1:a/foo-account-id::instance:foo-instance-id"),
+		})
+		g.Expect(err).To(Not(BeNil()))
+		g.Expect(scope.IBMVPCMachine.Spec.ProviderID).To(BeNil())
+	})
+
+	t.Run("Should set v2 ProviderID with the cached account ID", func(t *testing.T) {
+		g := NewWithT(t)
+		mockController, mockvpc := setup(t)
+		t.Cleanup(mockController.Finish)
+		scope := setupMachineScope(clusterName, machineName, mockvpc)
+		scope.IBMVPCCluster.Spec.Region = "us-south"
+		options.ProviderIDFormat = string(options.ProviderIDFormatV2)
+		options.ProviderIDAccountFromCRN = false
+		calls := 0
+		getAccountIDFunc := utils.GetAccountIDFunc
+		utils.GetAccountIDFunc = func(_ context.Context) (string, error) {
+			calls++
+			return "foo-account-id", nil
+		}
+		utils.ResetAccountID()
+		t.Cleanup(func() {
+			utils.GetAccountIDFunc = getAccountIDFunc
+			utils.ResetAccountID()
+		})
+		for i := 0; i < 2; i++ {
+			err := scope.SetProviderID(ctx, &vpcv1.Instance{
+				ID: core.StringPtr("foo-instance-id"),
+			})
+			g.Expect(err).To(BeNil())
+		}
+		g.Expect(*scope.IBMVPCMachine.Spec.ProviderID).To(Equal(fmt.Sprintf("ibm://foo-account-id///%s/foo-instance-id", scope.Machine.Spec.ClusterName)))
+		g.Expect(calls).To(Equal(1))
+	})
+
+	t.Run("Should set v2 ProviderID from instance CRN", func(t *testing.T) {
+		g := NewWithT(t)
+		mockController, mockvpc := setup(t)
+		t.Cleanup(mockController.Finish)
+		scope := setupMachineScope(clusterName, machineName, mockvpc)
+		options.ProviderIDFormat = string(options.ProviderIDFormatV2)
+		options.ProviderIDAccountFromCRN = true
+		err := scope.SetProviderID(ctx, &vpcv1.Instance{
+			ID:  core.StringPtr("foo-instance-id"),
+			CRN: core.StringPtr("crn:v1:bluemix:public:is:us-south-1:a/foo-account-id::instance:foo-instance-id"),
+		})
+		g.Expect(err).To(BeNil())
+		g.Expect(*scope.IBMVPCMachine.Spec.ProviderID).To(Equal(fmt.Sprintf("ibm://foo-account-id///%s/foo-instance-id", scope.Machine.Spec.ClusterName)))
+	})
+
+	t.Run("Should get instance CRN when it is not set for v2 ProviderID", func(t *testing.T) {
+		g := NewWithT(t)
+		mockController, mockvpc := setup(t)
+		t.Cleanup(mockController.Finish)
+		scope := setupMachineScope(clusterName, machineName, mockvpc)
+		options.ProviderIDFormat = string(options.ProviderIDFormatV2)
+		options.ProviderIDAccountFromCRN = true
+		mockvpc.EXPECT().GetInstance(&vpcv1.GetInstanceOptions{ID: core.StringPtr("foo-instance-id")}).Return(&vpcv1.Instance{
+			ID:  core.StringPtr("foo-instance-id"),
+			CRN: core.StringPtr("crn:v1:bluemix:public:is:us-south-1:a/foo-account-id::instance:foo-instance-id"),
+		}, &core.DetailedResponse{}, nil)
+		err := scope.SetProviderID(ctx, instance)
+		g.Expect(err).To(BeNil())
+		g.Expect(*scope.IBMVPCMachine.Spec.ProviderID).To(Equal(fmt.Sprintf("ibm://foo-account-id///%s/foo-instance-id", scope.Machine.Spec.ClusterName)))
+	})
+
+	t.Run("Error when instance CRN is malformed for v2 ProviderID", func(t *testing.T) {
+		g := NewWithT(t)
+		mockController, mockvpc := setup(t)
+		t.Cleanup(mockController.Finish)
+		scope := setupMachineScope(clusterName, machineName, mockvpc)
+		options.ProviderIDFormat = string(options.ProviderIDFormatV2)
+		options.ProviderIDAccountFromCRN = true
+		err := scope.SetProviderID(ctx, &vpcv1.Instance{
+			ID:  core.StringPtr("foo-instance-id"),
+			CRN: core.StringPtr("crn:v1:bluemix:public:is:us-south-1:::instance:foo-instance-id"),
+		})
+		g.Expect(err).To(MatchError(ContainSubstring("failed to get account id from CRN")))
+		g.Expect(scope.IBMVPCMachine.Spec.ProviderID).To(BeNil())
+	})
+}
+
+func TestReconcileTags(t *testing.T) {
+	setup := func(t *testing.T) (*gomock.Controller, *mock.MockVpc, *gtmock.MockGlobalTagging) {
+		t.Helper()
+		ctrl := gomock.NewController(t)
+		return ctrl, mock.NewMockVpc(ctrl), gtmock.NewMockGlobalTagging(ctrl)
+	}
+
+	instance := &vpcv1.Instance{
+		ID:  core.StringPtr("foo-instance-id"),
+		CRN: core.StringPtr("foo-instance-crn"),
+	}
+
+	t.Run("Should not attach tags when Tags is not set", func(t *testing.T) {
+		g := NewWithT(t)
+		mockController, mockvpc, mockgt := setup(t)
+		t.Cleanup(mockController.Finish)
+		scope := setupMachineScope(clusterName, machineName, mockvpc)
+		scope.GlobalTaggingClient = mockgt
+		err := scope.ReconcileTags(instance)
+		g.Expect(err).To(BeNil())
+	})
+
+	t.Run("Should attach missing tags", func(t *testing.T) {
+		g := NewWithT(t)
+		mockController, mockvpc, mockgt := setup(t)
+		t.Cleanup(mockController.Finish)
+		scope := setupMachineScope(clusterName, machineName, mockvpc)
+		scope.GlobalTaggingClient = mockgt
+		scope.IBMVPCMachine.Spec.Tags = []string{"env:dev", "team:foo"}
+		tagList := &globaltaggingv1.TagList{
+			Items: []globaltaggingv1.Tag{
+				{
+					Name: core.StringPtr("env:dev"),
+				},
+			},
+		}
+		mockgt.EXPECT().ListTags(gomock.AssignableToTypeOf(&globaltaggingv1.ListTagsOptions{})).Return(tagList, &core.DetailedResponse{}, nil)
+		mockgt.EXPECT().AttachTag(gomock.AssignableToTypeOf(&globaltaggingv1.AttachTagOptions{})).DoAndReturn(func(options *globaltaggingv1.AttachTagOptions) (*globaltaggingv1.TagResults, *core.DetailedResponse, error) {
+			g.Expect(*options.Resources[0].ResourceID).To(Equal("foo-instance-crn"))
+			g.Expect(options.TagNames).To(Equal([]string{"team:foo"}))
+			return &globaltaggingv1.TagResults{}, &core.DetailedResponse{}, nil
+		})
+		err := scope.ReconcileTags(instance)
+		g.Expect(err).To(BeNil())
+	})
+
+	t.Run("Should not attach tags when all tags are already attached", func(t *testing.T) {
+		g := NewWithT(t)
+		mockController, mockvpc, mockgt := setup(t)
+		t.Cleanup(mockController.Finish)
+		scope := setupMachineScope(clusterName, machineName, mockvpc)
+		scope.GlobalTaggingClient = mockgt
+		scope.IBMVPCMachine.Spec.Tags = []string{"env:dev"}
+		tagList := &globaltaggingv1.TagList{
+			Items: []globaltaggingv1.Tag{
+				{
+					Name: core.StringPtr("env:dev"),
+				},
+			},
+		}
+		mockgt.EXPECT().ListTags(gomock.AssignableToTypeOf(&globaltaggingv1.ListTagsOptions{})).Return(tagList, &core.DetailedResponse{}, nil)
+		err := scope.ReconcileTags(instance)
+		g.Expect(err).To(BeNil())
+	})
+
+	t.Run("Should not detach unmanaged tags when PruneTags is not set", func(t *testing.T) {
+		g := NewWithT(t)
+		mockController, mockvpc, mockgt := setup(t)
+		t.Cleanup(mockController.Finish)
+		scope := setupMachineScope(clusterName, machineName, mockvpc)
+		scope.GlobalTaggingClient = mockgt
+		scope.IBMVPCMachine.Spec.Tags = []string{"env:dev"}
+		tagList := &globaltaggingv1.TagList{
+			Items: []globaltaggingv1.Tag{
+				{
+					Name: core.StringPtr("env:dev"),
+				},
+				{
+					Name: core.StringPtr("owner:bar"),
+				},
+			},
+		}
+		mockgt.EXPECT().ListTags(gomock.AssignableToTypeOf(&globaltaggingv1.ListTagsOptions{})).Return(tagList, &core.DetailedResponse{}, nil)
+		err := scope.ReconcileTags(instance)
+		g.Expect(err).To(BeNil())
+	})
+
+	t.Run("Should attach missing tags and detach unmanaged tags when PruneTags is set", func(t *testing.T) {
+		g := NewWithT(t)
+		mockController, mockvpc, mockgt := setup(t)
+		t.Cleanup(mockController.Finish)
+		scope := setupMachineScope(clusterName, machineName, mockvpc)
+		scope.GlobalTaggingClient = mockgt
+		scope.IBMVPCMachine.Spec.Tags = []string{"env:dev", "team:foo"}
+		scope.IBMVPCMachine.Spec.PruneTags = true
+		tagList := &globaltaggingv1.TagList{
+			Items: []globaltaggingv1.Tag{
+				{
+					Name: core.StringPtr("env:dev"),
+				},
+				{
+					Name: core.StringPtr("owner:bar"),
+				},
+			},
+		}
+		mockgt.EXPECT().ListTags(gomock.AssignableToTypeOf(&globaltaggingv1.ListTagsOptions{})).Return(tagList, &core.DetailedResponse{}, nil)
+		mockgt.EXPECT().AttachTag(gomock.AssignableToTypeOf(&globaltaggingv1.AttachTagOptions{})).DoAndReturn(func(options *globaltaggingv1.AttachTagOptions) (*globaltaggingv1.TagResults, *core.DetailedResponse, error) {
+			g.Expect(options.TagNames).To(Equal([]string{"team:foo"}))
+			return &globaltaggingv1.TagResults{}, &core.DetailedResponse{}, nil
+		})
+		mockgt.EXPECT().DetachTag(gomock.AssignableToTypeOf(&globaltaggingv1.DetachTagOptions{})).DoAndReturn(func(options *globaltaggingv1.DetachTagOptions) (*globaltaggingv1.TagResults, *core.DetailedResponse, error) {
+			g.Expect(*options.Resources[0].ResourceID).To(Equal("foo-instance-crn"))
+			g.Expect(options.TagNames).To(Equal([]string{"owner:bar"}))
+			g.Expect(*options.TagType).To(Equal(globaltaggingv1.DetachTagOptionsTagTypeUserConst))
+			return &globaltaggingv1.TagResults{}, &core.DetailedResponse{}, nil
+		})
+		err := scope.ReconcileTags(instance)
+		g.Expect(err).To(BeNil())
+	})
+
+	t.Run("Should detach all tags when PruneTags is set without Tags", func(t *testing.T) {
+		g := NewWithT(t)
+		mockController, mockvpc, mockgt := setup(t)
+		t.Cleanup(mockController.Finish)
+		scope := setupMachineScope(clusterName, machineName, mockvpc)
+		scope.GlobalTaggingClient = mockgt
+		scope.IBMVPCMachine.Spec.PruneTags = true
+		tagList := &globaltaggingv1.TagList{
+			Items: []globaltaggingv1.Tag{
+				{
+					Name: core.StringPtr("owner:bar"),
+				},
+			},
+		}
+		mockgt.EXPECT().ListTags(gomock.AssignableToTypeOf(&globaltaggingv1.ListTagsOptions{})).Return(tagList, &core.DetailedResponse{}, nil)
+		mockgt.EXPECT().DetachTag(gomock.AssignableToTypeOf(&globaltaggingv1.DetachTagOptions{})).DoAndReturn(func(options *globaltaggingv1.DetachTagOptions) (*globaltaggingv1.TagResults, *core.DetailedResponse, error) {
+			g.Expect(options.TagNames).To(Equal([]string{"owner:bar"}))
+			return &globaltaggingv1.TagResults{}, &core.DetailedResponse{}, nil
+		})
+		err := scope.ReconcileTags(instance)
+		g.Expect(err).To(BeNil())
+	})
+
+	t.Run("Error when listing tags fails", func(t *testing.T) {
+		g := NewWithT(t)
+		mockController, mockvpc, mockgt := setup(t)
+		t.Cleanup(mockController.Finish)
+		scope := setupMachineScope(clusterName, machineName, mockvpc)
+		scope.GlobalTaggingClient = mockgt
+		scope.IBMVPCMachine.Spec.Tags = []string{"env:dev"}
+		mockgt.EXPECT().ListTags(gomock.AssignableToTypeOf(&globaltaggingv1.ListTagsOptions{})).Return(nil, &core.DetailedResponse{}, errors.New("failed to list tags"))
+		err := scope.ReconcileTags(instance)
+		g.Expect(err).To(Not(BeNil()))
+	})
+
+	t.Run("Error when attaching tags fails", func(t *testing.T) {
+		g := NewWithT(t)
+		mockController, mockvpc, mockgt := setup(t)
+		t.Cleanup(mockController.Finish)
+		scope := setupMachineScope(clusterName, machineName, mockvpc)
+		scope.GlobalTaggingClient = mockgt
+		scope.IBMVPCMachine.Spec.Tags = []string{"env:dev"}
+		mockgt.EXPECT().ListTags(gomock.AssignableToTypeOf(&globaltaggingv1.ListTagsOptions{})).Return(&globaltaggingv1.TagList{}, &core.DetailedResponse{}, nil)
+		mockgt.EXPECT().AttachTag(gomock.AssignableToTypeOf(&globaltaggingv1.AttachTagOptions{})).Return(nil, &core.DetailedResponse{}, errors.New("failed to attach tags"))
+		err := scope.ReconcileTags(instance)
+		g.Expect(err).To(Not(BeNil()))
+	})
+
+	t.Run("Error when attach tag result reports an error", func(t *testing.T) {
+		g := NewWithT(t)
+		mockController, mockvpc, mockgt := setup(t)
+		t.Cleanup(mockController.Finish)
+		scope := setupMachineScope(clusterName, machineName, mockvpc)
+		scope.GlobalTaggingClient = mockgt
+		scope.IBMVPCMachine.Spec.Tags = []string{"env:dev"}
+		tagResults := &globaltaggingv1.TagResults{
+			Results: []globaltaggingv1.TagResultsItem{
+				{
+					ResourceID: core.StringPtr("foo-instance-crn"),
+					IsError:    core.BoolPtr(true),
+				},
+			},
+		}
+		mockgt.EXPECT().ListTags(gomock.AssignableToTypeOf(&globaltaggingv1.ListTagsOptions{})).Return(&globaltaggingv1.TagList{}, &core.DetailedResponse{}, nil)
+		mockgt.EXPECT().AttachTag(gomock.AssignableToTypeOf(&globaltaggingv1.AttachTagOptions{})).Return(tagResults, &core.DetailedResponse{}, nil)
+		err := scope.ReconcileTags(instance)
+		g.Expect(err).To(Not(BeNil()))
+	})
+
+	t.Run("Error when detaching tags fails", func(t *testing.T) {
+		g := NewWithT(t)
+		mockController, mockvpc, mockgt := setup(t)
+		t.Cleanup(mockController.Finish)
+		scope := setupMachineScope(clusterName, machineName, mockvpc)
+		scope.GlobalTaggingClient = mockgt
+		scope.IBMVPCMachine.Spec.PruneTags = true
+		tagList := &globaltaggingv1.TagList{
+			Items: []globaltaggingv1.Tag{
+				{
+					Name: core.StringPtr("owner:bar"),
+				},
+			},
+		}
+		mockgt.EXPECT().ListTags(gomock.AssignableToTypeOf(&globaltaggingv1.ListTagsOptions{})).Return(tagList, &core.DetailedResponse{}, nil)
+		mockgt.EXPECT().DetachTag(gomock.AssignableToTypeOf(&globaltaggingv1.DetachTagOptions{})).Return(nil, &core.DetailedResponse{}, errors.New("failed to detach tags"))
+		err := scope.ReconcileTags(instance)
+		g.Expect(err).To(Not(BeNil()))
+	})
+}
+
+func TestCreateVPCLoadBalancerPoolMember(t *testing.T) {
+	setup := func(t *testing.T) (*gomock.Controller, *mock.MockVpc) {
+		t.Helper()
+		return gomock.NewController(t), mock.NewMockVpc(gomock.NewController(t))
+	}
+
+	vpcMachine := infrav1beta2.IBMVPCMachine{
+		Spec: infrav1beta2.IBMVPCMachineSpec{
+			Name: "foo-machine",
+		},
+		Status: infrav1beta2.IBMVPCMachineStatus{
+			Addresses: []corev1.NodeAddress{
+				{
+					Type:    corev1.NodeInternalIP,
+					Address: "192.168.1.1",
+				},
+			},
+		},
+	}
+
+	t.Run("Create VPCLoadBalancerPoolMember", func(t *testing.T) {
+		loadBalancer := &vpcv1.LoadBalancer{
+			ID:                 core.StringPtr("foo-load-balancer-id"),
+			ProvisioningStatus: core.StringPtr("active"),
+			Pools: []vpcv1.LoadBalancerPoolReference{
+				{
+					ID: core.StringPtr("foo-load-balancer-pool-id"),
+				},
+			},
+		}
+
+		t.Run("Error when fetching LoadBalancer", func(t *testing.T) {
+			g := NewWithT(t)
+			mockController, mockvpc := setup(t)
+			t.Cleanup(mockController.Finish)
+			scope := setupMachineScope(clusterName, machineName, mockvpc)
+			scope.IBMVPCMachine.Spec = vpcMachine.Spec
+			scope.IBMVPCMachine.Status = vpcMachine.Status
+			mockvpc.EXPECT().GetLoadBalancer(gomock.AssignableToTypeOf(&vpcv1.GetLoadBalancerOptions{})).Return(&vpcv1.LoadBalancer{}, &core.DetailedResponse{}, errors.New("Could not fetch LoadBalancer"))
+			_, err := scope.CreateVPCLoadBalancerPoolMember(&scope.IBMVPCMachine.Status.Addresses[0].Address, int64(infrav1beta2.DefaultAPIServerPort), nil, "")
+			g.Expect(err).To(Not(BeNil()))
+		})
+		t.Run("Error when LoadBalancer is not active", func(t *testing.T) {
+			g := NewWithT(t)
+			mockController, mockvpc := setup(t)
+			t.Cleanup(mockController.Finish)
+			scope := setupMachineScope(clusterName, machineName, mockvpc)
+			scope.IBMVPCMachine.Spec = vpcMachine.Spec
+			scope.IBMVPCMachine.Status = vpcMachine.Status
+			loadBalancer := &vpcv1.LoadBalancer{
+				ID:                 core.StringPtr("foo-load-balancer-id"),
+				ProvisioningStatus: core.StringPtr("pending"),
+			}
+			mockvpc.EXPECT().GetLoadBalancer(gomock.AssignableToTypeOf(&vpcv1.GetLoadBalancerOptions{})).Return(loadBalancer, &core.DetailedResponse{}, nil)
+			_, err := scope.CreateVPCLoadBalancerPoolMember(&scope.IBMVPCMachine.Status.Addresses[0].Address, int64(infrav1beta2.DefaultAPIServerPort), nil, "")
+			g.Expect(err).To(Not(BeNil()))
+			g.Expect(errors.Is(err, ErrLoadBalancerNotReady)).To(BeFalse())
+		})
+		t.Run("Return ErrLoadBalancerNotReady when LoadBalancer is update_pending", func(t *testing.T) {
+			g := NewWithT(t)
+			mockController, mockvpc := setup(t)
+			t.Cleanup(mockController.Finish)
+			scope := setupMachineScope(clusterName, machineName, mockvpc)
+			scope.IBMVPCMachine.Spec = vpcMachine.Spec
+			scope.IBMVPCMachine.Status = vpcMachine.Status
+			loadBalancer := &vpcv1.LoadBalancer{
+				ID:                 core.StringPtr("foo-load-balancer-id"),
+				ProvisioningStatus: core.StringPtr("update_pending"),
+			}
+			mockvpc.EXPECT().GetLoadBalancer(gomock.AssignableToTypeOf(&vpcv1.GetLoadBalancerOptions{})).Return(loadBalancer, &core.DetailedResponse{}, nil)
+			_, err := scope.CreateVPCLoadBalancerPoolMember(&scope.IBMVPCMachine.Status.Addresses[0].Address, int64(infrav1beta2.DefaultAPIServerPort), nil, "")
+			g.Expect(errors.Is(err, ErrLoadBalancerNotReady)).To(BeTrue())
+		})
+		t.Run("Error when no pool exist", func(t *testing.T) {
+			g := NewWithT(t)
+			mockController, mockvpc := setup(t)
+			t.Cleanup(mockController.Finish)
+			scope := setupMachineScope(clusterName, machineName, mockvpc)
+			scope.IBMVPCMachine.Spec = vpcMachine.Spec
 			scope.IBMVPCMachine.Status = vpcMachine.Status
 			loadBalancer := &vpcv1.LoadBalancer{
 				ID:                 core.StringPtr("foo-load-balancer-id"),
@@ -585,7 +3493,7 @@ func TestCreateVPCLoadBalancerPoolMember(t *testing.T) {
 				Pools:              []vpcv1.LoadBalancerPoolReference{},
 			}
 			mockvpc.EXPECT().GetLoadBalancer(gomock.AssignableToTypeOf(&vpcv1.GetLoadBalancerOptions{})).Return(loadBalancer, &core.DetailedResponse{}, nil)
-			_, err := scope.CreateVPCLoadBalancerPoolMember(&scope.IBMVPCMachine.Status.Addresses[0].Address, int64(infrav1beta2.DefaultAPIServerPort))
+			_, err := scope.CreateVPCLoadBalancerPoolMember(&scope.IBMVPCMachine.Status.Addresses[0].Address, int64(infrav1beta2.DefaultAPIServerPort), nil, "")
 			g.Expect(err).To(Not(BeNil()))
 		})
 		t.Run("Error when listing LoadBalancerPoolMembers", func(t *testing.T) {
@@ -597,32 +3505,127 @@ func TestCreateVPCLoadBalancerPoolMember(t *testing.T) {
 			scope.IBMVPCMachine.Status = vpcMachine.Status
 			mockvpc.EXPECT().GetLoadBalancer(gomock.AssignableToTypeOf(&vpcv1.GetLoadBalancerOptions{})).Return(loadBalancer, &core.DetailedResponse{}, nil)
 			mockvpc.EXPECT().ListLoadBalancerPoolMembers(gomock.AssignableToTypeOf(&vpcv1.ListLoadBalancerPoolMembersOptions{})).Return(&vpcv1.LoadBalancerPoolMemberCollection{}, &core.DetailedResponse{}, errors.New("Failed to list LoadBalancerPoolMembers"))
-			_, err := scope.CreateVPCLoadBalancerPoolMember(&scope.IBMVPCMachine.Status.Addresses[0].Address, int64(infrav1beta2.DefaultAPIServerPort))
+			_, err := scope.CreateVPCLoadBalancerPoolMember(&scope.IBMVPCMachine.Status.Addresses[0].Address, int64(infrav1beta2.DefaultAPIServerPort), nil, "")
+			g.Expect(err).To(Not(BeNil()))
+		})
+		t.Run("PoolMember already exist", func(t *testing.T) {
+			g := NewWithT(t)
+			mockController, mockvpc := setup(t)
+			t.Cleanup(mockController.Finish)
+			scope := setupMachineScope(clusterName, machineName, mockvpc)
+			scope.IBMVPCMachine.Spec = vpcMachine.Spec
+			scope.IBMVPCMachine.Status = vpcMachine.Status
+			loadBalancerPoolMemberCollection := &vpcv1.LoadBalancerPoolMemberCollection{
+				Members: []vpcv1.LoadBalancerPoolMember{
+					{
+						Port:   core.Int64Ptr(int64(infrav1beta2.DefaultAPIServerPort)),
+						Weight: core.Int64Ptr(10),
+						Target: &vpcv1.LoadBalancerPoolMemberTarget{
+							Address: core.StringPtr("192.168.1.1"),
+						},
+					},
+				},
+			}
+			mockvpc.EXPECT().GetLoadBalancer(gomock.AssignableToTypeOf(&vpcv1.GetLoadBalancerOptions{})).Return(loadBalancer, &core.DetailedResponse{}, nil)
+			mockvpc.EXPECT().ListLoadBalancerPoolMembers(gomock.AssignableToTypeOf(&vpcv1.ListLoadBalancerPoolMembersOptions{})).Return(loadBalancerPoolMemberCollection, &core.DetailedResponse{}, nil)
+			_, err := scope.CreateVPCLoadBalancerPoolMember(&scope.IBMVPCMachine.Status.Addresses[0].Address, int64(infrav1beta2.DefaultAPIServerPort), nil, "")
+			g.Expect(err).To(BeNil())
+		})
+		t.Run("Error when creating LoadBalancerPoolMember", func(t *testing.T) {
+			g := NewWithT(t)
+			mockController, mockvpc := setup(t)
+			t.Cleanup(mockController.Finish)
+			scope := setupMachineScope(clusterName, machineName, mockvpc)
+			scope.IBMVPCMachine.Spec = vpcMachine.Spec
+			scope.IBMVPCMachine.Status = vpcMachine.Status
+			mockvpc.EXPECT().GetLoadBalancer(gomock.AssignableToTypeOf(&vpcv1.GetLoadBalancerOptions{})).Return(loadBalancer, &core.DetailedResponse{}, nil)
+			mockvpc.EXPECT().ListLoadBalancerPoolMembers(gomock.AssignableToTypeOf(&vpcv1.ListLoadBalancerPoolMembersOptions{})).Return(&vpcv1.LoadBalancerPoolMemberCollection{}, &core.DetailedResponse{}, nil)
+			mockvpc.EXPECT().CreateLoadBalancerPoolMember(gomock.AssignableToTypeOf(&vpcv1.CreateLoadBalancerPoolMemberOptions{})).Return(&vpcv1.LoadBalancerPoolMember{}, &core.DetailedResponse{}, errors.New("Failed to create LoadBalancerPoolMember"))
+			_, err := scope.CreateVPCLoadBalancerPoolMember(&scope.IBMVPCMachine.Status.Addresses[0].Address, int64(64), nil, "")
 			g.Expect(err).To(Not(BeNil()))
 		})
-		t.Run("PoolMember already exist", func(t *testing.T) {
+		t.Run("Should create VPCLoadBalancerPoolMember", func(t *testing.T) {
+			g := NewWithT(t)
+			mockController, mockvpc := setup(t)
+			t.Cleanup(mockController.Finish)
+			scope := setupMachineScope(clusterName, machineName, mockvpc)
+			expectedOutput := &vpcv1.LoadBalancerPoolMember{
+				ID:   core.StringPtr("foo-load-balancer-pool-member-id"),
+				Port: core.Int64Ptr(int64(infrav1beta2.DefaultAPIServerPort)),
+			}
+			scope.IBMVPCMachine.Spec = vpcMachine.Spec
+			scope.IBMVPCMachine.Status = vpcMachine.Status
+			loadBalancerPoolMember := &vpcv1.LoadBalancerPoolMember{
+				ID:   core.StringPtr("foo-load-balancer-pool-member-id"),
+				Port: core.Int64Ptr(int64(infrav1beta2.DefaultAPIServerPort)),
+			}
+			mockvpc.EXPECT().GetLoadBalancer(gomock.AssignableToTypeOf(&vpcv1.GetLoadBalancerOptions{})).Return(loadBalancer, &core.DetailedResponse{}, nil)
+			mockvpc.EXPECT().ListLoadBalancerPoolMembers(gomock.AssignableToTypeOf(&vpcv1.ListLoadBalancerPoolMembersOptions{})).Return(&vpcv1.LoadBalancerPoolMemberCollection{}, &core.DetailedResponse{}, nil)
+			mockvpc.EXPECT().CreateLoadBalancerPoolMember(gomock.AssignableToTypeOf(&vpcv1.CreateLoadBalancerPoolMemberOptions{})).Return(loadBalancerPoolMember, &core.DetailedResponse{}, nil)
+			out, err := scope.CreateVPCLoadBalancerPoolMember(&scope.IBMVPCMachine.Status.Addresses[0].Address, int64(infrav1beta2.DefaultAPIServerPort), nil, "")
+			g.Expect(err).To(BeNil())
+			require.Equal(t, expectedOutput, out)
+			g.Expect(scope.IBMVPCMachine.Status.LoadBalancerPoolMemberID).To(Equal("foo-load-balancer-pool-member-id"))
+		})
+		t.Run("Should create VPCLoadBalancerPoolMember in named pool", func(t *testing.T) {
+			g := NewWithT(t)
+			mockController, mockvpc := setup(t)
+			t.Cleanup(mockController.Finish)
+			scope := setupMachineScope(clusterName, machineName, mockvpc)
+			scope.IBMVPCMachine.Spec = vpcMachine.Spec
+			scope.IBMVPCMachine.Status = vpcMachine.Status
+			multiPoolLoadBalancer := &vpcv1.LoadBalancer{
+				ID:                 core.StringPtr("foo-load-balancer-id"),
+				ProvisioningStatus: core.StringPtr("active"),
+				Pools: []vpcv1.LoadBalancerPoolReference{
+					{
+						ID:   core.StringPtr("foo-load-balancer-pool-id"),
+						Name: core.StringPtr("foo-load-balancer-pool"),
+					},
+					{
+						ID:   core.StringPtr("bar-load-balancer-pool-id"),
+						Name: core.StringPtr("bar-load-balancer-pool"),
+					},
+				},
+			}
+			mockvpc.EXPECT().GetLoadBalancer(gomock.AssignableToTypeOf(&vpcv1.GetLoadBalancerOptions{})).Return(multiPoolLoadBalancer, &core.DetailedResponse{}, nil)
+			mockvpc.EXPECT().ListLoadBalancerPoolMembers(gomock.AssignableToTypeOf(&vpcv1.ListLoadBalancerPoolMembersOptions{})).DoAndReturn(func(options *vpcv1.ListLoadBalancerPoolMembersOptions) (*vpcv1.LoadBalancerPoolMemberCollection, *core.DetailedResponse, error) {
+				g.Expect(*options.PoolID).To(Equal("bar-load-balancer-pool-id"))
+				return &vpcv1.LoadBalancerPoolMemberCollection{}, &core.DetailedResponse{}, nil
+			})
+			mockvpc.EXPECT().CreateLoadBalancerPoolMember(gomock.AssignableToTypeOf(&vpcv1.CreateLoadBalancerPoolMemberOptions{})).DoAndReturn(func(options *vpcv1.CreateLoadBalancerPoolMemberOptions) (*vpcv1.LoadBalancerPoolMember, *core.DetailedResponse, error) {
+				g.Expect(*options.PoolID).To(Equal("bar-load-balancer-pool-id"))
+				return &vpcv1.LoadBalancerPoolMember{ID: core.StringPtr("foo-load-balancer-pool-member-id")}, &core.DetailedResponse{}, nil
+			})
+			_, err := scope.CreateVPCLoadBalancerPoolMember(&scope.IBMVPCMachine.Status.Addresses[0].Address, int64(infrav1beta2.DefaultAPIServerPort), nil, "bar-load-balancer-pool")
+			g.Expect(err).To(BeNil())
+		})
+		t.Run("Error when named pool does not exist", func(t *testing.T) {
 			g := NewWithT(t)
 			mockController, mockvpc := setup(t)
 			t.Cleanup(mockController.Finish)
 			scope := setupMachineScope(clusterName, machineName, mockvpc)
 			scope.IBMVPCMachine.Spec = vpcMachine.Spec
 			scope.IBMVPCMachine.Status = vpcMachine.Status
-			loadBalancerPoolMemberCollection := &vpcv1.LoadBalancerPoolMemberCollection{
-				Members: []vpcv1.LoadBalancerPoolMember{
+			multiPoolLoadBalancer := &vpcv1.LoadBalancer{
+				ID:                 core.StringPtr("foo-load-balancer-id"),
+				ProvisioningStatus: core.StringPtr("active"),
+				Pools: []vpcv1.LoadBalancerPoolReference{
 					{
-						Port: core.Int64Ptr(int64(infrav1beta2.DefaultAPIServerPort)),
-						Target: &vpcv1.LoadBalancerPoolMemberTarget{
-							Address: core.StringPtr("192.168.1.1"),
-						},
+						ID:   core.StringPtr("foo-load-balancer-pool-id"),
+						Name: core.StringPtr("foo-load-balancer-pool"),
+					},
+					{
+						ID:   core.StringPtr("bar-load-balancer-pool-id"),
+						Name: core.StringPtr("bar-load-balancer-pool"),
 					},
 				},
 			}
-			mockvpc.EXPECT().GetLoadBalancer(gomock.AssignableToTypeOf(&vpcv1.GetLoadBalancerOptions{})).Return(loadBalancer, &core.DetailedResponse{}, nil)
-			mockvpc.EXPECT().ListLoadBalancerPoolMembers(gomock.AssignableToTypeOf(&vpcv1.ListLoadBalancerPoolMembersOptions{})).Return(loadBalancerPoolMemberCollection, &core.DetailedResponse{}, nil)
-			_, err := scope.CreateVPCLoadBalancerPoolMember(&scope.IBMVPCMachine.Status.Addresses[0].Address, int64(infrav1beta2.DefaultAPIServerPort))
-			g.Expect(err).To(BeNil())
+			mockvpc.EXPECT().GetLoadBalancer(gomock.AssignableToTypeOf(&vpcv1.GetLoadBalancerOptions{})).Return(multiPoolLoadBalancer, &core.DetailedResponse{}, nil)
+			_, err := scope.CreateVPCLoadBalancerPoolMember(&scope.IBMVPCMachine.Status.Addresses[0].Address, int64(infrav1beta2.DefaultAPIServerPort), nil, "baz-load-balancer-pool")
+			g.Expect(err).To(MatchError(ContainSubstring("pool baz-load-balancer-pool not found")))
 		})
-		t.Run("Error when creating LoadBalancerPoolMember", func(t *testing.T) {
+		t.Run("Should create VPCLoadBalancerPoolMember with weight", func(t *testing.T) {
 			g := NewWithT(t)
 			mockController, mockvpc := setup(t)
 			t.Cleanup(mockController.Finish)
@@ -631,35 +3634,241 @@ func TestCreateVPCLoadBalancerPoolMember(t *testing.T) {
 			scope.IBMVPCMachine.Status = vpcMachine.Status
 			mockvpc.EXPECT().GetLoadBalancer(gomock.AssignableToTypeOf(&vpcv1.GetLoadBalancerOptions{})).Return(loadBalancer, &core.DetailedResponse{}, nil)
 			mockvpc.EXPECT().ListLoadBalancerPoolMembers(gomock.AssignableToTypeOf(&vpcv1.ListLoadBalancerPoolMembersOptions{})).Return(&vpcv1.LoadBalancerPoolMemberCollection{}, &core.DetailedResponse{}, nil)
-			mockvpc.EXPECT().CreateLoadBalancerPoolMember(gomock.AssignableToTypeOf(&vpcv1.CreateLoadBalancerPoolMemberOptions{})).Return(&vpcv1.LoadBalancerPoolMember{}, &core.DetailedResponse{}, errors.New("Failed to create LoadBalancerPoolMember"))
-			_, err := scope.CreateVPCLoadBalancerPoolMember(&scope.IBMVPCMachine.Status.Addresses[0].Address, int64(64))
-			g.Expect(err).To(Not(BeNil()))
+			mockvpc.EXPECT().CreateLoadBalancerPoolMember(gomock.AssignableToTypeOf(&vpcv1.CreateLoadBalancerPoolMemberOptions{})).DoAndReturn(func(options *vpcv1.CreateLoadBalancerPoolMemberOptions) (*vpcv1.LoadBalancerPoolMember, *core.DetailedResponse, error) {
+				g.Expect(options.Weight).To(Equal(core.Int64Ptr(10)))
+				return &vpcv1.LoadBalancerPoolMember{ID: core.StringPtr("foo-load-balancer-pool-member-id")}, &core.DetailedResponse{}, nil
+			})
+			_, err := scope.CreateVPCLoadBalancerPoolMember(&scope.IBMVPCMachine.Status.Addresses[0].Address, int64(infrav1beta2.DefaultAPIServerPort), core.Int64Ptr(10), "")
+			g.Expect(err).To(BeNil())
 		})
-		t.Run("Should create VPCLoadBalancerPoolMember", func(t *testing.T) {
+		t.Run("Should create VPCLoadBalancerPoolMember with default weight", func(t *testing.T) {
 			g := NewWithT(t)
 			mockController, mockvpc := setup(t)
 			t.Cleanup(mockController.Finish)
 			scope := setupMachineScope(clusterName, machineName, mockvpc)
-			expectedOutput := &vpcv1.LoadBalancerPoolMember{
-				ID:   core.StringPtr("foo-load-balancer-pool-member-id"),
-				Port: core.Int64Ptr(int64(infrav1beta2.DefaultAPIServerPort)),
-			}
 			scope.IBMVPCMachine.Spec = vpcMachine.Spec
 			scope.IBMVPCMachine.Status = vpcMachine.Status
-			loadBalancerPoolMember := &vpcv1.LoadBalancerPoolMember{
-				ID:   core.StringPtr("foo-load-balancer-pool-member-id"),
-				Port: core.Int64Ptr(int64(infrav1beta2.DefaultAPIServerPort)),
-			}
 			mockvpc.EXPECT().GetLoadBalancer(gomock.AssignableToTypeOf(&vpcv1.GetLoadBalancerOptions{})).Return(loadBalancer, &core.DetailedResponse{}, nil)
 			mockvpc.EXPECT().ListLoadBalancerPoolMembers(gomock.AssignableToTypeOf(&vpcv1.ListLoadBalancerPoolMembersOptions{})).Return(&vpcv1.LoadBalancerPoolMemberCollection{}, &core.DetailedResponse{}, nil)
-			mockvpc.EXPECT().CreateLoadBalancerPoolMember(gomock.AssignableToTypeOf(&vpcv1.CreateLoadBalancerPoolMemberOptions{})).Return(loadBalancerPoolMember, &core.DetailedResponse{}, nil)
-			out, err := scope.CreateVPCLoadBalancerPoolMember(&scope.IBMVPCMachine.Status.Addresses[0].Address, int64(infrav1beta2.DefaultAPIServerPort))
+			mockvpc.EXPECT().CreateLoadBalancerPoolMember(gomock.AssignableToTypeOf(&vpcv1.CreateLoadBalancerPoolMemberOptions{})).DoAndReturn(func(options *vpcv1.CreateLoadBalancerPoolMemberOptions) (*vpcv1.LoadBalancerPoolMember, *core.DetailedResponse, error) {
+				g.Expect(options.Weight).To(Equal(core.Int64Ptr(50)))
+				return &vpcv1.LoadBalancerPoolMember{ID: core.StringPtr("foo-load-balancer-pool-member-id")}, &core.DetailedResponse{}, nil
+			})
+			_, err := scope.CreateVPCLoadBalancerPoolMember(&scope.IBMVPCMachine.Status.Addresses[0].Address, int64(infrav1beta2.DefaultAPIServerPort), nil, "")
 			g.Expect(err).To(BeNil())
-			require.Equal(t, expectedOutput, out)
 		})
 	})
 }
 
+func TestCreateVPCLoadBalancerPoolMembers(t *testing.T) {
+	setup := func(t *testing.T) (*gomock.Controller, *mock.MockVpc) {
+		t.Helper()
+		return gomock.NewController(t), mock.NewMockVpc(gomock.NewController(t))
+	}
+
+	loadBalancer := &vpcv1.LoadBalancer{
+		ID:                 core.StringPtr("foo-load-balancer-id"),
+		ProvisioningStatus: core.StringPtr("active"),
+		Pools: []vpcv1.LoadBalancerPoolReference{
+			{
+				ID:   core.StringPtr("foo-load-balancer-pool-id"),
+				Name: core.StringPtr("foo-load-balancer-pool"),
+			},
+			{
+				ID:   core.StringPtr("bar-load-balancer-pool-id"),
+				Name: core.StringPtr("bar-load-balancer-pool"),
+			},
+		},
+	}
+	targets := []infrav1beta2.VPCLoadBalancerPoolMemberTarget{
+		{
+			Port: int64(infrav1beta2.DefaultAPIServerPort),
+		},
+		{
+			PoolName: "bar-load-balancer-pool",
+			Port:     443,
+			Weight:   core.Int64Ptr(80),
+		},
+	}
+
+	t.Run("Should register instance in all the pools", func(t *testing.T) {
+		g := NewWithT(t)
+		mockController, mockvpc := setup(t)
+		t.Cleanup(mockController.Finish)
+		scope := setupMachineScope(clusterName, machineName, mockvpc)
+		mockvpc.EXPECT().GetLoadBalancer(gomock.AssignableToTypeOf(&vpcv1.GetLoadBalancerOptions{})).Return(loadBalancer, &core.DetailedResponse{}, nil).Times(2)
+		mockvpc.EXPECT().ListLoadBalancerPoolMembers(gomock.AssignableToTypeOf(&vpcv1.ListLoadBalancerPoolMembersOptions{})).Return(&vpcv1.LoadBalancerPoolMemberCollection{}, &core.DetailedResponse{}, nil).Times(2)
+		mockvpc.EXPECT().CreateLoadBalancerPoolMember(gomock.AssignableToTypeOf(&vpcv1.CreateLoadBalancerPoolMemberOptions{})).DoAndReturn(func(options *vpcv1.CreateLoadBalancerPoolMemberOptions) (*vpcv1.LoadBalancerPoolMember, *core.DetailedResponse, error) {
+			if *options.PoolID == "bar-load-balancer-pool-id" {
+				g.Expect(*options.Port).To(Equal(int64(443)))
+				g.Expect(*options.Weight).To(Equal(int64(80)))
+				return &vpcv1.LoadBalancerPoolMember{ID: core.StringPtr("bar-load-balancer-pool-member-id")}, &core.DetailedResponse{}, nil
+			}
+			g.Expect(*options.Port).To(Equal(int64(infrav1beta2.DefaultAPIServerPort)))
+			g.Expect(*options.Weight).To(Equal(int64(50)))
+			return &vpcv1.LoadBalancerPoolMember{ID: core.StringPtr("foo-load-balancer-pool-member-id")}, &core.DetailedResponse{}, nil
+		}).Times(2)
+		members, err := scope.CreateVPCLoadBalancerPoolMembers(core.StringPtr("192.168.1.1"), targets)
+		g.Expect(err).To(BeNil())
+		g.Expect(members).To(HaveLen(2))
+		g.Expect(scope.IBMVPCMachine.Status.LoadBalancerPoolMemberID).To(Equal("foo-load-balancer-pool-member-id"))
+		g.Expect(scope.IBMVPCMachine.Status.LoadBalancerPoolMembers).To(Equal([]infrav1beta2.VPCLoadBalancerPoolMemberReference{
+			{PoolID: "foo-load-balancer-pool-id", ID: "foo-load-balancer-pool-member-id"},
+			{PoolID: "bar-load-balancer-pool-id", ID: "bar-load-balancer-pool-member-id"},
+		}))
+	})
+	t.Run("Should only register instance in the pools it is missing from", func(t *testing.T) {
+		g := NewWithT(t)
+		mockController, mockvpc := setup(t)
+		t.Cleanup(mockController.Finish)
+		scope := setupMachineScope(clusterName, machineName, mockvpc)
+		mockvpc.EXPECT().GetLoadBalancer(gomock.AssignableToTypeOf(&vpcv1.GetLoadBalancerOptions{})).Return(loadBalancer, &core.DetailedResponse{}, nil).Times(2)
+		mockvpc.EXPECT().ListLoadBalancerPoolMembers(gomock.AssignableToTypeOf(&vpcv1.ListLoadBalancerPoolMembersOptions{})).DoAndReturn(func(options *vpcv1.ListLoadBalancerPoolMembersOptions) (*vpcv1.LoadBalancerPoolMemberCollection, *core.DetailedResponse, error) {
+			if *options.PoolID == "bar-load-balancer-pool-id" {
+				return &vpcv1.LoadBalancerPoolMemberCollection{}, &core.DetailedResponse{}, nil
+			}
+			return &vpcv1.LoadBalancerPoolMemberCollection{
+				Members: []vpcv1.LoadBalancerPoolMember{
+					{
+						ID:     core.StringPtr("foo-load-balancer-pool-member-id"),
+						Port:   core.Int64Ptr(int64(infrav1beta2.DefaultAPIServerPort)),
+						Target: &vpcv1.LoadBalancerPoolMemberTarget{Address: core.StringPtr("192.168.1.1")},
+					},
+				},
+			}, &core.DetailedResponse{}, nil
+		}).Times(2)
+		mockvpc.EXPECT().CreateLoadBalancerPoolMember(gomock.AssignableToTypeOf(&vpcv1.CreateLoadBalancerPoolMemberOptions{})).DoAndReturn(func(options *vpcv1.CreateLoadBalancerPoolMemberOptions) (*vpcv1.LoadBalancerPoolMember, *core.DetailedResponse, error) {
+			g.Expect(*options.PoolID).To(Equal("bar-load-balancer-pool-id"))
+			return &vpcv1.LoadBalancerPoolMember{ID: core.StringPtr("bar-load-balancer-pool-member-id")}, &core.DetailedResponse{}, nil
+		})
+		members, err := scope.CreateVPCLoadBalancerPoolMembers(core.StringPtr("192.168.1.1"), targets)
+		g.Expect(err).To(BeNil())
+		g.Expect(members).To(HaveLen(1))
+		g.Expect(scope.IBMVPCMachine.Status.LoadBalancerPoolMembers).To(HaveLen(2))
+	})
+	t.Run("Return ErrLoadBalancerNotReady when load balancer is updating after the first member", func(t *testing.T) {
+		g := NewWithT(t)
+		mockController, mockvpc := setup(t)
+		t.Cleanup(mockController.Finish)
+		scope := setupMachineScope(clusterName, machineName, mockvpc)
+		updatingLoadBalancer := *loadBalancer
+		updatingLoadBalancer.ProvisioningStatus = core.StringPtr("update_pending")
+		gomock.InOrder(
+			mockvpc.EXPECT().GetLoadBalancer(gomock.AssignableToTypeOf(&vpcv1.GetLoadBalancerOptions{})).Return(loadBalancer, &core.DetailedResponse{}, nil),
+			mockvpc.EXPECT().GetLoadBalancer(gomock.AssignableToTypeOf(&vpcv1.GetLoadBalancerOptions{})).Return(&updatingLoadBalancer, &core.DetailedResponse{}, nil),
+		)
+		mockvpc.EXPECT().ListLoadBalancerPoolMembers(gomock.AssignableToTypeOf(&vpcv1.ListLoadBalancerPoolMembersOptions{})).Return(&vpcv1.LoadBalancerPoolMemberCollection{}, &core.DetailedResponse{}, nil)
+		mockvpc.EXPECT().CreateLoadBalancerPoolMember(gomock.AssignableToTypeOf(&vpcv1.CreateLoadBalancerPoolMemberOptions{})).Return(&vpcv1.LoadBalancerPoolMember{ID: core.StringPtr("foo-load-balancer-pool-member-id")}, &core.DetailedResponse{}, nil)
+		_, err := scope.CreateVPCLoadBalancerPoolMembers(core.StringPtr("192.168.1.1"), targets)
+		g.Expect(errors.Is(err, ErrLoadBalancerNotReady)).To(BeTrue())
+		g.Expect(scope.IBMVPCMachine.Status.LoadBalancerPoolMembers).To(HaveLen(1))
+	})
+}
+
+func TestLoadBalancerPoolMemberTargets(t *testing.T) {
+	t.Run("Should default control plane machine to the API server pool", func(t *testing.T) {
+		g := NewWithT(t)
+		scope := setupMachineScope(clusterName, machineName, mock.NewMockVpc(gomock.NewController(t)))
+		scope.IBMVPCMachine.Labels = map[string]string{capiv1beta1.MachineControlPlaneNameLabel: "foo-control-plane"}
+		g.Expect(scope.LoadBalancerPoolMemberTargets()).To(Equal([]infrav1beta2.VPCLoadBalancerPoolMemberTarget{{Port: int64(scope.APIServerPort())}}))
+	})
+	t.Run("Should not register worker machine by default", func(t *testing.T) {
+		g := NewWithT(t)
+		scope := setupMachineScope(clusterName, machineName, mock.NewMockVpc(gomock.NewController(t)))
+		g.Expect(scope.LoadBalancerPoolMemberTargets()).To(BeEmpty())
+	})
+	t.Run("Should use the pools set in the spec", func(t *testing.T) {
+		g := NewWithT(t)
+		scope := setupMachineScope(clusterName, machineName, mock.NewMockVpc(gomock.NewController(t)))
+		scope.IBMVPCMachine.Spec.LoadBalancerPoolMembers = []infrav1beta2.VPCLoadBalancerPoolMemberTarget{{PoolName: "ingress-pool", Port: 443}}
+		g.Expect(scope.LoadBalancerPoolMemberTargets()).To(Equal([]infrav1beta2.VPCLoadBalancerPoolMemberTarget{{PoolName: "ingress-pool", Port: 443}}))
+	})
+}
+
+func TestWaitForLoadBalancerActive(t *testing.T) {
+	setup := func(t *testing.T) (*gomock.Controller, *mock.MockVpc) {
+		t.Helper()
+		return gomock.NewController(t), mock.NewMockVpc(gomock.NewController(t))
+	}
+
+	backoff := loadBalancerActiveBackoff
+	loadBalancerActiveBackoff = wait.Backoff{Duration: time.Millisecond, Factor: 2, Steps: math.MaxInt32}
+	t.Cleanup(func() {
+		loadBalancerActiveBackoff = backoff
+	})
+
+	newLoadBalancer := func(state string) *vpcv1.LoadBalancer {
+		return &vpcv1.LoadBalancer{
+			ID:                 core.StringPtr("foo-load-balancer-id"),
+			ProvisioningStatus: core.StringPtr(state),
+			Pools: []vpcv1.LoadBalancerPoolReference{
+				{
+					ID: core.StringPtr("foo-load-balancer-pool-id"),
+				},
+			},
+		}
+	}
+
+	t.Run("Should wait for pending load balancer to be active", func(t *testing.T) {
+		g := NewWithT(t)
+		mockController, mockvpc := setup(t)
+		t.Cleanup(mockController.Finish)
+		scope := setupMachineScope(clusterName, machineName, mockvpc)
+		gomock.InOrder(
+			mockvpc.EXPECT().GetLoadBalancer(gomock.AssignableToTypeOf(&vpcv1.GetLoadBalancerOptions{})).Return(newLoadBalancer("create_pending"), &core.DetailedResponse{}, nil),
+			mockvpc.EXPECT().GetLoadBalancer(gomock.AssignableToTypeOf(&vpcv1.GetLoadBalancerOptions{})).Return(newLoadBalancer("update_pending"), &core.DetailedResponse{}, nil),
+			mockvpc.EXPECT().GetLoadBalancer(gomock.AssignableToTypeOf(&vpcv1.GetLoadBalancerOptions{})).Return(newLoadBalancer("active"), &core.DetailedResponse{}, nil),
+		)
+		loadBalancer, err := scope.WaitForLoadBalancerActive(time.Minute)
+		g.Expect(err).To(BeNil())
+		g.Expect(*loadBalancer.ProvisioningStatus).To(Equal("active"))
+	})
+	t.Run("Return ErrLoadBalancerNotReady when load balancer is still pending after the timeout", func(t *testing.T) {
+		g := NewWithT(t)
+		mockController, mockvpc := setup(t)
+		t.Cleanup(mockController.Finish)
+		scope := setupMachineScope(clusterName, machineName, mockvpc)
+		mockvpc.EXPECT().GetLoadBalancer(gomock.AssignableToTypeOf(&vpcv1.GetLoadBalancerOptions{})).Return(newLoadBalancer("update_pending"), &core.DetailedResponse{}, nil).MinTimes(1)
+		_, err := scope.WaitForLoadBalancerActive(50 * time.Millisecond)
+		g.Expect(errors.Is(err, ErrLoadBalancerNotReady)).To(BeTrue())
+	})
+	t.Run("Error when load balancer is in failed state", func(t *testing.T) {
+		g := NewWithT(t)
+		mockController, mockvpc := setup(t)
+		t.Cleanup(mockController.Finish)
+		scope := setupMachineScope(clusterName, machineName, mockvpc)
+		mockvpc.EXPECT().GetLoadBalancer(gomock.AssignableToTypeOf(&vpcv1.GetLoadBalancerOptions{})).Return(newLoadBalancer("failed"), &core.DetailedResponse{}, nil)
+		_, err := scope.WaitForLoadBalancerActive(time.Minute)
+		g.Expect(err).To(Not(BeNil()))
+		g.Expect(errors.Is(err, ErrLoadBalancerNotReady)).To(BeFalse())
+	})
+	t.Run("Error when fetching LoadBalancer", func(t *testing.T) {
+		g := NewWithT(t)
+		mockController, mockvpc := setup(t)
+		t.Cleanup(mockController.Finish)
+		scope := setupMachineScope(clusterName, machineName, mockvpc)
+		mockvpc.EXPECT().GetLoadBalancer(gomock.AssignableToTypeOf(&vpcv1.GetLoadBalancerOptions{})).Return(nil, &core.DetailedResponse{}, errors.New("Could not fetch LoadBalancer"))
+		_, err := scope.WaitForLoadBalancerActive(time.Minute)
+		g.Expect(err).To(MatchError(ContainSubstring("Could not fetch LoadBalancer")))
+	})
+	t.Run("Should create VPCLoadBalancerPoolMember once load balancer is active", func(t *testing.T) {
+		g := NewWithT(t)
+		mockController, mockvpc := setup(t)
+		t.Cleanup(mockController.Finish)
+		scope := setupMachineScope(clusterName, machineName, mockvpc)
+		scope.loadBalancerActiveTimeout = time.Minute
+		gomock.InOrder(
+			mockvpc.EXPECT().GetLoadBalancer(gomock.AssignableToTypeOf(&vpcv1.GetLoadBalancerOptions{})).Return(newLoadBalancer("update_pending"), &core.DetailedResponse{}, nil),
+			mockvpc.EXPECT().GetLoadBalancer(gomock.AssignableToTypeOf(&vpcv1.GetLoadBalancerOptions{})).Return(newLoadBalancer("active"), &core.DetailedResponse{}, nil),
+		)
+		mockvpc.EXPECT().ListLoadBalancerPoolMembers(gomock.AssignableToTypeOf(&vpcv1.ListLoadBalancerPoolMembersOptions{})).Return(&vpcv1.LoadBalancerPoolMemberCollection{}, &core.DetailedResponse{}, nil)
+		mockvpc.EXPECT().CreateLoadBalancerPoolMember(gomock.AssignableToTypeOf(&vpcv1.CreateLoadBalancerPoolMemberOptions{})).Return(&vpcv1.LoadBalancerPoolMember{ID: core.StringPtr("foo-load-balancer-pool-member-id")}, &core.DetailedResponse{}, nil)
+		_, err := scope.CreateVPCLoadBalancerPoolMember(core.StringPtr("192.168.1.1"), int64(infrav1beta2.DefaultAPIServerPort), nil, "")
+		g.Expect(err).To(BeNil())
+		g.Expect(scope.IBMVPCMachine.Status.LoadBalancerPoolMemberID).To(Equal("foo-load-balancer-pool-member-id"))
+	})
+}
+
 func TestDeleteVPCLoadBalancerPoolMember(t *testing.T) {
 	setup := func(t *testing.T) (*gomock.Controller, *mock.MockVpc) {
 		t.Helper()
@@ -738,7 +3947,7 @@ func TestDeleteVPCLoadBalancerPoolMember(t *testing.T) {
 			t.Cleanup(mockController.Finish)
 			scope := setupMachineScope(clusterName, machineName, mockvpc)
 			scope.IBMVPCMachine.Spec = vpcMachine.Spec
-			scope.IBMVPCMachine.Status = vpcMachine.Status
+			scope.IBMVPCMachine.Status = infrav1beta2.IBMVPCMachineStatus{InstanceID: "foo-instance-id"}
 			mockvpc.EXPECT().GetLoadBalancer(gomock.AssignableToTypeOf(&vpcv1.GetLoadBalancerOptions{})).Return(loadBalancer, &core.DetailedResponse{}, nil)
 			mockvpc.EXPECT().GetInstance(gomock.AssignableToTypeOf(&vpcv1.GetInstanceOptions{})).Return(&vpcv1.Instance{}, &core.DetailedResponse{}, errors.New("Failed to fetch Instance"))
 			err := scope.DeleteVPCLoadBalancerPoolMember()
@@ -752,7 +3961,6 @@ func TestDeleteVPCLoadBalancerPoolMember(t *testing.T) {
 			scope.IBMVPCMachine.Spec = vpcMachine.Spec
 			scope.IBMVPCMachine.Status = vpcMachine.Status
 			mockvpc.EXPECT().GetLoadBalancer(gomock.AssignableToTypeOf(&vpcv1.GetLoadBalancerOptions{})).Return(loadBalancer, &core.DetailedResponse{}, nil)
-			mockvpc.EXPECT().GetInstance(gomock.AssignableToTypeOf(&vpcv1.GetInstanceOptions{})).Return(&vpcv1.Instance{}, &core.DetailedResponse{}, nil)
 			mockvpc.EXPECT().ListLoadBalancerPoolMembers(gomock.AssignableToTypeOf(&vpcv1.ListLoadBalancerPoolMembersOptions{})).Return(&vpcv1.LoadBalancerPoolMemberCollection{}, &core.DetailedResponse{}, errors.New("Failed to list LoadBalancerPoolMembers"))
 			err := scope.DeleteVPCLoadBalancerPoolMember()
 			g.Expect(err).To(Not(BeNil()))
@@ -765,7 +3973,6 @@ func TestDeleteVPCLoadBalancerPoolMember(t *testing.T) {
 			scope.IBMVPCMachine.Spec = vpcMachine.Spec
 			scope.IBMVPCMachine.Status = vpcMachine.Status
 			mockvpc.EXPECT().GetLoadBalancer(gomock.AssignableToTypeOf(&vpcv1.GetLoadBalancerOptions{})).Return(loadBalancer, &core.DetailedResponse{}, nil)
-			mockvpc.EXPECT().GetInstance(gomock.AssignableToTypeOf(&vpcv1.GetInstanceOptions{})).Return(&vpcv1.Instance{}, &core.DetailedResponse{}, nil)
 			mockvpc.EXPECT().ListLoadBalancerPoolMembers(gomock.AssignableToTypeOf(&vpcv1.ListLoadBalancerPoolMembersOptions{})).Return(&vpcv1.LoadBalancerPoolMemberCollection{}, &core.DetailedResponse{}, nil)
 			err := scope.DeleteVPCLoadBalancerPoolMember()
 			g.Expect(err).To(BeNil())
@@ -787,11 +3994,31 @@ func TestDeleteVPCLoadBalancerPoolMember(t *testing.T) {
 				},
 			}
 			mockvpc.EXPECT().GetLoadBalancer(gomock.AssignableToTypeOf(&vpcv1.GetLoadBalancerOptions{})).Return(loadBalancer, &core.DetailedResponse{}, nil)
-			mockvpc.EXPECT().GetInstance(gomock.AssignableToTypeOf(&vpcv1.GetInstanceOptions{})).Return(instance, &core.DetailedResponse{}, nil)
 			mockvpc.EXPECT().ListLoadBalancerPoolMembers(gomock.AssignableToTypeOf(&vpcv1.ListLoadBalancerPoolMembersOptions{})).Return(loadBalancerPoolMemberCollection, &core.DetailedResponse{}, nil)
 			err := scope.DeleteVPCLoadBalancerPoolMember()
 			g.Expect(err).To(Not(BeNil()))
 		})
+		t.Run("Return ErrLoadBalancerNotReady when load balancer is update_pending", func(t *testing.T) {
+			g := NewWithT(t)
+			mockController, mockvpc := setup(t)
+			t.Cleanup(mockController.Finish)
+			scope := setupMachineScope(clusterName, machineName, mockvpc)
+			scope.IBMVPCMachine.Spec = vpcMachine.Spec
+			scope.IBMVPCMachine.Status = vpcMachine.Status
+			loadBalancer := &vpcv1.LoadBalancer{
+				ID:                 core.StringPtr("foo-load-balancer-id"),
+				ProvisioningStatus: core.StringPtr("update_pending"),
+				Pools: []vpcv1.LoadBalancerPoolReference{
+					{
+						ID: core.StringPtr("foo-load-balancer-pool-id"),
+					},
+				},
+			}
+			mockvpc.EXPECT().GetLoadBalancer(gomock.AssignableToTypeOf(&vpcv1.GetLoadBalancerOptions{})).Return(loadBalancer, &core.DetailedResponse{}, nil)
+			mockvpc.EXPECT().ListLoadBalancerPoolMembers(gomock.AssignableToTypeOf(&vpcv1.ListLoadBalancerPoolMembersOptions{})).Return(loadBalancerPoolMemberCollection, &core.DetailedResponse{}, nil)
+			err := scope.DeleteVPCLoadBalancerPoolMember()
+			g.Expect(errors.Is(err, ErrLoadBalancerNotReady)).To(BeTrue())
+		})
 		t.Run("Error when deleting load balancer pool member", func(t *testing.T) {
 			g := NewWithT(t)
 			mockController, mockvpc := setup(t)
@@ -800,7 +4027,6 @@ func TestDeleteVPCLoadBalancerPoolMember(t *testing.T) {
 			scope.IBMVPCMachine.Spec = vpcMachine.Spec
 			scope.IBMVPCMachine.Status = vpcMachine.Status
 			mockvpc.EXPECT().GetLoadBalancer(gomock.AssignableToTypeOf(&vpcv1.GetLoadBalancerOptions{})).Return(loadBalancer, &core.DetailedResponse{}, nil)
-			mockvpc.EXPECT().GetInstance(gomock.AssignableToTypeOf(&vpcv1.GetInstanceOptions{})).Return(instance, &core.DetailedResponse{}, nil)
 			mockvpc.EXPECT().ListLoadBalancerPoolMembers(gomock.AssignableToTypeOf(&vpcv1.ListLoadBalancerPoolMembersOptions{})).Return(loadBalancerPoolMemberCollection, &core.DetailedResponse{}, nil)
 			mockvpc.EXPECT().DeleteLoadBalancerPoolMember(gomock.AssignableToTypeOf(&vpcv1.DeleteLoadBalancerPoolMemberOptions{})).Return(&core.DetailedResponse{}, errors.New("Failed to delete LoadBalancerPoolMember"))
 			err := scope.DeleteVPCLoadBalancerPoolMember()
@@ -814,11 +4040,193 @@ func TestDeleteVPCLoadBalancerPoolMember(t *testing.T) {
 			scope.IBMVPCMachine.Spec = vpcMachine.Spec
 			scope.IBMVPCMachine.Status = vpcMachine.Status
 			mockvpc.EXPECT().GetLoadBalancer(gomock.AssignableToTypeOf(&vpcv1.GetLoadBalancerOptions{})).Return(loadBalancer, &core.DetailedResponse{}, nil)
+			mockvpc.EXPECT().ListLoadBalancerPoolMembers(gomock.AssignableToTypeOf(&vpcv1.ListLoadBalancerPoolMembersOptions{})).Return(loadBalancerPoolMemberCollection, &core.DetailedResponse{}, nil)
+			mockvpc.EXPECT().DeleteLoadBalancerPoolMember(gomock.AssignableToTypeOf(&vpcv1.DeleteLoadBalancerPoolMemberOptions{})).Return(&core.DetailedResponse{}, nil)
+			err := scope.DeleteVPCLoadBalancerPoolMember()
+			g.Expect(err).To(BeNil())
+		})
+		t.Run("Should delete load balancer pool member by stored ID", func(t *testing.T) {
+			g := NewWithT(t)
+			mockController, mockvpc := setup(t)
+			t.Cleanup(mockController.Finish)
+			scope := setupMachineScope(clusterName, machineName, mockvpc)
+			scope.IBMVPCMachine.Spec = vpcMachine.Spec
+			scope.IBMVPCMachine.Status = vpcMachine.Status
+			scope.IBMVPCMachine.Status.LoadBalancerPoolMemberID = "foo-lb-pool-member-id"
+			mockvpc.EXPECT().GetLoadBalancer(gomock.AssignableToTypeOf(&vpcv1.GetLoadBalancerOptions{})).Return(loadBalancer, &core.DetailedResponse{}, nil)
+			mockvpc.EXPECT().DeleteLoadBalancerPoolMember(gomock.AssignableToTypeOf(&vpcv1.DeleteLoadBalancerPoolMemberOptions{})).DoAndReturn(func(options *vpcv1.DeleteLoadBalancerPoolMemberOptions) (*core.DetailedResponse, error) {
+				g.Expect(*options.ID).To(Equal("foo-lb-pool-member-id"))
+				return &core.DetailedResponse{}, nil
+			})
+			err := scope.DeleteVPCLoadBalancerPoolMember()
+			g.Expect(err).To(BeNil())
+			g.Expect(scope.IBMVPCMachine.Status.LoadBalancerPoolMemberID).To(BeEmpty())
+		})
+		t.Run("Should ignore not found error when deleting load balancer pool member by stored ID", func(t *testing.T) {
+			g := NewWithT(t)
+			mockController, mockvpc := setup(t)
+			t.Cleanup(mockController.Finish)
+			scope := setupMachineScope(clusterName, machineName, mockvpc)
+			scope.IBMVPCMachine.Spec = vpcMachine.Spec
+			scope.IBMVPCMachine.Status = vpcMachine.Status
+			scope.IBMVPCMachine.Status.LoadBalancerPoolMemberID = "foo-lb-pool-member-id"
+			mockvpc.EXPECT().GetLoadBalancer(gomock.AssignableToTypeOf(&vpcv1.GetLoadBalancerOptions{})).Return(loadBalancer, &core.DetailedResponse{}, nil)
+			mockvpc.EXPECT().DeleteLoadBalancerPoolMember(gomock.AssignableToTypeOf(&vpcv1.DeleteLoadBalancerPoolMemberOptions{})).Return(&core.DetailedResponse{StatusCode: http.StatusNotFound}, errors.New("Member not found"))
+			err := scope.DeleteVPCLoadBalancerPoolMember()
+			g.Expect(err).To(BeNil())
+		})
+		t.Run("Error when deleting load balancer pool member by stored ID", func(t *testing.T) {
+			g := NewWithT(t)
+			mockController, mockvpc := setup(t)
+			t.Cleanup(mockController.Finish)
+			scope := setupMachineScope(clusterName, machineName, mockvpc)
+			scope.IBMVPCMachine.Spec = vpcMachine.Spec
+			scope.IBMVPCMachine.Status = vpcMachine.Status
+			scope.IBMVPCMachine.Status.LoadBalancerPoolMemberID = "foo-lb-pool-member-id"
+			mockvpc.EXPECT().GetLoadBalancer(gomock.AssignableToTypeOf(&vpcv1.GetLoadBalancerOptions{})).Return(loadBalancer, &core.DetailedResponse{}, nil)
+			mockvpc.EXPECT().DeleteLoadBalancerPoolMember(gomock.AssignableToTypeOf(&vpcv1.DeleteLoadBalancerPoolMemberOptions{})).Return(&core.DetailedResponse{}, errors.New("Failed to delete LoadBalancerPoolMember"))
+			err := scope.DeleteVPCLoadBalancerPoolMember()
+			g.Expect(err).To(Not(BeNil()))
+			g.Expect(scope.IBMVPCMachine.Status.LoadBalancerPoolMemberID).To(Equal("foo-lb-pool-member-id"))
+		})
+		t.Run("Should fall back to address match when stored ID is empty", func(t *testing.T) {
+			g := NewWithT(t)
+			mockController, mockvpc := setup(t)
+			t.Cleanup(mockController.Finish)
+			scope := setupMachineScope(clusterName, machineName, mockvpc)
+			scope.IBMVPCMachine.Spec = vpcMachine.Spec
+			scope.IBMVPCMachine.Status = vpcMachine.Status
+			scope.IBMVPCMachine.Status.LoadBalancerPoolMemberID = ""
+			mockvpc.EXPECT().GetLoadBalancer(gomock.AssignableToTypeOf(&vpcv1.GetLoadBalancerOptions{})).Return(loadBalancer, &core.DetailedResponse{}, nil)
+			mockvpc.EXPECT().ListLoadBalancerPoolMembers(gomock.AssignableToTypeOf(&vpcv1.ListLoadBalancerPoolMembersOptions{})).Return(loadBalancerPoolMemberCollection, &core.DetailedResponse{}, nil)
+			mockvpc.EXPECT().DeleteLoadBalancerPoolMember(gomock.AssignableToTypeOf(&vpcv1.DeleteLoadBalancerPoolMemberOptions{})).DoAndReturn(func(options *vpcv1.DeleteLoadBalancerPoolMemberOptions) (*core.DetailedResponse, error) {
+				g.Expect(*options.ID).To(Equal("foo-lb-pool-member-id"))
+				return &core.DetailedResponse{}, nil
+			})
+			err := scope.DeleteVPCLoadBalancerPoolMember()
+			g.Expect(err).To(BeNil())
+		})
+		t.Run("Should delete load balancer pool member by status address when instance is already deleted", func(t *testing.T) {
+			g := NewWithT(t)
+			mockController, mockvpc := setup(t)
+			t.Cleanup(mockController.Finish)
+			scope := setupMachineScope(clusterName, machineName, mockvpc)
+			scope.IBMVPCMachine.Spec = vpcMachine.Spec
+			scope.IBMVPCMachine.Status = vpcMachine.Status
+			mockvpc.EXPECT().GetInstance(gomock.AssignableToTypeOf(&vpcv1.GetInstanceOptions{})).Return(nil, &core.DetailedResponse{StatusCode: http.StatusNotFound}, errors.New("Instance not found")).AnyTimes()
+			mockvpc.EXPECT().GetLoadBalancer(gomock.AssignableToTypeOf(&vpcv1.GetLoadBalancerOptions{})).Return(loadBalancer, &core.DetailedResponse{}, nil)
+			mockvpc.EXPECT().ListLoadBalancerPoolMembers(gomock.AssignableToTypeOf(&vpcv1.ListLoadBalancerPoolMembersOptions{})).Return(loadBalancerPoolMemberCollection, &core.DetailedResponse{}, nil)
+			mockvpc.EXPECT().DeleteLoadBalancerPoolMember(gomock.AssignableToTypeOf(&vpcv1.DeleteLoadBalancerPoolMemberOptions{})).DoAndReturn(func(options *vpcv1.DeleteLoadBalancerPoolMemberOptions) (*core.DetailedResponse, error) {
+				g.Expect(*options.ID).To(Equal("foo-lb-pool-member-id"))
+				return &core.DetailedResponse{}, nil
+			})
+			err := scope.DeleteVPCLoadBalancerPoolMember()
+			g.Expect(err).To(BeNil())
+		})
+		t.Run("Should fall back to instance address when no address is recorded", func(t *testing.T) {
+			g := NewWithT(t)
+			mockController, mockvpc := setup(t)
+			t.Cleanup(mockController.Finish)
+			scope := setupMachineScope(clusterName, machineName, mockvpc)
+			scope.IBMVPCMachine.Spec = vpcMachine.Spec
+			scope.IBMVPCMachine.Status = infrav1beta2.IBMVPCMachineStatus{InstanceID: "foo-instance-id"}
+			mockvpc.EXPECT().GetLoadBalancer(gomock.AssignableToTypeOf(&vpcv1.GetLoadBalancerOptions{})).Return(loadBalancer, &core.DetailedResponse{}, nil)
 			mockvpc.EXPECT().GetInstance(gomock.AssignableToTypeOf(&vpcv1.GetInstanceOptions{})).Return(instance, &core.DetailedResponse{}, nil)
 			mockvpc.EXPECT().ListLoadBalancerPoolMembers(gomock.AssignableToTypeOf(&vpcv1.ListLoadBalancerPoolMembersOptions{})).Return(loadBalancerPoolMemberCollection, &core.DetailedResponse{}, nil)
 			mockvpc.EXPECT().DeleteLoadBalancerPoolMember(gomock.AssignableToTypeOf(&vpcv1.DeleteLoadBalancerPoolMemberOptions{})).Return(&core.DetailedResponse{}, nil)
 			err := scope.DeleteVPCLoadBalancerPoolMember()
 			g.Expect(err).To(BeNil())
 		})
+		t.Run("Should delete load balancer pool member on the custom API server port", func(t *testing.T) {
+			g := NewWithT(t)
+			mockController, mockvpc := setup(t)
+			t.Cleanup(mockController.Finish)
+			scope := setupMachineScope(clusterName, machineName, mockvpc)
+			scope.IBMVPCMachine.Spec = vpcMachine.Spec
+			scope.IBMVPCMachine.Status = vpcMachine.Status
+			scope.Cluster.Spec.ClusterNetwork = &capiv1beta1.ClusterNetwork{APIServerPort: ptr.To(int32(7443))}
+			members := &vpcv1.LoadBalancerPoolMemberCollection{
+				Members: []vpcv1.LoadBalancerPoolMember{
+					{
+						ID:     core.StringPtr("foo-lb-pool-member-id"),
+						Port:   core.Int64Ptr(int64(infrav1beta2.DefaultAPIServerPort)),
+						Target: &vpcv1.LoadBalancerPoolMemberTarget{Address: core.StringPtr("192.168.1.1")},
+					},
+					{
+						ID:     core.StringPtr("bar-lb-pool-member-id"),
+						Port:   core.Int64Ptr(7443),
+						Target: &vpcv1.LoadBalancerPoolMemberTarget{Address: core.StringPtr("192.168.1.1")},
+					},
+				},
+			}
+			mockvpc.EXPECT().GetLoadBalancer(gomock.AssignableToTypeOf(&vpcv1.GetLoadBalancerOptions{})).Return(loadBalancer, &core.DetailedResponse{}, nil)
+			mockvpc.EXPECT().ListLoadBalancerPoolMembers(gomock.AssignableToTypeOf(&vpcv1.ListLoadBalancerPoolMembersOptions{})).Return(members, &core.DetailedResponse{}, nil)
+			mockvpc.EXPECT().DeleteLoadBalancerPoolMember(gomock.AssignableToTypeOf(&vpcv1.DeleteLoadBalancerPoolMemberOptions{})).DoAndReturn(func(options *vpcv1.DeleteLoadBalancerPoolMemberOptions) (*core.DetailedResponse, error) {
+				g.Expect(*options.ID).To(Equal("bar-lb-pool-member-id"))
+				return &core.DetailedResponse{}, nil
+			})
+			err := scope.DeleteVPCLoadBalancerPoolMember()
+			g.Expect(err).To(BeNil())
+		})
+		t.Run("Should delete load balancer pool members from all the pools", func(t *testing.T) {
+			g := NewWithT(t)
+			mockController, mockvpc := setup(t)
+			t.Cleanup(mockController.Finish)
+			scope := setupMachineScope(clusterName, machineName, mockvpc)
+			scope.IBMVPCMachine.Spec = vpcMachine.Spec
+			scope.IBMVPCMachine.Status = vpcMachine.Status
+			scope.IBMVPCMachine.Status.LoadBalancerPoolMemberID = "foo-lb-pool-member-id"
+			scope.IBMVPCMachine.Status.LoadBalancerPoolMembers = []infrav1beta2.VPCLoadBalancerPoolMemberReference{
+				{PoolID: "foo-load-balancer-pool-id", ID: "foo-lb-pool-member-id"},
+				{PoolID: "bar-load-balancer-pool-id", ID: "bar-lb-pool-member-id"},
+			}
+			var deleted []string
+			mockvpc.EXPECT().GetLoadBalancer(gomock.AssignableToTypeOf(&vpcv1.GetLoadBalancerOptions{})).Return(loadBalancer, &core.DetailedResponse{}, nil).Times(2)
+			mockvpc.EXPECT().DeleteLoadBalancerPoolMember(gomock.AssignableToTypeOf(&vpcv1.DeleteLoadBalancerPoolMemberOptions{})).DoAndReturn(func(options *vpcv1.DeleteLoadBalancerPoolMemberOptions) (*core.DetailedResponse, error) {
+				deleted = append(deleted, *options.PoolID+"/"+*options.ID)
+				return &core.DetailedResponse{}, nil
+			}).Times(2)
+			err := scope.DeleteVPCLoadBalancerPoolMember()
+			g.Expect(err).To(BeNil())
+			g.Expect(deleted).To(Equal([]string{"foo-load-balancer-pool-id/foo-lb-pool-member-id", "bar-load-balancer-pool-id/bar-lb-pool-member-id"}))
+			g.Expect(scope.IBMVPCMachine.Status.LoadBalancerPoolMembers).To(BeEmpty())
+			g.Expect(scope.IBMVPCMachine.Status.LoadBalancerPoolMemberID).To(BeEmpty())
+		})
+		t.Run("Should keep remaining load balancer pool members when load balancer is updating", func(t *testing.T) {
+			g := NewWithT(t)
+			mockController, mockvpc := setup(t)
+			t.Cleanup(mockController.Finish)
+			scope := setupMachineScope(clusterName, machineName, mockvpc)
+			scope.IBMVPCMachine.Spec = vpcMachine.Spec
+			scope.IBMVPCMachine.Status = vpcMachine.Status
+			scope.IBMVPCMachine.Status.LoadBalancerPoolMembers = []infrav1beta2.VPCLoadBalancerPoolMemberReference{
+				{PoolID: "foo-load-balancer-pool-id", ID: "foo-lb-pool-member-id"},
+				{PoolID: "bar-load-balancer-pool-id", ID: "bar-lb-pool-member-id"},
+			}
+			updatingLoadBalancer := *loadBalancer
+			updatingLoadBalancer.ProvisioningStatus = core.StringPtr("update_pending")
+			gomock.InOrder(
+				mockvpc.EXPECT().GetLoadBalancer(gomock.AssignableToTypeOf(&vpcv1.GetLoadBalancerOptions{})).Return(loadBalancer, &core.DetailedResponse{}, nil),
+				mockvpc.EXPECT().GetLoadBalancer(gomock.AssignableToTypeOf(&vpcv1.GetLoadBalancerOptions{})).Return(&updatingLoadBalancer, &core.DetailedResponse{}, nil),
+			)
+			mockvpc.EXPECT().DeleteLoadBalancerPoolMember(gomock.AssignableToTypeOf(&vpcv1.DeleteLoadBalancerPoolMemberOptions{})).Return(&core.DetailedResponse{}, nil)
+			err := scope.DeleteVPCLoadBalancerPoolMember()
+			g.Expect(errors.Is(err, ErrLoadBalancerNotReady)).To(BeTrue())
+			g.Expect(scope.IBMVPCMachine.Status.LoadBalancerPoolMembers).To(Equal([]infrav1beta2.VPCLoadBalancerPoolMemberReference{
+				{PoolID: "bar-load-balancer-pool-id", ID: "bar-lb-pool-member-id"},
+			}))
+		})
+		t.Run("Should not delete load balancer pool member when no address is recorded and instance is already deleted", func(t *testing.T) {
+			g := NewWithT(t)
+			mockController, mockvpc := setup(t)
+			t.Cleanup(mockController.Finish)
+			scope := setupMachineScope(clusterName, machineName, mockvpc)
+			scope.IBMVPCMachine.Spec = vpcMachine.Spec
+			scope.IBMVPCMachine.Status = infrav1beta2.IBMVPCMachineStatus{InstanceID: "foo-instance-id"}
+			mockvpc.EXPECT().GetLoadBalancer(gomock.AssignableToTypeOf(&vpcv1.GetLoadBalancerOptions{})).Return(loadBalancer, &core.DetailedResponse{}, nil)
+			mockvpc.EXPECT().GetInstance(gomock.AssignableToTypeOf(&vpcv1.GetInstanceOptions{})).Return(nil, &core.DetailedResponse{StatusCode: http.StatusNotFound}, errors.New("Instance not found"))
+			err := scope.DeleteVPCLoadBalancerPoolMember()
+			g.Expect(err).To(BeNil())
+		})
 	})
 }
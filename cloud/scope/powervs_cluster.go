@@ -20,6 +20,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"net/http"
 	"reflect"
 	"strings"
 
@@ -186,7 +187,7 @@ func NewPowerVSClusterScope(params PowerVSClusterScopeParams) (*PowerVSClusterSc
 	if err != nil {
 		return nil, fmt.Errorf("failed to create authenticator %w", err)
 	}
-	account, err := utils.GetAccount(auth)
+	account, err := utils.GetAccount(context.TODO(), auth)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get account details %w", err)
 	}
@@ -402,6 +403,12 @@ func (s *PowerVSClusterScope) SetStatus(resourceType infrav1beta2.ResourceType,
 			return
 		}
 		s.IBMPowerVSCluster.Status.COSInstance.Set(resource)
+	case infrav1beta2.ResourceTypeCOSBucket:
+		if s.IBMPowerVSCluster.Status.COSBucket == nil {
+			s.IBMPowerVSCluster.Status.COSBucket = &resource
+			return
+		}
+		s.IBMPowerVSCluster.Status.COSBucket.Set(resource)
 	case infrav1beta2.ResourceTypeResourceGroup:
 		if s.IBMPowerVSCluster.Status.ResourceGroup == nil {
 			s.IBMPowerVSCluster.Status.ResourceGroup = &resource
@@ -1594,16 +1601,17 @@ func (s *PowerVSClusterScope) validateVPCSecurityGroup(securityGroup infrav1beta
 }
 
 // ReconcileTransitGateway reconcile transit gateway.
+// A transit gateway supplied by ID, or found with the name of the cluster transit gateway, is reused, otherwise one is
+// created. The transit gateway is attached to both the Power VS workspace and the VPC, and a requeue is requested until
+// both connections are attached.
 func (s *PowerVSClusterScope) ReconcileTransitGateway() (bool, error) {
 	if s.GetTransitGatewayID() != nil {
 		s.V(3).Info("Transit gateway ID is set, fetching details", "id", s.GetTransitGatewayID())
-		tg, _, err := s.TransitGatewayClient.GetTransitGateway(&tgapiv1.GetTransitGatewayOptions{
-			ID: s.GetTransitGatewayID(),
-		})
-		if err != nil {
-			return false, err
+		if s.IBMPowerVSCluster.Status.TransitGateway == nil {
+			// A transit gateway supplied by ID is not created by the controller, so it is never deleted.
+			s.SetStatus(infrav1beta2.ResourceTypeTransitGateway, infrav1beta2.ResourceReference{ID: s.GetTransitGatewayID(), ControllerCreated: ptr.To(false)})
 		}
-		requeue, err := s.checkTransitGateway(tg.ID)
+		requeue, err := s.checkTransitGateway(s.GetTransitGatewayID())
 		if err != nil {
 			return false, err
 		}
@@ -1685,59 +1693,105 @@ func (s *PowerVSClusterScope) checkTransitGatewayStatus(tg *tgapiv1.TransitGatew
 		return true, nil
 	}
 
-	return s.checkTransitGatewayConnections(tg.ID)
+	return s.checkTransitGatewayConnections(tg)
 }
 
-func (s *PowerVSClusterScope) checkTransitGatewayConnections(id *string) (bool, error) {
-	requeue := false
+// checkTransitGatewayConnections makes sure the transit gateway has connections attaching the VPC and the Power VS
+// workspace, creating the missing ones, and records them in the status. A requeue is requested while a connection
+// is pending or has just been created.
+func (s *PowerVSClusterScope) checkTransitGatewayConnections(tg *tgapiv1.TransitGateway) (bool, error) {
 	tgConnections, _, err := s.TransitGatewayClient.ListTransitGatewayConnections(&tgapiv1.ListTransitGatewayConnectionsOptions{
-		TransitGatewayID: id,
+		TransitGatewayID: tg.ID,
 	})
 	if err != nil {
-		return requeue, fmt.Errorf("failed to list transit gateway connections: %w", err)
-	}
-
-	if len(tgConnections.Connections) == 0 {
-		return requeue, fmt.Errorf("no connections are attached to transit gateway")
+		return false, fmt.Errorf("failed to list transit gateway connections: %w", err)
 	}
 
 	vpcCRN, err := s.fetchVPCCRN()
 	if err != nil {
-		return requeue, fmt.Errorf("failed to fetch VPC CRN: %w", err)
+		return false, fmt.Errorf("failed to fetch VPC CRN: %w", err)
 	}
 
 	pvsServiceInstanceCRN, err := s.fetchPowerVSServiceInstanceCRN()
 	if err != nil {
-		return requeue, fmt.Errorf("failed to fetch PowerVS service instance CRN: %w", err)
+		return false, fmt.Errorf("failed to fetch PowerVS service instance CRN: %w", err)
 	}
 
-	var powerVSAttached, vpcAttached bool
-	for _, conn := range tgConnections.Connections {
-		if *conn.NetworkType == string(vpcNetworkConnectionType) && *conn.NetworkID == *vpcCRN {
-			if requeue, err := s.checkTransitGatewayConnectionStatus(conn.Status); err != nil {
-				return requeue, err
-			} else if requeue {
-				return requeue, nil
+	requeue := false
+	networks := []struct {
+		networkType networkConnectionType
+		networkID   *string
+	}{
+		{networkType: vpcNetworkConnectionType, networkID: vpcCRN},
+		{networkType: powervsNetworkConnectionType, networkID: pvsServiceInstanceCRN},
+	}
+	for _, network := range networks {
+		var connection *tgapiv1.TransitGatewayConnectionCust
+		for i, conn := range tgConnections.Connections {
+			if *conn.NetworkType == string(network.networkType) && *conn.NetworkID == *network.networkID {
+				connection = &tgConnections.Connections[i]
+				break
 			}
-			s.V(3).Info("VPC connection successfully attached to transit gateway", "name", *conn.Name)
-			vpcAttached = true
 		}
-		if *conn.NetworkType == string(powervsNetworkConnectionType) && *conn.NetworkID == *pvsServiceInstanceCRN {
-			if requeue, err := s.checkTransitGatewayConnectionStatus(conn.Status); err != nil {
-				return requeue, err
-			} else if requeue {
-				return requeue, nil
+		if connection == nil {
+			s.V(3).Info("Transit gateway connection not found, creating it", "networkType", network.networkType)
+			if err := s.createTransitGatewayConnection(tg, network.networkType, network.networkID); err != nil {
+				return false, err
 			}
-			s.V(3).Info("PowerVS connection successfully attached to transit gateway", "names", *conn.Name)
-			powerVSAttached = true
+			requeue = true
+			continue
 		}
-	}
-	if !powerVSAttached || !vpcAttached {
-		return requeue, fmt.Errorf("either one of PowerVS or VPC transit gateway connections is not attached, PowerVS: %t VPC: %t", powerVSAttached, vpcAttached)
+
+		s.setTransitGatewayConnectionStatus(network.networkType, infrav1beta2.ResourceReference{ID: connection.ID, ControllerCreated: ptr.To(false)})
+		connectionRequeue, err := s.checkTransitGatewayConnectionStatus(connection.Status)
+		if err != nil {
+			return false, err
+		}
+		if connectionRequeue {
+			requeue = true
+			continue
+		}
+		s.V(3).Info("Connection successfully attached to transit gateway", "networkType", network.networkType, "name", *connection.Name)
 	}
 	return requeue, nil
 }
 
+// createTransitGatewayConnection attaches a network to the transit gateway and records the connection in the status.
+func (s *PowerVSClusterScope) createTransitGatewayConnection(tg *tgapiv1.TransitGateway, networkType networkConnectionType, networkID *string) error {
+	tgName := tg.Name
+	if tgName == nil {
+		tgName = s.GetServiceName(infrav1beta2.ResourceTypeTransitGateway)
+	}
+	suffix := "vpc-con"
+	if networkType == powervsNetworkConnectionType {
+		suffix = "pvs-con"
+	}
+
+	connection, _, err := s.TransitGatewayClient.CreateTransitGatewayConnection(&tgapiv1.CreateTransitGatewayConnectionOptions{
+		TransitGatewayID: tg.ID,
+		NetworkType:      ptr.To(string(networkType)),
+		NetworkID:        networkID,
+		Name:             ptr.To(fmt.Sprintf("%s-%s", *tgName, suffix)),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create %s connection in transit gateway: %w", networkType, err)
+	}
+	s.setTransitGatewayConnectionStatus(networkType, infrav1beta2.ResourceReference{ID: connection.ID, ControllerCreated: ptr.To(true)})
+	return nil
+}
+
+// setTransitGatewayConnectionStatus records a connection of the transit gateway in the status.
+func (s *PowerVSClusterScope) setTransitGatewayConnectionStatus(networkType networkConnectionType, resource infrav1beta2.ResourceReference) {
+	if s.IBMPowerVSCluster.Status.TransitGatewayConnections == nil {
+		s.IBMPowerVSCluster.Status.TransitGatewayConnections = make(map[string]infrav1beta2.ResourceReference)
+	}
+	if connection, ok := s.IBMPowerVSCluster.Status.TransitGatewayConnections[string(networkType)]; ok {
+		connection.Set(resource)
+		resource = connection
+	}
+	s.IBMPowerVSCluster.Status.TransitGatewayConnections[string(networkType)] = resource
+}
+
 // checkTransitGatewayConnectionStatus checks the state of a transit gateway connection.
 // If state is pending, true is returned indicating a requeue for reconciliation.
 // In all other cases, it returns false.
@@ -1798,13 +1852,8 @@ func (s *PowerVSClusterScope) createTransitGateway() (*string, error) {
 		return nil, fmt.Errorf("failed to fetch VPC CRN: %w", err)
 	}
 
-	if _, _, err = s.TransitGatewayClient.CreateTransitGatewayConnection(&tgapiv1.CreateTransitGatewayConnectionOptions{
-		TransitGatewayID: tg.ID,
-		NetworkType:      ptr.To(string(vpcNetworkConnectionType)),
-		NetworkID:        vpcCRN,
-		Name:             ptr.To(fmt.Sprintf("%s-vpc-con", *tgName)),
-	}); err != nil {
-		return nil, fmt.Errorf("failed to create VPC connection in transit gateway: %w", err)
+	if err := s.createTransitGatewayConnection(tg, vpcNetworkConnectionType, vpcCRN); err != nil {
+		return nil, err
 	}
 
 	pvsServiceInstanceCRN, err := s.fetchPowerVSServiceInstanceCRN()
@@ -1812,13 +1861,8 @@ func (s *PowerVSClusterScope) createTransitGateway() (*string, error) {
 		return nil, fmt.Errorf("failed to fetch PowerVS service instance CRN: %w", err)
 	}
 
-	if _, _, err = s.TransitGatewayClient.CreateTransitGatewayConnection(&tgapiv1.CreateTransitGatewayConnectionOptions{
-		TransitGatewayID: tg.ID,
-		NetworkType:      ptr.To(string(powervsNetworkConnectionType)),
-		NetworkID:        pvsServiceInstanceCRN,
-		Name:             ptr.To(fmt.Sprintf("%s-pvs-con", *tgName)),
-	}); err != nil {
-		return nil, fmt.Errorf("failed to create PowerVS connection in transit gateway: %w", err)
+	if err := s.createTransitGatewayConnection(tg, powervsNetworkConnectionType, pvsServiceInstanceCRN); err != nil {
+		return nil, err
 	}
 	return tg.ID, nil
 }
@@ -2071,24 +2115,40 @@ func (s *PowerVSClusterScope) ReconcileCOSInstance() error {
 	}
 	s.COSClient = cosClient
 
+	return s.EnsureCOSBucket()
+}
+
+// EnsureCOSBucket creates the COS bucket referenced by the cluster if it does not exist and records its name in status.
+// An existing bucket owned by the account is reused, a bucket name taken by another account results in an error.
+func (s *PowerVSClusterScope) EnsureCOSBucket() error {
+	bucketName := *s.GetServiceName(infrav1beta2.ResourceTypeCOSBucket)
 	// check bucket exist in service instance
-	if exist, err := s.checkCOSBucket(); exist {
-		s.V(3).Info("COS bucket found in IBM Cloud")
-		return nil
-	} else if err != nil {
+	exist, err := s.checkCOSBucket(bucketName)
+	if err != nil {
 		s.Error(err, "failed to check COS bucket")
 		return err
 	}
+	if exist {
+		s.V(3).Info("COS bucket found in IBM Cloud", "name", bucketName)
+		s.SetStatus(infrav1beta2.ResourceTypeCOSBucket, infrav1beta2.ResourceReference{ID: &bucketName, ControllerCreated: ptr.To(false)})
+		return nil
+	}
 
 	// create bucket in service instance
-	if err := s.createCOSBucket(); err != nil {
+	s.V(3).Info("Creating COS bucket", "name", bucketName)
+	created, err := s.createCOSBucket(bucketName)
+	if err != nil {
 		return err
 	}
+	if created {
+		s.Info("Created COS bucket", "name", bucketName)
+	}
+	s.SetStatus(infrav1beta2.ResourceTypeCOSBucket, infrav1beta2.ResourceReference{ID: &bucketName, ControllerCreated: ptr.To(created)})
 	return nil
 }
 
-func (s *PowerVSClusterScope) checkCOSBucket() (bool, error) {
-	if _, err := s.COSClient.GetBucketByName(*s.GetServiceName(infrav1beta2.ResourceTypeCOSBucket)); err != nil {
+func (s *PowerVSClusterScope) checkCOSBucket(bucketName string) (bool, error) {
+	if _, err := s.COSClient.GetBucketByName(bucketName); err != nil {
 		if aerr, ok := err.(awserr.Error); ok {
 			switch aerr.Code() {
 			case s3.ErrCodeNoSuchBucket, "Forbidden", "NotFound":
@@ -2104,28 +2164,35 @@ func (s *PowerVSClusterScope) checkCOSBucket() (bool, error) {
 	return true, nil
 }
 
-func (s *PowerVSClusterScope) createCOSBucket() error {
+// createCOSBucket creates the COS bucket and returns whether it was created by this call.
+func (s *PowerVSClusterScope) createCOSBucket(bucketName string) (bool, error) {
 	input := &s3.CreateBucketInput{
-		Bucket: ptr.To(*s.GetServiceName(infrav1beta2.ResourceTypeCOSBucket)),
+		Bucket: ptr.To(bucketName),
+	}
+	if s.COSInstance() != nil && s.COSInstance().BucketStorageClass != "" {
+		// COS selects the bucket location and storage class using the <region>-<class> location constraint.
+		input.CreateBucketConfiguration = &s3.CreateBucketConfiguration{
+			LocationConstraint: ptr.To(fmt.Sprintf("%s-%s", s.bucketRegion(), s.COSInstance().BucketStorageClass)),
+		}
 	}
 	_, err := s.COSClient.CreateBucket(input)
 	if err == nil {
-		return nil
+		return true, nil
 	}
 
 	aerr, ok := err.(awserr.Error)
 	if !ok {
-		return fmt.Errorf("failed to create COS bucket %w", err)
+		return false, fmt.Errorf("failed to create COS bucket %w", err)
 	}
 
 	switch aerr.Code() {
-	// If bucket already exists, all good.
+	// If bucket already exists in the account, all good.
 	case s3.ErrCodeBucketAlreadyOwnedByYou:
-		return nil
+		return false, nil
 	case s3.ErrCodeBucketAlreadyExists:
-		return nil
+		return false, fmt.Errorf("COS bucket %s already exists and is owned by another account, set a different bucket name", bucketName)
 	default:
-		return fmt.Errorf("failed to create COS bucket %w", err)
+		return false, fmt.Errorf("failed to create COS bucket %w", err)
 	}
 }
 
@@ -2415,7 +2482,7 @@ func (s *PowerVSClusterScope) DeleteVPC() (bool, error) {
 func (s *PowerVSClusterScope) DeleteTransitGateway() (bool, error) {
 	if !s.isResourceCreatedByController(infrav1beta2.ResourceTypeTransitGateway) {
 		s.Info("Skipping transit gateway deletion as resource is not created by controller")
-		return false, nil
+		return s.deleteControllerCreatedTransitGatewayConnections()
 	}
 
 	if s.IBMPowerVSCluster.Status.TransitGateway.ID == nil {
@@ -2481,6 +2548,46 @@ func (s *PowerVSClusterScope) deleteTransitGatewayConnections(tg *tgapiv1.Transi
 	return requeue, nil
 }
 
+// deleteControllerCreatedTransitGatewayConnections deletes the connections created by the controller in a transit
+// gateway which is not created by the controller.
+func (s *PowerVSClusterScope) deleteControllerCreatedTransitGatewayConnections() (bool, error) {
+	if s.IBMPowerVSCluster.Status.TransitGateway == nil || s.IBMPowerVSCluster.Status.TransitGateway.ID == nil {
+		return false, nil
+	}
+
+	requeue := false
+	for networkType, connection := range s.IBMPowerVSCluster.Status.TransitGatewayConnections {
+		if connection.ID == nil || connection.ControllerCreated == nil || !*connection.ControllerCreated {
+			continue
+		}
+		conn, response, err := s.TransitGatewayClient.GetTransitGatewayConnection(&tgapiv1.GetTransitGatewayConnectionOptions{
+			TransitGatewayID: s.IBMPowerVSCluster.Status.TransitGateway.ID,
+			ID:               connection.ID,
+		})
+		if err != nil {
+			if response != nil && response.StatusCode == http.StatusNotFound {
+				s.Info("Transit gateway connection successfully deleted", "networkType", networkType)
+				delete(s.IBMPowerVSCluster.Status.TransitGatewayConnections, networkType)
+				continue
+			}
+			return false, fmt.Errorf("failed to fetch transit gateway connection: %w", err)
+		}
+
+		requeue = true
+		if conn.Status != nil && *conn.Status == string(infrav1beta2.TransitGatewayConnectionStateDeleting) {
+			s.V(3).Info("Transit gateway connection is in deleting state", "networkType", networkType)
+			continue
+		}
+		if _, err := s.TransitGatewayClient.DeleteTransitGatewayConnection(&tgapiv1.DeleteTransitGatewayConnectionOptions{
+			ID:               connection.ID,
+			TransitGatewayID: s.IBMPowerVSCluster.Status.TransitGateway.ID,
+		}); err != nil {
+			return false, fmt.Errorf("failed to delete transit gateway connection: %w", err)
+		}
+	}
+	return requeue, nil
+}
+
 // DeleteDHCPServer deletes DHCP server.
 func (s *PowerVSClusterScope) DeleteDHCPServer() error {
 	if !s.isResourceCreatedByController(infrav1beta2.ResourceTypeDHCPServer) {
@@ -17,8 +17,28 @@ limitations under the License.
 package scope
 
 import (
+	"errors"
+	"fmt"
 	"testing"
 
+	"go.uber.org/mock/gomock"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/ibm-cos-sdk-go/aws/awserr"
+	"github.com/IBM/ibm-cos-sdk-go/service/s3"
+	tgapiv1 "github.com/IBM/networking-go-sdk/transitgatewayapisv1"
+	"github.com/IBM/platform-services-go-sdk/resourcecontrollerv2"
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+
+	"k8s.io/klog/v2"
+	"k8s.io/utils/ptr"
+
+	infrav1beta2 "sigs.k8s.io/cluster-api-provider-ibmcloud/api/v1beta2"
+	cosmock "sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/cos/mock"
+	resourcecontrollermock "sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/resourcecontroller/mock"
+	tgmock "sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/transitgateway/mock"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/vpc/mock"
+
 	. "github.com/onsi/gomega"
 )
 
@@ -68,3 +88,243 @@ func TestNewPowerVSClusterScope(t *testing.T) {
 		})
 	}
 }
+
+func TestReconcileTransitGateway(t *testing.T) {
+	setup := func(t *testing.T) (*gomock.Controller, *tgmock.MockTransitGateway, *mock.MockVpc, *resourcecontrollermock.MockResourceController) {
+		t.Helper()
+		mockController := gomock.NewController(t)
+		return mockController, tgmock.NewMockTransitGateway(mockController), mock.NewMockVpc(mockController), resourcecontrollermock.NewMockResourceController(mockController)
+	}
+	setupPowerVSClusterScope := func(mocktg *tgmock.MockTransitGateway, mockvpc *mock.MockVpc, mockrc *resourcecontrollermock.MockResourceController) *PowerVSClusterScope {
+		powerVSCluster := newPowerVSCluster(clusterName)
+		powerVSCluster.Spec = infrav1beta2.IBMPowerVSClusterSpec{
+			Zone:              ptr.To("dal10"),
+			ServiceInstanceID: "foo-service-instance-id",
+			ResourceGroup:     &infrav1beta2.IBMPowerVSResourceReference{ID: ptr.To("foo-resource-group-id")},
+			VPC:               &infrav1beta2.VPCResourceReference{ID: ptr.To("foo-vpc-id"), Region: ptr.To("us-south")},
+			TransitGateway:    &infrav1beta2.TransitGateway{},
+		}
+		return &PowerVSClusterScope{
+			Logger:               klog.Background(),
+			IBMPowerVSCluster:    powerVSCluster,
+			TransitGatewayClient: mocktg,
+			IBMVPCClient:         mockvpc,
+			ResourceClient:       mockrc,
+		}
+	}
+	expectCRNs := func(mockvpc *mock.MockVpc, mockrc *resourcecontrollermock.MockResourceController) {
+		mockvpc.EXPECT().GetVPC(gomock.AssignableToTypeOf(&vpcv1.GetVPCOptions{})).Return(&vpcv1.VPC{CRN: ptr.To("foo-vpc-crn")}, &core.DetailedResponse{}, nil)
+		mockrc.EXPECT().GetResourceInstance(gomock.AssignableToTypeOf(&resourcecontrollerv2.GetResourceInstanceOptions{})).Return(&resourcecontrollerv2.ResourceInstance{CRN: ptr.To("foo-service-instance-crn")}, &core.DetailedResponse{}, nil)
+	}
+	transitGateway := &tgapiv1.TransitGateway{
+		ID:     ptr.To("foo-tg-id"),
+		Name:   ptr.To("foo-tg"),
+		Status: ptr.To(string(infrav1beta2.TransitGatewayStateAvailable)),
+	}
+	vpcConnection := tgapiv1.TransitGatewayConnectionCust{
+		ID:          ptr.To("foo-vpc-con-id"),
+		Name:        ptr.To("foo-tg-vpc-con"),
+		NetworkType: ptr.To(string(vpcNetworkConnectionType)),
+		NetworkID:   ptr.To("foo-vpc-crn"),
+		Status:      ptr.To(string(infrav1beta2.TransitGatewayConnectionStateAttached)),
+	}
+	powerVSConnection := tgapiv1.TransitGatewayConnectionCust{
+		ID:          ptr.To("foo-pvs-con-id"),
+		Name:        ptr.To("foo-tg-pvs-con"),
+		NetworkType: ptr.To(string(powervsNetworkConnectionType)),
+		NetworkID:   ptr.To("foo-service-instance-crn"),
+		Status:      ptr.To(string(infrav1beta2.TransitGatewayConnectionStateAttached)),
+	}
+
+	t.Run("Should create TransitGateway and attach PowerVS and VPC", func(t *testing.T) {
+		g := NewWithT(t)
+		mockController, mocktg, mockvpc, mockrc := setup(t)
+		t.Cleanup(mockController.Finish)
+		scope := setupPowerVSClusterScope(mocktg, mockvpc, mockrc)
+		mocktg.EXPECT().GetTransitGatewayByName(fmt.Sprintf("%s-transitgateway", clusterName)).Return(nil, nil)
+		mocktg.EXPECT().CreateTransitGateway(gomock.AssignableToTypeOf(&tgapiv1.CreateTransitGatewayOptions{})).Return(transitGateway, &core.DetailedResponse{}, nil)
+		expectCRNs(mockvpc, mockrc)
+		mocktg.EXPECT().CreateTransitGatewayConnection(gomock.AssignableToTypeOf(&tgapiv1.CreateTransitGatewayConnectionOptions{})).DoAndReturn(func(options *tgapiv1.CreateTransitGatewayConnectionOptions) (*tgapiv1.TransitGatewayConnectionCust, *core.DetailedResponse, error) {
+			g.Expect(*options.NetworkID).To(Equal("foo-vpc-crn"))
+			g.Expect(*options.Name).To(Equal("foo-tg-vpc-con"))
+			return &vpcConnection, &core.DetailedResponse{}, nil
+		})
+		mocktg.EXPECT().CreateTransitGatewayConnection(gomock.AssignableToTypeOf(&tgapiv1.CreateTransitGatewayConnectionOptions{})).DoAndReturn(func(options *tgapiv1.CreateTransitGatewayConnectionOptions) (*tgapiv1.TransitGatewayConnectionCust, *core.DetailedResponse, error) {
+			g.Expect(*options.NetworkID).To(Equal("foo-service-instance-crn"))
+			g.Expect(*options.Name).To(Equal("foo-tg-pvs-con"))
+			return &powerVSConnection, &core.DetailedResponse{}, nil
+		})
+		requeue, err := scope.ReconcileTransitGateway()
+		g.Expect(err).To(BeNil())
+		g.Expect(requeue).To(BeTrue())
+		g.Expect(scope.IBMPowerVSCluster.Status.TransitGateway).To(Equal(&infrav1beta2.ResourceReference{ID: ptr.To("foo-tg-id"), ControllerCreated: ptr.To(true)}))
+		g.Expect(scope.IBMPowerVSCluster.Status.TransitGatewayConnections).To(Equal(map[string]infrav1beta2.ResourceReference{
+			string(vpcNetworkConnectionType):     {ID: ptr.To("foo-vpc-con-id"), ControllerCreated: ptr.To(true)},
+			string(powervsNetworkConnectionType): {ID: ptr.To("foo-pvs-con-id"), ControllerCreated: ptr.To(true)},
+		}))
+	})
+	t.Run("Should reuse TransitGateway supplied by ID", func(t *testing.T) {
+		g := NewWithT(t)
+		mockController, mocktg, mockvpc, mockrc := setup(t)
+		t.Cleanup(mockController.Finish)
+		scope := setupPowerVSClusterScope(mocktg, mockvpc, mockrc)
+		scope.IBMPowerVSCluster.Spec.TransitGateway.ID = ptr.To("foo-tg-id")
+		mocktg.EXPECT().GetTransitGateway(gomock.AssignableToTypeOf(&tgapiv1.GetTransitGatewayOptions{})).Return(transitGateway, &core.DetailedResponse{}, nil)
+		mocktg.EXPECT().ListTransitGatewayConnections(gomock.AssignableToTypeOf(&tgapiv1.ListTransitGatewayConnectionsOptions{})).Return(&tgapiv1.TransitGatewayConnectionCollection{
+			Connections: []tgapiv1.TransitGatewayConnectionCust{vpcConnection, powerVSConnection},
+		}, &core.DetailedResponse{}, nil)
+		expectCRNs(mockvpc, mockrc)
+		requeue, err := scope.ReconcileTransitGateway()
+		g.Expect(err).To(BeNil())
+		g.Expect(requeue).To(BeFalse())
+		g.Expect(scope.IBMPowerVSCluster.Status.TransitGateway).To(Equal(&infrav1beta2.ResourceReference{ID: ptr.To("foo-tg-id"), ControllerCreated: ptr.To(false)}))
+		g.Expect(scope.IBMPowerVSCluster.Status.TransitGatewayConnections).To(Equal(map[string]infrav1beta2.ResourceReference{
+			string(vpcNetworkConnectionType):     {ID: ptr.To("foo-vpc-con-id"), ControllerCreated: ptr.To(false)},
+			string(powervsNetworkConnectionType): {ID: ptr.To("foo-pvs-con-id"), ControllerCreated: ptr.To(false)},
+		}))
+	})
+	t.Run("Should attach missing connection and requeue when TransitGateway is partially attached", func(t *testing.T) {
+		g := NewWithT(t)
+		mockController, mocktg, mockvpc, mockrc := setup(t)
+		t.Cleanup(mockController.Finish)
+		scope := setupPowerVSClusterScope(mocktg, mockvpc, mockrc)
+		scope.IBMPowerVSCluster.Spec.TransitGateway.ID = ptr.To("foo-tg-id")
+		mocktg.EXPECT().GetTransitGateway(gomock.AssignableToTypeOf(&tgapiv1.GetTransitGatewayOptions{})).Return(transitGateway, &core.DetailedResponse{}, nil)
+		mocktg.EXPECT().ListTransitGatewayConnections(gomock.AssignableToTypeOf(&tgapiv1.ListTransitGatewayConnectionsOptions{})).Return(&tgapiv1.TransitGatewayConnectionCollection{
+			Connections: []tgapiv1.TransitGatewayConnectionCust{vpcConnection},
+		}, &core.DetailedResponse{}, nil)
+		expectCRNs(mockvpc, mockrc)
+		mocktg.EXPECT().CreateTransitGatewayConnection(gomock.AssignableToTypeOf(&tgapiv1.CreateTransitGatewayConnectionOptions{})).DoAndReturn(func(options *tgapiv1.CreateTransitGatewayConnectionOptions) (*tgapiv1.TransitGatewayConnectionCust, *core.DetailedResponse, error) {
+			g.Expect(*options.TransitGatewayID).To(Equal("foo-tg-id"))
+			g.Expect(*options.NetworkType).To(Equal(string(powervsNetworkConnectionType)))
+			return &powerVSConnection, &core.DetailedResponse{}, nil
+		})
+		requeue, err := scope.ReconcileTransitGateway()
+		g.Expect(err).To(BeNil())
+		g.Expect(requeue).To(BeTrue())
+		g.Expect(scope.IBMPowerVSCluster.Status.TransitGatewayConnections[string(powervsNetworkConnectionType)]).To(Equal(infrav1beta2.ResourceReference{ID: ptr.To("foo-pvs-con-id"), ControllerCreated: ptr.To(true)}))
+	})
+	t.Run("Should requeue when connection is pending", func(t *testing.T) {
+		g := NewWithT(t)
+		mockController, mocktg, mockvpc, mockrc := setup(t)
+		t.Cleanup(mockController.Finish)
+		scope := setupPowerVSClusterScope(mocktg, mockvpc, mockrc)
+		scope.IBMPowerVSCluster.Spec.TransitGateway.ID = ptr.To("foo-tg-id")
+		pendingConnection := powerVSConnection
+		pendingConnection.Status = ptr.To(string(infrav1beta2.TransitGatewayConnectionStatePending))
+		mocktg.EXPECT().GetTransitGateway(gomock.AssignableToTypeOf(&tgapiv1.GetTransitGatewayOptions{})).Return(transitGateway, &core.DetailedResponse{}, nil)
+		mocktg.EXPECT().ListTransitGatewayConnections(gomock.AssignableToTypeOf(&tgapiv1.ListTransitGatewayConnectionsOptions{})).Return(&tgapiv1.TransitGatewayConnectionCollection{
+			Connections: []tgapiv1.TransitGatewayConnectionCust{vpcConnection, pendingConnection},
+		}, &core.DetailedResponse{}, nil)
+		expectCRNs(mockvpc, mockrc)
+		requeue, err := scope.ReconcileTransitGateway()
+		g.Expect(err).To(BeNil())
+		g.Expect(requeue).To(BeTrue())
+	})
+	t.Run("Error when creating connection fails", func(t *testing.T) {
+		g := NewWithT(t)
+		mockController, mocktg, mockvpc, mockrc := setup(t)
+		t.Cleanup(mockController.Finish)
+		scope := setupPowerVSClusterScope(mocktg, mockvpc, mockrc)
+		scope.IBMPowerVSCluster.Spec.TransitGateway.ID = ptr.To("foo-tg-id")
+		mocktg.EXPECT().GetTransitGateway(gomock.AssignableToTypeOf(&tgapiv1.GetTransitGatewayOptions{})).Return(transitGateway, &core.DetailedResponse{}, nil)
+		mocktg.EXPECT().ListTransitGatewayConnections(gomock.AssignableToTypeOf(&tgapiv1.ListTransitGatewayConnectionsOptions{})).Return(&tgapiv1.TransitGatewayConnectionCollection{}, &core.DetailedResponse{}, nil)
+		expectCRNs(mockvpc, mockrc)
+		mocktg.EXPECT().CreateTransitGatewayConnection(gomock.AssignableToTypeOf(&tgapiv1.CreateTransitGatewayConnectionOptions{})).Return(nil, &core.DetailedResponse{}, errors.New("failed to create connection"))
+		_, err := scope.ReconcileTransitGateway()
+		g.Expect(err).To(Not(BeNil()))
+	})
+}
+
+func TestEnsureCOSBucket(t *testing.T) {
+	setup := func(t *testing.T) (*gomock.Controller, *cosmock.MockCos) {
+		t.Helper()
+		mockController := gomock.NewController(t)
+		return mockController, cosmock.NewMockCos(mockController)
+	}
+	setupPowerVSClusterScope := func(mockcos *cosmock.MockCos) *PowerVSClusterScope {
+		powerVSCluster := newPowerVSCluster(clusterName)
+		powerVSCluster.Spec = infrav1beta2.IBMPowerVSClusterSpec{
+			CosInstance: &infrav1beta2.CosInstance{
+				Name:               "foo-cos-instance",
+				BucketName:         "foo-bucket",
+				BucketRegion:       "us-south",
+				BucketStorageClass: "smart",
+			},
+		}
+		return &PowerVSClusterScope{
+			Logger:            klog.Background(),
+			IBMPowerVSCluster: powerVSCluster,
+			COSClient:         mockcos,
+		}
+	}
+	notFoundErr := awserr.New("NotFound", "Not Found", nil)
+
+	t.Run("Should create COS bucket when it does not exist", func(t *testing.T) {
+		g := NewWithT(t)
+		mockController, mockcos := setup(t)
+		t.Cleanup(mockController.Finish)
+		scope := setupPowerVSClusterScope(mockcos)
+		mockcos.EXPECT().GetBucketByName("foo-bucket").Return(nil, notFoundErr)
+		mockcos.EXPECT().CreateBucket(gomock.AssignableToTypeOf(&s3.CreateBucketInput{})).DoAndReturn(func(input *s3.CreateBucketInput) (*s3.CreateBucketOutput, error) {
+			g.Expect(*input.Bucket).To(Equal("foo-bucket"))
+			g.Expect(*input.CreateBucketConfiguration.LocationConstraint).To(Equal("us-south-smart"))
+			return &s3.CreateBucketOutput{}, nil
+		})
+		err := scope.EnsureCOSBucket()
+		g.Expect(err).To(BeNil())
+		g.Expect(scope.IBMPowerVSCluster.Status.COSBucket).To(Equal(&infrav1beta2.ResourceReference{ID: ptr.To("foo-bucket"), ControllerCreated: ptr.To(true)}))
+	})
+	t.Run("Should reuse COS bucket when it already exists", func(t *testing.T) {
+		g := NewWithT(t)
+		mockController, mockcos := setup(t)
+		t.Cleanup(mockController.Finish)
+		scope := setupPowerVSClusterScope(mockcos)
+		mockcos.EXPECT().GetBucketByName("foo-bucket").Return(&s3.HeadBucketOutput{}, nil)
+		err := scope.EnsureCOSBucket()
+		g.Expect(err).To(BeNil())
+		g.Expect(scope.IBMPowerVSCluster.Status.COSBucket).To(Equal(&infrav1beta2.ResourceReference{ID: ptr.To("foo-bucket"), ControllerCreated: ptr.To(false)}))
+	})
+	t.Run("Should keep COS bucket controller created when it already exists", func(t *testing.T) {
+		g := NewWithT(t)
+		mockController, mockcos := setup(t)
+		t.Cleanup(mockController.Finish)
+		scope := setupPowerVSClusterScope(mockcos)
+		scope.IBMPowerVSCluster.Status.COSBucket = &infrav1beta2.ResourceReference{ID: ptr.To("foo-bucket"), ControllerCreated: ptr.To(true)}
+		mockcos.EXPECT().GetBucketByName("foo-bucket").Return(&s3.HeadBucketOutput{}, nil)
+		err := scope.EnsureCOSBucket()
+		g.Expect(err).To(BeNil())
+		g.Expect(*scope.IBMPowerVSCluster.Status.COSBucket.ControllerCreated).To(BeTrue())
+	})
+	t.Run("Should succeed when COS bucket is already owned by the account", func(t *testing.T) {
+		g := NewWithT(t)
+		mockController, mockcos := setup(t)
+		t.Cleanup(mockController.Finish)
+		scope := setupPowerVSClusterScope(mockcos)
+		mockcos.EXPECT().GetBucketByName("foo-bucket").Return(nil, notFoundErr)
+		mockcos.EXPECT().CreateBucket(gomock.AssignableToTypeOf(&s3.CreateBucketInput{})).Return(nil, awserr.New(s3.ErrCodeBucketAlreadyOwnedByYou, "bucket already owned", nil))
+		err := scope.EnsureCOSBucket()
+		g.Expect(err).To(BeNil())
+		g.Expect(scope.IBMPowerVSCluster.Status.COSBucket).To(Equal(&infrav1beta2.ResourceReference{ID: ptr.To("foo-bucket"), ControllerCreated: ptr.To(false)}))
+	})
+	t.Run("Error when COS bucket name is owned by another account", func(t *testing.T) {
+		g := NewWithT(t)
+		mockController, mockcos := setup(t)
+		t.Cleanup(mockController.Finish)
+		scope := setupPowerVSClusterScope(mockcos)
+		mockcos.EXPECT().GetBucketByName("foo-bucket").Return(nil, awserr.New("Forbidden", "Forbidden", nil))
+		mockcos.EXPECT().CreateBucket(gomock.AssignableToTypeOf(&s3.CreateBucketInput{})).Return(nil, awserr.New(s3.ErrCodeBucketAlreadyExists, "bucket already exists", nil))
+		err := scope.EnsureCOSBucket()
+		g.Expect(err).To(MatchError(ContainSubstring("owned by another account")))
+		g.Expect(scope.IBMPowerVSCluster.Status.COSBucket).To(BeNil())
+	})
+	t.Run("Error when checking COS bucket fails", func(t *testing.T) {
+		g := NewWithT(t)
+		mockController, mockcos := setup(t)
+		t.Cleanup(mockController.Finish)
+		scope := setupPowerVSClusterScope(mockcos)
+		mockcos.EXPECT().GetBucketByName("foo-bucket").Return(nil, errors.New("failed to get bucket"))
+		err := scope.EnsureCOSBucket()
+		g.Expect(err).To(Not(BeNil()))
+	})
+}
@@ -343,6 +343,14 @@ func (m *PowerVSMachineScope) CreateMachine() (*models.PVMInstanceReference, err
 	if s.SSHKey != "" {
 		params.Body.KeyPairName = s.SSHKey
 	}
+	if s.PlacementGroup != nil {
+		placementGroupID, err := getPlacementGroupID(s.PlacementGroup, m)
+		if err != nil {
+			record.Warnf(m.IBMPowerVSMachine, "FailedRetrievePlacementGroup", "Failed placement group retrieval - %v", err)
+			return nil, fmt.Errorf("error getting placement group ID: %w", err)
+		}
+		params.Body.PlacementGroup = *placementGroupID
+	}
 	_, err = m.IBMPowerVSClient.CreateInstance(params.Body)
 	if err != nil {
 		record.Warnf(m.IBMPowerVSMachine, "FailedCreateInstance", "Failed instance creation - %v", err)
@@ -665,6 +673,24 @@ func (m *PowerVSMachineScope) GetImages() (*models.Images, error) {
 	return m.IBMPowerVSClient.GetAllImage()
 }
 
+// getPlacementGroupID returns the ID of the placement group with the name of the reference, and checks that it has
+// the expected affinity policy when one is set.
+func getPlacementGroupID(placementGroup *infrav1beta2.IBMPowerVSPlacementGroup, m *PowerVSMachineScope) (*string, error) {
+	pg, err := m.IBMPowerVSClient.GetPlacementGroupByName(placementGroup.Name)
+	if err != nil {
+		m.Logger.Error(err, "Failed to get placement group")
+		return nil, err
+	}
+	if pg == nil {
+		return nil, fmt.Errorf("placement group %s does not exist", placementGroup.Name)
+	}
+	if placementGroup.Policy != "" && (pg.Policy == nil || *pg.Policy != string(placementGroup.Policy)) {
+		return nil, fmt.Errorf("placement group %s has policy %s, expected %s", placementGroup.Name, ptr.Deref(pg.Policy, ""), placementGroup.Policy)
+	}
+	m.Logger.Info("Placement group found with ID", "PlacementGroup", placementGroup.Name, "ID", *pg.ID)
+	return pg.ID, nil
+}
+
 func getNetworkID(network infrav1beta2.IBMPowerVSResourceReference, m *PowerVSMachineScope) (*string, error) {
 	if network.ID != nil {
 		return network.ID, nil
@@ -937,7 +963,7 @@ func (m *PowerVSMachineScope) GetServiceInstanceID() string {
 // SetProviderID will set the provider id for the machine.
 func (m *PowerVSMachineScope) SetProviderID(id *string) {
 	// Based on the ProviderIDFormat version the providerID format will be decided.
-	if options.ProviderIDFormatType(options.ProviderIDFormat) == options.ProviderIDFormatV2 {
+	if providerIDFormat := options.ProviderIDFormatType(options.ProviderIDFormat); providerIDFormat == options.ProviderIDFormatV2 || providerIDFormat == options.ProviderIDFormatV3 {
 		if id != nil {
 			m.IBMPowerVSMachine.Spec.ProviderID = ptr.To(fmt.Sprintf("ibmpowervs://%s/%s/%s/%s", m.GetRegion(), m.GetZone(), m.GetServiceInstanceID(), *id))
 		}
@@ -243,6 +243,157 @@ func TestCreateMachinePVS(t *testing.T) {
 			g.Expect(err).To(BeNil())
 		})
 
+		t.Run("Should create Machine with the processor type and processors", func(t *testing.T) {
+			testCases := []struct {
+				name             string
+				processorType    infrav1beta2.PowerVSProcessorType
+				processors       intstr.IntOrString
+				expectedProcType string
+				expectedCores    float64
+			}{
+				{
+					name:             "Shared processor type with fractional processors",
+					processorType:    infrav1beta2.PowerVSProcessorTypeShared,
+					processors:       intstr.FromString("0.25"),
+					expectedProcType: "shared",
+					expectedCores:    0.25,
+				},
+				{
+					name:             "Capped processor type with fractional processors",
+					processorType:    infrav1beta2.PowerVSProcessorTypeCapped,
+					processors:       intstr.FromString("1.75"),
+					expectedProcType: "capped",
+					expectedCores:    1.75,
+				},
+				{
+					name:             "Dedicated processor type with whole processors",
+					processorType:    infrav1beta2.PowerVSProcessorTypeDedicated,
+					processors:       intstr.FromInt(2),
+					expectedProcType: "dedicated",
+					expectedCores:    2,
+				},
+			}
+			for _, tc := range testCases {
+				t.Run(tc.name, func(t *testing.T) {
+					g := NewWithT(t)
+					setup(t)
+					t.Cleanup(teardown)
+					scope := setupPowerVSMachineScope(clusterName, machineName, core.StringPtr(pvsImage), core.StringPtr(pvsNetwork), true, mockpowervs)
+					scope.IBMPowerVSMachine.Spec.ProcessorType = tc.processorType
+					scope.IBMPowerVSMachine.Spec.Processors = tc.processors
+					mockpowervs.EXPECT().GetAllInstance().Return(pvmInstances, nil)
+					mockpowervs.EXPECT().CreateInstance(gomock.AssignableToTypeOf(pvmInstanceCreate)).DoAndReturn(func(body *models.PVMInstanceCreate) (*models.PVMInstanceList, error) {
+						g.Expect(*body.ProcType).To(Equal(tc.expectedProcType))
+						g.Expect(*body.Processors).To(Equal(tc.expectedCores))
+						return pvmInstanceList, nil
+					})
+					_, err := scope.CreateMachine()
+					g.Expect(err).To(BeNil())
+				})
+			}
+		})
+
+		t.Run("Should create Machine in the placement group", func(t *testing.T) {
+			placementGroups := &models.PlacementGroups{
+				PlacementGroups: []*models.PlacementGroup{
+					{
+						Name:   core.StringPtr("foo-affinity-group"),
+						ID:     core.StringPtr("foo-affinity-group-id"),
+						Policy: core.StringPtr(models.PlacementGroupPolicyAffinity),
+					},
+					{
+						Name:   core.StringPtr("foo-anti-affinity-group"),
+						ID:     core.StringPtr("foo-anti-affinity-group-id"),
+						Policy: core.StringPtr(models.PlacementGroupPolicyAntiDashAffinity),
+					},
+				},
+			}
+			testCases := []struct {
+				name            string
+				placementGroup  *infrav1beta2.IBMPowerVSPlacementGroup
+				expectedGroupID string
+			}{
+				{
+					name: "Affinity placement group",
+					placementGroup: &infrav1beta2.IBMPowerVSPlacementGroup{
+						Name:   "foo-affinity-group",
+						Policy: infrav1beta2.PowerVSPlacementGroupPolicyAffinity,
+					},
+					expectedGroupID: "foo-affinity-group-id",
+				},
+				{
+					name: "Anti-affinity placement group",
+					placementGroup: &infrav1beta2.IBMPowerVSPlacementGroup{
+						Name:   "foo-anti-affinity-group",
+						Policy: infrav1beta2.PowerVSPlacementGroupPolicyAntiAffinity,
+					},
+					expectedGroupID: "foo-anti-affinity-group-id",
+				},
+				{
+					name: "Placement group without policy",
+					placementGroup: &infrav1beta2.IBMPowerVSPlacementGroup{
+						Name: "foo-anti-affinity-group",
+					},
+					expectedGroupID: "foo-anti-affinity-group-id",
+				},
+			}
+			for _, tc := range testCases {
+				t.Run(tc.name, func(t *testing.T) {
+					g := NewWithT(t)
+					setup(t)
+					t.Cleanup(teardown)
+					scope := setupPowerVSMachineScope(clusterName, machineName, core.StringPtr(pvsImage), core.StringPtr(pvsNetwork), true, mockpowervs)
+					scope.IBMPowerVSMachine.Spec.PlacementGroup = tc.placementGroup
+					mockpowervs.EXPECT().GetAllInstance().Return(pvmInstances, nil)
+					mockpowervs.EXPECT().GetPlacementGroupByName(tc.placementGroup.Name).DoAndReturn(func(name string) (*models.PlacementGroup, error) {
+						for _, pg := range placementGroups.PlacementGroups {
+							if *pg.Name == name {
+								return pg, nil
+							}
+						}
+						return nil, nil
+					})
+					mockpowervs.EXPECT().CreateInstance(gomock.AssignableToTypeOf(pvmInstanceCreate)).DoAndReturn(func(body *models.PVMInstanceCreate) (*models.PVMInstanceList, error) {
+						g.Expect(body.PlacementGroup).To(Equal(tc.expectedGroupID))
+						return pvmInstanceList, nil
+					})
+					_, err := scope.CreateMachine()
+					g.Expect(err).To(BeNil())
+				})
+			}
+
+			t.Run("Error when the placement group does not exist", func(t *testing.T) {
+				g := NewWithT(t)
+				setup(t)
+				t.Cleanup(teardown)
+				scope := setupPowerVSMachineScope(clusterName, machineName, core.StringPtr(pvsImage), core.StringPtr(pvsNetwork), true, mockpowervs)
+				scope.IBMPowerVSMachine.Spec.PlacementGroup = &infrav1beta2.IBMPowerVSPlacementGroup{
+					Name: "foo-missing-group",
+				}
+				mockpowervs.EXPECT().GetAllInstance().Return(pvmInstances, nil)
+				mockpowervs.EXPECT().GetPlacementGroupByName("foo-missing-group").Return(nil, nil)
+				mockpowervs.EXPECT().CreateInstance(gomock.Any()).Times(0)
+				_, err := scope.CreateMachine()
+				g.Expect(err).To(MatchError(ContainSubstring("placement group foo-missing-group does not exist")))
+			})
+
+			t.Run("Error when the placement group has a different policy", func(t *testing.T) {
+				g := NewWithT(t)
+				setup(t)
+				t.Cleanup(teardown)
+				scope := setupPowerVSMachineScope(clusterName, machineName, core.StringPtr(pvsImage), core.StringPtr(pvsNetwork), true, mockpowervs)
+				scope.IBMPowerVSMachine.Spec.PlacementGroup = &infrav1beta2.IBMPowerVSPlacementGroup{
+					Name:   "foo-affinity-group",
+					Policy: infrav1beta2.PowerVSPlacementGroupPolicyAntiAffinity,
+				}
+				mockpowervs.EXPECT().GetAllInstance().Return(pvmInstances, nil)
+				mockpowervs.EXPECT().GetPlacementGroupByName("foo-affinity-group").Return(placementGroups.PlacementGroups[0], nil)
+				mockpowervs.EXPECT().CreateInstance(gomock.Any()).Times(0)
+				_, err := scope.CreateMachine()
+				g.Expect(err).To(MatchError(ContainSubstring("has policy affinity, expected anti-affinity")))
+			})
+		})
+
 		t.Run("Return exsisting Machine", func(t *testing.T) {
 			g := NewWithT(t)
 			setup(t)
@@ -18,13 +18,92 @@ limitations under the License.
 package iam
 
 import (
+	"fmt"
+	"os"
+	"path/filepath"
+
 	"github.com/IBM/go-sdk-core/v5/core"
 
+	"sigs.k8s.io/yaml"
+
 	"sigs.k8s.io/cluster-api-provider-ibmcloud/cmd/capibmadm/options"
 )
 
-// GetIAMAuth creates core Authenticator from API key provided.
-func GetIAMAuth() *core.IamAuthenticator {
+// Profile holds the credentials of a named profile in the credentials file.
+// A profile either sets an API key or a trusted profile ID, which is exchanged for a token using the compute
+// resource token read from CRTokenFilename.
+type Profile struct {
+	APIKey           string `json:"apiKey,omitempty"`
+	TrustedProfileID string `json:"trustedProfileID,omitempty"`
+	CRTokenFilename  string `json:"crTokenFilename,omitempty"`
+}
+
+// credentials is the format of the credentials file.
+type credentials struct {
+	Profiles map[string]Profile `json:"profiles"`
+}
+
+// DefaultCredentialsFile returns the path of the credentials file used when --credentials-file is not set.
+func DefaultCredentialsFile() string {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return ""
+	}
+	return filepath.Join(home, ".capibmadm", "credentials")
+}
+
+// LoadCredentials sets the credentials in the global options from the profile selected with --profile, falling back
+// to the API key set in the IBMCLOUD_API_KEY environment variable when no profile is selected.
+func LoadCredentials() error {
+	if options.GlobalOptions.Profile == "" {
+		apiKey := os.Getenv(options.IBMCloudAPIKeyEnvName)
+		if apiKey == "" {
+			return fmt.Errorf("ibmcloud api key is not provided, set %s environmental variable or select a profile with --profile", options.IBMCloudAPIKeyEnvName)
+		}
+		options.GlobalOptions.IBMCloudAPIKey = apiKey
+		return nil
+	}
+
+	profile, err := loadProfile(options.GlobalOptions.CredentialsFile, options.GlobalOptions.Profile)
+	if err != nil {
+		return err
+	}
+	options.GlobalOptions.IBMCloudAPIKey = profile.APIKey
+	options.GlobalOptions.TrustedProfileID = profile.TrustedProfileID
+	options.GlobalOptions.CRTokenFilename = profile.CRTokenFilename
+	return nil
+}
+
+// loadProfile reads the named profile from the credentials file.
+func loadProfile(credentialsFile, name string) (*Profile, error) {
+	data, err := os.ReadFile(credentialsFile) // #nosec
+	if err != nil {
+		return nil, fmt.Errorf("error reading credentials file: %w", err)
+	}
+
+	var creds credentials
+	if err := yaml.UnmarshalStrict(data, &creds); err != nil {
+		return nil, fmt.Errorf("error parsing credentials file %s: %w", credentialsFile, err)
+	}
+
+	profile, ok := creds.Profiles[name]
+	if !ok {
+		return nil, fmt.Errorf("profile %s not found in credentials file %s", name, credentialsFile)
+	}
+	if (profile.APIKey == "") == (profile.TrustedProfileID == "") {
+		return nil, fmt.Errorf("profile %s must set exactly one of apiKey or trustedProfileID", name)
+	}
+	return &profile, nil
+}
+
+// GetIAMAuth creates core Authenticator from the trusted profile or the API key provided.
+func GetIAMAuth() core.Authenticator {
+	if options.GlobalOptions.TrustedProfileID != "" {
+		return &core.ContainerAuthenticator{
+			IAMProfileID:    options.GlobalOptions.TrustedProfileID,
+			CRTokenFilename: options.GlobalOptions.CRTokenFilename,
+		}
+	}
 	return &core.IamAuthenticator{
 		ApiKey: options.GlobalOptions.IBMCloudAPIKey,
 	}
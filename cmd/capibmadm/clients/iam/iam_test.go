@@ -0,0 +1,107 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package iam
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+
+	. "github.com/onsi/gomega"
+
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/cmd/capibmadm/options"
+)
+
+const testCredentials = `profiles:
+  dev:
+    apiKey: dev-api-key
+  prod:
+    trustedProfileID: Profile-prod
+    crTokenFilename: /var/run/secrets/tokens/sa-token
+  invalid:
+    apiKey: invalid-api-key
+    trustedProfileID: Profile-invalid
+`
+
+func setupCredentials(t *testing.T, profile string) {
+	t.Helper()
+	credentialsFile := filepath.Join(t.TempDir(), "credentials")
+	if err := os.WriteFile(credentialsFile, []byte(testCredentials), 0600); err != nil {
+		t.Fatalf("failed to write credentials file: %v", err)
+	}
+
+	globalOptions := *options.GlobalOptions
+	t.Cleanup(func() {
+		*options.GlobalOptions = globalOptions
+	})
+	options.GlobalOptions.Profile = profile
+	options.GlobalOptions.CredentialsFile = credentialsFile
+}
+
+func TestLoadCredentials(t *testing.T) {
+	t.Run("Should fall back to the API key environment variable", func(t *testing.T) {
+		g := NewWithT(t)
+		setupCredentials(t, "")
+		t.Setenv(options.IBMCloudAPIKeyEnvName, "env-api-key")
+
+		g.Expect(LoadCredentials()).To(Succeed())
+		auth, ok := GetIAMAuth().(*core.IamAuthenticator)
+		g.Expect(ok).To(BeTrue())
+		g.Expect(auth.ApiKey).To(Equal("env-api-key"))
+	})
+	t.Run("Should use the API key of the named profile", func(t *testing.T) {
+		g := NewWithT(t)
+		setupCredentials(t, "dev")
+		t.Setenv(options.IBMCloudAPIKeyEnvName, "env-api-key")
+
+		g.Expect(LoadCredentials()).To(Succeed())
+		auth, ok := GetIAMAuth().(*core.IamAuthenticator)
+		g.Expect(ok).To(BeTrue())
+		g.Expect(auth.ApiKey).To(Equal("dev-api-key"))
+	})
+	t.Run("Should use the trusted profile of the named profile", func(t *testing.T) {
+		g := NewWithT(t)
+		setupCredentials(t, "prod")
+
+		g.Expect(LoadCredentials()).To(Succeed())
+		auth, ok := GetIAMAuth().(*core.ContainerAuthenticator)
+		g.Expect(ok).To(BeTrue())
+		g.Expect(auth.IAMProfileID).To(Equal("Profile-prod"))
+		g.Expect(auth.CRTokenFilename).To(Equal("/var/run/secrets/tokens/sa-token"))
+	})
+	t.Run("Error when the profile is missing", func(t *testing.T) {
+		g := NewWithT(t)
+		setupCredentials(t, "staging")
+
+		g.Expect(LoadCredentials()).To(MatchError(ContainSubstring("profile staging not found")))
+	})
+	t.Run("Error when the profile sets both an API key and a trusted profile", func(t *testing.T) {
+		g := NewWithT(t)
+		setupCredentials(t, "invalid")
+
+		g.Expect(LoadCredentials()).To(Not(Succeed()))
+	})
+	t.Run("Error when neither a profile nor the API key environment variable is set", func(t *testing.T) {
+		g := NewWithT(t)
+		setupCredentials(t, "")
+		t.Setenv(options.IBMCloudAPIKeyEnvName, "")
+
+		g.Expect(LoadCredentials()).To(Not(Succeed()))
+	})
+}
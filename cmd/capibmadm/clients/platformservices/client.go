@@ -19,6 +19,7 @@ package platformservices
 
 import (
 	"github.com/IBM/platform-services-go-sdk/iamidentityv1"
+	"github.com/IBM/platform-services-go-sdk/resourcecontrollerv2"
 	"github.com/IBM/platform-services-go-sdk/resourcemanagerv2"
 
 	"sigs.k8s.io/cluster-api-provider-ibmcloud/cmd/capibmadm/clients/iam"
@@ -39,3 +40,11 @@ func NewIAMIdentityClient() (*iamidentityv1.IamIdentityV1, error) {
 		URL:           iamidentityv1.DefaultServiceURL,
 	})
 }
+
+// NewResourceControllerV2Client creates new resource controller client.
+func NewResourceControllerV2Client() (*resourcecontrollerv2.ResourceControllerV2, error) {
+	return resourcecontrollerv2.NewResourceControllerV2(&resourcecontrollerv2.ResourceControllerV2Options{
+		Authenticator: iam.GetIAMAuth(),
+		URL:           resourcecontrollerv2.DefaultServiceURL,
+	})
+}
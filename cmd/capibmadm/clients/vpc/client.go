@@ -21,16 +21,29 @@ import (
 	"github.com/IBM/vpc-go-sdk/vpcv1"
 
 	"sigs.k8s.io/cluster-api-provider-ibmcloud/cmd/capibmadm/clients/iam"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/cmd/capibmadm/options"
 )
 
 // NewV1Client creates new vpcv1 client.
 // To-Do: Need to handle custom endpoint URL if user wants to use staging env.
 func NewV1Client(region string) (*vpcv1.VpcV1, error) {
+	if err := ValidateRegion(region); err != nil {
+		return nil, err
+	}
+
 	svcEndpoint := "https://" + region + ".iaas.cloud.ibm.com/v1"
 
-	return vpcv1.NewVpcV1(&vpcv1.VpcV1Options{
+	v1, err := vpcv1.NewVpcV1(&vpcv1.VpcV1Options{
 		ServiceName:   "vpcs",
 		Authenticator: iam.GetIAMAuth(),
 		URL:           svcEndpoint,
 	})
+	if err != nil {
+		return nil, err
+	}
+
+	if options.GlobalOptions.MaxRetries > 0 {
+		v1.Service.SetHTTPClient(withRetries(v1.Service.GetHTTPClient(), options.GlobalOptions.MaxRetries, options.GlobalOptions.RetryBaseDelay))
+	}
+	return v1, nil
 }
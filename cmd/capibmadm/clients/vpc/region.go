@@ -0,0 +1,57 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vpc
+
+import (
+	"fmt"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/cmd/capibmadm/options"
+)
+
+// supportedRegions is the list of the VPC regions known to capibmadm.
+var supportedRegions = []string{
+	"au-syd",
+	"br-sao",
+	"ca-tor",
+	"eu-de",
+	"eu-es",
+	"eu-gb",
+	"jp-osa",
+	"jp-tok",
+	"us-east",
+	"us-south",
+}
+
+// ValidateRegion returns an error if region is not one of the supported VPC regions.
+// The validation is skipped when the environmental variable named by options.AllowUnlistedVPCRegionEnvName is set to true.
+func ValidateRegion(region string) error {
+	if region == "" {
+		return fmt.Errorf("the required flag region is not set")
+	}
+	if allow, _ := strconv.ParseBool(os.Getenv(options.AllowUnlistedVPCRegionEnvName)); allow {
+		return nil
+	}
+	if !slices.Contains(supportedRegions, region) {
+		return fmt.Errorf("unsupported VPC region %q, supported regions: %s. Set %s=true to use a region not in this list",
+			region, strings.Join(supportedRegions, ", "), options.AllowUnlistedVPCRegionEnvName)
+	}
+	return nil
+}
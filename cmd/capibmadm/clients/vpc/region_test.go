@@ -0,0 +1,80 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vpc
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/cmd/capibmadm/options"
+)
+
+func TestValidateRegion(t *testing.T) {
+	testCases := []struct {
+		name      string
+		region    string
+		allowEnv  string
+		expectErr bool
+	}{
+		{
+			name:   "Should accept a supported region",
+			region: "us-south",
+		},
+		{
+			name:      "Error when region is not supported",
+			region:    "us-suoth",
+			expectErr: true,
+		},
+		{
+			name:      "Error when region is not set",
+			expectErr: true,
+		},
+		{
+			name:     "Should accept an unlisted region when the override is set",
+			region:   "us-west",
+			allowEnv: "true",
+		},
+		{
+			name:      "Error when region is not supported and the override is disabled",
+			region:    "us-west",
+			allowEnv:  "false",
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			t.Setenv(options.AllowUnlistedVPCRegionEnvName, tc.allowEnv)
+			err := ValidateRegion(tc.region)
+			if tc.expectErr {
+				g.Expect(err).To(Not(BeNil()))
+			} else {
+				g.Expect(err).To(BeNil())
+			}
+		})
+	}
+}
+
+func TestValidateRegionErrorMessage(t *testing.T) {
+	g := NewWithT(t)
+	t.Setenv(options.AllowUnlistedVPCRegionEnvName, "")
+	err := ValidateRegion("us-suoth")
+	g.Expect(err).To(MatchError(ContainSubstring("us-south")))
+	g.Expect(err).To(MatchError(ContainSubstring(options.AllowUnlistedVPCRegionEnvName)))
+}
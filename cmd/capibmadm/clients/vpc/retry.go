@@ -0,0 +1,102 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vpc
+
+import (
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// maxRetryDelay caps the delay between retries, including the one requested by a Retry-After header.
+const maxRetryDelay = 1 * time.Minute
+
+// retryTransport retries idempotent requests that failed with a transient status code, waiting with an exponential
+// backoff or for the duration requested by the Retry-After header.
+type retryTransport struct {
+	next       http.RoundTripper
+	maxRetries int
+	baseDelay  time.Duration
+}
+
+// withRetries returns a copy of client that retries GET and HEAD requests up to maxRetries times.
+func withRetries(client *http.Client, maxRetries int, baseDelay time.Duration) *http.Client {
+	next := client.Transport
+	if next == nil {
+		next = http.DefaultTransport
+	}
+
+	retryClient := *client
+	retryClient.Transport = &retryTransport{
+		next:       next,
+		maxRetries: maxRetries,
+		baseDelay:  baseDelay,
+	}
+	return &retryClient
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *retryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Method != http.MethodGet && req.Method != http.MethodHead {
+		return t.next.RoundTrip(req)
+	}
+
+	for attempt := 0; ; attempt++ {
+		resp, err := t.next.RoundTrip(req)
+		if err != nil || attempt >= t.maxRetries || !isRetryableStatus(resp.StatusCode) {
+			return resp, err
+		}
+
+		delay := t.retryDelay(resp, attempt)
+		_, _ = io.Copy(io.Discard, resp.Body)
+		_ = resp.Body.Close()
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-req.Context().Done():
+			timer.Stop()
+			return nil, req.Context().Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// retryDelay returns the delay requested by the Retry-After header of resp, or the exponential backoff for attempt.
+func (t *retryTransport) retryDelay(resp *http.Response, attempt int) time.Duration {
+	delay := t.baseDelay << attempt
+	if retryAfter := resp.Header.Get("Retry-After"); retryAfter != "" {
+		if seconds, err := strconv.Atoi(retryAfter); err == nil && seconds >= 0 {
+			delay = time.Duration(seconds) * time.Second
+		} else if retryTime, err := http.ParseTime(retryAfter); err == nil {
+			delay = time.Until(retryTime)
+		}
+	}
+	if delay < 0 {
+		return 0
+	}
+	if delay > maxRetryDelay {
+		return maxRetryDelay
+	}
+	return delay
+}
+
+// isRetryableStatus reports whether the status code is a transient failure: 429 or a 5xx other than 501.
+func isRetryableStatus(statusCode int) bool {
+	return statusCode == http.StatusTooManyRequests ||
+		(statusCode >= http.StatusInternalServerError && statusCode != http.StatusNotImplemented && statusCode <= 599)
+}
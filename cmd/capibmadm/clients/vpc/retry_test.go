@@ -0,0 +1,121 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vpc
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+)
+
+// newFlakyServer returns a server that responds with statusCode for the first failures requests and 200 afterwards.
+func newFlakyServer(failures, statusCode int, retryAfter string) (*httptest.Server, *atomic.Int32) {
+	requests := &atomic.Int32{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		if int(requests.Add(1)) <= failures {
+			if retryAfter != "" {
+				w.Header().Set("Retry-After", retryAfter)
+			}
+			w.WriteHeader(statusCode)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	return server, requests
+}
+
+func TestRetryTransport(t *testing.T) {
+	t.Run("Should retry a GET request until it succeeds", func(t *testing.T) {
+		g := NewWithT(t)
+		server, requests := newFlakyServer(2, http.StatusTooManyRequests, "")
+		defer server.Close()
+
+		client := withRetries(server.Client(), 3, time.Millisecond)
+		resp, err := client.Get(server.URL)
+		g.Expect(err).To(BeNil())
+		defer resp.Body.Close()
+		g.Expect(resp.StatusCode).To(Equal(http.StatusOK))
+		g.Expect(requests.Load()).To(Equal(int32(3)))
+	})
+	t.Run("Should retry a GET request failing with a 5xx response", func(t *testing.T) {
+		g := NewWithT(t)
+		server, requests := newFlakyServer(1, http.StatusServiceUnavailable, "")
+		defer server.Close()
+
+		client := withRetries(server.Client(), 3, time.Millisecond)
+		resp, err := client.Get(server.URL)
+		g.Expect(err).To(BeNil())
+		defer resp.Body.Close()
+		g.Expect(resp.StatusCode).To(Equal(http.StatusOK))
+		g.Expect(requests.Load()).To(Equal(int32(2)))
+	})
+	t.Run("Should honor the Retry-After header", func(t *testing.T) {
+		g := NewWithT(t)
+		server, requests := newFlakyServer(1, http.StatusTooManyRequests, "1")
+		defer server.Close()
+
+		client := withRetries(server.Client(), 3, time.Millisecond)
+		start := time.Now()
+		resp, err := client.Get(server.URL)
+		g.Expect(err).To(BeNil())
+		defer resp.Body.Close()
+		g.Expect(resp.StatusCode).To(Equal(http.StatusOK))
+		g.Expect(requests.Load()).To(Equal(int32(2)))
+		g.Expect(time.Since(start)).To(BeNumerically(">=", time.Second))
+	})
+	t.Run("Should return the last response once the retries are exhausted", func(t *testing.T) {
+		g := NewWithT(t)
+		server, requests := newFlakyServer(5, http.StatusTooManyRequests, "")
+		defer server.Close()
+
+		client := withRetries(server.Client(), 2, time.Millisecond)
+		resp, err := client.Get(server.URL)
+		g.Expect(err).To(BeNil())
+		defer resp.Body.Close()
+		g.Expect(resp.StatusCode).To(Equal(http.StatusTooManyRequests))
+		g.Expect(requests.Load()).To(Equal(int32(3)))
+	})
+	t.Run("Should not retry a POST request", func(t *testing.T) {
+		g := NewWithT(t)
+		server, requests := newFlakyServer(2, http.StatusTooManyRequests, "")
+		defer server.Close()
+
+		client := withRetries(server.Client(), 3, time.Millisecond)
+		resp, err := client.Post(server.URL, "application/json", strings.NewReader("{}"))
+		g.Expect(err).To(BeNil())
+		defer resp.Body.Close()
+		g.Expect(resp.StatusCode).To(Equal(http.StatusTooManyRequests))
+		g.Expect(requests.Load()).To(Equal(int32(1)))
+	})
+	t.Run("Should not retry a non transient failure", func(t *testing.T) {
+		g := NewWithT(t)
+		server, requests := newFlakyServer(2, http.StatusNotImplemented, "")
+		defer server.Close()
+
+		client := withRetries(server.Client(), 3, time.Millisecond)
+		resp, err := client.Get(server.URL)
+		g.Expect(err).To(BeNil())
+		defer resp.Body.Close()
+		g.Expect(resp.StatusCode).To(Equal(http.StatusNotImplemented))
+		g.Expect(requests.Load()).To(Equal(int32(1)))
+	})
+}
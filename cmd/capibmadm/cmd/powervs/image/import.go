@@ -37,6 +37,20 @@ import (
 	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/utils"
 )
 
+// jobPollInterval is the interval between image import job status checks.
+var jobPollInterval = 2 * time.Minute
+
+// imageImporter is the subset of the PowerVS image client used to import images.
+type imageImporter interface {
+	CreateCosImage(body *models.CreateCosImageImportJob) (*models.JobReference, error)
+	GetAll() (*models.Images, error)
+}
+
+// jobGetter is the subset of the PowerVS job client used to watch the image import job.
+type jobGetter interface {
+	Get(id string) (*models.Job, error)
+}
+
 type imageImportOptions struct {
 	BucketName    string
 	Region        string
@@ -47,6 +61,7 @@ type imageImportOptions struct {
 	StorageType   string
 	InstanceID    string
 	Public        bool
+	Wait          bool
 	WatchTimeout  time.Duration
 }
 
@@ -69,6 +84,9 @@ capibmadm powervs image import --service-instance-id <service-instance-id> -b <b
 
 # import image from a public IBM Cloud Storage bucket
 capibmadm powervs image import --service-instance-id <service-instance-id> -b <bucketname> --zone <zone> -r <region> --object rhel-83-10032020.ova.gz --name test_image --public-bucket 
+
+# submit the image import job without waiting for it to complete
+capibmadm powervs image import --service-instance-id <service-instance-id> -b <bucketname> --object rhel-83-10032020.ova.gz --name test-image -r <region> --zone <zone> --wait=false
 `,
 	}
 	var imageImportOption imageImportOptions
@@ -79,6 +97,7 @@ capibmadm powervs image import --service-instance-id <service-instance-id> -b <b
 	cmd.Flags().StringVar(&imageImportOption.SecretKey, "secretkey", "", "Cloud Object Storage HMAC secret key.")
 	cmd.Flags().StringVar(&imageImportOption.ImageName, "name", "", "Name to PowerVS imported image.")
 	cmd.Flags().BoolVarP(&imageImportOption.Public, "public-bucket", "", false, "Cloud Object Storage public bucket.")
+	cmd.Flags().BoolVar(&imageImportOption.Wait, "wait", true, "Wait for the image import job to complete.")
 	cmd.Flags().DurationVar(&imageImportOption.WatchTimeout, "watch-timeout", 1*time.Hour, "watch timeout")
 	cmd.Flags().StringVar(&imageImportOption.StorageType, "pvs-storagetype", "tier3", "PowerVS Storage type, accepted values are [tier1, tier3].")
 	_ = cmd.MarkFlagRequired("bucket")
@@ -107,7 +126,7 @@ func importimage(ctx context.Context, imageImportOption imageImportOptions) erro
 	log := logf.Log
 	log.Info("Importing PowerVS images: ", "service-instance-id", options.GlobalOptions.ServiceInstanceID)
 
-	accountID, err := utils.GetAccount(iam.GetIAMAuth())
+	accountID, err := utils.GetAccount(ctx, iam.GetIAMAuth())
 	if err != nil {
 		return err
 	}
@@ -116,6 +135,15 @@ func importimage(ctx context.Context, imageImportOption imageImportOptions) erro
 	if err != nil {
 		return err
 	}
+
+	imageClient := powerClient.NewIBMPIImageClient(ctx, sess, options.GlobalOptions.ServiceInstanceID)
+	jobClient := powerClient.NewIBMPIJobClient(ctx, sess, options.GlobalOptions.ServiceInstanceID)
+	return createCosImage(ctx, imageClient, jobClient, imageImportOption)
+}
+
+func createCosImage(ctx context.Context, imageClient imageImporter, jobClient jobGetter, imageImportOption imageImportOptions) error {
+	log := logf.Log
+
 	// By default Bucket Access is private
 	bucketAccess := "private"
 
@@ -133,16 +161,19 @@ func importimage(ctx context.Context, imageImportOption imageImportOptions) erro
 		Region:        &imageImportOption.Region,
 		StorageType:   strings.ToLower(imageImportOption.StorageType),
 	}
-	imageClient := powerClient.NewIBMPIImageClient(ctx, sess, options.GlobalOptions.ServiceInstanceID)
 	jobRef, err := imageClient.CreateCosImage(body)
 	if err != nil {
 		return err
 	}
+	log.Info("Image import job created", "job-id", *jobRef.ID)
+
+	if !imageImportOption.Wait {
+		return nil
+	}
 
 	start := time.Now()
-	pollErr := wait.PollUntilContextTimeout(ctx, 2*time.Minute, imageImportOption.WatchTimeout, false, func(context.Context) (bool, error) {
-		jobclient := powerClient.NewIBMPIJobClient(ctx, sess, options.GlobalOptions.ServiceInstanceID)
-		job, err := jobclient.Get(*jobRef.ID)
+	pollErr := wait.PollUntilContextTimeout(ctx, jobPollInterval, imageImportOption.WatchTimeout, false, func(context.Context) (bool, error) {
+		job, err := jobClient.Get(*jobRef.ID)
 		if err != nil {
 			return false, err
 		}
@@ -153,12 +184,12 @@ func importimage(ctx context.Context, imageImportOption imageImportOptions) erro
 		if *job.Status.State == "failed" {
 			return false, fmt.Errorf("image import job failed to complete, err: %v", job.Status.Message)
 		}
-		log.Info("Image Import Job in-progress,", "current state", job.Status.State)
+		log.Info("Image Import Job in-progress,", "job-id", *jobRef.ID, "current state", *job.Status.State)
 		return false, nil
 	})
 
 	if pollErr != nil {
-		return fmt.Errorf("image import job failed to complete, err: %v", pollErr)
+		return fmt.Errorf("image import job %s failed to complete, err: %v", *jobRef.ID, pollErr)
 	}
 
 	log.Info("Retrieving image details")
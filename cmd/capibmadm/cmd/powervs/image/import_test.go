@@ -0,0 +1,121 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package image
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/IBM-Cloud/power-go-client/power/models"
+
+	"k8s.io/utils/ptr"
+
+	. "github.com/onsi/gomega"
+)
+
+// fakeImageImporter records the submitted import job and serves the imported image once the job completed.
+type fakeImageImporter struct {
+	body      *models.CreateCosImageImportJob
+	createErr error
+	images    []*models.ImageReference
+}
+
+func (f *fakeImageImporter) CreateCosImage(body *models.CreateCosImageImportJob) (*models.JobReference, error) {
+	if f.createErr != nil {
+		return nil, f.createErr
+	}
+	f.body = body
+	return &models.JobReference{ID: ptr.To("foo-job-id")}, nil
+}
+
+func (f *fakeImageImporter) GetAll() (*models.Images, error) {
+	return &models.Images{Images: f.images}, nil
+}
+
+// fakeJobGetter returns the given job states in order, repeating the last one.
+type fakeJobGetter struct {
+	states []string
+	calls  int
+}
+
+func (f *fakeJobGetter) Get(_ string) (*models.Job, error) {
+	state := f.states[min(f.calls, len(f.states)-1)]
+	f.calls++
+	return &models.Job{Status: &models.Status{State: ptr.To(state)}}, nil
+}
+
+func newImageImportOptions(wait bool) imageImportOptions {
+	return imageImportOptions{
+		BucketName:    "foo-bucket",
+		Region:        "us-south",
+		ImageFilename: "rhel.ova.gz",
+		ImageName:     "foo-image",
+		StorageType:   "TIER3",
+		Wait:          wait,
+		WatchTimeout:  time.Minute,
+	}
+}
+
+func TestCreateCosImage(t *testing.T) {
+	pollInterval := jobPollInterval
+	jobPollInterval = time.Millisecond
+	t.Cleanup(func() {
+		jobPollInterval = pollInterval
+	})
+
+	t.Run("Should submit the import job without waiting", func(t *testing.T) {
+		g := NewWithT(t)
+		imageClient := &fakeImageImporter{}
+		jobClient := &fakeJobGetter{states: []string{"running"}}
+		err := createCosImage(context.TODO(), imageClient, jobClient, newImageImportOptions(false))
+		g.Expect(err).To(BeNil())
+		g.Expect(*imageClient.body.BucketName).To(Equal("foo-bucket"))
+		g.Expect(*imageClient.body.BucketAccess).To(Equal("private"))
+		g.Expect(imageClient.body.StorageType).To(Equal("tier3"))
+		g.Expect(jobClient.calls).To(Equal(0))
+	})
+	t.Run("Should poll the import job to completion", func(t *testing.T) {
+		g := NewWithT(t)
+		imageClient := &fakeImageImporter{images: []*models.ImageReference{{Name: ptr.To("foo-image"), ImageID: ptr.To("foo-image-id")}}}
+		jobClient := &fakeJobGetter{states: []string{"queued", "running", "completed"}}
+		err := createCosImage(context.TODO(), imageClient, jobClient, newImageImportOptions(true))
+		g.Expect(err).To(BeNil())
+		g.Expect(jobClient.calls).To(Equal(3))
+	})
+	t.Run("Error when the import job fails", func(t *testing.T) {
+		g := NewWithT(t)
+		imageClient := &fakeImageImporter{}
+		jobClient := &fakeJobGetter{states: []string{"running", "failed"}}
+		err := createCosImage(context.TODO(), imageClient, jobClient, newImageImportOptions(true))
+		g.Expect(err).To(MatchError(ContainSubstring("foo-job-id")))
+	})
+	t.Run("Error when the imported image is not found", func(t *testing.T) {
+		g := NewWithT(t)
+		imageClient := &fakeImageImporter{}
+		jobClient := &fakeJobGetter{states: []string{"completed"}}
+		err := createCosImage(context.TODO(), imageClient, jobClient, newImageImportOptions(true))
+		g.Expect(err).To(Not(BeNil()))
+	})
+	t.Run("Error when submitting the import job fails", func(t *testing.T) {
+		g := NewWithT(t)
+		imageClient := &fakeImageImporter{createErr: errors.New("failed to create job")}
+		err := createCosImage(context.TODO(), imageClient, &fakeJobGetter{}, newImageImportOptions(true))
+		g.Expect(err).To(Not(BeNil()))
+	})
+}
@@ -19,12 +19,14 @@ package image
 import (
 	"context"
 	"fmt"
+	"io"
 	"os"
 
 	"github.com/go-openapi/strfmt"
 	"github.com/spf13/cobra"
 
 	powerClient "github.com/IBM-Cloud/power-go-client/clients/instance"
+	"github.com/IBM-Cloud/power-go-client/power/models"
 
 	logf "sigs.k8s.io/cluster-api/cmd/clusterctl/log"
 
@@ -57,7 +59,7 @@ func listimage(ctx context.Context) error {
 	log := logf.Log
 	log.Info("Listing PowerVS images", "service-instance-id", options.GlobalOptions.ServiceInstanceID)
 
-	accountID, err := pkgUtils.GetAccount(iam.GetIAMAuth())
+	accountID, err := pkgUtils.GetAccount(ctx, iam.GetIAMAuth())
 	if err != nil {
 		return err
 	}
@@ -72,16 +74,16 @@ func listimage(ctx context.Context) error {
 	if err != nil {
 		return err
 	}
-	if len(images.Images) == 0 {
-		fmt.Println("No images found")
-		return nil
-	}
 
+	return display(images.Images, os.Stdout)
+}
+
+func toImgList(images []*models.ImageReference) ImgList {
 	imageList := ImgList{
 		Items: []ImgSpec{},
 	}
 
-	for _, image := range images.Images {
+	for _, image := range images {
 		imageToAppend := ImgSpec{
 			ImageID:        utils.DereferencePointer(image.ImageID).(string),
 			Name:           utils.DereferencePointer(image.Name).(string),
@@ -104,15 +106,29 @@ func listimage(ctx context.Context) error {
 
 		imageList.Items = append(imageList.Items, imageToAppend)
 	}
+	return imageList
+}
+
+func display(images []*models.ImageReference, w io.Writer) error {
+	if options.GlobalOptions.Quiet {
+		imageList := toImgList(images)
+		return printer.NewQuiet(w).Print(&imageList)
+	}
+
+	if len(images) == 0 {
+		_, err := fmt.Fprintln(w, "No images found")
+		return err
+	}
 
-	printerObj, err := printer.New(options.GlobalOptions.Output, os.Stdout)
+	imageList := toImgList(images)
+	printerObj, err := options.NewPrinter(w)
 
 	if err != nil {
 		return err
 	}
 
 	switch options.GlobalOptions.Output {
-	case printer.PrinterTypeJSON:
+	case printer.PrinterTypeJSON, printer.PrinterTypeYAML, printer.PrinterTypeTemplate:
 		err = printerObj.Print(imageList)
 	default:
 		table := imageList.ToTable()
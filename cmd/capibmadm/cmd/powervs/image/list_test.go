@@ -0,0 +1,92 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package image
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/IBM-Cloud/power-go-client/power/models"
+
+	"k8s.io/utils/ptr"
+
+	. "github.com/onsi/gomega"
+
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/cmd/capibmadm/options"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/cmd/capibmadm/printer"
+)
+
+func TestToImgList(t *testing.T) {
+	g := NewWithT(t)
+	images := []*models.ImageReference{
+		{
+			ImageID:     ptr.To("foo-image-id"),
+			Name:        ptr.To("foo-image"),
+			State:       ptr.To("active"),
+			StoragePool: ptr.To("Tier1-Flash-1"),
+			StorageType: ptr.To("tier1"),
+			Specifications: &models.ImageSpecifications{
+				Architecture:    "ppc64",
+				OperatingSystem: "rhel",
+			},
+		},
+		{
+			ImageID: ptr.To("bar-image-id"),
+			Name:    ptr.To("bar-image"),
+		},
+	}
+
+	imageList := toImgList(images)
+	g.Expect(imageList.Items).To(Equal([]ImgSpec{
+		{
+			ImageID:         "foo-image-id",
+			Name:            "foo-image",
+			State:           "active",
+			StoragePool:     "Tier1-Flash-1",
+			StorageType:     "tier1",
+			Architecture:    "ppc64",
+			OperatingSystem: "rhel",
+		},
+		{
+			ImageID: "bar-image-id",
+			Name:    "bar-image",
+		},
+	}))
+}
+
+func TestDisplay(t *testing.T) {
+	output := options.GlobalOptions.Output
+	t.Cleanup(func() {
+		options.GlobalOptions.Output = output
+	})
+
+	t.Run("Should print the images", func(t *testing.T) {
+		g := NewWithT(t)
+		options.GlobalOptions.Output = printer.PrinterTypeJSON
+		buf := &bytes.Buffer{}
+		err := display([]*models.ImageReference{{ImageID: ptr.To("foo-image-id"), Name: ptr.To("foo-image")}}, buf)
+		g.Expect(err).To(BeNil())
+		g.Expect(buf.String()).To(ContainSubstring(`"id": "foo-image-id"`))
+	})
+	t.Run("Should report when there are no images", func(t *testing.T) {
+		g := NewWithT(t)
+		options.GlobalOptions.Output = printer.PrinterTypeTable
+		buf := &bytes.Buffer{}
+		g.Expect(display(nil, buf)).To(Succeed())
+		g.Expect(buf.String()).To(Equal("No images found\n"))
+	})
+}
@@ -46,6 +46,15 @@ type ImgList struct {
 	Items []ImgSpec `json:"items"`
 }
 
+// IDs returns the IDs of the images in the list.
+func (imageList *ImgList) IDs() []string {
+	ids := make([]string, 0, len(imageList.Items))
+	for _, image := range imageList.Items {
+		ids = append(ids, image.ImageID)
+	}
+	return ids
+}
+
 // ToTable converts List to *metav1.Table.
 func (imageList *ImgList) ToTable() *metav1.Table {
 	table := &metav1.Table{
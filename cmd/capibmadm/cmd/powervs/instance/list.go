@@ -0,0 +1,133 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package instance
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	v "github.com/IBM-Cloud/power-go-client/clients/instance"
+	"github.com/IBM-Cloud/power-go-client/power/models"
+
+	logf "sigs.k8s.io/cluster-api/cmd/clusterctl/log"
+
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/cmd/capibmadm/clients/iam"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/cmd/capibmadm/clients/powervs"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/cmd/capibmadm/options"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/cmd/capibmadm/printer"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/cmd/capibmadm/utils"
+	pkgUtils "sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/utils"
+)
+
+// ListCommand function to list PowerVS instances.
+func ListCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List PowerVS instances",
+		Example: `
+# List PowerVS instances
+export IBMCLOUD_API_KEY=<api-key>
+capibmadm powervs instance list --service-instance-id <service-instance-id> --zone <zone>`,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			return listInstances(cmd.Context())
+		},
+	}
+
+	options.AddCommonFlags(cmd)
+	return cmd
+}
+
+func listInstances(ctx context.Context) error {
+	log := logf.Log
+	log.Info("Listing PowerVS instances", "service-instance-id", options.GlobalOptions.ServiceInstanceID, "zone", options.GlobalOptions.PowerVSZone)
+
+	accountID, err := pkgUtils.GetAccount(ctx, iam.GetIAMAuth())
+	if err != nil {
+		return err
+	}
+	sess, err := powervs.NewPISession(accountID, options.GlobalOptions.PowerVSZone, options.GlobalOptions.Debug)
+	if err != nil {
+		return err
+	}
+
+	c := v.NewIBMPIInstanceClient(ctx, sess, options.GlobalOptions.ServiceInstanceID)
+	instances, err := c.GetAll()
+	if err != nil {
+		return err
+	}
+
+	return display(toInstanceList(instances.PvmInstances), os.Stdout)
+}
+
+// toInstanceList maps the instances, tolerating the fields left unset while an instance is still being provisioned.
+func toInstanceList(instances []*models.PVMInstanceReference) IList {
+	listByVersion := IList{
+		Items: []InstanceSpec{},
+	}
+
+	for _, instance := range instances {
+		if instance == nil {
+			continue
+		}
+		instanceToAppend := InstanceSpec{
+			InstanceID: utils.DereferencePointer(instance.PvmInstanceID).(string),
+			Name:       utils.DereferencePointer(instance.ServerName).(string),
+			Status:     utils.DereferencePointer(instance.Status).(string),
+			ProcType:   utils.DereferencePointer(instance.ProcType).(string),
+		}
+		if instance.Processors != nil {
+			instanceToAppend.Processors = *instance.Processors
+		}
+		if instance.Memory != nil {
+			instanceToAppend.Memory = *instance.Memory
+		}
+		if instance.Health != nil {
+			instanceToAppend.Health = instance.Health.Status
+		}
+		listByVersion.Items = append(listByVersion.Items, instanceToAppend)
+	}
+	return listByVersion
+}
+
+func display(listByVersion IList, w io.Writer) error {
+	if options.GlobalOptions.Quiet {
+		return printer.NewQuiet(w).Print(&listByVersion)
+	}
+
+	if len(listByVersion.Items) == 0 {
+		_, err := fmt.Fprintln(w, "No instances found")
+		return err
+	}
+
+	pr, err := options.NewPrinter(w)
+	if err != nil {
+		return err
+	}
+
+	if options.GlobalOptions.Output == printer.PrinterTypeJSON || options.GlobalOptions.Output == printer.PrinterTypeYAML || options.GlobalOptions.Output == printer.PrinterTypeTemplate {
+		err = pr.Print(listByVersion)
+	} else {
+		table := listByVersion.ToTable()
+		err = pr.Print(table)
+	}
+
+	return err
+}
@@ -0,0 +1,100 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package instance
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/IBM-Cloud/power-go-client/power/models"
+
+	"k8s.io/utils/ptr"
+
+	. "github.com/onsi/gomega"
+
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/cmd/capibmadm/options"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/cmd/capibmadm/printer"
+)
+
+func TestToInstanceList(t *testing.T) {
+	t.Run("Should map a running instance", func(t *testing.T) {
+		g := NewWithT(t)
+		listByVersion := toInstanceList([]*models.PVMInstanceReference{
+			{
+				PvmInstanceID: ptr.To("foo-instance-id"),
+				ServerName:    ptr.To("foo-instance"),
+				Status:        ptr.To("ACTIVE"),
+				ProcType:      ptr.To("shared"),
+				Processors:    ptr.To(0.5),
+				Memory:        ptr.To(8.0),
+				Health:        &models.PVMInstanceHealth{Status: "OK"},
+			},
+		})
+		g.Expect(listByVersion.Items).To(Equal([]InstanceSpec{
+			{
+				InstanceID: "foo-instance-id",
+				Name:       "foo-instance",
+				Status:     "ACTIVE",
+				ProcType:   "shared",
+				Processors: 0.5,
+				Memory:     8,
+				Health:     "OK",
+			},
+		}))
+	})
+	t.Run("Should map an instance that is still building", func(t *testing.T) {
+		g := NewWithT(t)
+		listByVersion := toInstanceList([]*models.PVMInstanceReference{
+			{
+				PvmInstanceID: ptr.To("bar-instance-id"),
+				ServerName:    ptr.To("bar-instance"),
+				Status:        ptr.To("BUILD"),
+			},
+		})
+		g.Expect(listByVersion.Items).To(Equal([]InstanceSpec{
+			{
+				InstanceID: "bar-instance-id",
+				Name:       "bar-instance",
+				Status:     "BUILD",
+			},
+		}))
+	})
+}
+
+func TestDisplay(t *testing.T) {
+	output := options.GlobalOptions.Output
+	t.Cleanup(func() {
+		options.GlobalOptions.Output = output
+	})
+
+	t.Run("Should print the instances as a table", func(t *testing.T) {
+		g := NewWithT(t)
+		options.GlobalOptions.Output = printer.PrinterTypeTable
+		buf := &bytes.Buffer{}
+		err := display(IList{Items: []InstanceSpec{{InstanceID: "foo-instance-id", Name: "foo-instance", Status: "ACTIVE", Health: "OK"}}}, buf)
+		g.Expect(err).To(BeNil())
+		g.Expect(buf.String()).To(ContainSubstring("foo-instance-id"))
+		g.Expect(buf.String()).To(ContainSubstring("ACTIVE"))
+	})
+	t.Run("Should report when there are no instances", func(t *testing.T) {
+		g := NewWithT(t)
+		options.GlobalOptions.Output = printer.PrinterTypeTable
+		buf := &bytes.Buffer{}
+		g.Expect(display(IList{Items: []InstanceSpec{}}, buf)).To(Succeed())
+		g.Expect(buf.String()).To(Equal("No instances found\n"))
+	})
+}
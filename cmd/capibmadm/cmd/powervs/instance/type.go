@@ -0,0 +1,94 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package instance
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// InstanceSpec defines a PowerVS instance.
+type InstanceSpec struct {
+	InstanceID string  `json:"id"`
+	Name       string  `json:"name"`
+	Status     string  `json:"status"`
+	ProcType   string  `json:"procType"`
+	Processors float64 `json:"processors"`
+	Memory     float64 `json:"memory"`
+	Health     string  `json:"health"`
+}
+
+// IList defines a list of PowerVS instances.
+type IList struct {
+	Items []InstanceSpec `json:"items"`
+}
+
+// IDs returns the IDs of the instances in the list.
+func (instanceList *IList) IDs() []string {
+	ids := make([]string, 0, len(instanceList.Items))
+	for _, instance := range instanceList.Items {
+		ids = append(ids, instance.InstanceID)
+	}
+	return ids
+}
+
+// ToTable converts List to *metav1.Table.
+func (instanceList *IList) ToTable() *metav1.Table {
+	table := &metav1.Table{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: metav1.SchemeGroupVersion.String(),
+			Kind:       "Table",
+		},
+		ColumnDefinitions: []metav1.TableColumnDefinition{
+			{
+				Name: "ID",
+				Type: "string",
+			},
+			{
+				Name: "Name",
+				Type: "string",
+			},
+			{
+				Name: "Status",
+				Type: "string",
+			},
+			{
+				Name: "Proc Type",
+				Type: "string",
+			},
+			{
+				Name: "Processors",
+				Type: "number",
+			},
+			{
+				Name: "Memory (GiB)",
+				Type: "number",
+			},
+			{
+				Name: "Health",
+				Type: "string",
+			},
+		},
+	}
+
+	for _, instance := range instanceList.Items {
+		row := metav1.TableRow{
+			Cells: []interface{}{instance.InstanceID, instance.Name, instance.Status, instance.ProcType, instance.Processors, instance.Memory, instance.Health},
+		}
+		table.Rows = append(table.Rows, row)
+	}
+	return table
+}
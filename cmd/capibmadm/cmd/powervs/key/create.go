@@ -89,7 +89,7 @@ func createSSHKey(ctx context.Context, keyCreateOption keyCreateOptions) error {
 	logger := log.Log
 	logger.Info("Creating SSH key...")
 
-	accountID, err := utils.GetAccount(iam.GetIAMAuth())
+	accountID, err := utils.GetAccount(ctx, iam.GetIAMAuth())
 	if err != nil {
 		return err
 	}
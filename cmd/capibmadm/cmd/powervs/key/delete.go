@@ -56,7 +56,7 @@ func deleteSSHKey(ctx context.Context, keyName string) error {
 	logger := log.Log
 	logger.Info("Deleting SSH key...")
 
-	accountID, err := utils.GetAccount(iam.GetIAMAuth())
+	accountID, err := utils.GetAccount(ctx, iam.GetIAMAuth())
 	if err != nil {
 		return err
 	}
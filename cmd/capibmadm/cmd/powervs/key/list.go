@@ -19,11 +19,15 @@ package key
 import (
 	"context"
 	"fmt"
+	"io"
 	"os"
 
+	"github.com/go-openapi/strfmt"
 	"github.com/spf13/cobra"
+	"golang.org/x/crypto/ssh"
 
 	v "github.com/IBM-Cloud/power-go-client/clients/instance"
+	"github.com/IBM-Cloud/power-go-client/power/models"
 
 	logf "sigs.k8s.io/cluster-api/cmd/clusterctl/log"
 
@@ -31,7 +35,8 @@ import (
 	"sigs.k8s.io/cluster-api-provider-ibmcloud/cmd/capibmadm/clients/powervs"
 	"sigs.k8s.io/cluster-api-provider-ibmcloud/cmd/capibmadm/options"
 	"sigs.k8s.io/cluster-api-provider-ibmcloud/cmd/capibmadm/printer"
-	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/utils"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/cmd/capibmadm/utils"
+	pkgUtils "sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/utils"
 )
 
 // ListSSHKeyCommand function to list PowerVS SSH Keys.
@@ -43,6 +48,9 @@ func ListSSHKeyCommand() *cobra.Command {
 # List PowerVS SSH Keys
 export IBMCLOUD_API_KEY=<api-key>
 capibmadm powervs key list --service-instance-id <service-instance-id> --zone <zone>`,
+		PreRunE: func(_ *cobra.Command, _ []string) error {
+			return validateServiceInstanceID()
+		},
 		RunE: func(cmd *cobra.Command, _ []string) error {
 			return listSSHKeys(cmd.Context())
 		},
@@ -56,7 +64,7 @@ func listSSHKeys(ctx context.Context) error {
 	log := logf.Log
 	log.Info("Listing PowerVS SSH Keys", "service-instance-id", options.GlobalOptions.ServiceInstanceID, "zone", options.GlobalOptions.PowerVSZone)
 
-	accountID, err := utils.GetAccount(iam.GetIAMAuth())
+	accountID, err := pkgUtils.GetAccount(ctx, iam.GetIAMAuth())
 	if err != nil {
 		return err
 	}
@@ -71,29 +79,51 @@ func listSSHKeys(ctx context.Context) error {
 		return err
 	}
 
-	if len(keys.SSHKeys) == 0 {
-		fmt.Println("No SSH Key found")
-		return nil
+	return display(toKeyList(keys.SSHKeys), os.Stdout)
+}
+
+func validateServiceInstanceID() error {
+	if options.GlobalOptions.ServiceInstanceID == "" {
+		return fmt.Errorf("the required flag service-instance-id is not set")
 	}
+	return nil
+}
 
+func toKeyList(keys []*models.SSHKey) IList {
 	listByVersion := IList{
 		Items: []SSHKeySpec{},
 	}
 
-	for _, key := range keys.SSHKeys {
-		listByVersion.Items = append(listByVersion.Items, SSHKeySpec{
-			Name:         *key.Name,
-			Key:          *key.SSHKey,
-			CreationDate: *key.CreationDate,
-		})
+	for _, key := range keys {
+		keyToAppend := SSHKeySpec{
+			Name:         utils.DereferencePointer(key.Name).(string),
+			Key:          utils.DereferencePointer(key.SSHKey).(string),
+			CreationDate: utils.DereferencePointer(key.CreationDate).(strfmt.DateTime),
+		}
+		if publicKey, _, _, _, err := ssh.ParseAuthorizedKey([]byte(keyToAppend.Key)); err == nil {
+			keyToAppend.FingerPrint = ssh.FingerprintSHA256(publicKey)
+		}
+		listByVersion.Items = append(listByVersion.Items, keyToAppend)
+	}
+	return listByVersion
+}
+
+func display(listByVersion IList, w io.Writer) error {
+	if options.GlobalOptions.Quiet {
+		return printer.NewQuiet(w).Print(&listByVersion)
+	}
+
+	if len(listByVersion.Items) == 0 {
+		_, err := fmt.Fprintln(w, "No SSH Key found")
+		return err
 	}
 
-	pr, err := printer.New(options.GlobalOptions.Output, os.Stdout)
+	pr, err := options.NewPrinter(w)
 	if err != nil {
 		return err
 	}
 
-	if options.GlobalOptions.Output == printer.PrinterTypeJSON {
+	if options.GlobalOptions.Output == printer.PrinterTypeJSON || options.GlobalOptions.Output == printer.PrinterTypeYAML || options.GlobalOptions.Output == printer.PrinterTypeTemplate {
 		err = pr.Print(listByVersion)
 	} else {
 		table := listByVersion.ToTable()
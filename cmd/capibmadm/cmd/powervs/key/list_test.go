@@ -0,0 +1,98 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package key
+
+import (
+	"bytes"
+	"crypto/ed25519"
+	"crypto/rand"
+	"testing"
+
+	"golang.org/x/crypto/ssh"
+
+	"github.com/IBM-Cloud/power-go-client/power/models"
+
+	"k8s.io/utils/ptr"
+
+	. "github.com/onsi/gomega"
+
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/cmd/capibmadm/options"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/cmd/capibmadm/printer"
+)
+
+func TestToKeyList(t *testing.T) {
+	g := NewWithT(t)
+	publicKey, _, err := ed25519.GenerateKey(rand.Reader)
+	g.Expect(err).To(BeNil())
+	sshPublicKey, err := ssh.NewPublicKey(publicKey)
+	g.Expect(err).To(BeNil())
+	authorizedKey := string(ssh.MarshalAuthorizedKey(sshPublicKey))
+
+	listByVersion := toKeyList([]*models.SSHKey{
+		{Name: ptr.To("foo-key"), SSHKey: ptr.To(authorizedKey)},
+		{Name: ptr.To("bar-key"), SSHKey: ptr.To("invalid-key")},
+	})
+	g.Expect(listByVersion.Items).To(HaveLen(2))
+	g.Expect(listByVersion.Items[0].Name).To(Equal("foo-key"))
+	g.Expect(listByVersion.Items[0].FingerPrint).To(Equal(ssh.FingerprintSHA256(sshPublicKey)))
+	g.Expect(listByVersion.Items[1].Name).To(Equal("bar-key"))
+	g.Expect(listByVersion.Items[1].FingerPrint).To(BeEmpty())
+}
+
+func TestDisplay(t *testing.T) {
+	output := options.GlobalOptions.Output
+	t.Cleanup(func() {
+		options.GlobalOptions.Output = output
+	})
+
+	t.Run("Should print the keys as a table", func(t *testing.T) {
+		g := NewWithT(t)
+		options.GlobalOptions.Output = printer.PrinterTypeTable
+		buf := &bytes.Buffer{}
+		err := display(IList{Items: []SSHKeySpec{{Name: "foo-key", FingerPrint: "SHA256:foo"}}}, buf)
+		g.Expect(err).To(BeNil())
+		g.Expect(buf.String()).To(ContainSubstring("foo-key"))
+		g.Expect(buf.String()).To(ContainSubstring("SHA256:foo"))
+	})
+	t.Run("Should report when there are no keys", func(t *testing.T) {
+		g := NewWithT(t)
+		options.GlobalOptions.Output = printer.PrinterTypeTable
+		buf := &bytes.Buffer{}
+		g.Expect(display(IList{Items: []SSHKeySpec{}}, buf)).To(Succeed())
+		g.Expect(buf.String()).To(Equal("No SSH Key found\n"))
+	})
+}
+
+func TestListSSHKeyCommandServiceInstanceID(t *testing.T) {
+	serviceInstanceID := options.GlobalOptions.ServiceInstanceID
+	t.Cleanup(func() {
+		options.GlobalOptions.ServiceInstanceID = serviceInstanceID
+	})
+
+	t.Run("Error when service instance ID is not set", func(t *testing.T) {
+		g := NewWithT(t)
+		options.GlobalOptions.ServiceInstanceID = ""
+		cmd := ListSSHKeyCommand()
+		g.Expect(cmd.PreRunE(cmd, nil)).To(MatchError(ContainSubstring("service-instance-id")))
+	})
+	t.Run("Should accept service instance ID", func(t *testing.T) {
+		g := NewWithT(t)
+		options.GlobalOptions.ServiceInstanceID = "foo-service-instance-id"
+		cmd := ListSSHKeyCommand()
+		g.Expect(cmd.PreRunE(cmd, nil)).To(Succeed())
+	})
+}
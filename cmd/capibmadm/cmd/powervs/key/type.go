@@ -28,6 +28,7 @@ import (
 type SSHKeySpec struct {
 	Name         string          `json:"name"`
 	Key          string          `json:"key"`
+	FingerPrint  string          `json:"fingerPrint"`
 	CreationDate strfmt.DateTime `json:"creationDate"`
 }
 
@@ -36,6 +37,15 @@ type IList struct {
 	Items []SSHKeySpec `json:"items"`
 }
 
+// IDs returns the names of the SSH keys in the list, which identify the keys in the PowerVS API.
+func (keyList *IList) IDs() []string {
+	ids := make([]string, 0, len(keyList.Items))
+	for _, key := range keyList.Items {
+		ids = append(ids, key.Name)
+	}
+	return ids
+}
+
 // ToTable converts List to *metav1.Table.
 func (keyList *IList) ToTable() *metav1.Table {
 	table := &metav1.Table{
@@ -48,6 +58,10 @@ func (keyList *IList) ToTable() *metav1.Table {
 				Name: "Name",
 				Type: "string",
 			},
+			{
+				Name: "Fingerprint",
+				Type: "string",
+			},
 			{
 				Name: "Creation Date",
 				Type: "string",
@@ -61,7 +75,7 @@ func (keyList *IList) ToTable() *metav1.Table {
 
 	for _, key := range keyList.Items {
 		row := metav1.TableRow{
-			Cells: []interface{}{key.Name, time.Time(key.CreationDate).Format(time.RFC822), key.Key},
+			Cells: []interface{}{key.Name, key.FingerPrint, time.Time(key.CreationDate).Format(time.RFC822), key.Key},
 		}
 		table.Rows = append(table.Rows, row)
 	}
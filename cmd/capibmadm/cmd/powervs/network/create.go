@@ -84,7 +84,7 @@ func createNetwork(ctx context.Context, netCreateOption networkCreateOptions) er
 	log := logf.Log
 	log.Info("Creating PowerVS network", "service-instance-id", options.GlobalOptions.ServiceInstanceID, "zone", options.GlobalOptions.PowerVSZone)
 
-	accountID, err := utils.GetAccount(iam.GetIAMAuth())
+	accountID, err := utils.GetAccount(ctx, iam.GetIAMAuth())
 	if err != nil {
 		return err
 	}
@@ -56,7 +56,7 @@ func deleteNetwork(ctx context.Context, networkID string) error {
 	log := logf.Log
 	log.Info("Deleting PowerVS network", "service-instance-id", options.GlobalOptions.ServiceInstanceID, "zone", options.GlobalOptions.PowerVSZone)
 
-	accountID, err := utils.GetAccount(iam.GetIAMAuth())
+	accountID, err := utils.GetAccount(ctx, iam.GetIAMAuth())
 	if err != nil {
 		return err
 	}
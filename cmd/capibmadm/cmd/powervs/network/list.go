@@ -19,11 +19,13 @@ package network
 import (
 	"context"
 	"fmt"
+	"io"
 	"os"
 
 	"github.com/spf13/cobra"
 
 	v "github.com/IBM-Cloud/power-go-client/clients/instance"
+	"github.com/IBM-Cloud/power-go-client/power/models"
 
 	logf "sigs.k8s.io/cluster-api/cmd/clusterctl/log"
 
@@ -31,9 +33,16 @@ import (
 	"sigs.k8s.io/cluster-api-provider-ibmcloud/cmd/capibmadm/clients/powervs"
 	"sigs.k8s.io/cluster-api-provider-ibmcloud/cmd/capibmadm/options"
 	"sigs.k8s.io/cluster-api-provider-ibmcloud/cmd/capibmadm/printer"
-	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/utils"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/cmd/capibmadm/utils"
+	pkgUtils "sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/utils"
 )
 
+// networkClient is the subset of the PowerVS network client used to list networks.
+type networkClient interface {
+	GetAll() (*models.Networks, error)
+	Get(id string) (*models.Network, error)
+}
+
 // ListCommand function to create PowerVS network.
 func ListCommand() *cobra.Command {
 	cmd := &cobra.Command{
@@ -56,7 +65,7 @@ func listNetwork(ctx context.Context) error {
 	log := logf.Log
 	log.Info("Listing PowerVS networks", "service-instance-id", options.GlobalOptions.ServiceInstanceID, "zone", options.GlobalOptions.PowerVSZone)
 
-	accountID, err := utils.GetAccount(iam.GetIAMAuth())
+	accountID, err := pkgUtils.GetAccount(ctx, iam.GetIAMAuth())
 	if err != nil {
 		return err
 	}
@@ -66,37 +75,69 @@ func listNetwork(ctx context.Context) error {
 	}
 
 	c := v.NewIBMPINetworkClient(ctx, sess, options.GlobalOptions.ServiceInstanceID)
-	nets, err := c.GetAll()
+	listByVersion, err := fetchNetworks(c)
 	if err != nil {
 		return err
 	}
 
-	if len(nets.Networks) == 0 {
-		fmt.Println("No Networks found")
-		return nil
-	}
+	return display(listByVersion, os.Stdout)
+}
 
+// fetchNetworks lists the networks along with the details, like the CIDR and gateway, missing from the list response.
+func fetchNetworks(c networkClient) (IList, error) {
 	listByVersion := IList{
 		Items: []NetSpec{},
 	}
 
+	nets, err := c.GetAll()
+	if err != nil {
+		return listByVersion, err
+	}
+
 	for _, network := range nets.Networks {
-		listByVersion.Items = append(listByVersion.Items, NetSpec{
-			NetworkID:   *network.NetworkID,
-			Name:        *network.Name,
-			Type:        *network.Type,
-			VlanID:      *network.VlanID,
-			Jumbo:       network.Jumbo,
-			DhcpManaged: network.DhcpManaged,
-		})
+		networkDetails, err := c.Get(*network.NetworkID)
+		if err != nil {
+			return listByVersion, fmt.Errorf("error fetching network %s: %w", *network.NetworkID, err)
+		}
+		listByVersion.Items = append(listByVersion.Items, toNetSpec(network, networkDetails))
+	}
+	return listByVersion, nil
+}
+
+func toNetSpec(network *models.NetworkReference, networkDetails *models.Network) NetSpec {
+	netSpec := NetSpec{
+		NetworkID:   utils.DereferencePointer(network.NetworkID).(string),
+		Name:        utils.DereferencePointer(network.Name).(string),
+		Type:        utils.DereferencePointer(network.Type).(string),
+		Jumbo:       network.Jumbo,
+		DhcpManaged: network.DhcpManaged,
+	}
+	if network.VlanID != nil {
+		netSpec.VlanID = *network.VlanID
+	}
+	if networkDetails != nil {
+		netSpec.CIDR = utils.DereferencePointer(networkDetails.Cidr).(string)
+		netSpec.Gateway = networkDetails.Gateway
+	}
+	return netSpec
+}
+
+func display(listByVersion IList, w io.Writer) error {
+	if options.GlobalOptions.Quiet {
+		return printer.NewQuiet(w).Print(&listByVersion)
+	}
+
+	if len(listByVersion.Items) == 0 {
+		_, err := fmt.Fprintln(w, "No Networks found")
+		return err
 	}
 
-	pr, err := printer.New(options.GlobalOptions.Output, os.Stdout)
+	pr, err := options.NewPrinter(w)
 	if err != nil {
 		return err
 	}
 
-	if options.GlobalOptions.Output == printer.PrinterTypeJSON {
+	if options.GlobalOptions.Output == printer.PrinterTypeJSON || options.GlobalOptions.Output == printer.PrinterTypeYAML || options.GlobalOptions.Output == printer.PrinterTypeTemplate {
 		err = pr.Print(listByVersion)
 	} else {
 		table := listByVersion.ToTable()
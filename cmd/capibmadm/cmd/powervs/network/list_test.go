@@ -0,0 +1,140 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package network
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/IBM-Cloud/power-go-client/power/models"
+
+	"k8s.io/utils/ptr"
+
+	. "github.com/onsi/gomega"
+
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/cmd/capibmadm/options"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/cmd/capibmadm/printer"
+)
+
+// fakeNetworkClient serves a fixed set of networks and their details.
+type fakeNetworkClient struct {
+	networks []*models.Network
+	err      error
+}
+
+func (f *fakeNetworkClient) GetAll() (*models.Networks, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	nets := &models.Networks{Networks: []*models.NetworkReference{}}
+	for _, network := range f.networks {
+		nets.Networks = append(nets.Networks, &models.NetworkReference{
+			NetworkID: network.NetworkID,
+			Name:      network.Name,
+			Type:      network.Type,
+			VlanID:    network.VlanID,
+		})
+	}
+	return nets, nil
+}
+
+func (f *fakeNetworkClient) Get(id string) (*models.Network, error) {
+	for _, network := range f.networks {
+		if *network.NetworkID == id {
+			return network, nil
+		}
+	}
+	return nil, errors.New("network not found")
+}
+
+func TestFetchNetworks(t *testing.T) {
+	t.Run("Should map the network details", func(t *testing.T) {
+		g := NewWithT(t)
+		c := &fakeNetworkClient{networks: []*models.Network{
+			{
+				NetworkID: ptr.To("foo-network-id"),
+				Name:      ptr.To("foo-network"),
+				Type:      ptr.To("vlan"),
+				VlanID:    ptr.To(float64(100)),
+				Cidr:      ptr.To("192.168.0.0/24"),
+				Gateway:   "192.168.0.1",
+			},
+			{
+				NetworkID: ptr.To("bar-network-id"),
+				Name:      ptr.To("bar-network"),
+				Type:      ptr.To("pub-vlan"),
+				VlanID:    ptr.To(float64(200)),
+				Cidr:      ptr.To("10.0.0.0/29"),
+			},
+		}}
+		listByVersion, err := fetchNetworks(c)
+		g.Expect(err).To(BeNil())
+		g.Expect(listByVersion.Items).To(Equal([]NetSpec{
+			{
+				NetworkID: "foo-network-id",
+				Name:      "foo-network",
+				Type:      "vlan",
+				VlanID:    100,
+				CIDR:      "192.168.0.0/24",
+				Gateway:   "192.168.0.1",
+			},
+			{
+				NetworkID: "bar-network-id",
+				Name:      "bar-network",
+				Type:      "pub-vlan",
+				VlanID:    200,
+				CIDR:      "10.0.0.0/29",
+			},
+		}))
+	})
+	t.Run("Should return no networks for an empty workspace", func(t *testing.T) {
+		g := NewWithT(t)
+		listByVersion, err := fetchNetworks(&fakeNetworkClient{})
+		g.Expect(err).To(BeNil())
+		g.Expect(listByVersion.Items).To(BeEmpty())
+	})
+	t.Run("Error when listing networks", func(t *testing.T) {
+		g := NewWithT(t)
+		_, err := fetchNetworks(&fakeNetworkClient{err: errors.New("failed to list networks")})
+		g.Expect(err).To(Not(BeNil()))
+	})
+}
+
+func TestDisplay(t *testing.T) {
+	output := options.GlobalOptions.Output
+	t.Cleanup(func() {
+		options.GlobalOptions.Output = output
+	})
+
+	t.Run("Should print the networks as json", func(t *testing.T) {
+		g := NewWithT(t)
+		options.GlobalOptions.Output = printer.PrinterTypeJSON
+		buf := &bytes.Buffer{}
+		err := display(IList{Items: []NetSpec{{NetworkID: "foo-network-id", CIDR: "192.168.0.0/24"}}}, buf)
+		g.Expect(err).To(BeNil())
+		g.Expect(buf.String()).To(ContainSubstring(`"cidr": "192.168.0.0/24"`))
+		g.Expect(buf.String()).ToNot(ContainSubstring("gateway"))
+	})
+	t.Run("Should report when there are no networks", func(t *testing.T) {
+		g := NewWithT(t)
+		options.GlobalOptions.Output = printer.PrinterTypeTable
+		buf := &bytes.Buffer{}
+		g.Expect(display(IList{Items: []NetSpec{}}, buf)).To(Succeed())
+		g.Expect(buf.String()).To(Equal("No Networks found\n"))
+	})
+}
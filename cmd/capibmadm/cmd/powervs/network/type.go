@@ -26,6 +26,8 @@ type NetSpec struct {
 	Name        string  `json:"name"`
 	Type        string  `json:"type"`
 	VlanID      float64 `json:"vlanID"`
+	CIDR        string  `json:"cidr"`
+	Gateway     string  `json:"gateway,omitempty"`
 	Jumbo       bool    `json:"jumbo"`
 	DhcpManaged bool    `json:"dhcpManaged"`
 }
@@ -35,6 +37,15 @@ type IList struct {
 	Items []NetSpec `json:"items"`
 }
 
+// IDs returns the IDs of the networks in the list.
+func (netList *IList) IDs() []string {
+	ids := make([]string, 0, len(netList.Items))
+	for _, network := range netList.Items {
+		ids = append(ids, network.NetworkID)
+	}
+	return ids
+}
+
 // ToTable converts List to *metav1.Table.
 func (netList *IList) ToTable() *metav1.Table {
 	table := &metav1.Table{
@@ -59,6 +70,14 @@ func (netList *IList) ToTable() *metav1.Table {
 				Name: "VLAN ID",
 				Type: "string",
 			},
+			{
+				Name: "CIDR",
+				Type: "string",
+			},
+			{
+				Name: "Gateway",
+				Type: "string",
+			},
 			{
 				Name: "Jumbo",
 				Type: "bool",
@@ -72,7 +91,7 @@ func (netList *IList) ToTable() *metav1.Table {
 
 	for _, network := range netList.Items {
 		row := metav1.TableRow{
-			Cells: []interface{}{network.NetworkID, network.Name, network.Type, network.VlanID, network.Jumbo, network.DhcpManaged},
+			Cells: []interface{}{network.NetworkID, network.Name, network.Type, network.VlanID, network.CIDR, network.Gateway, network.Jumbo, network.DhcpManaged},
 		}
 		table.Rows = append(table.Rows, row)
 	}
@@ -68,7 +68,7 @@ capibmadm powervs port create --network <netword-id/network-name> --description
 func createPort(ctx context.Context, portCreateOption portCreateOptions) error {
 	logger := log.Log
 	logger.Info("Creating Port ", "Network ID/Name", portCreateOption.network, "IP Address", portCreateOption.ipAddress, "Description", portCreateOption.description, "service-instance-id", options.GlobalOptions.ServiceInstanceID, "zone", options.GlobalOptions.PowerVSZone)
-	accountID, err := pkgUtils.GetAccount(iam.GetIAMAuth())
+	accountID, err := pkgUtils.GetAccount(ctx, iam.GetIAMAuth())
 	if err != nil {
 		return err
 	}
@@ -108,16 +108,16 @@ func createPort(ctx context.Context, portCreateOption portCreateOptions) error {
 		Status:      utils.DereferencePointer(port.Status).(string),
 	})
 
-	printerObj, err := printer.New(options.GlobalOptions.Output, os.Stdout)
+	printerObj, err := options.NewPrinter(os.Stdout)
 	if err != nil {
 		return fmt.Errorf("failed creating output printer: %w", err)
 	}
 
-	if options.GlobalOptions.Output == printer.PrinterTypeTable {
+	if options.GlobalOptions.Output == printer.PrinterTypeJSON || options.GlobalOptions.Output == printer.PrinterTypeYAML || options.GlobalOptions.Output == printer.PrinterTypeTemplate {
+		err = printerObj.Print(portInfo)
+	} else {
 		table := portInfo.ToTable()
 		err = printerObj.Print(table)
-	} else {
-		err = printerObj.Print(portInfo)
 	}
 	return err
 }
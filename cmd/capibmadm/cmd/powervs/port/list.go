@@ -63,7 +63,7 @@ func listPorts(ctx context.Context, network string) error {
 	log := logf.Log
 	log.Info("Listing PowerVS ports", "service-instance-id", options.GlobalOptions.ServiceInstanceID, "network", network)
 
-	accountID, err := pkgUtils.GetAccount(iam.GetIAMAuth())
+	accountID, err := pkgUtils.GetAccount(ctx, iam.GetIAMAuth())
 	if err != nil {
 		return err
 	}
@@ -100,16 +100,20 @@ func listPorts(ctx context.Context, network string) error {
 		})
 	}
 
-	printerObj, err := printer.New(options.GlobalOptions.Output, os.Stdout)
+	if options.GlobalOptions.Quiet {
+		return printer.NewQuiet(os.Stdout).Print(&portList)
+	}
+
+	printerObj, err := options.NewPrinter(os.Stdout)
 	if err != nil {
 		return fmt.Errorf("failed creating output printer: %w", err)
 	}
 
-	if options.GlobalOptions.Output == printer.PrinterTypeTable {
+	if options.GlobalOptions.Output == printer.PrinterTypeJSON || options.GlobalOptions.Output == printer.PrinterTypeYAML || options.GlobalOptions.Output == printer.PrinterTypeTemplate {
+		err = printerObj.Print(portList)
+	} else {
 		table := portList.ToTable()
 		err = printerObj.Print(table)
-	} else {
-		err = printerObj.Print(portList)
 	}
 
 	return err
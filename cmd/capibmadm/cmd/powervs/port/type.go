@@ -36,6 +36,15 @@ type PList struct {
 	Items []PSpec `json:"items"`
 }
 
+// IDs returns the IDs of the ports in the list.
+func (portList *PList) IDs() []string {
+	ids := make([]string, 0, len(portList.Items))
+	for _, port := range portList.Items {
+		ids = append(ids, port.PortID)
+	}
+	return ids
+}
+
 // ToTable converts List to *metav1.Table.
 func (portList *PList) ToTable() *metav1.Table {
 	table := &metav1.Table{
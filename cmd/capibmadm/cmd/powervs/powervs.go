@@ -17,16 +17,16 @@ limitations under the License.
 package powervs
 
 import (
-	"fmt"
-	"os"
-
 	"github.com/spf13/cobra"
 
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/cmd/capibmadm/clients/iam"
 	"sigs.k8s.io/cluster-api-provider-ibmcloud/cmd/capibmadm/cmd/powervs/image"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/cmd/capibmadm/cmd/powervs/instance"
 	"sigs.k8s.io/cluster-api-provider-ibmcloud/cmd/capibmadm/cmd/powervs/key"
 	"sigs.k8s.io/cluster-api-provider-ibmcloud/cmd/capibmadm/cmd/powervs/network"
 	"sigs.k8s.io/cluster-api-provider-ibmcloud/cmd/capibmadm/cmd/powervs/port"
 	"sigs.k8s.io/cluster-api-provider-ibmcloud/cmd/capibmadm/options"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/cmd/capibmadm/utils"
 )
 
 // Commands initialises and returns powervs command.
@@ -34,27 +34,31 @@ func Commands() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "powervs",
 		Short: "Commands for operations on PowerVS resources",
-		PersistentPreRunE: func(_ *cobra.Command, _ []string) error {
-			apiKey := os.Getenv(options.IBMCloudAPIKeyEnvName)
-			if apiKey == "" {
-				return fmt.Errorf("ibmcloud api key is not provided, set %s environmental variable", options.IBMCloudAPIKeyEnvName)
+		PersistentPreRunE: func(cmd *cobra.Command, _ []string) error {
+			if err := iam.LoadCredentials(); err != nil {
+				return err
+			}
+			serviceInstanceID, err := utils.ResolveServiceInstanceID(cmd.Context(), options.GlobalOptions.ServiceInstanceID, options.GlobalOptions.ServiceInstanceName)
+			if err != nil {
+				return err
 			}
-			options.GlobalOptions.IBMCloudAPIKey = apiKey
+			options.GlobalOptions.ServiceInstanceID = serviceInstanceID
 			return nil
 		},
 	}
 
-	cmd.PersistentFlags().StringVar(&options.GlobalOptions.ServiceInstanceID, "service-instance-id", "", "PowerVS service instance id (Required)")
+	cmd.PersistentFlags().StringVar(&options.GlobalOptions.ServiceInstanceID, "service-instance-id", "", "PowerVS service instance id, either service-instance-id or service-instance-name is required")
+	cmd.PersistentFlags().StringVar(&options.GlobalOptions.ServiceInstanceName, "service-instance-name", "", "PowerVS service instance name, either service-instance-id or service-instance-name is required")
 	cmd.PersistentFlags().StringVar(&options.GlobalOptions.PowerVSZone, "zone", options.GlobalOptions.PowerVSZone, "PowerVS service instance location (Required)")
 	cmd.PersistentFlags().BoolVar(&options.GlobalOptions.Debug, "debug", false, "Enable/Disable http transport debugging log")
 
-	_ = cmd.MarkPersistentFlagRequired("service-instance-id")
 	_ = cmd.MarkPersistentFlagRequired("zone")
 
 	cmd.AddCommand(key.Commands())
 	cmd.AddCommand(network.Commands())
 	cmd.AddCommand(port.Commands())
 	cmd.AddCommand(image.Commands())
+	cmd.AddCommand(instance.Commands())
 
 	return cmd
 }
@@ -0,0 +1,126 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resourcegroup
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/resourcemanagerv2"
+
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/cmd/capibmadm/clients/iam"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/cmd/capibmadm/clients/platformservices"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/cmd/capibmadm/options"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/cmd/capibmadm/printer"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/cmd/capibmadm/utils"
+	pkgUtils "sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/utils"
+)
+
+// resourceGroupLister is the subset of the resource manager client used to list resource groups.
+type resourceGroupLister interface {
+	ListResourceGroupsWithContext(ctx context.Context, listResourceGroupsOptions *resourcemanagerv2.ListResourceGroupsOptions) (*resourcemanagerv2.ResourceGroupList, *core.DetailedResponse, error)
+}
+
+// ListCommand resource group list command.
+func ListCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List resource groups",
+		Example: `
+ # List resource groups of the account
+ export IBMCLOUD_API_KEY=<api-key>
+ capibmadm resource-group list`,
+	}
+
+	options.AddCommonFlags(cmd)
+
+	cmd.RunE = func(cmd *cobra.Command, _ []string) error {
+		return listResourceGroups(cmd.Context())
+	}
+
+	return cmd
+}
+
+func listResourceGroups(ctx context.Context) error {
+	rmv2, err := platformservices.NewResourceManagerV2Client()
+	if err != nil {
+		return err
+	}
+
+	accountID, err := pkgUtils.GetAccount(ctx, iam.GetIAMAuth())
+	if err != nil {
+		return err
+	}
+
+	resourceGroups, err := fetchResourceGroups(ctx, rmv2, accountID)
+	if err != nil {
+		return err
+	}
+
+	return display(toList(resourceGroups))
+}
+
+// fetchResourceGroups lists the resource groups of the account.
+func fetchResourceGroups(ctx context.Context, rmv2 resourceGroupLister, accountID string) ([]resourcemanagerv2.ResourceGroup, error) {
+	resourceGroupL, _, err := rmv2.ListResourceGroupsWithContext(ctx, &resourcemanagerv2.ListResourceGroupsOptions{AccountID: &accountID})
+	if err != nil {
+		return nil, fmt.Errorf("error listing resource groups: %w", err)
+	}
+	if resourceGroupL == nil {
+		return nil, nil
+	}
+	return resourceGroupL.Resources, nil
+}
+
+func toList(resourceGroups []resourcemanagerv2.ResourceGroup) List {
+	var resourceGroupListToDisplay List
+	for _, resourceGroup := range resourceGroups {
+		resourceGroupListToDisplay = append(resourceGroupListToDisplay, ResourceGroup{
+			ID:      utils.DereferencePointer(resourceGroup.ID).(string),
+			Name:    utils.DereferencePointer(resourceGroup.Name).(string),
+			State:   utils.DereferencePointer(resourceGroup.State).(string),
+			Default: utils.DereferencePointer(resourceGroup.Default).(bool),
+		})
+	}
+	return resourceGroupListToDisplay
+}
+
+func display(resourceGroupListToDisplay List) error {
+	if options.GlobalOptions.Quiet {
+		return printer.NewQuiet(os.Stdout).Print(&resourceGroupListToDisplay)
+	}
+
+	p, err := options.NewPrinter(os.Stdout)
+
+	if err != nil {
+		return err
+	}
+
+	switch options.GlobalOptions.Output {
+	case printer.PrinterTypeJSON, printer.PrinterTypeYAML, printer.PrinterTypeTemplate:
+		err = p.Print(resourceGroupListToDisplay)
+	default:
+		table := resourceGroupListToDisplay.ToTable()
+		err = p.Print(table)
+	}
+
+	return err
+}
@@ -0,0 +1,96 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resourcegroup
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/resourcemanagerv2"
+
+	. "github.com/onsi/gomega"
+)
+
+// fakeResourceGroupLister serves the resource groups of the requested account.
+type fakeResourceGroupLister struct {
+	resourceGroups map[string][]resourcemanagerv2.ResourceGroup
+	err            error
+}
+
+func (f *fakeResourceGroupLister) ListResourceGroupsWithContext(_ context.Context, options *resourcemanagerv2.ListResourceGroupsOptions) (*resourcemanagerv2.ResourceGroupList, *core.DetailedResponse, error) {
+	if f.err != nil {
+		return nil, nil, f.err
+	}
+	return &resourcemanagerv2.ResourceGroupList{Resources: f.resourceGroups[*options.AccountID]}, &core.DetailedResponse{}, nil
+}
+
+func newResourceGroup(name string, isDefault bool) resourcemanagerv2.ResourceGroup {
+	return resourcemanagerv2.ResourceGroup{
+		ID:      core.StringPtr(name + "-id"),
+		Name:    core.StringPtr(name),
+		State:   core.StringPtr("ACTIVE"),
+		Default: core.BoolPtr(isDefault),
+	}
+}
+
+func TestFetchResourceGroups(t *testing.T) {
+	t.Run("Should list the single default resource group of the account", func(t *testing.T) {
+		g := NewWithT(t)
+		lister := &fakeResourceGroupLister{resourceGroups: map[string][]resourcemanagerv2.ResourceGroup{
+			"foo-account-id": {newResourceGroup("Default", true)},
+			"bar-account-id": {newResourceGroup("bar-rg", false)},
+		}}
+		resourceGroups, err := fetchResourceGroups(context.TODO(), lister, "foo-account-id")
+		g.Expect(err).To(BeNil())
+		g.Expect(toList(resourceGroups)).To(Equal(List{
+			{
+				ID:      "Default-id",
+				Name:    "Default",
+				State:   "ACTIVE",
+				Default: true,
+			},
+		}))
+	})
+	t.Run("Error when listing resource groups", func(t *testing.T) {
+		g := NewWithT(t)
+		lister := &fakeResourceGroupLister{err: errors.New("failed to list resource groups")}
+		_, err := fetchResourceGroups(context.TODO(), lister, "foo-account-id")
+		g.Expect(err).To(MatchError(ContainSubstring("error listing resource groups")))
+	})
+}
+
+func TestToList(t *testing.T) {
+	g := NewWithT(t)
+	withoutState := newResourceGroup("bar-rg", false)
+	withoutState.State = nil
+	withoutState.Default = nil
+
+	g.Expect(toList([]resourcemanagerv2.ResourceGroup{newResourceGroup("Default", true), withoutState})).To(Equal(List{
+		{
+			ID:      "Default-id",
+			Name:    "Default",
+			State:   "ACTIVE",
+			Default: true,
+		},
+		{
+			ID:   "bar-rg-id",
+			Name: "bar-rg",
+		},
+	}))
+}
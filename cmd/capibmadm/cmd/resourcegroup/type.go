@@ -0,0 +1,77 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resourcegroup
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ResourceGroup resource group info.
+type ResourceGroup struct {
+	ID      string `json:"id"`
+	Name    string `json:"name"`
+	State   string `json:"state"`
+	Default bool   `json:"default"`
+}
+
+// List is list of ResourceGroup.
+type List []ResourceGroup
+
+// IDs returns the IDs of the resource groups in the list.
+func (resourceGroupList *List) IDs() []string {
+	ids := make([]string, 0, len(*resourceGroupList))
+	for _, resourceGroup := range *resourceGroupList {
+		ids = append(ids, resourceGroup.ID)
+	}
+	return ids
+}
+
+// ToTable converts List to *metav1.Table.
+func (resourceGroupList *List) ToTable() *metav1.Table {
+	table := &metav1.Table{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: metav1.SchemeGroupVersion.String(),
+			Kind:       "Table",
+		},
+		ColumnDefinitions: []metav1.TableColumnDefinition{
+			{
+				Name: "ID",
+				Type: "string",
+			},
+			{
+				Name: "NAME",
+				Type: "string",
+			},
+			{
+				Name: "STATE",
+				Type: "string",
+			},
+			{
+				Name: "DEFAULT",
+				Type: "bool",
+			},
+		},
+	}
+
+	for _, resourceGroup := range *resourceGroupList {
+		row := metav1.TableRow{
+			Cells: []interface{}{resourceGroup.ID, resourceGroup.Name, resourceGroup.State, resourceGroup.Default},
+		}
+		table.Rows = append(table.Rows, row)
+	}
+	return table
+}
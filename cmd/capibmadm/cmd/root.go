@@ -28,9 +28,12 @@ import (
 
 	logf "sigs.k8s.io/cluster-api/cmd/clusterctl/log"
 
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/cmd/capibmadm/clients/iam"
 	"sigs.k8s.io/cluster-api-provider-ibmcloud/cmd/capibmadm/cmd/powervs"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/cmd/capibmadm/cmd/resourcegroup"
 	"sigs.k8s.io/cluster-api-provider-ibmcloud/cmd/capibmadm/cmd/version"
 	"sigs.k8s.io/cluster-api-provider-ibmcloud/cmd/capibmadm/cmd/vpc"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/cmd/capibmadm/options"
 )
 
 func init() {
@@ -46,8 +49,11 @@ func rootCommand() *cobra.Command {
 	}
 
 	cmd.PersistentFlags().AddGoFlagSet(flag.CommandLine)
+	cmd.PersistentFlags().StringVar(&options.GlobalOptions.Profile, "profile", "", fmt.Sprintf("Name of the profile in the credentials file to authenticate with, defaults to the API key set in %s environmental variable", options.IBMCloudAPIKeyEnvName))
+	cmd.PersistentFlags().StringVar(&options.GlobalOptions.CredentialsFile, "credentials-file", iam.DefaultCredentialsFile(), "Path to the credentials file holding the profiles")
 	cmd.AddCommand(powervs.Commands())
 	cmd.AddCommand(vpc.Commands())
+	cmd.AddCommand(resourcegroup.Commands())
 	cmd.AddCommand(version.Commands(os.Stdout))
 
 	return cmd
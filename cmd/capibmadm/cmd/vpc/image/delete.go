@@ -0,0 +1,164 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package image
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+
+	logf "sigs.k8s.io/cluster-api/cmd/clusterctl/log"
+
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/cmd/capibmadm/clients/vpc"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/cmd/capibmadm/options"
+)
+
+// imageDeleter is the subset of the VPC client used to look up and delete images.
+type imageDeleter interface {
+	imageLister
+	GetImageWithContext(ctx context.Context, getImageOptions *vpcv1.GetImageOptions) (*vpcv1.Image, *core.DetailedResponse, error)
+	DeleteImageWithContext(ctx context.Context, deleteImageOptions *vpcv1.DeleteImageOptions) (*core.DetailedResponse, error)
+}
+
+type imageDeleteOptions struct {
+	id   string
+	name string
+	yes  bool
+}
+
+// DeleteCommand vpc image delete command.
+func DeleteCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "delete",
+		Short: "Delete VPC image",
+		Example: `
+ # Delete image in VPC by ID
+ export IBMCLOUD_API_KEY=<api-key>
+ capibmadm vpc image delete --id <image-id> --region <region>
+
+ # Delete image in VPC by name without confirmation
+ export IBMCLOUD_API_KEY=<api-key>
+ capibmadm vpc image delete --name <image-name> --region <region> --yes`,
+	}
+
+	var imageDeleteOption imageDeleteOptions
+	cmd.Flags().StringVar(&imageDeleteOption.id, "id", "", "The ID of the image.")
+	cmd.Flags().StringVar(&imageDeleteOption.name, "name", "", "The name of the image.")
+	cmd.Flags().BoolVarP(&imageDeleteOption.yes, "yes", "y", false, "Delete the image without asking for confirmation.")
+	// TODO: Flag validation is handled in PreRunE until the support for MarkFlagsMutuallyExclusiveAndRequired is available.
+	// Related issue: https://github.com/spf13/cobra/issues/1216
+	cmd.PreRunE = func(_ *cobra.Command, _ []string) error {
+		if (imageDeleteOption.id == "") == (imageDeleteOption.name == "") {
+			return fmt.Errorf("exactly one of the flags id or name is required")
+		}
+		return nil
+	}
+	cmd.RunE = func(cmd *cobra.Command, _ []string) error {
+		v1, err := vpc.NewV1Client(options.GlobalOptions.VPCRegion)
+		if err != nil {
+			return err
+		}
+		return deleteImage(cmd.Context(), v1, imageDeleteOption, cmd.InOrStdin(), cmd.OutOrStdout())
+	}
+
+	return cmd
+}
+
+func deleteImage(ctx context.Context, v1 imageDeleter, imageDeleteOption imageDeleteOptions, in io.Reader, out io.Writer) error {
+	log := logf.Log
+
+	image, err := getImage(ctx, v1, imageDeleteOption)
+	if err != nil {
+		return err
+	}
+
+	if err := checkImageDeletable(image); err != nil {
+		return err
+	}
+
+	if !imageDeleteOption.yes {
+		confirmed, err := confirm(in, out, fmt.Sprintf("Are you sure you want to delete image %s (%s)? [y/N]: ", *image.Name, *image.ID))
+		if err != nil {
+			return err
+		}
+		if !confirmed {
+			log.Info("Aborted deleting the image.", "name", *image.Name)
+			return nil
+		}
+	}
+
+	log.Info("Deleting image...", "name", *image.Name, "id", *image.ID)
+	if _, err := v1.DeleteImageWithContext(ctx, &vpcv1.DeleteImageOptions{ID: image.ID}); err != nil {
+		return fmt.Errorf("error deleting image %s: %w", *image.Name, err)
+	}
+	log.Info("Successfully deleted the image.", "name", *image.Name)
+	return nil
+}
+
+// getImage fetches the image by ID or, when no ID is given, resolves it by name.
+func getImage(ctx context.Context, v1 imageDeleter, imageDeleteOption imageDeleteOptions) (*vpcv1.Image, error) {
+	if imageDeleteOption.id != "" {
+		image, _, err := v1.GetImageWithContext(ctx, &vpcv1.GetImageOptions{ID: &imageDeleteOption.id})
+		if err != nil {
+			return nil, fmt.Errorf("error fetching image %s: %w", imageDeleteOption.id, err)
+		}
+		return image, nil
+	}
+
+	imageL, _, err := v1.ListImagesWithContext(ctx, &vpcv1.ListImagesOptions{Name: &imageDeleteOption.name})
+	if err != nil {
+		return nil, fmt.Errorf("error listing images with name %s: %w", imageDeleteOption.name, err)
+	}
+	if imageL == nil || len(imageL.Images) == 0 {
+		return nil, fmt.Errorf("image with name %s not found", imageDeleteOption.name)
+	}
+	return &imageL.Images[0], nil
+}
+
+// checkImageDeletable returns an error if the image is not a private image that can be deleted.
+func checkImageDeletable(image *vpcv1.Image) error {
+	if image.Visibility == nil || *image.Visibility != vpcv1.ImageVisibilityPrivateConst {
+		return fmt.Errorf("image %s is not a private image and cannot be deleted", *image.Name)
+	}
+	if image.CatalogOffering != nil && image.CatalogOffering.Managed != nil && *image.CatalogOffering.Managed {
+		return fmt.Errorf("image %s is managed by a catalog offering and cannot be deleted", *image.Name)
+	}
+	if image.Status != nil && (*image.Status == vpcv1.ImageStatusDeletingConst || *image.Status == vpcv1.ImageStatusPendingConst) {
+		return fmt.Errorf("image %s is in %s state and cannot be deleted", *image.Name, *image.Status)
+	}
+	return nil
+}
+
+func confirm(in io.Reader, out io.Writer, prompt string) (bool, error) {
+	if _, err := fmt.Fprint(out, prompt); err != nil {
+		return false, err
+	}
+	answer, err := bufio.NewReader(in).ReadString('\n')
+	if err != nil && !errors.Is(err, io.EOF) {
+		return false, err
+	}
+	answer = strings.ToLower(strings.TrimSpace(answer))
+	return answer == "y" || answer == "yes", nil
+}
@@ -0,0 +1,194 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package image
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+
+	. "github.com/onsi/gomega"
+)
+
+// fakeImageDeleter resolves images from a fixed set and records the deleted image IDs.
+type fakeImageDeleter struct {
+	fakeImageLister
+	deleted   []string
+	deleteErr error
+}
+
+func (f *fakeImageDeleter) GetImageWithContext(_ context.Context, options *vpcv1.GetImageOptions) (*vpcv1.Image, *core.DetailedResponse, error) {
+	for i := range f.images {
+		if *f.images[i].ID == *options.ID {
+			return &f.images[i], &core.DetailedResponse{}, nil
+		}
+	}
+	return nil, &core.DetailedResponse{}, errors.New("image not found")
+}
+
+func (f *fakeImageDeleter) ListImagesWithContext(_ context.Context, options *vpcv1.ListImagesOptions) (*vpcv1.ImageCollection, *core.DetailedResponse, error) {
+	f.requests = append(f.requests, *options)
+	collection := &vpcv1.ImageCollection{}
+	for _, image := range f.images {
+		if options.Name == nil || *image.Name == *options.Name {
+			collection.Images = append(collection.Images, image)
+		}
+	}
+	return collection, &core.DetailedResponse{}, nil
+}
+
+func (f *fakeImageDeleter) DeleteImageWithContext(_ context.Context, options *vpcv1.DeleteImageOptions) (*core.DetailedResponse, error) {
+	if f.deleteErr != nil {
+		return nil, f.deleteErr
+	}
+	f.deleted = append(f.deleted, *options.ID)
+	return &core.DetailedResponse{}, nil
+}
+
+func newFakeImageDeleter() *fakeImageDeleter {
+	return &fakeImageDeleter{
+		fakeImageLister: fakeImageLister{
+			images: []vpcv1.Image{
+				{
+					ID:         core.StringPtr("private-image-id"),
+					Name:       core.StringPtr("private-image"),
+					Status:     core.StringPtr(vpcv1.ImageStatusAvailableConst),
+					Visibility: core.StringPtr(vpcv1.ImageVisibilityPrivateConst),
+				},
+				{
+					ID:         core.StringPtr("public-image-id"),
+					Name:       core.StringPtr("public-image"),
+					Status:     core.StringPtr(vpcv1.ImageStatusAvailableConst),
+					Visibility: core.StringPtr(vpcv1.ImageVisibilityPublicConst),
+				},
+				{
+					ID:         core.StringPtr("pending-image-id"),
+					Name:       core.StringPtr("pending-image"),
+					Status:     core.StringPtr(vpcv1.ImageStatusPendingConst),
+					Visibility: core.StringPtr(vpcv1.ImageVisibilityPrivateConst),
+				},
+			},
+		},
+	}
+}
+
+func TestDeleteImage(t *testing.T) {
+	t.Run("Should delete image by ID", func(t *testing.T) {
+		g := NewWithT(t)
+		client := newFakeImageDeleter()
+		err := deleteImage(context.TODO(), client, imageDeleteOptions{id: "private-image-id", yes: true}, strings.NewReader(""), &bytes.Buffer{})
+		g.Expect(err).To(BeNil())
+		g.Expect(client.deleted).To(Equal([]string{"private-image-id"}))
+	})
+	t.Run("Should delete image resolved by name", func(t *testing.T) {
+		g := NewWithT(t)
+		client := newFakeImageDeleter()
+		err := deleteImage(context.TODO(), client, imageDeleteOptions{name: "private-image", yes: true}, strings.NewReader(""), &bytes.Buffer{})
+		g.Expect(err).To(BeNil())
+		g.Expect(client.deleted).To(Equal([]string{"private-image-id"}))
+		g.Expect(client.requests).To(HaveLen(1))
+		g.Expect(*client.requests[0].Name).To(Equal("private-image"))
+	})
+	t.Run("Should delete image after confirmation", func(t *testing.T) {
+		g := NewWithT(t)
+		client := newFakeImageDeleter()
+		out := &bytes.Buffer{}
+		err := deleteImage(context.TODO(), client, imageDeleteOptions{id: "private-image-id"}, strings.NewReader("yes\n"), out)
+		g.Expect(err).To(BeNil())
+		g.Expect(out.String()).To(ContainSubstring("Are you sure you want to delete image private-image"))
+		g.Expect(client.deleted).To(Equal([]string{"private-image-id"}))
+	})
+	t.Run("Should not delete image when confirmation is declined", func(t *testing.T) {
+		g := NewWithT(t)
+		client := newFakeImageDeleter()
+		err := deleteImage(context.TODO(), client, imageDeleteOptions{id: "private-image-id"}, strings.NewReader("n\n"), &bytes.Buffer{})
+		g.Expect(err).To(BeNil())
+		g.Expect(client.deleted).To(BeEmpty())
+	})
+	t.Run("Error when deleting a public image", func(t *testing.T) {
+		g := NewWithT(t)
+		client := newFakeImageDeleter()
+		err := deleteImage(context.TODO(), client, imageDeleteOptions{id: "public-image-id", yes: true}, strings.NewReader(""), &bytes.Buffer{})
+		g.Expect(err).To(MatchError(ContainSubstring("not a private image")))
+		g.Expect(client.deleted).To(BeEmpty())
+	})
+	t.Run("Error when image is pending", func(t *testing.T) {
+		g := NewWithT(t)
+		client := newFakeImageDeleter()
+		err := deleteImage(context.TODO(), client, imageDeleteOptions{id: "pending-image-id", yes: true}, strings.NewReader(""), &bytes.Buffer{})
+		g.Expect(err).To(Not(BeNil()))
+		g.Expect(client.deleted).To(BeEmpty())
+	})
+	t.Run("Error when image name is not found", func(t *testing.T) {
+		g := NewWithT(t)
+		client := newFakeImageDeleter()
+		err := deleteImage(context.TODO(), client, imageDeleteOptions{name: "missing-image", yes: true}, strings.NewReader(""), &bytes.Buffer{})
+		g.Expect(err).To(MatchError(ContainSubstring("not found")))
+	})
+	t.Run("Error when deleting image fails", func(t *testing.T) {
+		g := NewWithT(t)
+		client := newFakeImageDeleter()
+		client.deleteErr = errors.New("failed to delete image")
+		err := deleteImage(context.TODO(), client, imageDeleteOptions{id: "private-image-id", yes: true}, strings.NewReader(""), &bytes.Buffer{})
+		g.Expect(err).To(Not(BeNil()))
+	})
+}
+
+func TestDeleteCommandFlags(t *testing.T) {
+	testCases := []struct {
+		name      string
+		args      []string
+		expectErr bool
+	}{
+		{
+			name: "Should accept id",
+			args: []string{"--id", "private-image-id"},
+		},
+		{
+			name: "Should accept name",
+			args: []string{"--name", "private-image"},
+		},
+		{
+			name:      "Error when neither id nor name is set",
+			expectErr: true,
+		},
+		{
+			name:      "Error when both id and name are set",
+			args:      []string{"--id", "private-image-id", "--name", "private-image"},
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			cmd := DeleteCommand()
+			g.Expect(cmd.ParseFlags(tc.args)).To(Succeed())
+			err := cmd.PreRunE(cmd, nil)
+			if tc.expectErr {
+				g.Expect(err).To(Not(BeNil()))
+			} else {
+				g.Expect(err).To(BeNil())
+			}
+		})
+	}
+}
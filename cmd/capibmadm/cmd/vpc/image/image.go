@@ -29,6 +29,8 @@ func Commands() *cobra.Command {
 	}
 
 	cmd.AddCommand(ListCommand())
+	cmd.AddCommand(DeleteCommand())
+	cmd.AddCommand(ImportCommand())
 
 	return cmd
 }
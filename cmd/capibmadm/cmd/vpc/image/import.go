@@ -0,0 +1,212 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package image
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+
+	"k8s.io/apimachinery/pkg/util/wait"
+
+	logf "sigs.k8s.io/cluster-api/cmd/clusterctl/log"
+
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/cmd/capibmadm/clients/iam"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/cmd/capibmadm/clients/vpc"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/cmd/capibmadm/options"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/cmd/capibmadm/utils"
+	pkgUtils "sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/utils"
+)
+
+// imageAvailablePollInterval is the interval between image status checks while waiting for an imported image.
+var imageAvailablePollInterval = 30 * time.Second
+
+// imageImporter is the subset of the VPC client used to import images.
+type imageImporter interface {
+	CreateImageWithContext(ctx context.Context, createImageOptions *vpcv1.CreateImageOptions) (*vpcv1.Image, *core.DetailedResponse, error)
+	GetImageWithContext(ctx context.Context, getImageOptions *vpcv1.GetImageOptions) (*vpcv1.Image, *core.DetailedResponse, error)
+	ListOperatingSystemsWithContext(ctx context.Context, listOperatingSystemsOptions *vpcv1.ListOperatingSystemsOptions) (*vpcv1.OperatingSystemCollection, *core.DetailedResponse, error)
+}
+
+type imageImportOptions struct {
+	name         string
+	bucketName   string
+	objectName   string
+	bucketRegion string
+	osName       string
+	wait         bool
+	watchTimeout time.Duration
+}
+
+// ImportCommand vpc image import command.
+func ImportCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "import",
+		Short: "Import VPC image from Cloud Object Storage",
+		Example: `
+ # Import image in VPC from a Cloud Object Storage bucket
+ export IBMCLOUD_API_KEY=<api-key>
+ capibmadm vpc image import --name <image-name> --cos-bucket <bucket-name> --cos-object <object-name> --os-name <os-name> --region <region>
+
+ # Import image in VPC and wait for it to become available
+ export IBMCLOUD_API_KEY=<api-key>
+ capibmadm vpc image import --name <image-name> --cos-bucket <bucket-name> --cos-object <object-name> --os-name <os-name> --region <region> --wait`,
+	}
+
+	var imageImportOption imageImportOptions
+	cmd.Flags().StringVar(&imageImportOption.name, "name", "", "Name of the imported image.")
+	cmd.Flags().StringVar(&imageImportOption.bucketName, "cos-bucket", "", "Cloud Object Storage bucket name.")
+	cmd.Flags().StringVar(&imageImportOption.objectName, "cos-object", "", "Cloud Object Storage object name.")
+	cmd.Flags().StringVar(&imageImportOption.bucketRegion, "cos-region", "", "Cloud Object Storage bucket region, defaults to the VPC region.")
+	cmd.Flags().StringVar(&imageImportOption.osName, "os-name", "", "Name of the operating system of the image.")
+	cmd.Flags().BoolVar(&imageImportOption.wait, "wait", false, "Wait for the image to become available.")
+	cmd.Flags().DurationVar(&imageImportOption.watchTimeout, "watch-timeout", 1*time.Hour, "Time to wait for the image to become available.")
+	_ = cmd.MarkFlagRequired("name")
+	_ = cmd.MarkFlagRequired("cos-bucket")
+	_ = cmd.MarkFlagRequired("cos-object")
+	_ = cmd.MarkFlagRequired("os-name")
+
+	cmd.RunE = func(cmd *cobra.Command, _ []string) error {
+		return importImage(cmd.Context(), imageImportOption)
+	}
+
+	return cmd
+}
+
+func importImage(ctx context.Context, imageImportOption imageImportOptions) error {
+	v1, err := vpc.NewV1Client(options.GlobalOptions.VPCRegion)
+	if err != nil {
+		return err
+	}
+
+	var resourceGroupID string
+	if options.GlobalOptions.ResourceGroupName != "" {
+		accountID, err := pkgUtils.GetAccount(ctx, iam.GetIAMAuth())
+		if err != nil {
+			return err
+		}
+		resourceGroupID, err = utils.GetResourceGroupID(ctx, options.GlobalOptions.ResourceGroupName, accountID)
+		if err != nil {
+			return err
+		}
+	}
+
+	if imageImportOption.bucketRegion == "" {
+		imageImportOption.bucketRegion = options.GlobalOptions.VPCRegion
+	}
+
+	_, err = createImage(ctx, v1, imageImportOption, resourceGroupID)
+	return err
+}
+
+func createImage(ctx context.Context, v1 imageImporter, imageImportOption imageImportOptions, resourceGroupID string) (*vpcv1.Image, error) {
+	log := logf.Log
+
+	if err := validateOperatingSystem(ctx, v1, imageImportOption.osName); err != nil {
+		return nil, err
+	}
+
+	href := fmt.Sprintf("cos://%s/%s/%s", imageImportOption.bucketRegion, imageImportOption.bucketName, imageImportOption.objectName)
+	imagePrototype := &vpcv1.ImagePrototypeImageByFile{
+		Name: &imageImportOption.name,
+		File: &vpcv1.ImageFilePrototype{
+			Href: &href,
+		},
+		OperatingSystem: &vpcv1.OperatingSystemIdentityByName{
+			Name: &imageImportOption.osName,
+		},
+	}
+	if resourceGroupID != "" {
+		imagePrototype.ResourceGroup = &vpcv1.ResourceGroupIdentityByID{
+			ID: &resourceGroupID,
+		}
+	}
+
+	log.Info("Importing VPC image", "name", imageImportOption.name, "file", href)
+	image, _, err := v1.CreateImageWithContext(ctx, &vpcv1.CreateImageOptions{ImagePrototype: imagePrototype})
+	if err != nil {
+		return nil, fmt.Errorf("error creating image %s: %w", imageImportOption.name, err)
+	}
+
+	if !imageImportOption.wait {
+		log.Info("Image import started", "name", *image.Name, "id", *image.ID)
+		return image, nil
+	}
+
+	start := time.Now()
+	pollErr := wait.PollUntilContextTimeout(ctx, imageAvailablePollInterval, imageImportOption.watchTimeout, false, func(ctx context.Context) (bool, error) {
+		image, _, err = v1.GetImageWithContext(ctx, &vpcv1.GetImageOptions{ID: image.ID})
+		if err != nil {
+			return false, err
+		}
+		switch *image.Status {
+		case vpcv1.ImageStatusAvailableConst:
+			return true, nil
+		case vpcv1.ImageStatusFailedConst:
+			return false, fmt.Errorf("image %s failed to import", *image.Name)
+		}
+		log.Info("Image import in-progress", "current state", *image.Status)
+		return false, nil
+	})
+	if pollErr != nil {
+		return nil, fmt.Errorf("image %s did not become available: %w", imageImportOption.name, pollErr)
+	}
+
+	log.Info(fmt.Sprintf("Successfully imported the image: %s with ID: %s within %s", *image.Name, *image.ID, time.Since(start)))
+	return image, nil
+}
+
+// validateOperatingSystem returns an error if osName is not one of the operating systems available in the region.
+func validateOperatingSystem(ctx context.Context, v1 imageImporter, osName string) error {
+	var found bool
+	f := func(start string) (bool, string, error) {
+		var listOperatingSystemsOpt vpcv1.ListOperatingSystemsOptions
+		if start != "" {
+			listOperatingSystemsOpt.Start = &start
+		}
+
+		operatingSystemL, _, err := v1.ListOperatingSystemsWithContext(ctx, &listOperatingSystemsOpt)
+		if err != nil {
+			return false, "", err
+		}
+		for _, operatingSystem := range operatingSystemL.OperatingSystems {
+			if operatingSystem.Name != nil && *operatingSystem.Name == osName {
+				found = true
+				return true, "", nil
+			}
+		}
+
+		if operatingSystemL.Next != nil && *operatingSystemL.Next.Href != "" {
+			return false, *operatingSystemL.Next.Href, nil
+		}
+
+		return true, "", nil
+	}
+
+	if err := pkgUtils.PagingHelper(ctx, f); err != nil {
+		return fmt.Errorf("error listing operating systems: %w", err)
+	}
+	if !found {
+		return fmt.Errorf("operating system %s is not available", osName)
+	}
+	return nil
+}
@@ -0,0 +1,134 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package image
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+
+	. "github.com/onsi/gomega"
+)
+
+// fakeImageImporter creates images and reports the configured status for them.
+type fakeImageImporter struct {
+	operatingSystems []string
+	status           string
+	createRequests   []vpcv1.CreateImageOptions
+	createErr        error
+}
+
+func (f *fakeImageImporter) CreateImageWithContext(_ context.Context, options *vpcv1.CreateImageOptions) (*vpcv1.Image, *core.DetailedResponse, error) {
+	f.createRequests = append(f.createRequests, *options)
+	if f.createErr != nil {
+		return nil, nil, f.createErr
+	}
+	prototype := options.ImagePrototype.(*vpcv1.ImagePrototypeImageByFile)
+	return &vpcv1.Image{
+		ID:     core.StringPtr("foo-image-id"),
+		Name:   prototype.Name,
+		Status: core.StringPtr(vpcv1.ImageStatusPendingConst),
+	}, &core.DetailedResponse{}, nil
+}
+
+func (f *fakeImageImporter) GetImageWithContext(_ context.Context, options *vpcv1.GetImageOptions) (*vpcv1.Image, *core.DetailedResponse, error) {
+	return &vpcv1.Image{
+		ID:     options.ID,
+		Name:   core.StringPtr("foo-image"),
+		Status: core.StringPtr(f.status),
+	}, &core.DetailedResponse{}, nil
+}
+
+func (f *fakeImageImporter) ListOperatingSystemsWithContext(_ context.Context, _ *vpcv1.ListOperatingSystemsOptions) (*vpcv1.OperatingSystemCollection, *core.DetailedResponse, error) {
+	collection := &vpcv1.OperatingSystemCollection{}
+	for _, name := range f.operatingSystems {
+		collection.OperatingSystems = append(collection.OperatingSystems, vpcv1.OperatingSystem{Name: core.StringPtr(name)})
+	}
+	return collection, &core.DetailedResponse{}, nil
+}
+
+func TestCreateImage(t *testing.T) {
+	imagePollInterval := imageAvailablePollInterval
+	imageAvailablePollInterval = 10 * time.Millisecond
+	t.Cleanup(func() { imageAvailablePollInterval = imagePollInterval })
+
+	imageImportOption := imageImportOptions{
+		name:         "foo-image",
+		bucketName:   "foo-bucket",
+		objectName:   "foo-image.qcow2",
+		bucketRegion: "us-south",
+		osName:       "ubuntu-22-04-amd64",
+		watchTimeout: time.Second,
+	}
+
+	t.Run("Should import image from Cloud Object Storage", func(t *testing.T) {
+		g := NewWithT(t)
+		client := &fakeImageImporter{operatingSystems: []string{"centos-7-amd64", "ubuntu-22-04-amd64"}}
+		image, err := createImage(context.TODO(), client, imageImportOption, "foo-resource-group-id")
+		g.Expect(err).To(BeNil())
+		g.Expect(*image.ID).To(Equal("foo-image-id"))
+		g.Expect(client.createRequests).To(HaveLen(1))
+		prototype := client.createRequests[0].ImagePrototype.(*vpcv1.ImagePrototypeImageByFile)
+		g.Expect(*prototype.Name).To(Equal("foo-image"))
+		g.Expect(*prototype.File.Href).To(Equal("cos://us-south/foo-bucket/foo-image.qcow2"))
+		g.Expect(*prototype.OperatingSystem.(*vpcv1.OperatingSystemIdentityByName).Name).To(Equal("ubuntu-22-04-amd64"))
+		g.Expect(*prototype.ResourceGroup.(*vpcv1.ResourceGroupIdentityByID).ID).To(Equal("foo-resource-group-id"))
+	})
+	t.Run("Should wait for image to become available", func(t *testing.T) {
+		g := NewWithT(t)
+		client := &fakeImageImporter{operatingSystems: []string{"ubuntu-22-04-amd64"}, status: vpcv1.ImageStatusAvailableConst}
+		waitOption := imageImportOption
+		waitOption.wait = true
+		image, err := createImage(context.TODO(), client, waitOption, "")
+		g.Expect(err).To(BeNil())
+		g.Expect(*image.Status).To(Equal(vpcv1.ImageStatusAvailableConst))
+	})
+	t.Run("Error when operating system is not available", func(t *testing.T) {
+		g := NewWithT(t)
+		client := &fakeImageImporter{operatingSystems: []string{"centos-7-amd64"}}
+		_, err := createImage(context.TODO(), client, imageImportOption, "")
+		g.Expect(err).To(MatchError(ContainSubstring("operating system ubuntu-22-04-amd64 is not available")))
+		g.Expect(client.createRequests).To(BeEmpty())
+	})
+	t.Run("Error when creating image", func(t *testing.T) {
+		g := NewWithT(t)
+		client := &fakeImageImporter{operatingSystems: []string{"ubuntu-22-04-amd64"}, createErr: errors.New("failed to create image")}
+		_, err := createImage(context.TODO(), client, imageImportOption, "")
+		g.Expect(err).To(Not(BeNil()))
+	})
+	t.Run("Error when image does not become available before timeout", func(t *testing.T) {
+		g := NewWithT(t)
+		client := &fakeImageImporter{operatingSystems: []string{"ubuntu-22-04-amd64"}, status: vpcv1.ImageStatusPendingConst}
+		waitOption := imageImportOption
+		waitOption.wait = true
+		waitOption.watchTimeout = 50 * time.Millisecond
+		_, err := createImage(context.TODO(), client, waitOption, "")
+		g.Expect(err).To(MatchError(ContainSubstring("did not become available")))
+	})
+	t.Run("Error when image import fails", func(t *testing.T) {
+		g := NewWithT(t)
+		client := &fakeImageImporter{operatingSystems: []string{"ubuntu-22-04-amd64"}, status: vpcv1.ImageStatusFailedConst}
+		waitOption := imageImportOption
+		waitOption.wait = true
+		_, err := createImage(context.TODO(), client, waitOption, "")
+		g.Expect(err).To(MatchError(ContainSubstring("failed to import")))
+	})
+}
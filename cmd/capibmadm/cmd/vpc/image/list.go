@@ -18,11 +18,16 @@ package image
 
 import (
 	"context"
+	"fmt"
+	"io"
 	"os"
+	"sort"
+	"time"
 
 	"github.com/go-openapi/strfmt"
 	"github.com/spf13/cobra"
 
+	"github.com/IBM/go-sdk-core/v5/core"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
 
 	"sigs.k8s.io/cluster-api-provider-ibmcloud/cmd/capibmadm/clients/iam"
@@ -33,6 +38,20 @@ import (
 	pkgUtils "sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/utils"
 )
 
+// maxImagesPageLimit is the maximum number of images the VPC API returns per page.
+const maxImagesPageLimit = 100
+
+const (
+	sortByName    = "name"
+	sortByCreated = "created"
+	sortByStatus  = "status"
+)
+
+// imageLister is the subset of the VPC client used to list images.
+type imageLister interface {
+	ListImagesWithContext(ctx context.Context, listImagesOptions *vpcv1.ListImagesOptions) (*vpcv1.ImageCollection, *core.DetailedResponse, error)
+}
+
 // ListCommand vpc image list command.
 func ListCommand() *cobra.Command {
 	cmd := &cobra.Command{
@@ -41,25 +60,56 @@ func ListCommand() *cobra.Command {
 		Example: `
  # List images in VPC
  export IBMCLOUD_API_KEY=<api-key>
- capibmadm vpc image list --region <region> --resource-group-name <resource-group-name>`,
+ capibmadm vpc image list --region <region> --resource-group-name <resource-group-name>
+
+ # List the first 10 images in VPC
+ export IBMCLOUD_API_KEY=<api-key>
+ capibmadm vpc image list --region <region> --limit 10
+
+ # List private images in VPC
+ export IBMCLOUD_API_KEY=<api-key>
+ capibmadm vpc image list --region <region> --visibility private
+
+ # List images in VPC sorted by creation time, newest first
+ export IBMCLOUD_API_KEY=<api-key>
+ capibmadm vpc image list --region <region> --sort-by created --sort-order desc`,
 	}
 
 	options.AddCommonFlags(cmd)
+	options.AddLimitFlag(cmd)
+	options.AddSortFlags(cmd, sortByName, sortByCreated, sortByStatus)
+	var visibility string
+	cmd.Flags().StringVar(&visibility, "visibility", "", "Filter images by visibility. Supported values: public, private")
 
+	cmd.PreRunE = func(_ *cobra.Command, _ []string) error {
+		if err := options.ValidateSortFlags(sortByName, sortByCreated, sortByStatus); err != nil {
+			return err
+		}
+		return validateVisibility(visibility)
+	}
 	cmd.RunE = func(cmd *cobra.Command, _ []string) error {
-		return listImages(cmd.Context(), options.GlobalOptions.ResourceGroupName)
+		return listImages(cmd.Context(), options.GlobalOptions.ResourceGroupName, visibility)
 	}
 
 	return cmd
 }
 
-func listImages(ctx context.Context, resourceGroupName string) error {
+func validateVisibility(visibility string) error {
+	switch visibility {
+	case "", vpcv1.ListImagesOptionsVisibilityPublicConst, vpcv1.ListImagesOptionsVisibilityPrivateConst:
+		return nil
+	default:
+		return fmt.Errorf("invalid visibility %q, supported values: %s, %s", visibility, vpcv1.ListImagesOptionsVisibilityPublicConst, vpcv1.ListImagesOptionsVisibilityPrivateConst)
+	}
+}
+
+func listImages(ctx context.Context, resourceGroupName, visibility string) error {
 	v1, err := vpc.NewV1Client(options.GlobalOptions.VPCRegion)
 	if err != nil {
 		return err
 	}
 
-	accountID, err := pkgUtils.GetAccount(iam.GetIAMAuth())
+	accountID, err := pkgUtils.GetAccount(ctx, iam.GetIAMAuth())
 	if err != nil {
 		return err
 	}
@@ -72,23 +122,53 @@ func listImages(ctx context.Context, resourceGroupName string) error {
 		}
 	}
 
+	imageNesList, err := fetchImages(ctx, v1, resourceGroupID, visibility, options.GlobalOptions.Limit)
+	if err != nil {
+		return err
+	}
+
+	return display(imageNesList, os.Stdout)
+}
+
+// fetchImages lists the images page by page, stopping once limit images are collected. A limit of 0 means no limit.
+// When visibility is set the images are filtered by the VPC API.
+func fetchImages(ctx context.Context, v1 imageLister, resourceGroupID, visibility string, limit int64) ([]*vpcv1.ImageCollection, error) {
+	if limit < 0 {
+		return nil, fmt.Errorf("limit must not be negative, got %d", limit)
+	}
+
 	var imageNesList []*vpcv1.ImageCollection
+	var collected int64
 	f := func(start string) (bool, string, error) {
 		var listImageOpt vpcv1.ListImagesOptions
 
 		if resourceGroupID != "" {
 			listImageOpt.ResourceGroupID = &resourceGroupID
 		}
+		if visibility != "" {
+			listImageOpt.Visibility = &visibility
+		}
 		if start != "" {
 			listImageOpt.Start = &start
 		}
+		if limit > 0 {
+			listImageOpt.Limit = core.Int64Ptr(min(limit-collected, maxImagesPageLimit))
+		}
 
 		imageL, _, err := v1.ListImagesWithContext(ctx, &listImageOpt)
 		if err != nil {
 			return false, "", err
 		}
+		if limit > 0 && int64(len(imageL.Images)) > limit-collected {
+			imageL.Images = imageL.Images[:limit-collected]
+		}
+		collected += int64(len(imageL.Images))
 		imageNesList = append(imageNesList, imageL)
 
+		if limit > 0 && collected >= limit {
+			return true, "", nil
+		}
+
 		if imageL.Next != nil && *imageL.Next.Href != "" {
 			return false, *imageL.Next.Href, nil
 		}
@@ -96,28 +176,61 @@ func listImages(ctx context.Context, resourceGroupName string) error {
 		return true, "", nil
 	}
 
-	if err = pkgUtils.PagingHelper(f); err != nil {
-		return err
+	if err := pkgUtils.PagingHelper(ctx, f); err != nil {
+		return nil, err
+	}
+
+	return imageNesList, nil
+}
+
+// sortImages sorts the images in place by sortBy in sortOrder. The API order is kept when sortBy is empty.
+func sortImages(imageList List, sortBy, sortOrder string) {
+	var less func(a, b Image) bool
+	switch sortBy {
+	case sortByName:
+		less = func(a, b Image) bool { return a.Name < b.Name }
+	case sortByCreated:
+		less = func(a, b Image) bool { return time.Time(a.CreatedAt).Before(time.Time(b.CreatedAt)) }
+	case sortByStatus:
+		less = func(a, b Image) bool { return a.Status < b.Status }
+	default:
+		return
 	}
 
-	return display(imageNesList)
+	sort.SliceStable(imageList, func(i, j int) bool {
+		if sortOrder == options.SortOrderDescending {
+			return less(imageList[j], imageList[i])
+		}
+		return less(imageList[i], imageList[j])
+	})
 }
 
-func display(imageNesList []*vpcv1.ImageCollection) error {
+func toList(imageNesList []*vpcv1.ImageCollection) List {
 	var imageListToDisplay List
 	for _, imageL := range imageNesList {
 		for _, image := range imageL.Images {
 			imageToAppend := Image{
 				ID:         utils.DereferencePointer(image.ID).(string),
+				CRN:        utils.DereferencePointer(image.CRN).(string),
 				Name:       utils.DereferencePointer(image.Name).(string),
 				Status:     utils.DereferencePointer(image.Status).(string),
 				CreatedAt:  utils.DereferencePointer(image.CreatedAt).(strfmt.DateTime),
 				Visibility: utils.DereferencePointer(image.Visibility).(string),
 				Encryption: utils.DereferencePointer(image.Encryption).(string),
+				// Only images scheduled for deprecation or obsolescence have these timestamps set.
+				DeprecationAt:  image.DeprecationAt,
+				ObsolescenceAt: image.ObsolescenceAt,
 			}
 
 			if image.File != nil {
 				imageToAppend.FileSize = utils.DereferencePointer(image.File.Size).(int64)
+				if image.File.Checksums != nil {
+					imageToAppend.FileChecksum = utils.DereferencePointer(image.File.Checksums.Sha256).(string)
+				}
+			}
+
+			if image.EncryptionKey != nil {
+				imageToAppend.EncryptionKeyCRN = utils.DereferencePointer(image.EncryptionKey.CRN).(string)
 			}
 
 			if image.ResourceGroup != nil {
@@ -141,16 +254,29 @@ func display(imageNesList []*vpcv1.ImageCollection) error {
 			imageListToDisplay = append(imageListToDisplay, imageToAppend)
 		}
 	}
+	return imageListToDisplay
+}
+
+func display(imageNesList []*vpcv1.ImageCollection, w io.Writer) error {
+	imageListToDisplay := toList(imageNesList)
+	sortImages(imageListToDisplay, options.GlobalOptions.SortBy, options.GlobalOptions.SortOrder)
 
-	p, err := printer.New(options.GlobalOptions.Output, os.Stdout)
+	if options.GlobalOptions.Quiet {
+		return printer.NewQuiet(w).Print(&imageListToDisplay)
+	}
+
+	p, err := options.NewPrinter(w)
 
 	if err != nil {
 		return err
 	}
 
 	switch options.GlobalOptions.Output {
-	case printer.PrinterTypeJSON:
+	case printer.PrinterTypeJSON, printer.PrinterTypeYAML, printer.PrinterTypeTemplate:
 		err = p.Print(imageListToDisplay)
+	case printer.PrinterTypeWide:
+		table := imageListToDisplay.ToWideTable()
+		err = p.Print(table)
 	default:
 		table := imageListToDisplay.ToTable()
 		err = p.Print(table)
@@ -0,0 +1,422 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package image
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	. "github.com/onsi/gomega"
+
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/cmd/capibmadm/options"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/cmd/capibmadm/printer"
+)
+
+// fakeImageLister serves images in pages of pageSize, honouring the requested limit.
+type fakeImageLister struct {
+	images   []vpcv1.Image
+	pageSize int
+	requests []vpcv1.ListImagesOptions
+	err      error
+}
+
+func (f *fakeImageLister) ListImagesWithContext(_ context.Context, options *vpcv1.ListImagesOptions) (*vpcv1.ImageCollection, *core.DetailedResponse, error) {
+	f.requests = append(f.requests, *options)
+	if f.err != nil {
+		return nil, nil, f.err
+	}
+
+	start := 0
+	if options.Start != nil {
+		var err error
+		if start, err = strconv.Atoi(*options.Start); err != nil {
+			return nil, nil, err
+		}
+	}
+	end := start + f.pageSize
+	if options.Limit != nil && start+int(*options.Limit) < end {
+		end = start + int(*options.Limit)
+	}
+	if end > len(f.images) {
+		end = len(f.images)
+	}
+
+	collection := &vpcv1.ImageCollection{Images: f.images[start:end]}
+	if end < len(f.images) {
+		collection.Next = &vpcv1.ImageCollectionNext{Href: core.StringPtr(fmt.Sprintf("https://us-south.iaas.cloud.ibm.com/v1/images?start=%d", end))}
+	}
+	return collection, &core.DetailedResponse{}, nil
+}
+
+func newFakeImageLister(count, pageSize int) *fakeImageLister {
+	images := make([]vpcv1.Image, count)
+	for i := range images {
+		images[i] = vpcv1.Image{ID: core.StringPtr(fmt.Sprintf("image-%d", i))}
+	}
+	return &fakeImageLister{images: images, pageSize: pageSize}
+}
+
+func countImages(imageNesList []*vpcv1.ImageCollection) int {
+	count := 0
+	for _, imageL := range imageNesList {
+		count += len(imageL.Images)
+	}
+	return count
+}
+
+func TestFetchImages(t *testing.T) {
+	t.Run("Should stop paginating once the limit is reached", func(t *testing.T) {
+		g := NewWithT(t)
+		lister := newFakeImageLister(25, 10)
+		imageNesList, err := fetchImages(context.TODO(), lister, "", "", 15)
+		g.Expect(err).To(BeNil())
+		g.Expect(countImages(imageNesList)).To(Equal(15))
+		g.Expect(lister.requests).To(HaveLen(2))
+		g.Expect(*lister.requests[0].Limit).To(Equal(int64(15)))
+		g.Expect(*lister.requests[1].Limit).To(Equal(int64(5)))
+	})
+	t.Run("Should cap the page size to the VPC maximum", func(t *testing.T) {
+		g := NewWithT(t)
+		lister := newFakeImageLister(5, 10)
+		_, err := fetchImages(context.TODO(), lister, "", "", 500)
+		g.Expect(err).To(BeNil())
+		g.Expect(*lister.requests[0].Limit).To(Equal(int64(maxImagesPageLimit)))
+	})
+	t.Run("Should list all images when limit is 0", func(t *testing.T) {
+		g := NewWithT(t)
+		lister := newFakeImageLister(25, 10)
+		imageNesList, err := fetchImages(context.TODO(), lister, "", "", 0)
+		g.Expect(err).To(BeNil())
+		g.Expect(countImages(imageNesList)).To(Equal(25))
+		g.Expect(lister.requests).To(HaveLen(3))
+		for _, request := range lister.requests {
+			g.Expect(request.Limit).To(BeNil())
+		}
+	})
+	t.Run("Should pass the resource group to each request", func(t *testing.T) {
+		g := NewWithT(t)
+		lister := newFakeImageLister(15, 10)
+		_, err := fetchImages(context.TODO(), lister, "foo-resource-group-id", "", 0)
+		g.Expect(err).To(BeNil())
+		for _, request := range lister.requests {
+			g.Expect(*request.ResourceGroupID).To(Equal("foo-resource-group-id"))
+		}
+	})
+	t.Run("Should pass the visibility filter to each request", func(t *testing.T) {
+		g := NewWithT(t)
+		lister := newFakeImageLister(15, 10)
+		_, err := fetchImages(context.TODO(), lister, "", vpcv1.ListImagesOptionsVisibilityPrivateConst, 0)
+		g.Expect(err).To(BeNil())
+		g.Expect(lister.requests).To(HaveLen(2))
+		for _, request := range lister.requests {
+			g.Expect(*request.Visibility).To(Equal(vpcv1.ListImagesOptionsVisibilityPrivateConst))
+		}
+	})
+	t.Run("Error when limit is negative", func(t *testing.T) {
+		g := NewWithT(t)
+		lister := newFakeImageLister(5, 10)
+		_, err := fetchImages(context.TODO(), lister, "", "", -1)
+		g.Expect(err).To(Not(BeNil()))
+		g.Expect(lister.requests).To(BeEmpty())
+	})
+	t.Run("Error when listing images", func(t *testing.T) {
+		g := NewWithT(t)
+		lister := &fakeImageLister{err: errors.New("failed to list images")}
+		_, err := fetchImages(context.TODO(), lister, "", "", 0)
+		g.Expect(err).To(Not(BeNil()))
+	})
+}
+
+func TestListCommandVisibility(t *testing.T) {
+	testCases := []struct {
+		name      string
+		args      []string
+		expectErr bool
+	}{
+		{
+			name: "Should accept empty visibility",
+		},
+		{
+			name: "Should accept public visibility",
+			args: []string{"--visibility", "public"},
+		},
+		{
+			name: "Should accept private visibility",
+			args: []string{"--visibility", "private"},
+		},
+		{
+			name:      "Should reject invalid visibility",
+			args:      []string{"--visibility", "shared"},
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			cmd := ListCommand()
+			g.Expect(cmd.ParseFlags(tc.args)).To(Succeed())
+			err := cmd.PreRunE(cmd, nil)
+			if tc.expectErr {
+				g.Expect(err).To(Not(BeNil()))
+			} else {
+				g.Expect(err).To(BeNil())
+			}
+		})
+	}
+}
+
+func imageNames(imageList List) []string {
+	names := make([]string, len(imageList))
+	for i, image := range imageList {
+		names[i] = image.Name
+	}
+	return names
+}
+
+func TestSortImages(t *testing.T) {
+	created := func(days int) strfmt.DateTime {
+		return strfmt.DateTime(time.Date(2023, time.January, days, 0, 0, 0, 0, time.UTC))
+	}
+	newImageList := func() List {
+		return List{
+			{Name: "bar-image", Status: vpcv1.ImageStatusPendingConst, CreatedAt: created(3)},
+			{Name: "foo-image", Status: vpcv1.ImageStatusAvailableConst, CreatedAt: created(1)},
+			{Name: "baz-image", Status: vpcv1.ImageStatusDeprecatedConst, CreatedAt: created(2)},
+		}
+	}
+
+	testCases := []struct {
+		name      string
+		sortBy    string
+		sortOrder string
+		expected  []string
+	}{
+		{
+			name:     "Should keep the API order when sort key is not set",
+			expected: []string{"bar-image", "foo-image", "baz-image"},
+		},
+		{
+			name:      "Should sort by name",
+			sortBy:    sortByName,
+			sortOrder: options.SortOrderAscending,
+			expected:  []string{"bar-image", "baz-image", "foo-image"},
+		},
+		{
+			name:      "Should sort by name in descending order",
+			sortBy:    sortByName,
+			sortOrder: options.SortOrderDescending,
+			expected:  []string{"foo-image", "baz-image", "bar-image"},
+		},
+		{
+			name:      "Should sort by creation time",
+			sortBy:    sortByCreated,
+			sortOrder: options.SortOrderAscending,
+			expected:  []string{"foo-image", "baz-image", "bar-image"},
+		},
+		{
+			name:      "Should sort by creation time in descending order",
+			sortBy:    sortByCreated,
+			sortOrder: options.SortOrderDescending,
+			expected:  []string{"bar-image", "baz-image", "foo-image"},
+		},
+		{
+			name:      "Should sort by status",
+			sortBy:    sortByStatus,
+			sortOrder: options.SortOrderAscending,
+			expected:  []string{"foo-image", "baz-image", "bar-image"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			imageList := newImageList()
+			sortImages(imageList, tc.sortBy, tc.sortOrder)
+			g.Expect(imageNames(imageList)).To(Equal(tc.expected))
+		})
+	}
+}
+
+func TestListCommandSort(t *testing.T) {
+	sortBy, sortOrder := options.GlobalOptions.SortBy, options.GlobalOptions.SortOrder
+	t.Cleanup(func() {
+		options.GlobalOptions.SortBy, options.GlobalOptions.SortOrder = sortBy, sortOrder
+	})
+
+	testCases := []struct {
+		name      string
+		args      []string
+		expectErr bool
+	}{
+		{
+			name: "Should accept empty sort key",
+		},
+		{
+			name: "Should accept name sort key",
+			args: []string{"--sort-by", "name"},
+		},
+		{
+			name: "Should accept created sort key with descending order",
+			args: []string{"--sort-by", "created", "--sort-order", "desc"},
+		},
+		{
+			name: "Should accept status sort key",
+			args: []string{"--sort-by", "status"},
+		},
+		{
+			name:      "Should reject unknown sort key",
+			args:      []string{"--sort-by", "size"},
+			expectErr: true,
+		},
+		{
+			name:      "Should reject unknown sort order",
+			args:      []string{"--sort-by", "name", "--sort-order", "random"},
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			cmd := ListCommand()
+			g.Expect(cmd.ParseFlags(tc.args)).To(Succeed())
+			err := cmd.PreRunE(cmd, nil)
+			if tc.expectErr {
+				g.Expect(err).To(Not(BeNil()))
+			} else {
+				g.Expect(err).To(BeNil())
+			}
+		})
+	}
+}
+
+func TestToListLifecycle(t *testing.T) {
+	g := NewWithT(t)
+	deprecationAt := strfmt.DateTime(time.Date(2026, time.January, 1, 0, 0, 0, 0, time.UTC))
+	obsolescenceAt := strfmt.DateTime(time.Date(2026, time.July, 1, 0, 0, 0, 0, time.UTC))
+	imageList := toList([]*vpcv1.ImageCollection{
+		{
+			Images: []vpcv1.Image{
+				{
+					ID:             core.StringPtr("deprecated-image-id"),
+					Name:           core.StringPtr("deprecated-image"),
+					Status:         core.StringPtr(vpcv1.ImageStatusDeprecatedConst),
+					DeprecationAt:  &deprecationAt,
+					ObsolescenceAt: &obsolescenceAt,
+				},
+				{
+					ID:     core.StringPtr("available-image-id"),
+					Name:   core.StringPtr("available-image"),
+					Status: core.StringPtr(vpcv1.ImageStatusAvailableConst),
+				},
+			},
+		},
+	})
+	g.Expect(imageList).To(HaveLen(2))
+	g.Expect(imageList[0].DeprecationAt).To(Equal(&deprecationAt))
+	g.Expect(imageList[0].ObsolescenceAt).To(Equal(&obsolescenceAt))
+	g.Expect(imageList[1].DeprecationAt).To(BeNil())
+	g.Expect(imageList[1].ObsolescenceAt).To(BeNil())
+
+	table := imageList.ToTable()
+	g.Expect(table.Rows).To(HaveLen(2))
+	g.Expect(table.Rows[0].Cells[4]).To(Equal(deprecationAt.String()))
+	g.Expect(table.Rows[0].Cells[5]).To(Equal(obsolescenceAt.String()))
+	g.Expect(table.Rows[1].Cells[4]).To(Equal(""))
+	g.Expect(table.Rows[1].Cells[5]).To(Equal(""))
+}
+
+func TestDisplayQuiet(t *testing.T) {
+	output, quiet := options.GlobalOptions.Output, options.GlobalOptions.Quiet
+	t.Cleanup(func() {
+		options.GlobalOptions.Output, options.GlobalOptions.Quiet = output, quiet
+	})
+
+	imageNesList := []*vpcv1.ImageCollection{
+		{
+			Images: []vpcv1.Image{
+				{ID: core.StringPtr("foo-image-id"), Name: core.StringPtr("foo-image")},
+				{ID: core.StringPtr("bar-image-id"), Name: core.StringPtr("bar-image")},
+			},
+		},
+	}
+	for _, output := range []printer.PType{printer.PrinterTypeTable, printer.PrinterTypeJSON, printer.PrinterTypeCSV} {
+		t.Run(fmt.Sprintf("Should print only image IDs with %s output", output), func(t *testing.T) {
+			g := NewWithT(t)
+			options.GlobalOptions.Output = output
+			options.GlobalOptions.Quiet = true
+			buf := &bytes.Buffer{}
+			g.Expect(display(imageNesList, buf)).To(Succeed())
+			g.Expect(buf.String()).To(Equal("foo-image-id\nbar-image-id\n"))
+			g.Expect(buf.String()).To(Not(ContainSubstring("NAME")))
+		})
+	}
+}
+
+func TestToWideTable(t *testing.T) {
+	g := NewWithT(t)
+	imageList := toList([]*vpcv1.ImageCollection{
+		{
+			Images: []vpcv1.Image{
+				{
+					ID:   core.StringPtr("foo-image-id"),
+					CRN:  core.StringPtr("crn:v1:bluemix:public:is:us-south:a/foo::image:foo-image-id"),
+					Name: core.StringPtr("foo-image"),
+					File: &vpcv1.ImageFile{
+						Checksums: &vpcv1.ImageFileChecksums{Sha256: core.StringPtr("foo-checksum")},
+						Size:      core.Int64Ptr(10),
+					},
+					EncryptionKey: &vpcv1.EncryptionKeyReference{CRN: core.StringPtr("crn:v1:bluemix:public:kms:us-south:a/foo:bar:key:foo-key")},
+				},
+			},
+		},
+	})
+
+	columnNames := func(columns []metav1.TableColumnDefinition) []string {
+		names := make([]string, len(columns))
+		for i, column := range columns {
+			names[i] = column.Name
+		}
+		return names
+	}
+
+	table := imageList.ToTable()
+	g.Expect(columnNames(table.ColumnDefinitions)).To(Not(ContainElements("CRN", "FILE CHECKSUM(SHA256)", "ENCRYPTION KEY CRN")))
+	g.Expect(table.Rows[0].Cells).To(Not(ContainElement("foo-checksum")))
+
+	wideTable := imageList.ToWideTable()
+	g.Expect(columnNames(wideTable.ColumnDefinitions)).To(ContainElements("CRN", "FILE CHECKSUM(SHA256)", "ENCRYPTION KEY CRN"))
+	g.Expect(wideTable.Rows[0].Cells).To(HaveLen(len(wideTable.ColumnDefinitions)))
+	g.Expect(wideTable.Rows[0].Cells).To(ContainElements(
+		"crn:v1:bluemix:public:is:us-south:a/foo::image:foo-image-id",
+		"foo-checksum",
+		"crn:v1:bluemix:public:kms:us-south:a/foo:bar:key:foo-key",
+	))
+}
@@ -24,24 +24,38 @@ import (
 
 // Image vpc image info.
 type Image struct {
-	CatalogOffering        bool            `json:"catalogOffering"`
-	CreatedAt              strfmt.DateTime `json:"created_at"`
-	Encryption             string          `json:"encryption"`
-	ID                     string          `json:"id"`
-	Name                   string          `json:"name"`
-	OperatingSystemName    string          `json:"operatingSystemName"`
-	OperatingSystemVersion string          `json:"operatingSystemVersion"`
-	Arch                   string          `json:"arch"`
-	FileSize               int64           `json:"fileSizeInGB"`
-	SourceVolumeName       string          `json:"sourceVolumeName"`
-	ResourceGroupName      string          `json:"resourceGroupName"`
-	Status                 string          `json:"status"`
-	Visibility             string          `json:"visibility"`
+	CatalogOffering        bool             `json:"catalogOffering"`
+	CreatedAt              strfmt.DateTime  `json:"created_at"`
+	CRN                    string           `json:"crn"`
+	DeprecationAt          *strfmt.DateTime `json:"deprecation_at,omitempty"`
+	ObsolescenceAt         *strfmt.DateTime `json:"obsolescence_at,omitempty"`
+	Encryption             string           `json:"encryption"`
+	ID                     string           `json:"id"`
+	Name                   string           `json:"name"`
+	OperatingSystemName    string           `json:"operatingSystemName"`
+	OperatingSystemVersion string           `json:"operatingSystemVersion"`
+	Arch                   string           `json:"arch"`
+	FileSize               int64            `json:"fileSizeInGB"`
+	FileChecksum           string           `json:"fileChecksum"`
+	EncryptionKeyCRN       string           `json:"encryptionKeyCRN,omitempty"`
+	SourceVolumeName       string           `json:"sourceVolumeName"`
+	ResourceGroupName      string           `json:"resourceGroupName"`
+	Status                 string           `json:"status"`
+	Visibility             string           `json:"visibility"`
 }
 
 // List is list of Image.
 type List []Image
 
+// IDs returns the IDs of the images in the list.
+func (imageList *List) IDs() []string {
+	ids := make([]string, 0, len(*imageList))
+	for _, image := range *imageList {
+		ids = append(ids, image.ID)
+	}
+	return ids
+}
+
 // ToTable converts List to *metav1.Table.
 func (imageList *List) ToTable() *metav1.Table {
 	table := &metav1.Table{
@@ -66,6 +80,14 @@ func (imageList *List) ToTable() *metav1.Table {
 				Name: "CREATED AT",
 				Type: "string",
 			},
+			{
+				Name: "DEPRECATION AT",
+				Type: "string",
+			},
+			{
+				Name: "OBSOLESCENCE AT",
+				Type: "string",
+			},
 			{
 				Name: "OS NAME",
 				Type: "string",
@@ -107,9 +129,41 @@ func (imageList *List) ToTable() *metav1.Table {
 
 	for _, image := range *imageList {
 		row := metav1.TableRow{
-			Cells: []interface{}{image.ID, image.Name, image.Status, image.CreatedAt, image.OperatingSystemName, image.OperatingSystemVersion, image.Arch, image.FileSize, image.SourceVolumeName, image.Visibility, image.Encryption, image.ResourceGroupName, image.CatalogOffering},
+			Cells: []interface{}{image.ID, image.Name, image.Status, image.CreatedAt, formatDateTime(image.DeprecationAt), formatDateTime(image.ObsolescenceAt), image.OperatingSystemName, image.OperatingSystemVersion, image.Arch, image.FileSize, image.SourceVolumeName, image.Visibility, image.Encryption, image.ResourceGroupName, image.CatalogOffering},
 		}
 		table.Rows = append(table.Rows, row)
 	}
 	return table
 }
+
+// formatDateTime returns the date time as a string, or an empty string if it is not set.
+func formatDateTime(dateTime *strfmt.DateTime) string {
+	if dateTime == nil {
+		return ""
+	}
+	return dateTime.String()
+}
+
+// ToWideTable converts List to *metav1.Table with the CRN, file checksum and encryption key CRN columns appended.
+func (imageList *List) ToWideTable() *metav1.Table {
+	table := imageList.ToTable()
+	table.ColumnDefinitions = append(table.ColumnDefinitions,
+		metav1.TableColumnDefinition{
+			Name: "CRN",
+			Type: "string",
+		},
+		metav1.TableColumnDefinition{
+			Name: "FILE CHECKSUM(SHA256)",
+			Type: "string",
+		},
+		metav1.TableColumnDefinition{
+			Name: "ENCRYPTION KEY CRN",
+			Type: "string",
+		},
+	)
+
+	for i, image := range *imageList {
+		table.Rows[i].Cells = append(table.Rows[i].Cells, image.CRN, image.FileChecksum, image.EncryptionKeyCRN)
+	}
+	return table
+}
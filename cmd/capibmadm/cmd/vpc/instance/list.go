@@ -0,0 +1,184 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package instance
+
+import (
+	"context"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/cmd/capibmadm/clients/iam"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/cmd/capibmadm/clients/vpc"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/cmd/capibmadm/options"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/cmd/capibmadm/printer"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/cmd/capibmadm/utils"
+	pkgUtils "sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/utils"
+)
+
+// instanceLister is the subset of the VPC client used to list instances.
+type instanceLister interface {
+	ListInstancesWithContext(ctx context.Context, listInstancesOptions *vpcv1.ListInstancesOptions) (*vpcv1.InstanceCollection, *core.DetailedResponse, error)
+}
+
+// ListCommand vpc instance list command.
+func ListCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List VPC instances",
+		Example: `
+ # List instances in VPC region
+ export IBMCLOUD_API_KEY=<api-key>
+ capibmadm vpc instance list --region <region> --resource-group-name <resource-group-name>
+
+ # List instances in a VPC
+ export IBMCLOUD_API_KEY=<api-key>
+ capibmadm vpc instance list --region <region> --vpc-id <vpc-id>`,
+	}
+
+	options.AddCommonFlags(cmd)
+	var vpcID string
+	cmd.Flags().StringVar(&vpcID, "vpc-id", "", "Filter instances by VPC ID.")
+
+	cmd.RunE = func(cmd *cobra.Command, _ []string) error {
+		return listInstances(cmd.Context(), options.GlobalOptions.ResourceGroupName, vpcID)
+	}
+
+	return cmd
+}
+
+func listInstances(ctx context.Context, resourceGroupName, vpcID string) error {
+	v1, err := vpc.NewV1Client(options.GlobalOptions.VPCRegion)
+	if err != nil {
+		return err
+	}
+
+	var resourceGroupID string
+	if resourceGroupName != "" {
+		accountID, err := pkgUtils.GetAccount(ctx, iam.GetIAMAuth())
+		if err != nil {
+			return err
+		}
+		resourceGroupID, err = utils.GetResourceGroupID(ctx, resourceGroupName, accountID)
+		if err != nil {
+			return err
+		}
+	}
+
+	instanceNesList, err := fetchInstances(ctx, v1, resourceGroupID, vpcID)
+	if err != nil {
+		return err
+	}
+
+	return display(instanceNesList)
+}
+
+// fetchInstances lists the instances page by page. When resourceGroupID or vpcID is set the instances are filtered
+// by the VPC API.
+func fetchInstances(ctx context.Context, v1 instanceLister, resourceGroupID, vpcID string) ([]*vpcv1.InstanceCollection, error) {
+	var instanceNesList []*vpcv1.InstanceCollection
+	f := func(start string) (bool, string, error) {
+		var listInstanceOpt vpcv1.ListInstancesOptions
+
+		if resourceGroupID != "" {
+			listInstanceOpt.ResourceGroupID = &resourceGroupID
+		}
+		if vpcID != "" {
+			listInstanceOpt.VPCID = &vpcID
+		}
+		if start != "" {
+			listInstanceOpt.Start = &start
+		}
+
+		instanceL, _, err := v1.ListInstancesWithContext(ctx, &listInstanceOpt)
+		if err != nil {
+			return false, "", err
+		}
+		instanceNesList = append(instanceNesList, instanceL)
+
+		if instanceL.Next != nil && *instanceL.Next.Href != "" {
+			return false, *instanceL.Next.Href, nil
+		}
+
+		return true, "", nil
+	}
+
+	if err := pkgUtils.PagingHelper(ctx, f); err != nil {
+		return nil, err
+	}
+
+	return instanceNesList, nil
+}
+
+func toList(instanceNesList []*vpcv1.InstanceCollection) List {
+	var instanceListToDisplay List
+	for _, instanceL := range instanceNesList {
+		for _, instance := range instanceL.Instances {
+			instanceToAppend := Instance{
+				ID:     utils.DereferencePointer(instance.ID).(string),
+				Name:   utils.DereferencePointer(instance.Name).(string),
+				Status: utils.DereferencePointer(instance.Status).(string),
+			}
+
+			if instance.Profile != nil {
+				instanceToAppend.Profile = utils.DereferencePointer(instance.Profile.Name).(string)
+			}
+
+			if instance.Zone != nil {
+				instanceToAppend.Zone = utils.DereferencePointer(instance.Zone.Name).(string)
+			}
+
+			if instance.PrimaryNetworkInterface != nil && instance.PrimaryNetworkInterface.PrimaryIP != nil {
+				instanceToAppend.PrimaryIP = utils.DereferencePointer(instance.PrimaryNetworkInterface.PrimaryIP.Address).(string)
+			}
+
+			if instance.VPC != nil {
+				instanceToAppend.VPCName = utils.DereferencePointer(instance.VPC.Name).(string)
+			}
+
+			instanceListToDisplay = append(instanceListToDisplay, instanceToAppend)
+		}
+	}
+	return instanceListToDisplay
+}
+
+func display(instanceNesList []*vpcv1.InstanceCollection) error {
+	instanceListToDisplay := toList(instanceNesList)
+
+	if options.GlobalOptions.Quiet {
+		return printer.NewQuiet(os.Stdout).Print(&instanceListToDisplay)
+	}
+
+	p, err := options.NewPrinter(os.Stdout)
+
+	if err != nil {
+		return err
+	}
+
+	switch options.GlobalOptions.Output {
+	case printer.PrinterTypeJSON, printer.PrinterTypeYAML, printer.PrinterTypeTemplate:
+		err = p.Print(instanceListToDisplay)
+	default:
+		table := instanceListToDisplay.ToTable()
+		err = p.Print(table)
+	}
+
+	return err
+}
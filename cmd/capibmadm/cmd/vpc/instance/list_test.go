@@ -0,0 +1,138 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package instance
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+
+	. "github.com/onsi/gomega"
+)
+
+// fakeInstanceLister serves instances one page at a time, filtering them by the requested VPC.
+type fakeInstanceLister struct {
+	pages    [][]vpcv1.Instance
+	requests []vpcv1.ListInstancesOptions
+	err      error
+}
+
+func (f *fakeInstanceLister) ListInstancesWithContext(_ context.Context, options *vpcv1.ListInstancesOptions) (*vpcv1.InstanceCollection, *core.DetailedResponse, error) {
+	f.requests = append(f.requests, *options)
+	if f.err != nil {
+		return nil, nil, f.err
+	}
+
+	page := len(f.requests) - 1
+	collection := &vpcv1.InstanceCollection{}
+	for _, instance := range f.pages[page] {
+		if options.VPCID == nil || *instance.VPC.ID == *options.VPCID {
+			collection.Instances = append(collection.Instances, instance)
+		}
+	}
+	if page+1 < len(f.pages) {
+		collection.Next = &vpcv1.InstanceCollectionNext{Href: core.StringPtr("https://us-south.iaas.cloud.ibm.com/v1/instances?start=next")}
+	}
+	return collection, &core.DetailedResponse{}, nil
+}
+
+func newInstance(name, vpcID string) vpcv1.Instance {
+	return vpcv1.Instance{
+		ID:      core.StringPtr(name + "-id"),
+		Name:    core.StringPtr(name),
+		Status:  core.StringPtr(vpcv1.InstanceStatusRunningConst),
+		Profile: &vpcv1.InstanceProfileReference{Name: core.StringPtr("bx2-2x8")},
+		Zone:    &vpcv1.ZoneReference{Name: core.StringPtr("us-south-1")},
+		PrimaryNetworkInterface: &vpcv1.NetworkInterfaceInstanceContextReference{
+			PrimaryIP: &vpcv1.ReservedIPReference{Address: core.StringPtr("10.240.0.4")},
+		},
+		VPC: &vpcv1.VPCReference{
+			ID:   core.StringPtr(vpcID),
+			Name: core.StringPtr(vpcID + "-name"),
+		},
+	}
+}
+
+func TestFetchInstances(t *testing.T) {
+	t.Run("Should list all instances across pages", func(t *testing.T) {
+		g := NewWithT(t)
+		lister := &fakeInstanceLister{pages: [][]vpcv1.Instance{
+			{newInstance("foo-instance", "foo-vpc-id")},
+			{newInstance("bar-instance", "bar-vpc-id")},
+		}}
+		instanceNesList, err := fetchInstances(context.TODO(), lister, "", "")
+		g.Expect(err).To(BeNil())
+		g.Expect(toList(instanceNesList)).To(HaveLen(2))
+		g.Expect(lister.requests).To(HaveLen(2))
+		g.Expect(lister.requests[0].VPCID).To(BeNil())
+		g.Expect(lister.requests[0].ResourceGroupID).To(BeNil())
+	})
+	t.Run("Should filter instances by VPC and resource group", func(t *testing.T) {
+		g := NewWithT(t)
+		lister := &fakeInstanceLister{pages: [][]vpcv1.Instance{
+			{newInstance("foo-instance", "foo-vpc-id"), newInstance("bar-instance", "bar-vpc-id")},
+			{newInstance("baz-instance", "foo-vpc-id")},
+		}}
+		instanceNesList, err := fetchInstances(context.TODO(), lister, "foo-resource-group-id", "foo-vpc-id")
+		g.Expect(err).To(BeNil())
+		for _, request := range lister.requests {
+			g.Expect(*request.VPCID).To(Equal("foo-vpc-id"))
+			g.Expect(*request.ResourceGroupID).To(Equal("foo-resource-group-id"))
+		}
+		instanceList := toList(instanceNesList)
+		g.Expect(instanceList).To(HaveLen(2))
+		for _, instance := range instanceList {
+			g.Expect(instance.VPCName).To(Equal("foo-vpc-id-name"))
+		}
+	})
+	t.Run("Error when listing instances", func(t *testing.T) {
+		g := NewWithT(t)
+		lister := &fakeInstanceLister{err: errors.New("failed to list instances")}
+		_, err := fetchInstances(context.TODO(), lister, "", "")
+		g.Expect(err).To(Not(BeNil()))
+	})
+}
+
+func TestToList(t *testing.T) {
+	g := NewWithT(t)
+	withoutNetworkInterface := newInstance("bar-instance", "foo-vpc-id")
+	withoutNetworkInterface.PrimaryNetworkInterface = nil
+
+	instanceList := toList([]*vpcv1.InstanceCollection{{Instances: []vpcv1.Instance{newInstance("foo-instance", "foo-vpc-id"), withoutNetworkInterface}}})
+	g.Expect(instanceList).To(Equal(List{
+		{
+			ID:        "foo-instance-id",
+			Name:      "foo-instance",
+			Profile:   "bx2-2x8",
+			Status:    vpcv1.InstanceStatusRunningConst,
+			Zone:      "us-south-1",
+			PrimaryIP: "10.240.0.4",
+			VPCName:   "foo-vpc-id-name",
+		},
+		{
+			ID:      "bar-instance-id",
+			Name:    "bar-instance",
+			Profile: "bx2-2x8",
+			Status:  vpcv1.InstanceStatusRunningConst,
+			Zone:    "us-south-1",
+			VPCName: "foo-vpc-id-name",
+		},
+	}))
+}
@@ -0,0 +1,92 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package instance
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// Instance vpc instance info.
+type Instance struct {
+	ID        string `json:"id"`
+	Name      string `json:"name"`
+	Profile   string `json:"profile"`
+	Status    string `json:"status"`
+	Zone      string `json:"zone"`
+	PrimaryIP string `json:"primaryIP"`
+	VPCName   string `json:"vpcName"`
+}
+
+// List is list of Instance.
+type List []Instance
+
+// IDs returns the IDs of the instances in the list.
+func (instanceList *List) IDs() []string {
+	ids := make([]string, 0, len(*instanceList))
+	for _, instance := range *instanceList {
+		ids = append(ids, instance.ID)
+	}
+	return ids
+}
+
+// ToTable converts List to *metav1.Table.
+func (instanceList *List) ToTable() *metav1.Table {
+	table := &metav1.Table{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: metav1.SchemeGroupVersion.String(),
+			Kind:       "Table",
+		},
+		ColumnDefinitions: []metav1.TableColumnDefinition{
+			{
+				Name: "ID",
+				Type: "string",
+			},
+			{
+				Name: "NAME",
+				Type: "string",
+			},
+			{
+				Name: "PROFILE",
+				Type: "string",
+			},
+			{
+				Name: "STATUS",
+				Type: "string",
+			},
+			{
+				Name: "ZONE",
+				Type: "string",
+			},
+			{
+				Name: "PRIMARY IP",
+				Type: "string",
+			},
+			{
+				Name: "VPC",
+				Type: "string",
+			},
+		},
+	}
+
+	for _, instance := range *instanceList {
+		row := metav1.TableRow{
+			Cells: []interface{}{instance.ID, instance.Name, instance.Profile, instance.Status, instance.Zone, instance.PrimaryIP, instance.VPCName},
+		}
+		table.Rows = append(table.Rows, row)
+	}
+	return table
+}
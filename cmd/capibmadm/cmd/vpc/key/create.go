@@ -38,9 +38,16 @@ import (
 type keyCreateOptions struct {
 	name              string
 	publicKey         string
+	keyType           string
 	resourceGroupName string
 }
 
+// sshKeyTypes maps the algorithm of an authorized public key to the VPC key type.
+var sshKeyTypes = map[string]string{
+	ssh.KeyAlgoRSA:     vpcv1.CreateKeyOptionsTypeRsaConst,
+	ssh.KeyAlgoED25519: vpcv1.CreateKeyOptionsTypeEd25519Const,
+}
+
 // CreateCommand vpc key create command.
 func CreateCommand() *cobra.Command {
 	cmd := &cobra.Command{
@@ -50,7 +57,8 @@ func CreateCommand() *cobra.Command {
 # Create key in VPC
 export IBMCLOUD_API_KEY=<api-key>
 capibmadm vpc key create --name <key-name> --region <region> --public-key "<public-key-string>"
-Using file-path to SSH key : capibmadm vpc key create --name <key-name> --region <region> --key-path <path/to/ssh/key>
+Using file-path to SSH key : capibmadm vpc key create --name <key-name> --region <region> --public-key-file <path/to/ssh/key.pub>
+Using an ed25519 SSH key : capibmadm vpc key create --name <key-name> --region <region> --public-key-file <path/to/ssh/key.pub> --type ed25519
 `,
 	}
 
@@ -58,50 +66,92 @@ Using file-path to SSH key : capibmadm vpc key create --name <key-name> --region
 	var keyCreateOption keyCreateOptions
 	var filePath string
 	cmd.Flags().StringVar(&keyCreateOption.name, "name", keyCreateOption.name, "Key Name")
+	cmd.Flags().StringVar(&filePath, "public-key-file", "", "The path to the SSH public key file.")
 	cmd.Flags().StringVar(&filePath, "key-path", "", "The absolute path to the SSH key file.")
+	_ = cmd.Flags().MarkDeprecated("key-path", "use --public-key-file instead")
 	cmd.Flags().StringVar(&keyCreateOption.publicKey, "public-key", keyCreateOption.publicKey, "Public Key")
+	cmd.Flags().StringVar(&keyCreateOption.keyType, "type", "", "The type of the SSH key, accepted values are [rsa, ed25519]. Detected from the public key when not set.")
 	_ = cmd.MarkFlagRequired("name")
 	// TODO: Flag validation is handled in PreRunE until the support for MarkFlagsMutuallyExclusiveAndRequired is available.
 	// Related issue: https://github.com/spf13/cobra/issues/1216
 	cmd.PreRunE = func(_ *cobra.Command, _ []string) error {
 		if (keyCreateOption.publicKey == "") == (filePath == "") {
-			return fmt.Errorf("the required flags either key-path of SSH key or the public-key within double quotation marks is not found")
+			return fmt.Errorf("the required flags either public-key-file of SSH key or the public-key within double quotation marks is not found")
+		}
+		if keyCreateOption.keyType != "" && keyCreateOption.keyType != vpcv1.CreateKeyOptionsTypeRsaConst && keyCreateOption.keyType != vpcv1.CreateKeyOptionsTypeEd25519Const {
+			return fmt.Errorf("invalid value %s for flag type, accepted values are [rsa, ed25519]", keyCreateOption.keyType)
 		}
 		return nil
 	}
 	cmd.RunE = func(cmd *cobra.Command, _ []string) error {
 		if filePath != "" {
-			sshKey, err := os.ReadFile(filePath) // #nosec
+			publicKey, err := readPublicKeyFile(filePath)
 			if err != nil {
-				return fmt.Errorf("error while reading the SSH key from path: %w", err)
+				return err
 			}
-			keyCreateOption.publicKey = string(sshKey)
-		}
-
-		if _, _, _, _, err := ssh.ParseAuthorizedKey([]byte(keyCreateOption.publicKey)); err != nil {
-			return fmt.Errorf("the provided SSH key is invalid: %w ", err)
+			keyCreateOption.publicKey = publicKey
 		}
 		return createKey(cmd.Context(), keyCreateOption)
 	}
 	return cmd
 }
 
+func readPublicKeyFile(filePath string) (string, error) {
+	sshKey, err := os.ReadFile(filePath) // #nosec
+	if err != nil {
+		return "", fmt.Errorf("error while reading the SSH key from path: %w", err)
+	}
+	return string(sshKey), nil
+}
+
+// newCreateKeyOptions validates the public key and builds the options to create the key with.
+func newCreateKeyOptions(keyCreateOption keyCreateOptions) (*vpcv1.CreateKeyOptions, error) {
+	keyType, err := validatePublicKey(keyCreateOption.publicKey, keyCreateOption.keyType)
+	if err != nil {
+		return nil, err
+	}
+
+	createKeyOptions := &vpcv1.CreateKeyOptions{}
+	createKeyOptions.SetName(keyCreateOption.name)
+	createKeyOptions.SetPublicKey(keyCreateOption.publicKey)
+	createKeyOptions.SetType(keyType)
+	return createKeyOptions, nil
+}
+
+// validatePublicKey parses the authorized public key and returns its VPC key type. If keyType is set it must match
+// the type of the parsed key.
+func validatePublicKey(publicKey, keyType string) (string, error) {
+	pubKey, _, _, _, err := ssh.ParseAuthorizedKey([]byte(publicKey))
+	if err != nil {
+		return "", fmt.Errorf("the provided SSH key is invalid: %w ", err)
+	}
+
+	parsedType, ok := sshKeyTypes[pubKey.Type()]
+	if !ok {
+		return "", fmt.Errorf("the provided SSH key type %s is not supported, accepted values are [rsa, ed25519]", pubKey.Type())
+	}
+	if keyType != "" && keyType != parsedType {
+		return "", fmt.Errorf("the provided SSH key is of type %s but --type is %s", parsedType, keyType)
+	}
+	return parsedType, nil
+}
+
 func createKey(ctx context.Context, keyCreateOption keyCreateOptions) error {
 	log := logf.Log
-	vpcClient, err := vpc.NewV1Client(options.GlobalOptions.VPCRegion)
+	createKeyOptions, err := newCreateKeyOptions(keyCreateOption)
 	if err != nil {
 		return err
 	}
 
-	accountID, err := pkgUtils.GetAccount(iam.GetIAMAuth())
+	vpcClient, err := vpc.NewV1Client(options.GlobalOptions.VPCRegion)
 	if err != nil {
 		return err
 	}
 
-	options := &vpcv1.CreateKeyOptions{}
-
-	options.SetName(keyCreateOption.name)
-	options.SetPublicKey(keyCreateOption.publicKey)
+	accountID, err := pkgUtils.GetAccount(ctx, iam.GetIAMAuth())
+	if err != nil {
+		return err
+	}
 
 	if keyCreateOption.resourceGroupName != "" {
 		resourceGroupID, err := utils.GetResourceGroupID(ctx, keyCreateOption.resourceGroupName, accountID)
@@ -111,13 +161,13 @@ func createKey(ctx context.Context, keyCreateOption keyCreateOptions) error {
 		resourceGroup := &vpcv1.ResourceGroupIdentity{
 			ID: &resourceGroupID,
 		}
-		options.SetResourceGroup(resourceGroup)
+		createKeyOptions.SetResourceGroup(resourceGroup)
 	}
 
-	key, _, err := vpcClient.CreateKey(options)
+	key, _, err := vpcClient.CreateKey(createKeyOptions)
 	if err != nil {
 		return err
 	}
-	log.Info("SSH Key created successfully,", "key-name", *key.Name)
+	log.Info("SSH Key created successfully,", "key-name", *key.Name, "key-id", *key.ID)
 	return nil
 }
@@ -0,0 +1,158 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package key
+
+import (
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"golang.org/x/crypto/ssh"
+
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+
+	. "github.com/onsi/gomega"
+)
+
+func writePublicKeyFile(t *testing.T, key crypto.PublicKey) string {
+	t.Helper()
+	sshPublicKey, err := ssh.NewPublicKey(key)
+	if err != nil {
+		t.Fatalf("failed to create SSH public key: %v", err)
+	}
+	return writeFile(t, ssh.MarshalAuthorizedKey(sshPublicKey))
+}
+
+func writeFile(t *testing.T, content []byte) string {
+	t.Helper()
+	filePath := filepath.Join(t.TempDir(), "id.pub")
+	if err := os.WriteFile(filePath, content, 0600); err != nil {
+		t.Fatalf("failed to write public key file: %v", err)
+	}
+	return filePath
+}
+
+func TestNewCreateKeyOptions(t *testing.T) {
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("failed to generate rsa key: %v", err)
+	}
+	ed25519PublicKey, _, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatalf("failed to generate ed25519 key: %v", err)
+	}
+
+	testCases := []struct {
+		name         string
+		filePath     string
+		keyType      string
+		expectedType string
+		expectErr    bool
+	}{
+		{
+			name:         "Should build options for a valid rsa key",
+			filePath:     writePublicKeyFile(t, &rsaKey.PublicKey),
+			expectedType: vpcv1.CreateKeyOptionsTypeRsaConst,
+		},
+		{
+			name:         "Should build options for a valid rsa key with matching type",
+			filePath:     writePublicKeyFile(t, &rsaKey.PublicKey),
+			keyType:      vpcv1.CreateKeyOptionsTypeRsaConst,
+			expectedType: vpcv1.CreateKeyOptionsTypeRsaConst,
+		},
+		{
+			name:         "Should build options for a valid ed25519 key",
+			filePath:     writePublicKeyFile(t, ed25519PublicKey),
+			keyType:      vpcv1.CreateKeyOptionsTypeEd25519Const,
+			expectedType: vpcv1.CreateKeyOptionsTypeEd25519Const,
+		},
+		{
+			name:      "Error when the file is not an authorized public key",
+			filePath:  writeFile(t, []byte("not-a-public-key\n")),
+			expectErr: true,
+		},
+		{
+			name:      "Error when the key does not match the type",
+			filePath:  writePublicKeyFile(t, ed25519PublicKey),
+			keyType:   vpcv1.CreateKeyOptionsTypeRsaConst,
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			publicKey, err := readPublicKeyFile(tc.filePath)
+			g.Expect(err).To(BeNil())
+
+			createKeyOptions, err := newCreateKeyOptions(keyCreateOptions{name: "foo-key", publicKey: publicKey, keyType: tc.keyType})
+			if tc.expectErr {
+				g.Expect(err).To(Not(BeNil()))
+				return
+			}
+			g.Expect(err).To(BeNil())
+			g.Expect(*createKeyOptions.Name).To(Equal("foo-key"))
+			g.Expect(*createKeyOptions.PublicKey).To(Equal(publicKey))
+			g.Expect(*createKeyOptions.Type).To(Equal(tc.expectedType))
+		})
+	}
+}
+
+func TestCreateCommandFlags(t *testing.T) {
+	testCases := []struct {
+		name      string
+		args      []string
+		expectErr bool
+	}{
+		{
+			name: "Should accept public key file",
+			args: []string{"--name", "foo-key", "--public-key-file", "id.pub"},
+		},
+		{
+			name: "Should accept public key file with type",
+			args: []string{"--name", "foo-key", "--public-key-file", "id.pub", "--type", "ed25519"},
+		},
+		{
+			name:      "Error when neither public key nor public key file is set",
+			args:      []string{"--name", "foo-key"},
+			expectErr: true,
+		},
+		{
+			name:      "Error when type is invalid",
+			args:      []string{"--name", "foo-key", "--public-key-file", "id.pub", "--type", "dsa"},
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			cmd := CreateCommand()
+			g.Expect(cmd.ParseFlags(tc.args)).To(Succeed())
+			err := cmd.PreRunE(cmd, nil)
+			if tc.expectErr {
+				g.Expect(err).To(Not(BeNil()))
+			} else {
+				g.Expect(err).To(BeNil())
+			}
+		})
+	}
+}
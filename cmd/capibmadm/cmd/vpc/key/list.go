@@ -18,11 +18,13 @@ package key
 
 import (
 	"context"
+	"fmt"
 	"os"
 
 	"github.com/go-openapi/strfmt"
 	"github.com/spf13/cobra"
 
+	"github.com/IBM/go-sdk-core/v5/core"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
 
 	"sigs.k8s.io/cluster-api-provider-ibmcloud/cmd/capibmadm/clients/vpc"
@@ -32,6 +34,9 @@ import (
 	pkgUtils "sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/utils"
 )
 
+// maxKeysPageLimit is the maximum number of keys the VPC API returns per page.
+const maxKeysPageLimit = 100
+
 // ListCommand vpc key list command.
 func ListCommand() *cobra.Command {
 	cmd := &cobra.Command{
@@ -44,6 +49,7 @@ capibmadm vpc key list --region <region> --resource-group-name <resource-group-n
 	}
 
 	options.AddCommonFlags(cmd)
+	options.AddLimitFlag(cmd)
 
 	cmd.RunE = func(cmd *cobra.Command, _ []string) error {
 		return listKeys(cmd.Context())
@@ -58,20 +64,37 @@ func listKeys(ctx context.Context) error {
 		return err
 	}
 
+	limit := options.GlobalOptions.Limit
+	if limit < 0 {
+		return fmt.Errorf("limit must not be negative, got %d", limit)
+	}
+
 	var keyNesList []*vpcv1.KeyCollection
+	var collected int64
 	f := func(start string) (bool, string, error) {
 		var listKeyOpt vpcv1.ListKeysOptions
 
 		if start != "" {
 			listKeyOpt.Start = &start
 		}
+		if limit > 0 {
+			listKeyOpt.Limit = core.Int64Ptr(min(limit-collected, maxKeysPageLimit))
+		}
 
 		keyL, _, err := v1.ListKeysWithContext(ctx, &listKeyOpt)
 		if err != nil {
 			return false, "", err
 		}
+		if limit > 0 && int64(len(keyL.Keys)) > limit-collected {
+			keyL.Keys = keyL.Keys[:limit-collected]
+		}
+		collected += int64(len(keyL.Keys))
 		keyNesList = append(keyNesList, keyL)
 
+		if limit > 0 && collected >= limit {
+			return true, "", nil
+		}
+
 		if keyL.Next != nil && *keyL.Next.Href != "" {
 			return false, *keyL.Next.Href, nil
 		}
@@ -79,7 +102,7 @@ func listKeys(ctx context.Context) error {
 		return true, "", nil
 	}
 
-	if err = pkgUtils.PagingHelper(f); err != nil {
+	if err = pkgUtils.PagingHelper(ctx, f); err != nil {
 		return err
 	}
 
@@ -107,14 +130,18 @@ func display(keyNesList []*vpcv1.KeyCollection) error {
 		}
 	}
 
-	printkeys, err := printer.New(options.GlobalOptions.Output, os.Stdout)
+	if options.GlobalOptions.Quiet {
+		return printer.NewQuiet(os.Stdout).Print(&keyListToDisplay)
+	}
+
+	printkeys, err := options.NewPrinter(os.Stdout)
 
 	if err != nil {
 		return err
 	}
 
 	switch options.GlobalOptions.Output {
-	case printer.PrinterTypeJSON:
+	case printer.PrinterTypeJSON, printer.PrinterTypeYAML, printer.PrinterTypeTemplate:
 		err = printkeys.Print(keyListToDisplay)
 	default:
 		table := keyListToDisplay.ToTable()
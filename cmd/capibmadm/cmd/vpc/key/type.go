@@ -37,6 +37,15 @@ type Key struct {
 // List is list of Key.
 type List []Key
 
+// IDs returns the IDs of the keys in the list.
+func (keyList *List) IDs() []string {
+	ids := make([]string, 0, len(*keyList))
+	for _, key := range *keyList {
+		ids = append(ids, key.ID)
+	}
+	return ids
+}
+
 // ToTable converts List to *metav1.Table.
 func (keyList *List) ToTable() *metav1.Table {
 	table := &metav1.Table{
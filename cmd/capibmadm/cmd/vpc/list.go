@@ -0,0 +1,172 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vpc
+
+import (
+	"context"
+	"os"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/spf13/cobra"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/cmd/capibmadm/clients/iam"
+	vpcClient "sigs.k8s.io/cluster-api-provider-ibmcloud/cmd/capibmadm/clients/vpc"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/cmd/capibmadm/options"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/cmd/capibmadm/printer"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/cmd/capibmadm/utils"
+	pkgUtils "sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/utils"
+)
+
+// vpcLister is the subset of the VPC client used to list VPCs.
+type vpcLister interface {
+	ListVpcsWithContext(ctx context.Context, listVpcsOptions *vpcv1.ListVpcsOptions) (*vpcv1.VPCCollection, *core.DetailedResponse, error)
+}
+
+// ListCommand vpc list command.
+func ListCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List VPCs",
+		Example: `
+ # List VPCs
+ export IBMCLOUD_API_KEY=<api-key>
+ capibmadm vpc list --region <region> --resource-group-name <resource-group-name>`,
+	}
+
+	options.AddCommonFlags(cmd)
+
+	cmd.RunE = func(cmd *cobra.Command, _ []string) error {
+		return listVPCs(cmd.Context(), options.GlobalOptions.ResourceGroupName)
+	}
+
+	return cmd
+}
+
+func listVPCs(ctx context.Context, resourceGroupName string) error {
+	v1, err := vpcClient.NewV1Client(options.GlobalOptions.VPCRegion)
+	if err != nil {
+		return err
+	}
+
+	var resourceGroupID string
+	if resourceGroupName != "" {
+		accountID, err := pkgUtils.GetAccount(ctx, iam.GetIAMAuth())
+		if err != nil {
+			return err
+		}
+		resourceGroupID, err = utils.GetResourceGroupID(ctx, resourceGroupName, accountID)
+		if err != nil {
+			return err
+		}
+	}
+
+	vpcNesList, err := fetchVPCs(ctx, v1, resourceGroupID)
+	if err != nil {
+		return err
+	}
+
+	return display(vpcNesList)
+}
+
+// fetchVPCs lists the VPCs page by page. When resourceGroupID is set the VPCs are filtered by the VPC API.
+func fetchVPCs(ctx context.Context, v1 vpcLister, resourceGroupID string) ([]*vpcv1.VPCCollection, error) {
+	var vpcNesList []*vpcv1.VPCCollection
+	f := func(start string) (bool, string, error) {
+		var listVPCOpt vpcv1.ListVpcsOptions
+
+		if resourceGroupID != "" {
+			listVPCOpt.ResourceGroupID = &resourceGroupID
+		}
+		if start != "" {
+			listVPCOpt.Start = &start
+		}
+
+		vpcL, _, err := v1.ListVpcsWithContext(ctx, &listVPCOpt)
+		if err != nil {
+			return false, "", err
+		}
+		vpcNesList = append(vpcNesList, vpcL)
+
+		if vpcL.Next != nil && vpcL.Next.Href != nil && *vpcL.Next.Href != "" {
+			return false, *vpcL.Next.Href, nil
+		}
+
+		return true, "", nil
+	}
+
+	if err := pkgUtils.PagingHelper(ctx, f); err != nil {
+		return nil, err
+	}
+
+	return vpcNesList, nil
+}
+
+func toList(vpcNesList []*vpcv1.VPCCollection) List {
+	var vpcListToDisplay List
+	for _, vpcL := range vpcNesList {
+		for _, vpc := range vpcL.Vpcs {
+			vpcToAppend := VPC{
+				ID:        utils.DereferencePointer(vpc.ID).(string),
+				Name:      utils.DereferencePointer(vpc.Name).(string),
+				Status:    utils.DereferencePointer(vpc.Status).(string),
+				CreatedAt: utils.DereferencePointer(vpc.CreatedAt).(strfmt.DateTime),
+			}
+
+			if vpc.ResourceGroup != nil {
+				vpcToAppend.ResourceGroupName = utils.DereferencePointer(vpc.ResourceGroup.Name).(string)
+			}
+
+			if vpc.DefaultSecurityGroup != nil {
+				vpcToAppend.DefaultSecurityGroup = utils.DereferencePointer(vpc.DefaultSecurityGroup.Name).(string)
+			}
+
+			if vpc.DefaultNetworkACL != nil {
+				vpcToAppend.DefaultNetworkACL = utils.DereferencePointer(vpc.DefaultNetworkACL.Name).(string)
+			}
+
+			vpcListToDisplay = append(vpcListToDisplay, vpcToAppend)
+		}
+	}
+	return vpcListToDisplay
+}
+
+func display(vpcNesList []*vpcv1.VPCCollection) error {
+	vpcListToDisplay := toList(vpcNesList)
+
+	if options.GlobalOptions.Quiet {
+		return printer.NewQuiet(os.Stdout).Print(&vpcListToDisplay)
+	}
+
+	p, err := options.NewPrinter(os.Stdout)
+
+	if err != nil {
+		return err
+	}
+
+	switch options.GlobalOptions.Output {
+	case printer.PrinterTypeJSON, printer.PrinterTypeYAML, printer.PrinterTypeTemplate:
+		err = p.Print(vpcListToDisplay)
+	default:
+		table := vpcListToDisplay.ToTable()
+		err = p.Print(table)
+	}
+
+	return err
+}
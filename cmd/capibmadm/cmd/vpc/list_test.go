@@ -0,0 +1,132 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vpc
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+
+	. "github.com/onsi/gomega"
+)
+
+// fakeVPCLister serves VPCs one page at a time, filtering them by the requested resource group.
+type fakeVPCLister struct {
+	pages    [][]vpcv1.VPC
+	requests []vpcv1.ListVpcsOptions
+	err      error
+}
+
+func (f *fakeVPCLister) ListVpcsWithContext(_ context.Context, options *vpcv1.ListVpcsOptions) (*vpcv1.VPCCollection, *core.DetailedResponse, error) {
+	f.requests = append(f.requests, *options)
+	if f.err != nil {
+		return nil, nil, f.err
+	}
+
+	page := len(f.requests) - 1
+	collection := &vpcv1.VPCCollection{}
+	for _, vpc := range f.pages[page] {
+		if options.ResourceGroupID == nil || *vpc.ResourceGroup.ID == *options.ResourceGroupID {
+			collection.Vpcs = append(collection.Vpcs, vpc)
+		}
+	}
+	if page+1 < len(f.pages) {
+		collection.Next = &vpcv1.VPCCollectionNext{Href: core.StringPtr("https://us-south.iaas.cloud.ibm.com/v1/vpcs?start=next")}
+	}
+	return collection, &core.DetailedResponse{}, nil
+}
+
+func newVPC(name, resourceGroupID string) vpcv1.VPC {
+	return vpcv1.VPC{
+		ID:     core.StringPtr(name + "-id"),
+		Name:   core.StringPtr(name),
+		Status: core.StringPtr(vpcv1.VPCStatusAvailableConst),
+		ResourceGroup: &vpcv1.ResourceGroupReference{
+			ID:   core.StringPtr(resourceGroupID),
+			Name: core.StringPtr(resourceGroupID + "-name"),
+		},
+	}
+}
+
+func TestFetchVPCs(t *testing.T) {
+	t.Run("Should list VPCs across pages", func(t *testing.T) {
+		g := NewWithT(t)
+		lister := &fakeVPCLister{pages: [][]vpcv1.VPC{
+			{newVPC("foo-vpc", "foo-resource-group-id")},
+			{newVPC("bar-vpc", "bar-resource-group-id")},
+		}}
+		vpcNesList, err := fetchVPCs(context.TODO(), lister, "")
+		g.Expect(err).To(BeNil())
+		g.Expect(toList(vpcNesList)).To(HaveLen(2))
+		g.Expect(lister.requests).To(HaveLen(2))
+		g.Expect(lister.requests[0].ResourceGroupID).To(BeNil())
+		g.Expect(*lister.requests[1].Start).To(Equal("next"))
+	})
+	t.Run("Should filter VPCs by resource group", func(t *testing.T) {
+		g := NewWithT(t)
+		lister := &fakeVPCLister{pages: [][]vpcv1.VPC{
+			{newVPC("foo-vpc", "foo-resource-group-id"), newVPC("bar-vpc", "bar-resource-group-id")},
+			{newVPC("baz-vpc", "foo-resource-group-id")},
+		}}
+		vpcNesList, err := fetchVPCs(context.TODO(), lister, "foo-resource-group-id")
+		g.Expect(err).To(BeNil())
+		for _, request := range lister.requests {
+			g.Expect(*request.ResourceGroupID).To(Equal("foo-resource-group-id"))
+		}
+		vpcList := toList(vpcNesList)
+		g.Expect(vpcList).To(HaveLen(2))
+		for _, vpc := range vpcList {
+			g.Expect(vpc.ResourceGroupName).To(Equal("foo-resource-group-id-name"))
+		}
+	})
+	t.Run("Error when listing VPCs", func(t *testing.T) {
+		g := NewWithT(t)
+		lister := &fakeVPCLister{err: errors.New("failed to list vpcs")}
+		_, err := fetchVPCs(context.TODO(), lister, "")
+		g.Expect(err).To(Not(BeNil()))
+	})
+}
+
+func TestToList(t *testing.T) {
+	g := NewWithT(t)
+	withDefaults := newVPC("foo-vpc", "foo-resource-group-id")
+	withDefaults.DefaultSecurityGroup = &vpcv1.SecurityGroupReference{Name: core.StringPtr("foo-security-group")}
+	withDefaults.DefaultNetworkACL = &vpcv1.NetworkACLReference{Name: core.StringPtr("foo-network-acl")}
+	withoutDefaults := vpcv1.VPC{
+		ID:   core.StringPtr("bar-vpc-id"),
+		Name: core.StringPtr("bar-vpc"),
+	}
+
+	vpcList := toList([]*vpcv1.VPCCollection{{Vpcs: []vpcv1.VPC{withDefaults, withoutDefaults}}})
+	g.Expect(vpcList).To(Equal(List{
+		{
+			ID:                   "foo-vpc-id",
+			Name:                 "foo-vpc",
+			Status:               vpcv1.VPCStatusAvailableConst,
+			ResourceGroupName:    "foo-resource-group-id-name",
+			DefaultSecurityGroup: "foo-security-group",
+			DefaultNetworkACL:    "foo-network-acl",
+		},
+		{
+			ID:   "bar-vpc-id",
+			Name: "bar-vpc",
+		},
+	}))
+}
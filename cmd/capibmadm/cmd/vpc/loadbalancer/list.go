@@ -0,0 +1,178 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package loadbalancer
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/cmd/capibmadm/clients/iam"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/cmd/capibmadm/clients/vpc"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/cmd/capibmadm/options"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/cmd/capibmadm/printer"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/cmd/capibmadm/utils"
+	pkgUtils "sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/utils"
+)
+
+// loadBalancerLister is the subset of the VPC client used to list load balancers and their pool members.
+type loadBalancerLister interface {
+	ListLoadBalancersWithContext(ctx context.Context, listLoadBalancersOptions *vpcv1.ListLoadBalancersOptions) (*vpcv1.LoadBalancerCollection, *core.DetailedResponse, error)
+	ListLoadBalancerPoolMembersWithContext(ctx context.Context, listLoadBalancerPoolMembersOptions *vpcv1.ListLoadBalancerPoolMembersOptions) (*vpcv1.LoadBalancerPoolMemberCollection, *core.DetailedResponse, error)
+}
+
+// ListCommand vpc load balancer list command.
+func ListCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List VPC load balancers",
+		Example: `
+ # List load balancers in VPC region
+ export IBMCLOUD_API_KEY=<api-key>
+ capibmadm vpc loadbalancer list --region <region> --resource-group-name <resource-group-name>`,
+	}
+
+	options.AddCommonFlags(cmd)
+
+	cmd.RunE = func(cmd *cobra.Command, _ []string) error {
+		return listLoadBalancers(cmd.Context(), options.GlobalOptions.ResourceGroupName)
+	}
+
+	return cmd
+}
+
+func listLoadBalancers(ctx context.Context, resourceGroupName string) error {
+	v1, err := vpc.NewV1Client(options.GlobalOptions.VPCRegion)
+	if err != nil {
+		return err
+	}
+
+	var resourceGroupID string
+	if resourceGroupName != "" {
+		accountID, err := pkgUtils.GetAccount(ctx, iam.GetIAMAuth())
+		if err != nil {
+			return err
+		}
+		resourceGroupID, err = utils.GetResourceGroupID(ctx, resourceGroupName, accountID)
+		if err != nil {
+			return err
+		}
+	}
+
+	loadBalancers, err := fetchLoadBalancers(ctx, v1, resourceGroupID)
+	if err != nil {
+		return err
+	}
+
+	loadBalancerListToDisplay, err := toList(ctx, v1, loadBalancers)
+	if err != nil {
+		return err
+	}
+
+	return display(loadBalancerListToDisplay)
+}
+
+// fetchLoadBalancers lists the load balancers page by page. The VPC API does not filter load balancers by
+// resource group, so they are filtered here when resourceGroupID is set.
+func fetchLoadBalancers(ctx context.Context, v1 loadBalancerLister, resourceGroupID string) ([]vpcv1.LoadBalancer, error) {
+	var loadBalancers []vpcv1.LoadBalancer
+	f := func(start string) (bool, string, error) {
+		var listLoadBalancersOpt vpcv1.ListLoadBalancersOptions
+		if start != "" {
+			listLoadBalancersOpt.Start = &start
+		}
+
+		loadBalancerL, _, err := v1.ListLoadBalancersWithContext(ctx, &listLoadBalancersOpt)
+		if err != nil {
+			return false, "", err
+		}
+		for _, loadBalancer := range loadBalancerL.LoadBalancers {
+			if resourceGroupID != "" && (loadBalancer.ResourceGroup == nil || utils.DereferencePointer(loadBalancer.ResourceGroup.ID).(string) != resourceGroupID) {
+				continue
+			}
+			loadBalancers = append(loadBalancers, loadBalancer)
+		}
+
+		if loadBalancerL.Next != nil && *loadBalancerL.Next.Href != "" {
+			return false, *loadBalancerL.Next.Href, nil
+		}
+
+		return true, "", nil
+	}
+
+	if err := pkgUtils.PagingHelper(ctx, f); err != nil {
+		return nil, err
+	}
+
+	return loadBalancers, nil
+}
+
+// toList maps the load balancers to the list to display, counting the members of each of their pools.
+func toList(ctx context.Context, v1 loadBalancerLister, loadBalancers []vpcv1.LoadBalancer) (List, error) {
+	var loadBalancerListToDisplay List
+	for _, loadBalancer := range loadBalancers {
+		loadBalancerToAppend := LoadBalancer{
+			ID:                 utils.DereferencePointer(loadBalancer.ID).(string),
+			Name:               utils.DereferencePointer(loadBalancer.Name).(string),
+			ProvisioningStatus: utils.DereferencePointer(loadBalancer.ProvisioningStatus).(string),
+			Hostname:           utils.DereferencePointer(loadBalancer.Hostname).(string),
+			Public:             utils.DereferencePointer(loadBalancer.IsPublic).(bool),
+			Pools:              len(loadBalancer.Pools),
+		}
+
+		for _, pool := range loadBalancer.Pools {
+			memberL, _, err := v1.ListLoadBalancerPoolMembersWithContext(ctx, &vpcv1.ListLoadBalancerPoolMembersOptions{
+				LoadBalancerID: loadBalancer.ID,
+				PoolID:         pool.ID,
+			})
+			if err != nil {
+				return nil, fmt.Errorf("error listing members of pool %s of load balancer %s: %w", utils.DereferencePointer(pool.Name).(string), loadBalancerToAppend.Name, err)
+			}
+			loadBalancerToAppend.Members += len(memberL.Members)
+		}
+
+		loadBalancerListToDisplay = append(loadBalancerListToDisplay, loadBalancerToAppend)
+	}
+	return loadBalancerListToDisplay, nil
+}
+
+func display(loadBalancerListToDisplay List) error {
+	if options.GlobalOptions.Quiet {
+		return printer.NewQuiet(os.Stdout).Print(&loadBalancerListToDisplay)
+	}
+
+	p, err := options.NewPrinter(os.Stdout)
+
+	if err != nil {
+		return err
+	}
+
+	switch options.GlobalOptions.Output {
+	case printer.PrinterTypeJSON, printer.PrinterTypeYAML, printer.PrinterTypeTemplate:
+		err = p.Print(loadBalancerListToDisplay)
+	default:
+		table := loadBalancerListToDisplay.ToTable()
+		err = p.Print(table)
+	}
+
+	return err
+}
@@ -0,0 +1,148 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package loadbalancer
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+
+	. "github.com/onsi/gomega"
+)
+
+// fakeLoadBalancerLister serves load balancers one page at a time along with the members of their pools.
+type fakeLoadBalancerLister struct {
+	pages     [][]vpcv1.LoadBalancer
+	members   map[string][]vpcv1.LoadBalancerPoolMember
+	requests  int
+	err       error
+	memberErr error
+}
+
+func (f *fakeLoadBalancerLister) ListLoadBalancersWithContext(_ context.Context, _ *vpcv1.ListLoadBalancersOptions) (*vpcv1.LoadBalancerCollection, *core.DetailedResponse, error) {
+	f.requests++
+	if f.err != nil {
+		return nil, nil, f.err
+	}
+
+	page := f.requests - 1
+	collection := &vpcv1.LoadBalancerCollection{LoadBalancers: f.pages[page]}
+	if page+1 < len(f.pages) {
+		collection.Next = &vpcv1.LoadBalancerCollectionNext{Href: core.StringPtr("https://us-south.iaas.cloud.ibm.com/v1/load_balancers?start=next")}
+	}
+	return collection, &core.DetailedResponse{}, nil
+}
+
+func (f *fakeLoadBalancerLister) ListLoadBalancerPoolMembersWithContext(_ context.Context, options *vpcv1.ListLoadBalancerPoolMembersOptions) (*vpcv1.LoadBalancerPoolMemberCollection, *core.DetailedResponse, error) {
+	if f.memberErr != nil {
+		return nil, nil, f.memberErr
+	}
+	return &vpcv1.LoadBalancerPoolMemberCollection{Members: f.members[*options.PoolID]}, &core.DetailedResponse{}, nil
+}
+
+func newLoadBalancer(name, resourceGroupID string, poolIDs ...string) vpcv1.LoadBalancer {
+	loadBalancer := vpcv1.LoadBalancer{
+		ID:                 core.StringPtr(name + "-id"),
+		Name:               core.StringPtr(name),
+		ProvisioningStatus: core.StringPtr(vpcv1.LoadBalancerProvisioningStatusActiveConst),
+		Hostname:           core.StringPtr(name + ".lb.appdomain.cloud"),
+		IsPublic:           core.BoolPtr(true),
+		ResourceGroup:      &vpcv1.ResourceGroupReference{ID: core.StringPtr(resourceGroupID)},
+	}
+	for _, poolID := range poolIDs {
+		loadBalancer.Pools = append(loadBalancer.Pools, vpcv1.LoadBalancerPoolReference{
+			ID:   core.StringPtr(poolID),
+			Name: core.StringPtr(poolID + "-name"),
+		})
+	}
+	return loadBalancer
+}
+
+func TestFetchLoadBalancers(t *testing.T) {
+	t.Run("Should list all load balancers across pages", func(t *testing.T) {
+		g := NewWithT(t)
+		lister := &fakeLoadBalancerLister{pages: [][]vpcv1.LoadBalancer{
+			{newLoadBalancer("foo-lb", "foo-resource-group-id")},
+			{newLoadBalancer("bar-lb", "bar-resource-group-id")},
+		}}
+		loadBalancers, err := fetchLoadBalancers(context.TODO(), lister, "")
+		g.Expect(err).To(BeNil())
+		g.Expect(loadBalancers).To(HaveLen(2))
+		g.Expect(lister.requests).To(Equal(2))
+	})
+	t.Run("Should filter load balancers by resource group", func(t *testing.T) {
+		g := NewWithT(t)
+		lister := &fakeLoadBalancerLister{pages: [][]vpcv1.LoadBalancer{
+			{newLoadBalancer("foo-lb", "foo-resource-group-id"), newLoadBalancer("bar-lb", "bar-resource-group-id")},
+		}}
+		loadBalancers, err := fetchLoadBalancers(context.TODO(), lister, "foo-resource-group-id")
+		g.Expect(err).To(BeNil())
+		g.Expect(loadBalancers).To(HaveLen(1))
+		g.Expect(*loadBalancers[0].Name).To(Equal("foo-lb"))
+	})
+	t.Run("Error when listing load balancers", func(t *testing.T) {
+		g := NewWithT(t)
+		lister := &fakeLoadBalancerLister{err: errors.New("failed to list load balancers")}
+		_, err := fetchLoadBalancers(context.TODO(), lister, "")
+		g.Expect(err).To(Not(BeNil()))
+	})
+}
+
+func TestToList(t *testing.T) {
+	t.Run("Should map load balancers with their pool and member counts", func(t *testing.T) {
+		g := NewWithT(t)
+		lister := &fakeLoadBalancerLister{members: map[string][]vpcv1.LoadBalancerPoolMember{
+			"foo-pool-id": {{ID: core.StringPtr("foo-member-id")}, {ID: core.StringPtr("bar-member-id")}},
+			"bar-pool-id": {{ID: core.StringPtr("baz-member-id")}},
+		}}
+		withoutPools := newLoadBalancer("bar-lb", "foo-resource-group-id")
+		withoutPools.IsPublic = core.BoolPtr(false)
+		withoutPools.ProvisioningStatus = core.StringPtr(vpcv1.LoadBalancerProvisioningStatusCreatePendingConst)
+
+		loadBalancerList, err := toList(context.TODO(), lister, []vpcv1.LoadBalancer{
+			newLoadBalancer("foo-lb", "foo-resource-group-id", "foo-pool-id", "bar-pool-id"),
+			withoutPools,
+		})
+		g.Expect(err).To(BeNil())
+		g.Expect(loadBalancerList).To(Equal(List{
+			{
+				ID:                 "foo-lb-id",
+				Name:               "foo-lb",
+				ProvisioningStatus: vpcv1.LoadBalancerProvisioningStatusActiveConst,
+				Hostname:           "foo-lb.lb.appdomain.cloud",
+				Public:             true,
+				Pools:              2,
+				Members:            3,
+			},
+			{
+				ID:                 "bar-lb-id",
+				Name:               "bar-lb",
+				ProvisioningStatus: vpcv1.LoadBalancerProvisioningStatusCreatePendingConst,
+				Hostname:           "bar-lb.lb.appdomain.cloud",
+			},
+		}))
+	})
+	t.Run("Error when listing pool members", func(t *testing.T) {
+		g := NewWithT(t)
+		lister := &fakeLoadBalancerLister{memberErr: errors.New("failed to list pool members")}
+		_, err := toList(context.TODO(), lister, []vpcv1.LoadBalancer{newLoadBalancer("foo-lb", "foo-resource-group-id", "foo-pool-id")})
+		g.Expect(err).To(MatchError(ContainSubstring("foo-pool-id-name")))
+	})
+}
@@ -0,0 +1,47 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package loadbalancer contains the commands to operate on vpc load balancer resources.
+package loadbalancer
+
+import (
+	"github.com/spf13/cobra"
+)
+
+// Commands function to add VPC load balancer commands.
+func Commands() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "loadbalancer",
+		Short: "Perform VPC load balancer operations",
+	}
+
+	cmd.AddCommand(ListCommand())
+	cmd.AddCommand(MemberCommands())
+
+	return cmd
+}
+
+// MemberCommands function to add VPC load balancer pool member commands.
+func MemberCommands() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "member",
+		Short: "Perform VPC load balancer pool member operations",
+	}
+
+	cmd.AddCommand(MemberListCommand())
+
+	return cmd
+}
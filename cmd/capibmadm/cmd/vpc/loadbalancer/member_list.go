@@ -0,0 +1,215 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package loadbalancer
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/cmd/capibmadm/clients/vpc"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/cmd/capibmadm/options"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/cmd/capibmadm/printer"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/cmd/capibmadm/utils"
+)
+
+// memberLister is the subset of the VPC client used to look up a load balancer pool and list its members.
+type memberLister interface {
+	loadBalancerLister
+	GetLoadBalancerWithContext(ctx context.Context, getLoadBalancerOptions *vpcv1.GetLoadBalancerOptions) (*vpcv1.LoadBalancer, *core.DetailedResponse, error)
+}
+
+type memberListOptions struct {
+	loadBalancerID   string
+	loadBalancerName string
+	poolID           string
+	poolName         string
+}
+
+// MemberListCommand vpc load balancer pool member list command.
+func MemberListCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List VPC load balancer pool members",
+		Example: `
+ # List members of a load balancer pool by ID
+ export IBMCLOUD_API_KEY=<api-key>
+ capibmadm vpc loadbalancer member list --load-balancer-id <load-balancer-id> --pool-id <pool-id> --region <region>
+
+ # List members of a load balancer pool by name
+ export IBMCLOUD_API_KEY=<api-key>
+ capibmadm vpc loadbalancer member list --load-balancer-name <load-balancer-name> --pool-name <pool-name> --region <region>`,
+	}
+
+	options.AddCommonFlags(cmd)
+	var memberListOption memberListOptions
+	cmd.Flags().StringVar(&memberListOption.loadBalancerID, "load-balancer-id", "", "The ID of the load balancer.")
+	cmd.Flags().StringVar(&memberListOption.loadBalancerName, "load-balancer-name", "", "The name of the load balancer.")
+	cmd.Flags().StringVar(&memberListOption.poolID, "pool-id", "", "The ID of the load balancer pool.")
+	cmd.Flags().StringVar(&memberListOption.poolName, "pool-name", "", "The name of the load balancer pool.")
+	// TODO: Flag validation is handled in PreRunE until the support for MarkFlagsMutuallyExclusiveAndRequired is available.
+	// Related issue: https://github.com/spf13/cobra/issues/1216
+	cmd.PreRunE = func(_ *cobra.Command, _ []string) error {
+		if (memberListOption.loadBalancerID == "") == (memberListOption.loadBalancerName == "") {
+			return fmt.Errorf("exactly one of the flags load-balancer-id or load-balancer-name is required")
+		}
+		if (memberListOption.poolID == "") == (memberListOption.poolName == "") {
+			return fmt.Errorf("exactly one of the flags pool-id or pool-name is required")
+		}
+		return nil
+	}
+	cmd.RunE = func(cmd *cobra.Command, _ []string) error {
+		v1, err := vpc.NewV1Client(options.GlobalOptions.VPCRegion)
+		if err != nil {
+			return err
+		}
+		memberListToDisplay, err := listMembers(cmd.Context(), v1, memberListOption)
+		if err != nil {
+			return err
+		}
+		return displayMembers(memberListToDisplay)
+	}
+
+	return cmd
+}
+
+func listMembers(ctx context.Context, v1 memberLister, memberListOption memberListOptions) (MemberList, error) {
+	loadBalancer, err := getLoadBalancer(ctx, v1, memberListOption)
+	if err != nil {
+		return nil, err
+	}
+
+	pool, err := getPool(loadBalancer, memberListOption)
+	if err != nil {
+		return nil, err
+	}
+
+	memberL, _, err := v1.ListLoadBalancerPoolMembersWithContext(ctx, &vpcv1.ListLoadBalancerPoolMembersOptions{
+		LoadBalancerID: loadBalancer.ID,
+		PoolID:         pool.ID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error listing members of pool %s: %w", utils.DereferencePointer(pool.Name).(string), err)
+	}
+
+	return toMemberList(memberL.Members), nil
+}
+
+// getLoadBalancer fetches the load balancer by ID or, when no ID is given, resolves it by name.
+func getLoadBalancer(ctx context.Context, v1 memberLister, memberListOption memberListOptions) (*vpcv1.LoadBalancer, error) {
+	if memberListOption.loadBalancerID != "" {
+		loadBalancer, _, err := v1.GetLoadBalancerWithContext(ctx, &vpcv1.GetLoadBalancerOptions{ID: &memberListOption.loadBalancerID})
+		if err != nil {
+			return nil, fmt.Errorf("error fetching load balancer %s: %w", memberListOption.loadBalancerID, err)
+		}
+		return loadBalancer, nil
+	}
+
+	loadBalancers, err := fetchLoadBalancers(ctx, v1, "")
+	if err != nil {
+		return nil, fmt.Errorf("error listing load balancers: %w", err)
+	}
+	for i := range loadBalancers {
+		if utils.DereferencePointer(loadBalancers[i].Name).(string) == memberListOption.loadBalancerName {
+			return &loadBalancers[i], nil
+		}
+	}
+	return nil, fmt.Errorf("load balancer with name %s not found", memberListOption.loadBalancerName)
+}
+
+// getPool returns the pool of the load balancer matching the pool ID or, when no ID is given, the pool name.
+func getPool(loadBalancer *vpcv1.LoadBalancer, memberListOption memberListOptions) (*vpcv1.LoadBalancerPoolReference, error) {
+	for i, pool := range loadBalancer.Pools {
+		if memberListOption.poolID != "" && utils.DereferencePointer(pool.ID).(string) == memberListOption.poolID {
+			return &loadBalancer.Pools[i], nil
+		}
+		if memberListOption.poolName != "" && utils.DereferencePointer(pool.Name).(string) == memberListOption.poolName {
+			return &loadBalancer.Pools[i], nil
+		}
+	}
+
+	pool := memberListOption.poolID
+	if pool == "" {
+		pool = memberListOption.poolName
+	}
+	return nil, fmt.Errorf("pool %s not found in load balancer %s", pool, utils.DereferencePointer(loadBalancer.Name).(string))
+}
+
+func toMemberList(members []vpcv1.LoadBalancerPoolMember) MemberList {
+	var memberListToDisplay MemberList
+	for _, member := range members {
+		memberToAppend := Member{
+			ID:     utils.DereferencePointer(member.ID).(string),
+			Target: memberTarget(member.Target),
+			Health: utils.DereferencePointer(member.Health).(string),
+		}
+
+		if member.Port != nil {
+			memberToAppend.Port = *member.Port
+		}
+
+		if member.Weight != nil {
+			memberToAppend.Weight = *member.Weight
+		}
+
+		memberListToDisplay = append(memberListToDisplay, memberToAppend)
+	}
+	return memberListToDisplay
+}
+
+// memberTarget returns the IP address of the member target or, for an instance target without one, its name.
+func memberTarget(target vpcv1.LoadBalancerPoolMemberTargetIntf) string {
+	switch t := target.(type) {
+	case *vpcv1.LoadBalancerPoolMemberTarget:
+		if t.Address != nil {
+			return *t.Address
+		}
+		return utils.DereferencePointer(t.Name).(string)
+	case *vpcv1.LoadBalancerPoolMemberTargetIP:
+		return utils.DereferencePointer(t.Address).(string)
+	case *vpcv1.LoadBalancerPoolMemberTargetInstanceReference:
+		return utils.DereferencePointer(t.Name).(string)
+	}
+	return ""
+}
+
+func displayMembers(memberListToDisplay MemberList) error {
+	if options.GlobalOptions.Quiet {
+		return printer.NewQuiet(os.Stdout).Print(&memberListToDisplay)
+	}
+
+	p, err := options.NewPrinter(os.Stdout)
+
+	if err != nil {
+		return err
+	}
+
+	switch options.GlobalOptions.Output {
+	case printer.PrinterTypeJSON, printer.PrinterTypeYAML, printer.PrinterTypeTemplate:
+		err = p.Print(memberListToDisplay)
+	default:
+		table := memberListToDisplay.ToTable()
+		err = p.Print(table)
+	}
+
+	return err
+}
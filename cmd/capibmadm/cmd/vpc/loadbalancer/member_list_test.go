@@ -0,0 +1,117 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package loadbalancer
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+
+	. "github.com/onsi/gomega"
+)
+
+// fakeMemberLister serves a single page of load balancers which can also be fetched by ID.
+type fakeMemberLister struct {
+	fakeLoadBalancerLister
+}
+
+func (f *fakeMemberLister) GetLoadBalancerWithContext(_ context.Context, options *vpcv1.GetLoadBalancerOptions) (*vpcv1.LoadBalancer, *core.DetailedResponse, error) {
+	for i := range f.pages[0] {
+		if *f.pages[0][i].ID == *options.ID {
+			return &f.pages[0][i], &core.DetailedResponse{}, nil
+		}
+	}
+	return nil, &core.DetailedResponse{StatusCode: 404}, errors.New("load balancer not found")
+}
+
+func newMemberLister() *fakeMemberLister {
+	return &fakeMemberLister{fakeLoadBalancerLister{
+		pages: [][]vpcv1.LoadBalancer{{newLoadBalancer("foo-lb", "foo-resource-group-id", "foo-pool-id", "bar-pool-id")}},
+		members: map[string][]vpcv1.LoadBalancerPoolMember{
+			"foo-pool-id": {
+				{
+					ID:     core.StringPtr("foo-member-id"),
+					Target: &vpcv1.LoadBalancerPoolMemberTarget{Address: core.StringPtr("10.240.0.4")},
+					Port:   core.Int64Ptr(6443),
+					Weight: core.Int64Ptr(50),
+					Health: core.StringPtr(vpcv1.LoadBalancerPoolMemberHealthOkConst),
+				},
+				{
+					ID:     core.StringPtr("bar-member-id"),
+					Target: &vpcv1.LoadBalancerPoolMemberTarget{Name: core.StringPtr("bar-instance"), ID: core.StringPtr("bar-instance-id")},
+					Port:   core.Int64Ptr(6443),
+					Health: core.StringPtr(vpcv1.LoadBalancerPoolMemberHealthFaultedConst),
+				},
+			},
+		},
+	}}
+}
+
+func TestListMembers(t *testing.T) {
+	expectedMembers := MemberList{
+		{
+			ID:     "foo-member-id",
+			Target: "10.240.0.4",
+			Port:   6443,
+			Weight: 50,
+			Health: vpcv1.LoadBalancerPoolMemberHealthOkConst,
+		},
+		{
+			ID:     "bar-member-id",
+			Target: "bar-instance",
+			Port:   6443,
+			Health: vpcv1.LoadBalancerPoolMemberHealthFaultedConst,
+		},
+	}
+
+	t.Run("Should list pool members by load balancer and pool ID", func(t *testing.T) {
+		g := NewWithT(t)
+		memberList, err := listMembers(context.TODO(), newMemberLister(), memberListOptions{loadBalancerID: "foo-lb-id", poolID: "foo-pool-id"})
+		g.Expect(err).To(BeNil())
+		g.Expect(memberList).To(Equal(expectedMembers))
+	})
+	t.Run("Should list pool members by load balancer and pool name", func(t *testing.T) {
+		g := NewWithT(t)
+		memberList, err := listMembers(context.TODO(), newMemberLister(), memberListOptions{loadBalancerName: "foo-lb", poolName: "foo-pool-id-name"})
+		g.Expect(err).To(BeNil())
+		g.Expect(memberList).To(Equal(expectedMembers))
+	})
+	t.Run("Should list no members of an empty pool", func(t *testing.T) {
+		g := NewWithT(t)
+		memberList, err := listMembers(context.TODO(), newMemberLister(), memberListOptions{loadBalancerID: "foo-lb-id", poolID: "bar-pool-id"})
+		g.Expect(err).To(BeNil())
+		g.Expect(memberList).To(BeEmpty())
+	})
+	t.Run("Error when pool is not found", func(t *testing.T) {
+		g := NewWithT(t)
+		_, err := listMembers(context.TODO(), newMemberLister(), memberListOptions{loadBalancerID: "foo-lb-id", poolName: "baz-pool"})
+		g.Expect(err).To(MatchError("pool baz-pool not found in load balancer foo-lb"))
+	})
+	t.Run("Error when load balancer is not found by name", func(t *testing.T) {
+		g := NewWithT(t)
+		_, err := listMembers(context.TODO(), newMemberLister(), memberListOptions{loadBalancerName: "bar-lb", poolID: "foo-pool-id"})
+		g.Expect(err).To(MatchError("load balancer with name bar-lb not found"))
+	})
+	t.Run("Error when load balancer is not found by ID", func(t *testing.T) {
+		g := NewWithT(t)
+		_, err := listMembers(context.TODO(), newMemberLister(), memberListOptions{loadBalancerID: "bar-lb-id", poolID: "foo-pool-id"})
+		g.Expect(err).To(MatchError(ContainSubstring("error fetching load balancer bar-lb-id")))
+	})
+}
@@ -0,0 +1,153 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package loadbalancer
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// LoadBalancer vpc load balancer info.
+type LoadBalancer struct {
+	ID                 string `json:"id"`
+	Name               string `json:"name"`
+	ProvisioningStatus string `json:"provisioningStatus"`
+	Hostname           string `json:"hostname"`
+	Public             bool   `json:"public"`
+	Pools              int    `json:"pools"`
+	Members            int    `json:"members"`
+}
+
+// List is list of LoadBalancer.
+type List []LoadBalancer
+
+// IDs returns the IDs of the load balancers in the list.
+func (loadBalancerList *List) IDs() []string {
+	ids := make([]string, 0, len(*loadBalancerList))
+	for _, loadBalancer := range *loadBalancerList {
+		ids = append(ids, loadBalancer.ID)
+	}
+	return ids
+}
+
+// ToTable converts List to *metav1.Table.
+func (loadBalancerList *List) ToTable() *metav1.Table {
+	table := &metav1.Table{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: metav1.SchemeGroupVersion.String(),
+			Kind:       "Table",
+		},
+		ColumnDefinitions: []metav1.TableColumnDefinition{
+			{
+				Name: "ID",
+				Type: "string",
+			},
+			{
+				Name: "NAME",
+				Type: "string",
+			},
+			{
+				Name: "PROVISIONING STATUS",
+				Type: "string",
+			},
+			{
+				Name: "HOSTNAME",
+				Type: "string",
+			},
+			{
+				Name: "PUBLIC",
+				Type: "bool",
+			},
+			{
+				Name: "POOLS",
+				Type: "number",
+			},
+			{
+				Name: "MEMBERS",
+				Type: "number",
+			},
+		},
+	}
+
+	for _, loadBalancer := range *loadBalancerList {
+		row := metav1.TableRow{
+			Cells: []interface{}{loadBalancer.ID, loadBalancer.Name, loadBalancer.ProvisioningStatus, loadBalancer.Hostname, loadBalancer.Public, loadBalancer.Pools, loadBalancer.Members},
+		}
+		table.Rows = append(table.Rows, row)
+	}
+	return table
+}
+
+// Member vpc load balancer pool member info.
+type Member struct {
+	ID     string `json:"id"`
+	Target string `json:"target"`
+	Port   int64  `json:"port"`
+	Weight int64  `json:"weight"`
+	Health string `json:"health"`
+}
+
+// MemberList is list of Member.
+type MemberList []Member
+
+// IDs returns the IDs of the pool members in the list.
+func (memberList *MemberList) IDs() []string {
+	ids := make([]string, 0, len(*memberList))
+	for _, member := range *memberList {
+		ids = append(ids, member.ID)
+	}
+	return ids
+}
+
+// ToTable converts MemberList to *metav1.Table.
+func (memberList *MemberList) ToTable() *metav1.Table {
+	table := &metav1.Table{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: metav1.SchemeGroupVersion.String(),
+			Kind:       "Table",
+		},
+		ColumnDefinitions: []metav1.TableColumnDefinition{
+			{
+				Name: "ID",
+				Type: "string",
+			},
+			{
+				Name: "TARGET",
+				Type: "string",
+			},
+			{
+				Name: "PORT",
+				Type: "number",
+			},
+			{
+				Name: "WEIGHT",
+				Type: "number",
+			},
+			{
+				Name: "HEALTH",
+				Type: "string",
+			},
+		},
+	}
+
+	for _, member := range *memberList {
+		row := metav1.TableRow{
+			Cells: []interface{}{member.ID, member.Target, member.Port, member.Weight, member.Health},
+		}
+		table.Rows = append(table.Rows, row)
+	}
+	return table
+}
@@ -0,0 +1,172 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package securitygroup
+
+import (
+	"context"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/cmd/capibmadm/clients/iam"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/cmd/capibmadm/clients/vpc"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/cmd/capibmadm/options"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/cmd/capibmadm/printer"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/cmd/capibmadm/utils"
+	pkgUtils "sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/utils"
+)
+
+// securityGroupLister is the subset of the VPC client used to list security groups.
+type securityGroupLister interface {
+	ListSecurityGroupsWithContext(ctx context.Context, listSecurityGroupsOptions *vpcv1.ListSecurityGroupsOptions) (*vpcv1.SecurityGroupCollection, *core.DetailedResponse, error)
+}
+
+// ListCommand vpc security group list command.
+func ListCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List VPC security groups",
+		Example: `
+ # List security groups in VPC region
+ export IBMCLOUD_API_KEY=<api-key>
+ capibmadm vpc security-group list --region <region> --resource-group-name <resource-group-name>
+
+ # List security groups in a VPC
+ export IBMCLOUD_API_KEY=<api-key>
+ capibmadm vpc security-group list --region <region> --vpc-id <vpc-id>`,
+	}
+
+	options.AddCommonFlags(cmd)
+	var vpcID string
+	cmd.Flags().StringVar(&vpcID, "vpc-id", "", "Filter security groups by VPC ID.")
+
+	cmd.RunE = func(cmd *cobra.Command, _ []string) error {
+		return listSecurityGroups(cmd.Context(), options.GlobalOptions.ResourceGroupName, vpcID)
+	}
+
+	return cmd
+}
+
+func listSecurityGroups(ctx context.Context, resourceGroupName, vpcID string) error {
+	v1, err := vpc.NewV1Client(options.GlobalOptions.VPCRegion)
+	if err != nil {
+		return err
+	}
+
+	var resourceGroupID string
+	if resourceGroupName != "" {
+		accountID, err := pkgUtils.GetAccount(ctx, iam.GetIAMAuth())
+		if err != nil {
+			return err
+		}
+		resourceGroupID, err = utils.GetResourceGroupID(ctx, resourceGroupName, accountID)
+		if err != nil {
+			return err
+		}
+	}
+
+	securityGroupNesList, err := fetchSecurityGroups(ctx, v1, resourceGroupID, vpcID)
+	if err != nil {
+		return err
+	}
+
+	return display(securityGroupNesList)
+}
+
+// fetchSecurityGroups lists the security groups page by page. When resourceGroupID or vpcID is set the security groups
+// are filtered by the VPC API.
+func fetchSecurityGroups(ctx context.Context, v1 securityGroupLister, resourceGroupID, vpcID string) ([]*vpcv1.SecurityGroupCollection, error) {
+	var securityGroupNesList []*vpcv1.SecurityGroupCollection
+	f := func(start string) (bool, string, error) {
+		var listSecurityGroupsOpt vpcv1.ListSecurityGroupsOptions
+
+		if resourceGroupID != "" {
+			listSecurityGroupsOpt.ResourceGroupID = &resourceGroupID
+		}
+		if vpcID != "" {
+			listSecurityGroupsOpt.VPCID = &vpcID
+		}
+		if start != "" {
+			listSecurityGroupsOpt.Start = &start
+		}
+
+		securityGroupL, _, err := v1.ListSecurityGroupsWithContext(ctx, &listSecurityGroupsOpt)
+		if err != nil {
+			return false, "", err
+		}
+		securityGroupNesList = append(securityGroupNesList, securityGroupL)
+
+		if securityGroupL.Next != nil && *securityGroupL.Next.Href != "" {
+			return false, *securityGroupL.Next.Href, nil
+		}
+
+		return true, "", nil
+	}
+
+	if err := pkgUtils.PagingHelper(ctx, f); err != nil {
+		return nil, err
+	}
+
+	return securityGroupNesList, nil
+}
+
+func toList(securityGroupNesList []*vpcv1.SecurityGroupCollection) List {
+	var securityGroupListToDisplay List
+	for _, securityGroupL := range securityGroupNesList {
+		for _, securityGroup := range securityGroupL.SecurityGroups {
+			securityGroupToAppend := SecurityGroup{
+				ID:    utils.DereferencePointer(securityGroup.ID).(string),
+				Name:  utils.DereferencePointer(securityGroup.Name).(string),
+				Rules: len(securityGroup.Rules),
+			}
+
+			if securityGroup.VPC != nil {
+				securityGroupToAppend.VPCName = utils.DereferencePointer(securityGroup.VPC.Name).(string)
+			}
+
+			securityGroupListToDisplay = append(securityGroupListToDisplay, securityGroupToAppend)
+		}
+	}
+	return securityGroupListToDisplay
+}
+
+func display(securityGroupNesList []*vpcv1.SecurityGroupCollection) error {
+	securityGroupListToDisplay := toList(securityGroupNesList)
+
+	if options.GlobalOptions.Quiet {
+		return printer.NewQuiet(os.Stdout).Print(&securityGroupListToDisplay)
+	}
+
+	p, err := options.NewPrinter(os.Stdout)
+
+	if err != nil {
+		return err
+	}
+
+	switch options.GlobalOptions.Output {
+	case printer.PrinterTypeJSON, printer.PrinterTypeYAML, printer.PrinterTypeTemplate:
+		err = p.Print(securityGroupListToDisplay)
+	default:
+		table := securityGroupListToDisplay.ToTable()
+		err = p.Print(table)
+	}
+
+	return err
+}
@@ -0,0 +1,132 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package securitygroup
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+
+	. "github.com/onsi/gomega"
+)
+
+// fakeSecurityGroupLister serves security groups one page at a time, filtering them by the requested VPC.
+type fakeSecurityGroupLister struct {
+	pages    [][]vpcv1.SecurityGroup
+	requests []vpcv1.ListSecurityGroupsOptions
+	err      error
+}
+
+func (f *fakeSecurityGroupLister) ListSecurityGroupsWithContext(_ context.Context, options *vpcv1.ListSecurityGroupsOptions) (*vpcv1.SecurityGroupCollection, *core.DetailedResponse, error) {
+	f.requests = append(f.requests, *options)
+	if f.err != nil {
+		return nil, nil, f.err
+	}
+
+	page := len(f.requests) - 1
+	collection := &vpcv1.SecurityGroupCollection{}
+	for _, securityGroup := range f.pages[page] {
+		if options.VPCID == nil || *securityGroup.VPC.ID == *options.VPCID {
+			collection.SecurityGroups = append(collection.SecurityGroups, securityGroup)
+		}
+	}
+	if page+1 < len(f.pages) {
+		collection.Next = &vpcv1.SecurityGroupCollectionNext{Href: core.StringPtr("https://us-south.iaas.cloud.ibm.com/v1/security_groups?start=next")}
+	}
+	return collection, &core.DetailedResponse{}, nil
+}
+
+func newSecurityGroup(name, vpcID string, rules int) vpcv1.SecurityGroup {
+	securityGroup := vpcv1.SecurityGroup{
+		ID:   core.StringPtr(name + "-id"),
+		Name: core.StringPtr(name),
+		VPC: &vpcv1.VPCReference{
+			ID:   core.StringPtr(vpcID),
+			Name: core.StringPtr(vpcID + "-name"),
+		},
+	}
+	for i := 0; i < rules; i++ {
+		securityGroup.Rules = append(securityGroup.Rules, &vpcv1.SecurityGroupRule{
+			Direction: core.StringPtr(vpcv1.SecurityGroupRuleDirectionInboundConst),
+			Protocol:  core.StringPtr(vpcv1.SecurityGroupRuleProtocolAllConst),
+		})
+	}
+	return securityGroup
+}
+
+func TestFetchSecurityGroups(t *testing.T) {
+	t.Run("Should list all security groups across pages", func(t *testing.T) {
+		g := NewWithT(t)
+		lister := &fakeSecurityGroupLister{pages: [][]vpcv1.SecurityGroup{
+			{newSecurityGroup("foo-sg", "foo-vpc-id", 1)},
+			{newSecurityGroup("bar-sg", "bar-vpc-id", 2)},
+		}}
+		securityGroupNesList, err := fetchSecurityGroups(context.TODO(), lister, "", "")
+		g.Expect(err).To(BeNil())
+		g.Expect(toList(securityGroupNesList)).To(HaveLen(2))
+		g.Expect(lister.requests).To(HaveLen(2))
+		g.Expect(lister.requests[0].VPCID).To(BeNil())
+		g.Expect(lister.requests[0].ResourceGroupID).To(BeNil())
+	})
+	t.Run("Should filter security groups by VPC and resource group", func(t *testing.T) {
+		g := NewWithT(t)
+		lister := &fakeSecurityGroupLister{pages: [][]vpcv1.SecurityGroup{
+			{newSecurityGroup("foo-sg", "foo-vpc-id", 1), newSecurityGroup("bar-sg", "bar-vpc-id", 1)},
+			{newSecurityGroup("baz-sg", "foo-vpc-id", 1)},
+		}}
+		securityGroupNesList, err := fetchSecurityGroups(context.TODO(), lister, "foo-resource-group-id", "foo-vpc-id")
+		g.Expect(err).To(BeNil())
+		for _, request := range lister.requests {
+			g.Expect(*request.VPCID).To(Equal("foo-vpc-id"))
+			g.Expect(*request.ResourceGroupID).To(Equal("foo-resource-group-id"))
+		}
+		securityGroupList := toList(securityGroupNesList)
+		g.Expect(securityGroupList).To(HaveLen(2))
+		for _, securityGroup := range securityGroupList {
+			g.Expect(securityGroup.VPCName).To(Equal("foo-vpc-id-name"))
+		}
+	})
+	t.Run("Error when listing security groups", func(t *testing.T) {
+		g := NewWithT(t)
+		lister := &fakeSecurityGroupLister{err: errors.New("failed to list security groups")}
+		_, err := fetchSecurityGroups(context.TODO(), lister, "", "")
+		g.Expect(err).To(Not(BeNil()))
+	})
+}
+
+func TestToList(t *testing.T) {
+	g := NewWithT(t)
+	withoutVPC := newSecurityGroup("bar-sg", "foo-vpc-id", 0)
+	withoutVPC.VPC = nil
+
+	securityGroupList := toList([]*vpcv1.SecurityGroupCollection{{SecurityGroups: []vpcv1.SecurityGroup{newSecurityGroup("foo-sg", "foo-vpc-id", 3), withoutVPC}}})
+	g.Expect(securityGroupList).To(Equal(List{
+		{
+			ID:      "foo-sg-id",
+			Name:    "foo-sg",
+			VPCName: "foo-vpc-id-name",
+			Rules:   3,
+		},
+		{
+			ID:   "bar-sg-id",
+			Name: "bar-sg",
+		},
+	}))
+}
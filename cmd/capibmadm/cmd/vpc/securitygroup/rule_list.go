@@ -0,0 +1,226 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package securitygroup
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/cmd/capibmadm/clients/vpc"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/cmd/capibmadm/options"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/cmd/capibmadm/printer"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/cmd/capibmadm/utils"
+)
+
+// ruleLister is the subset of the VPC client used to look up a security group and its rules.
+type ruleLister interface {
+	securityGroupLister
+	GetSecurityGroupWithContext(ctx context.Context, getSecurityGroupOptions *vpcv1.GetSecurityGroupOptions) (*vpcv1.SecurityGroup, *core.DetailedResponse, error)
+}
+
+type ruleListOptions struct {
+	securityGroupID string
+	name            string
+}
+
+// RuleListCommand vpc security group rule list command.
+func RuleListCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List VPC security group rules",
+		Example: `
+ # List rules of a security group by ID
+ export IBMCLOUD_API_KEY=<api-key>
+ capibmadm vpc security-group rule list --security-group-id <security-group-id> --region <region>
+
+ # List rules of a security group by name
+ export IBMCLOUD_API_KEY=<api-key>
+ capibmadm vpc security-group rule list --name <security-group-name> --region <region>`,
+	}
+
+	options.AddCommonFlags(cmd)
+	var ruleListOption ruleListOptions
+	cmd.Flags().StringVar(&ruleListOption.securityGroupID, "security-group-id", "", "The ID of the security group.")
+	cmd.Flags().StringVar(&ruleListOption.name, "name", "", "The name of the security group.")
+	// TODO: Flag validation is handled in PreRunE until the support for MarkFlagsMutuallyExclusiveAndRequired is available.
+	// Related issue: https://github.com/spf13/cobra/issues/1216
+	cmd.PreRunE = func(_ *cobra.Command, _ []string) error {
+		if (ruleListOption.securityGroupID == "") == (ruleListOption.name == "") {
+			return fmt.Errorf("exactly one of the flags security-group-id or name is required")
+		}
+		return nil
+	}
+	cmd.RunE = func(cmd *cobra.Command, _ []string) error {
+		v1, err := vpc.NewV1Client(options.GlobalOptions.VPCRegion)
+		if err != nil {
+			return err
+		}
+		securityGroup, err := getSecurityGroup(cmd.Context(), v1, ruleListOption)
+		if err != nil {
+			return err
+		}
+		return displayRules(toRuleList(securityGroup.Rules))
+	}
+
+	return cmd
+}
+
+// getSecurityGroup fetches the security group by ID or, when no ID is given, resolves it by name.
+func getSecurityGroup(ctx context.Context, v1 ruleLister, ruleListOption ruleListOptions) (*vpcv1.SecurityGroup, error) {
+	if ruleListOption.securityGroupID != "" {
+		securityGroup, _, err := v1.GetSecurityGroupWithContext(ctx, &vpcv1.GetSecurityGroupOptions{ID: &ruleListOption.securityGroupID})
+		if err != nil {
+			return nil, fmt.Errorf("error fetching security group %s: %w", ruleListOption.securityGroupID, err)
+		}
+		return securityGroup, nil
+	}
+
+	securityGroupNesList, err := fetchSecurityGroups(ctx, v1, "", "")
+	if err != nil {
+		return nil, fmt.Errorf("error listing security groups: %w", err)
+	}
+	for _, securityGroupL := range securityGroupNesList {
+		for i := range securityGroupL.SecurityGroups {
+			if utils.DereferencePointer(securityGroupL.SecurityGroups[i].Name).(string) == ruleListOption.name {
+				return &securityGroupL.SecurityGroups[i], nil
+			}
+		}
+	}
+	return nil, fmt.Errorf("security group with name %s not found", ruleListOption.name)
+}
+
+func toRuleList(rules []vpcv1.SecurityGroupRuleIntf) RuleList {
+	var ruleListToDisplay RuleList
+	for _, r := range rules {
+		var ruleToAppend Rule
+		switch rule := r.(type) {
+		case *vpcv1.SecurityGroupRuleSecurityGroupRuleProtocolAll:
+			ruleToAppend = Rule{
+				ID:        utils.DereferencePointer(rule.ID).(string),
+				Direction: utils.DereferencePointer(rule.Direction).(string),
+				Protocol:  utils.DereferencePointer(rule.Protocol).(string),
+				Remote:    ruleRemote(rule.Remote),
+			}
+		case *vpcv1.SecurityGroupRuleSecurityGroupRuleProtocolTcpudp:
+			ruleToAppend = Rule{
+				ID:        utils.DereferencePointer(rule.ID).(string),
+				Direction: utils.DereferencePointer(rule.Direction).(string),
+				Protocol:  utils.DereferencePointer(rule.Protocol).(string),
+				PortRange: rulePortRange(rule.PortMin, rule.PortMax),
+				Remote:    ruleRemote(rule.Remote),
+			}
+		case *vpcv1.SecurityGroupRuleSecurityGroupRuleProtocolIcmp:
+			ruleToAppend = Rule{
+				ID:        utils.DereferencePointer(rule.ID).(string),
+				Direction: utils.DereferencePointer(rule.Direction).(string),
+				Protocol:  utils.DereferencePointer(rule.Protocol).(string),
+				ICMP:      ruleICMP(rule.Type, rule.Code),
+				Remote:    ruleRemote(rule.Remote),
+			}
+		case *vpcv1.SecurityGroupRule:
+			ruleToAppend = Rule{
+				ID:        utils.DereferencePointer(rule.ID).(string),
+				Direction: utils.DereferencePointer(rule.Direction).(string),
+				Protocol:  utils.DereferencePointer(rule.Protocol).(string),
+				Remote:    ruleRemote(rule.Remote),
+			}
+			switch ruleToAppend.Protocol {
+			case vpcv1.SecurityGroupRuleProtocolTCPConst, vpcv1.SecurityGroupRuleProtocolUDPConst:
+				ruleToAppend.PortRange = rulePortRange(rule.PortMin, rule.PortMax)
+			case vpcv1.SecurityGroupRuleProtocolIcmpConst:
+				ruleToAppend.ICMP = ruleICMP(rule.Type, rule.Code)
+			}
+		default:
+			continue
+		}
+		ruleListToDisplay = append(ruleListToDisplay, ruleToAppend)
+	}
+	return ruleListToDisplay
+}
+
+// rulePortRange renders the port range of a tcp or udp rule, a rule without ports applies to any port.
+func rulePortRange(portMin, portMax *int64) string {
+	if portMin == nil || portMax == nil {
+		return "any"
+	}
+	if *portMin == *portMax {
+		return fmt.Sprintf("%d", *portMin)
+	}
+	return fmt.Sprintf("%d-%d", *portMin, *portMax)
+}
+
+// ruleICMP renders the ICMP type and code of an icmp rule, a rule without type applies to any ICMP traffic.
+func ruleICMP(icmpType, icmpCode *int64) string {
+	switch {
+	case icmpType == nil:
+		return "any"
+	case icmpCode == nil:
+		return fmt.Sprintf("type %d", *icmpType)
+	}
+	return fmt.Sprintf("type %d code %d", *icmpType, *icmpCode)
+}
+
+// ruleRemote renders the remote of a rule, which is either a CIDR block, an IP address or a security group.
+func ruleRemote(remote vpcv1.SecurityGroupRuleRemoteIntf) string {
+	switch r := remote.(type) {
+	case *vpcv1.SecurityGroupRuleRemote:
+		switch {
+		case r.CIDRBlock != nil:
+			return *r.CIDRBlock
+		case r.Address != nil:
+			return *r.Address
+		case r.Name != nil:
+			return *r.Name
+		}
+		return utils.DereferencePointer(r.ID).(string)
+	case *vpcv1.SecurityGroupRuleRemoteCIDR:
+		return utils.DereferencePointer(r.CIDRBlock).(string)
+	case *vpcv1.SecurityGroupRuleRemoteIP:
+		return utils.DereferencePointer(r.Address).(string)
+	case *vpcv1.SecurityGroupRuleRemoteSecurityGroupReference:
+		return utils.DereferencePointer(r.Name).(string)
+	}
+	return ""
+}
+
+func displayRules(ruleListToDisplay RuleList) error {
+	if options.GlobalOptions.Quiet {
+		return printer.NewQuiet(os.Stdout).Print(&ruleListToDisplay)
+	}
+
+	p, err := options.NewPrinter(os.Stdout)
+
+	if err != nil {
+		return err
+	}
+
+	switch options.GlobalOptions.Output {
+	case printer.PrinterTypeJSON, printer.PrinterTypeYAML, printer.PrinterTypeTemplate:
+		err = p.Print(ruleListToDisplay)
+	default:
+		table := ruleListToDisplay.ToTable()
+		err = p.Print(table)
+	}
+
+	return err
+}
@@ -0,0 +1,131 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package securitygroup
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+
+	. "github.com/onsi/gomega"
+)
+
+// fakeRuleLister serves a single page of security groups which can also be fetched by ID.
+type fakeRuleLister struct {
+	fakeSecurityGroupLister
+}
+
+func (f *fakeRuleLister) GetSecurityGroupWithContext(_ context.Context, options *vpcv1.GetSecurityGroupOptions) (*vpcv1.SecurityGroup, *core.DetailedResponse, error) {
+	for i := range f.pages[0] {
+		if *f.pages[0][i].ID == *options.ID {
+			return &f.pages[0][i], &core.DetailedResponse{}, nil
+		}
+	}
+	return nil, &core.DetailedResponse{StatusCode: 404}, errors.New("security group not found")
+}
+
+func TestGetSecurityGroup(t *testing.T) {
+	newRuleLister := func() *fakeRuleLister {
+		return &fakeRuleLister{fakeSecurityGroupLister{pages: [][]vpcv1.SecurityGroup{
+			{newSecurityGroup("foo-sg", "foo-vpc-id", 1), newSecurityGroup("bar-sg", "foo-vpc-id", 0)},
+		}}}
+	}
+
+	t.Run("Should get security group by ID", func(t *testing.T) {
+		g := NewWithT(t)
+		securityGroup, err := getSecurityGroup(context.TODO(), newRuleLister(), ruleListOptions{securityGroupID: "foo-sg-id"})
+		g.Expect(err).To(BeNil())
+		g.Expect(*securityGroup.Name).To(Equal("foo-sg"))
+	})
+	t.Run("Should get security group by name", func(t *testing.T) {
+		g := NewWithT(t)
+		securityGroup, err := getSecurityGroup(context.TODO(), newRuleLister(), ruleListOptions{name: "bar-sg"})
+		g.Expect(err).To(BeNil())
+		g.Expect(*securityGroup.ID).To(Equal("bar-sg-id"))
+		g.Expect(toRuleList(securityGroup.Rules)).To(BeEmpty())
+	})
+	t.Run("Error when security group is not found by name", func(t *testing.T) {
+		g := NewWithT(t)
+		_, err := getSecurityGroup(context.TODO(), newRuleLister(), ruleListOptions{name: "baz-sg"})
+		g.Expect(err).To(MatchError("security group with name baz-sg not found"))
+	})
+	t.Run("Error when security group is not found by ID", func(t *testing.T) {
+		g := NewWithT(t)
+		_, err := getSecurityGroup(context.TODO(), newRuleLister(), ruleListOptions{securityGroupID: "baz-sg-id"})
+		g.Expect(err).To(MatchError(ContainSubstring("error fetching security group baz-sg-id")))
+	})
+}
+
+func TestToRuleList(t *testing.T) {
+	g := NewWithT(t)
+	rules := []vpcv1.SecurityGroupRuleIntf{
+		&vpcv1.SecurityGroupRuleSecurityGroupRuleProtocolTcpudp{
+			ID:        core.StringPtr("tcp-rule-id"),
+			Direction: core.StringPtr(vpcv1.SecurityGroupRuleDirectionInboundConst),
+			Protocol:  core.StringPtr(vpcv1.SecurityGroupRuleProtocolTCPConst),
+			PortMin:   core.Int64Ptr(6443),
+			PortMax:   core.Int64Ptr(6443),
+			Remote:    &vpcv1.SecurityGroupRuleRemote{CIDRBlock: core.StringPtr("10.240.0.0/24")},
+		},
+		&vpcv1.SecurityGroupRuleSecurityGroupRuleProtocolTcpudp{
+			ID:        core.StringPtr("udp-rule-id"),
+			Direction: core.StringPtr(vpcv1.SecurityGroupRuleDirectionOutboundConst),
+			Protocol:  core.StringPtr(vpcv1.SecurityGroupRuleProtocolUDPConst),
+			PortMin:   core.Int64Ptr(30000),
+			PortMax:   core.Int64Ptr(32767),
+			Remote:    &vpcv1.SecurityGroupRuleRemote{Name: core.StringPtr("foo-sg"), ID: core.StringPtr("foo-sg-id")},
+		},
+		&vpcv1.SecurityGroupRuleSecurityGroupRuleProtocolTcpudp{
+			ID:        core.StringPtr("tcp-any-rule-id"),
+			Direction: core.StringPtr(vpcv1.SecurityGroupRuleDirectionInboundConst),
+			Protocol:  core.StringPtr(vpcv1.SecurityGroupRuleProtocolTCPConst),
+			Remote:    &vpcv1.SecurityGroupRuleRemote{Address: core.StringPtr("10.240.0.4")},
+		},
+		&vpcv1.SecurityGroupRuleSecurityGroupRuleProtocolIcmp{
+			ID:        core.StringPtr("icmp-rule-id"),
+			Direction: core.StringPtr(vpcv1.SecurityGroupRuleDirectionInboundConst),
+			Protocol:  core.StringPtr(vpcv1.SecurityGroupRuleProtocolIcmpConst),
+			Type:      core.Int64Ptr(8),
+			Code:      core.Int64Ptr(0),
+			Remote:    &vpcv1.SecurityGroupRuleRemote{CIDRBlock: core.StringPtr("0.0.0.0/0")},
+		},
+		&vpcv1.SecurityGroupRuleSecurityGroupRuleProtocolIcmp{
+			ID:        core.StringPtr("icmp-any-rule-id"),
+			Direction: core.StringPtr(vpcv1.SecurityGroupRuleDirectionInboundConst),
+			Protocol:  core.StringPtr(vpcv1.SecurityGroupRuleProtocolIcmpConst),
+			Remote:    &vpcv1.SecurityGroupRuleRemote{CIDRBlock: core.StringPtr("0.0.0.0/0")},
+		},
+		&vpcv1.SecurityGroupRuleSecurityGroupRuleProtocolAll{
+			ID:        core.StringPtr("all-rule-id"),
+			Direction: core.StringPtr(vpcv1.SecurityGroupRuleDirectionOutboundConst),
+			Protocol:  core.StringPtr(vpcv1.SecurityGroupRuleProtocolAllConst),
+			Remote:    &vpcv1.SecurityGroupRuleRemote{CIDRBlock: core.StringPtr("0.0.0.0/0")},
+		},
+	}
+
+	g.Expect(toRuleList(rules)).To(Equal(RuleList{
+		{ID: "tcp-rule-id", Direction: "inbound", Protocol: "tcp", PortRange: "6443", Remote: "10.240.0.0/24"},
+		{ID: "udp-rule-id", Direction: "outbound", Protocol: "udp", PortRange: "30000-32767", Remote: "foo-sg"},
+		{ID: "tcp-any-rule-id", Direction: "inbound", Protocol: "tcp", PortRange: "any", Remote: "10.240.0.4"},
+		{ID: "icmp-rule-id", Direction: "inbound", Protocol: "icmp", ICMP: "type 8 code 0", Remote: "0.0.0.0/0"},
+		{ID: "icmp-any-rule-id", Direction: "inbound", Protocol: "icmp", ICMP: "any", Remote: "0.0.0.0/0"},
+		{ID: "all-rule-id", Direction: "outbound", Protocol: "all", Remote: "0.0.0.0/0"},
+	}))
+}
@@ -0,0 +1,47 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package securitygroup contains the commands to operate on vpc security group resources.
+package securitygroup
+
+import (
+	"github.com/spf13/cobra"
+)
+
+// Commands function to add VPC security group commands.
+func Commands() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "security-group",
+		Short: "Perform VPC security group operations",
+	}
+
+	cmd.AddCommand(ListCommand())
+	cmd.AddCommand(RuleCommands())
+
+	return cmd
+}
+
+// RuleCommands function to add VPC security group rule commands.
+func RuleCommands() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "rule",
+		Short: "Perform VPC security group rule operations",
+	}
+
+	cmd.AddCommand(RuleListCommand())
+
+	return cmd
+}
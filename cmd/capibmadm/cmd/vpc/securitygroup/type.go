@@ -0,0 +1,143 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package securitygroup
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// SecurityGroup vpc security group info.
+type SecurityGroup struct {
+	ID      string `json:"id"`
+	Name    string `json:"name"`
+	VPCName string `json:"vpcName"`
+	Rules   int    `json:"rules"`
+}
+
+// List is list of SecurityGroup.
+type List []SecurityGroup
+
+// IDs returns the IDs of the security groups in the list.
+func (securityGroupList *List) IDs() []string {
+	ids := make([]string, 0, len(*securityGroupList))
+	for _, securityGroup := range *securityGroupList {
+		ids = append(ids, securityGroup.ID)
+	}
+	return ids
+}
+
+// ToTable converts List to *metav1.Table.
+func (securityGroupList *List) ToTable() *metav1.Table {
+	table := &metav1.Table{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: metav1.SchemeGroupVersion.String(),
+			Kind:       "Table",
+		},
+		ColumnDefinitions: []metav1.TableColumnDefinition{
+			{
+				Name: "ID",
+				Type: "string",
+			},
+			{
+				Name: "NAME",
+				Type: "string",
+			},
+			{
+				Name: "VPC",
+				Type: "string",
+			},
+			{
+				Name: "RULES",
+				Type: "number",
+			},
+		},
+	}
+
+	for _, securityGroup := range *securityGroupList {
+		row := metav1.TableRow{
+			Cells: []interface{}{securityGroup.ID, securityGroup.Name, securityGroup.VPCName, securityGroup.Rules},
+		}
+		table.Rows = append(table.Rows, row)
+	}
+	return table
+}
+
+// Rule vpc security group rule info.
+type Rule struct {
+	ID        string `json:"id"`
+	Direction string `json:"direction"`
+	Protocol  string `json:"protocol"`
+	PortRange string `json:"portRange"`
+	ICMP      string `json:"icmp"`
+	Remote    string `json:"remote"`
+}
+
+// RuleList is list of Rule.
+type RuleList []Rule
+
+// IDs returns the IDs of the security group rules in the list.
+func (ruleList *RuleList) IDs() []string {
+	ids := make([]string, 0, len(*ruleList))
+	for _, rule := range *ruleList {
+		ids = append(ids, rule.ID)
+	}
+	return ids
+}
+
+// ToTable converts RuleList to *metav1.Table.
+func (ruleList *RuleList) ToTable() *metav1.Table {
+	table := &metav1.Table{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: metav1.SchemeGroupVersion.String(),
+			Kind:       "Table",
+		},
+		ColumnDefinitions: []metav1.TableColumnDefinition{
+			{
+				Name: "ID",
+				Type: "string",
+			},
+			{
+				Name: "DIRECTION",
+				Type: "string",
+			},
+			{
+				Name: "PROTOCOL",
+				Type: "string",
+			},
+			{
+				Name: "PORT RANGE",
+				Type: "string",
+			},
+			{
+				Name: "ICMP",
+				Type: "string",
+			},
+			{
+				Name: "REMOTE",
+				Type: "string",
+			},
+		},
+	}
+
+	for _, rule := range *ruleList {
+		row := metav1.TableRow{
+			Cells: []interface{}{rule.ID, rule.Direction, rule.Protocol, rule.PortRange, rule.ICMP, rule.Remote},
+		}
+		table.Rows = append(table.Rows, row)
+	}
+	return table
+}
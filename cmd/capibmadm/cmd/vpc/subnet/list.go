@@ -0,0 +1,184 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package subnet
+
+import (
+	"context"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/cmd/capibmadm/clients/iam"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/cmd/capibmadm/clients/vpc"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/cmd/capibmadm/options"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/cmd/capibmadm/printer"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/cmd/capibmadm/utils"
+	pkgUtils "sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/utils"
+)
+
+// subnetLister is the subset of the VPC client used to list subnets.
+type subnetLister interface {
+	ListSubnetsWithContext(ctx context.Context, listSubnetsOptions *vpcv1.ListSubnetsOptions) (*vpcv1.SubnetCollection, *core.DetailedResponse, error)
+}
+
+// ListCommand vpc subnet list command.
+func ListCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List VPC subnets",
+		Example: `
+ # List subnets in VPC
+ export IBMCLOUD_API_KEY=<api-key>
+ capibmadm vpc subnet list --region <region> --resource-group-name <resource-group-name>
+
+ # List subnets in a VPC zone
+ export IBMCLOUD_API_KEY=<api-key>
+ capibmadm vpc subnet list --region <region> --zone <zone>`,
+	}
+
+	options.AddCommonFlags(cmd)
+	var zone string
+	cmd.Flags().StringVar(&zone, "zone", "", "Filter subnets by zone.")
+
+	cmd.RunE = func(cmd *cobra.Command, _ []string) error {
+		return listSubnets(cmd.Context(), options.GlobalOptions.ResourceGroupName, zone)
+	}
+
+	return cmd
+}
+
+func listSubnets(ctx context.Context, resourceGroupName, zone string) error {
+	v1, err := vpc.NewV1Client(options.GlobalOptions.VPCRegion)
+	if err != nil {
+		return err
+	}
+
+	var resourceGroupID string
+	if resourceGroupName != "" {
+		accountID, err := pkgUtils.GetAccount(ctx, iam.GetIAMAuth())
+		if err != nil {
+			return err
+		}
+		resourceGroupID, err = utils.GetResourceGroupID(ctx, resourceGroupName, accountID)
+		if err != nil {
+			return err
+		}
+	}
+
+	subnetNesList, err := fetchSubnets(ctx, v1, resourceGroupID, zone)
+	if err != nil {
+		return err
+	}
+
+	return display(subnetNesList)
+}
+
+// fetchSubnets lists the subnets page by page. When zone is set the subnets are filtered by the VPC API.
+func fetchSubnets(ctx context.Context, v1 subnetLister, resourceGroupID, zone string) ([]*vpcv1.SubnetCollection, error) {
+	var subnetNesList []*vpcv1.SubnetCollection
+	f := func(start string) (bool, string, error) {
+		var listSubnetOpt vpcv1.ListSubnetsOptions
+
+		if resourceGroupID != "" {
+			listSubnetOpt.ResourceGroupID = &resourceGroupID
+		}
+		if zone != "" {
+			listSubnetOpt.ZoneName = &zone
+		}
+		if start != "" {
+			listSubnetOpt.Start = &start
+		}
+
+		subnetL, _, err := v1.ListSubnetsWithContext(ctx, &listSubnetOpt)
+		if err != nil {
+			return false, "", err
+		}
+		subnetNesList = append(subnetNesList, subnetL)
+
+		if subnetL.Next != nil && *subnetL.Next.Href != "" {
+			return false, *subnetL.Next.Href, nil
+		}
+
+		return true, "", nil
+	}
+
+	if err := pkgUtils.PagingHelper(ctx, f); err != nil {
+		return nil, err
+	}
+
+	return subnetNesList, nil
+}
+
+func toList(subnetNesList []*vpcv1.SubnetCollection) List {
+	var subnetListToDisplay List
+	for _, subnetL := range subnetNesList {
+		for _, subnet := range subnetL.Subnets {
+			subnetToAppend := Subnet{
+				ID:                 utils.DereferencePointer(subnet.ID).(string),
+				Name:               utils.DereferencePointer(subnet.Name).(string),
+				CIDR:               utils.DereferencePointer(subnet.Ipv4CIDRBlock).(string),
+				AvailableIPv4Count: utils.DereferencePointer(subnet.AvailableIpv4AddressCount).(int64),
+			}
+
+			if subnet.Zone != nil {
+				subnetToAppend.Zone = utils.DereferencePointer(subnet.Zone.Name).(string)
+			}
+
+			if subnet.PublicGateway != nil {
+				subnetToAppend.PublicGatewayName = utils.DereferencePointer(subnet.PublicGateway.Name).(string)
+			}
+
+			if subnet.VPC != nil {
+				subnetToAppend.VPCName = utils.DereferencePointer(subnet.VPC.Name).(string)
+			}
+
+			if subnet.ResourceGroup != nil {
+				subnetToAppend.ResourceGroupName = utils.DereferencePointer(subnet.ResourceGroup.Name).(string)
+			}
+
+			subnetListToDisplay = append(subnetListToDisplay, subnetToAppend)
+		}
+	}
+	return subnetListToDisplay
+}
+
+func display(subnetNesList []*vpcv1.SubnetCollection) error {
+	subnetListToDisplay := toList(subnetNesList)
+
+	if options.GlobalOptions.Quiet {
+		return printer.NewQuiet(os.Stdout).Print(&subnetListToDisplay)
+	}
+
+	p, err := options.NewPrinter(os.Stdout)
+
+	if err != nil {
+		return err
+	}
+
+	switch options.GlobalOptions.Output {
+	case printer.PrinterTypeJSON, printer.PrinterTypeYAML, printer.PrinterTypeTemplate:
+		err = p.Print(subnetListToDisplay)
+	default:
+		table := subnetListToDisplay.ToTable()
+		err = p.Print(table)
+	}
+
+	return err
+}
@@ -0,0 +1,137 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package subnet
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+
+	. "github.com/onsi/gomega"
+)
+
+// fakeSubnetLister serves subnets one page at a time, filtering them by the requested zone.
+type fakeSubnetLister struct {
+	pages    [][]vpcv1.Subnet
+	requests []vpcv1.ListSubnetsOptions
+	err      error
+}
+
+func (f *fakeSubnetLister) ListSubnetsWithContext(_ context.Context, options *vpcv1.ListSubnetsOptions) (*vpcv1.SubnetCollection, *core.DetailedResponse, error) {
+	f.requests = append(f.requests, *options)
+	if f.err != nil {
+		return nil, nil, f.err
+	}
+
+	page := len(f.requests) - 1
+	collection := &vpcv1.SubnetCollection{}
+	for _, subnet := range f.pages[page] {
+		if options.ZoneName == nil || *subnet.Zone.Name == *options.ZoneName {
+			collection.Subnets = append(collection.Subnets, subnet)
+		}
+	}
+	if page+1 < len(f.pages) {
+		collection.Next = &vpcv1.SubnetCollectionNext{Href: core.StringPtr("https://us-south.iaas.cloud.ibm.com/v1/subnets?start=next")}
+	}
+	return collection, &core.DetailedResponse{}, nil
+}
+
+func newSubnet(name, zone string) vpcv1.Subnet {
+	return vpcv1.Subnet{
+		ID:                        core.StringPtr(name + "-id"),
+		Name:                      core.StringPtr(name),
+		Ipv4CIDRBlock:             core.StringPtr("10.240.0.0/24"),
+		AvailableIpv4AddressCount: core.Int64Ptr(251),
+		Zone:                      &vpcv1.ZoneReference{Name: core.StringPtr(zone)},
+	}
+}
+
+func TestFetchSubnets(t *testing.T) {
+	t.Run("Should list subnets across pages", func(t *testing.T) {
+		g := NewWithT(t)
+		lister := &fakeSubnetLister{pages: [][]vpcv1.Subnet{
+			{newSubnet("foo-subnet", "us-south-1")},
+			{newSubnet("bar-subnet", "us-south-2")},
+		}}
+		subnetNesList, err := fetchSubnets(context.TODO(), lister, "", "")
+		g.Expect(err).To(BeNil())
+		g.Expect(toList(subnetNesList)).To(HaveLen(2))
+		g.Expect(lister.requests).To(HaveLen(2))
+		g.Expect(lister.requests[0].ZoneName).To(BeNil())
+	})
+	t.Run("Should filter subnets by zone", func(t *testing.T) {
+		g := NewWithT(t)
+		lister := &fakeSubnetLister{pages: [][]vpcv1.Subnet{
+			{newSubnet("foo-subnet", "us-south-1"), newSubnet("bar-subnet", "us-south-2")},
+			{newSubnet("baz-subnet", "us-south-1")},
+		}}
+		subnetNesList, err := fetchSubnets(context.TODO(), lister, "foo-resource-group-id", "us-south-1")
+		g.Expect(err).To(BeNil())
+		for _, request := range lister.requests {
+			g.Expect(*request.ZoneName).To(Equal("us-south-1"))
+			g.Expect(*request.ResourceGroupID).To(Equal("foo-resource-group-id"))
+		}
+		subnetList := toList(subnetNesList)
+		g.Expect(subnetList).To(HaveLen(2))
+		for _, subnet := range subnetList {
+			g.Expect(subnet.Zone).To(Equal("us-south-1"))
+		}
+	})
+	t.Run("Error when listing subnets", func(t *testing.T) {
+		g := NewWithT(t)
+		lister := &fakeSubnetLister{err: errors.New("failed to list subnets")}
+		_, err := fetchSubnets(context.TODO(), lister, "", "")
+		g.Expect(err).To(Not(BeNil()))
+	})
+}
+
+func TestToList(t *testing.T) {
+	g := NewWithT(t)
+	withGateway := newSubnet("foo-subnet", "us-south-1")
+	withGateway.PublicGateway = &vpcv1.PublicGatewayReference{Name: core.StringPtr("foo-gateway")}
+	withGateway.VPC = &vpcv1.VPCReference{Name: core.StringPtr("foo-vpc")}
+	withGateway.ResourceGroup = &vpcv1.ResourceGroupReference{Name: core.StringPtr("foo-resource-group")}
+	withoutGateway := newSubnet("bar-subnet", "us-south-2")
+
+	subnetList := toList([]*vpcv1.SubnetCollection{{Subnets: []vpcv1.Subnet{withGateway, withoutGateway}}})
+	g.Expect(subnetList).To(Equal(List{
+		{
+			ID:                 "foo-subnet-id",
+			Name:               "foo-subnet",
+			Zone:               "us-south-1",
+			CIDR:               "10.240.0.0/24",
+			AvailableIPv4Count: 251,
+			PublicGatewayName:  "foo-gateway",
+			VPCName:            "foo-vpc",
+			ResourceGroupName:  "foo-resource-group",
+		},
+		{
+			ID:                 "bar-subnet-id",
+			Name:               "bar-subnet",
+			Zone:               "us-south-2",
+			CIDR:               "10.240.0.0/24",
+			AvailableIPv4Count: 251,
+		},
+	}))
+
+	table := subnetList.ToTable()
+	g.Expect(table.Rows).To(HaveLen(2))
+	g.Expect(table.Rows[1].Cells[5]).To(Equal(""))
+}
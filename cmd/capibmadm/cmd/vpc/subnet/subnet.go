@@ -0,0 +1,34 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package subnet contains the commands to operate on vpc subnet resources.
+package subnet
+
+import (
+	"github.com/spf13/cobra"
+)
+
+// Commands function to add VPC subnet commands.
+func Commands() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "subnet",
+		Short: "Perform VPC subnet operations",
+	}
+
+	cmd.AddCommand(ListCommand())
+
+	return cmd
+}
@@ -0,0 +1,97 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package subnet
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// Subnet vpc subnet info.
+type Subnet struct {
+	ID                 string `json:"id"`
+	Name               string `json:"name"`
+	Zone               string `json:"zone"`
+	CIDR               string `json:"cidr"`
+	AvailableIPv4Count int64  `json:"availableIPv4Count"`
+	PublicGatewayName  string `json:"publicGatewayName"`
+	VPCName            string `json:"vpcName"`
+	ResourceGroupName  string `json:"resourceGroupName"`
+}
+
+// List is list of Subnet.
+type List []Subnet
+
+// IDs returns the IDs of the subnets in the list.
+func (subnetList *List) IDs() []string {
+	ids := make([]string, 0, len(*subnetList))
+	for _, subnet := range *subnetList {
+		ids = append(ids, subnet.ID)
+	}
+	return ids
+}
+
+// ToTable converts List to *metav1.Table.
+func (subnetList *List) ToTable() *metav1.Table {
+	table := &metav1.Table{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: metav1.SchemeGroupVersion.String(),
+			Kind:       "Table",
+		},
+		ColumnDefinitions: []metav1.TableColumnDefinition{
+			{
+				Name: "ID",
+				Type: "string",
+			},
+			{
+				Name: "NAME",
+				Type: "string",
+			},
+			{
+				Name: "ZONE",
+				Type: "string",
+			},
+			{
+				Name: "CIDR",
+				Type: "string",
+			},
+			{
+				Name: "AVAILABLE IPS",
+				Type: "integer",
+			},
+			{
+				Name: "PUBLIC GATEWAY",
+				Type: "string",
+			},
+			{
+				Name: "VPC",
+				Type: "string",
+			},
+			{
+				Name: "RESOURCE GROUP",
+				Type: "string",
+			},
+		},
+	}
+
+	for _, subnet := range *subnetList {
+		row := metav1.TableRow{
+			Cells: []interface{}{subnet.ID, subnet.Name, subnet.Zone, subnet.CIDR, subnet.AvailableIPv4Count, subnet.PublicGatewayName, subnet.VPCName, subnet.ResourceGroupName},
+		}
+		table.Rows = append(table.Rows, row)
+	}
+	return table
+}
@@ -0,0 +1,94 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vpc
+
+import (
+	"github.com/go-openapi/strfmt"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// VPC vpc info.
+type VPC struct {
+	ID                   string          `json:"id"`
+	Name                 string          `json:"name"`
+	Status               string          `json:"status"`
+	CreatedAt            strfmt.DateTime `json:"created_at"`
+	ResourceGroupName    string          `json:"resourceGroupName"`
+	DefaultSecurityGroup string          `json:"defaultSecurityGroup"`
+	DefaultNetworkACL    string          `json:"defaultNetworkACL"`
+}
+
+// List is list of VPC.
+type List []VPC
+
+// IDs returns the IDs of the VPCs in the list.
+func (vpcList *List) IDs() []string {
+	ids := make([]string, 0, len(*vpcList))
+	for _, vpc := range *vpcList {
+		ids = append(ids, vpc.ID)
+	}
+	return ids
+}
+
+// ToTable converts List to *metav1.Table.
+func (vpcList *List) ToTable() *metav1.Table {
+	table := &metav1.Table{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: metav1.SchemeGroupVersion.String(),
+			Kind:       "Table",
+		},
+		ColumnDefinitions: []metav1.TableColumnDefinition{
+			{
+				Name: "ID",
+				Type: "string",
+			},
+			{
+				Name: "NAME",
+				Type: "string",
+			},
+			{
+				Name: "STATUS",
+				Type: "string",
+			},
+			{
+				Name: "CREATED AT",
+				Type: "string",
+			},
+			{
+				Name: "RESOURCE GROUP",
+				Type: "string",
+			},
+			{
+				Name: "DEFAULT SECURITY GROUP",
+				Type: "string",
+			},
+			{
+				Name: "DEFAULT NETWORK ACL",
+				Type: "string",
+			},
+		},
+	}
+
+	for _, vpc := range *vpcList {
+		row := metav1.TableRow{
+			Cells: []interface{}{vpc.ID, vpc.Name, vpc.Status, vpc.CreatedAt, vpc.ResourceGroupName, vpc.DefaultSecurityGroup, vpc.DefaultNetworkACL},
+		}
+		table.Rows = append(table.Rows, row)
+	}
+	return table
+}
@@ -18,13 +18,17 @@ limitations under the License.
 package vpc
 
 import (
-	"fmt"
-	"os"
+	"time"
 
 	"github.com/spf13/cobra"
 
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/cmd/capibmadm/clients/iam"
 	"sigs.k8s.io/cluster-api-provider-ibmcloud/cmd/capibmadm/cmd/vpc/image"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/cmd/capibmadm/cmd/vpc/instance"
 	"sigs.k8s.io/cluster-api-provider-ibmcloud/cmd/capibmadm/cmd/vpc/key"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/cmd/capibmadm/cmd/vpc/loadbalancer"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/cmd/capibmadm/cmd/vpc/securitygroup"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/cmd/capibmadm/cmd/vpc/subnet"
 	"sigs.k8s.io/cluster-api-provider-ibmcloud/cmd/capibmadm/options"
 )
 
@@ -34,22 +38,24 @@ func Commands() *cobra.Command {
 		Use:   "vpc",
 		Short: "Commands for operations on VPC resources",
 		PersistentPreRunE: func(_ *cobra.Command, _ []string) error {
-			apiKey := os.Getenv(options.IBMCloudAPIKeyEnvName)
-			if apiKey == "" {
-				return fmt.Errorf("ibmcloud api key is not provided, set %s environmental variable", options.IBMCloudAPIKeyEnvName)
-			}
-			options.GlobalOptions.IBMCloudAPIKey = apiKey
-			return nil
+			return iam.LoadCredentials()
 		},
 	}
 
 	cmd.PersistentFlags().StringVar(&options.GlobalOptions.VPCRegion, "region", options.GlobalOptions.VPCRegion, "IBM cloud vpc region. (Required)")
 	cmd.PersistentFlags().StringVar(&options.GlobalOptions.ResourceGroupName, "resource-group-name", options.GlobalOptions.ResourceGroupName, "IBM cloud resource group name")
+	cmd.PersistentFlags().IntVar(&options.GlobalOptions.MaxRetries, "max-retries", 3, "Maximum number of retries of VPC read requests failing with a 429 or 5xx response, 0 disables retries")
+	cmd.PersistentFlags().DurationVar(&options.GlobalOptions.RetryBaseDelay, "retry-base-delay", 1*time.Second, "Initial delay between retries of VPC read requests, doubled after each retry unless the response sets Retry-After")
 
 	_ = cmd.MarkPersistentFlagRequired("region")
 
+	cmd.AddCommand(ListCommand())
 	cmd.AddCommand(key.Commands())
 	cmd.AddCommand(image.Commands())
+	cmd.AddCommand(subnet.Commands())
+	cmd.AddCommand(instance.Commands())
+	cmd.AddCommand(loadbalancer.Commands())
+	cmd.AddCommand(securitygroup.Commands())
 
 	return cmd
 }
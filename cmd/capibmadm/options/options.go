@@ -18,29 +18,90 @@ limitations under the License.
 package options
 
 import (
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+	"time"
+
 	"github.com/spf13/cobra"
 
 	"sigs.k8s.io/cluster-api-provider-ibmcloud/cmd/capibmadm/printer"
 )
 
+const (
+	// SortOrderAscending sorts the results in ascending order.
+	SortOrderAscending = "asc"
+	// SortOrderDescending sorts the results in descending order.
+	SortOrderDescending = "desc"
+)
+
 // IBMCloudAPIKeyEnvName holds the environmental variable name to set PowerVS service instance ID.
 const IBMCloudAPIKeyEnvName = "IBMCLOUD_API_KEY" //nolint:gosec
 
+// AllowUnlistedVPCRegionEnvName holds the environmental variable name to skip the validation of the VPC region,
+// allowing regions which are not yet known to capibmadm.
+const AllowUnlistedVPCRegionEnvName = "CAPIBMADM_ALLOW_UNLISTED_VPC_REGION"
+
 // GlobalOptions holds the global variable struct.
 var GlobalOptions = &options{}
 
 type options struct {
-	IBMCloudAPIKey    string
-	ServiceInstanceID string
-	PowerVSZone       string
-	VPCRegion         string
-	ResourceGroupName string
-	Debug             bool
-	Output            printer.PType
+	IBMCloudAPIKey      string
+	Profile             string
+	CredentialsFile     string
+	TrustedProfileID    string
+	CRTokenFilename     string
+	ServiceInstanceID   string
+	ServiceInstanceName string
+	PowerVSZone         string
+	VPCRegion           string
+	ResourceGroupName   string
+	Debug               bool
+	Output              printer.PType
+	Template            string
+	Quiet               bool
+	Limit               int64
+	SortBy              string
+	SortOrder           string
+	MaxRetries          int
+	RetryBaseDelay      time.Duration
 }
 
 // AddCommonFlags will add common flags to the cli.
 func AddCommonFlags(cmd *cobra.Command) {
 	GlobalOptions.Output = printer.PrinterTypeTable
-	cmd.Flags().VarP(&GlobalOptions.Output, "output", "o", "The output format of the results. Supported printer types: table, json")
+	cmd.Flags().VarP(&GlobalOptions.Output, "output", "o", "The output format of the results. Supported printer types: table, wide, json, yaml, csv, template")
+	cmd.Flags().StringVar(&GlobalOptions.Template, "template", "", "The Go template to execute against the results when the output format is template, e.g. '{{range .}}{{.ID}}{{\"\\n\"}}{{end}}'")
+	cmd.Flags().BoolVarP(&GlobalOptions.Quiet, "quiet", "q", false, "Print only the IDs of the results, one per line, regardless of the output format")
+}
+
+// NewPrinter creates the printer for the output format of the GlobalOptions.
+func NewPrinter(writer io.Writer) (printer.Printer, error) {
+	if GlobalOptions.Output == printer.PrinterTypeTemplate {
+		return printer.NewTemplate(GlobalOptions.Template, writer)
+	}
+	return printer.New(GlobalOptions.Output, writer)
+}
+
+// AddLimitFlag will add the flag to cap the number of results returned by paginated list commands.
+func AddLimitFlag(cmd *cobra.Command) {
+	cmd.Flags().Int64Var(&GlobalOptions.Limit, "limit", 0, "The maximum number of results to return, 0 means no limit")
+}
+
+// AddSortFlags will add the flags to sort the results of list commands by one of the given keys.
+func AddSortFlags(cmd *cobra.Command, keys ...string) {
+	cmd.Flags().StringVar(&GlobalOptions.SortBy, "sort-by", "", fmt.Sprintf("Sort the results by the given key, defaults to the API order. Supported keys: %s", strings.Join(keys, ", ")))
+	cmd.Flags().StringVar(&GlobalOptions.SortOrder, "sort-order", SortOrderAscending, fmt.Sprintf("The order to sort the results in. Supported values: %s, %s", SortOrderAscending, SortOrderDescending))
+}
+
+// ValidateSortFlags returns an error if the sort flags are not set to one of the given keys and a supported order.
+func ValidateSortFlags(keys ...string) error {
+	if GlobalOptions.SortBy != "" && !slices.Contains(keys, GlobalOptions.SortBy) {
+		return fmt.Errorf("invalid sort key %q, supported keys: %s", GlobalOptions.SortBy, strings.Join(keys, ", "))
+	}
+	if GlobalOptions.SortOrder != SortOrderAscending && GlobalOptions.SortOrder != SortOrderDescending {
+		return fmt.Errorf("invalid sort order %q, supported values: %s, %s", GlobalOptions.SortOrder, SortOrderAscending, SortOrderDescending)
+	}
+	return nil
 }
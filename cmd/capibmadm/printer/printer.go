@@ -18,14 +18,18 @@ limitations under the License.
 package printer
 
 import (
+	"encoding/csv"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
+	"text/template"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/cli-runtime/pkg/printers"
+
+	"sigs.k8s.io/yaml"
 )
 
 // PType is a type declaration for a printer type.
@@ -39,7 +43,7 @@ func (p *PType) String() string {
 // Set sets value for var.
 func (p *PType) Set(s string) error {
 	switch s {
-	case string(PrinterTypeTable), string(PrinterTypeJSON):
+	case string(PrinterTypeTable), string(PrinterTypeWide), string(PrinterTypeJSON), string(PrinterTypeYAML), string(PrinterTypeCSV), string(PrinterTypeTemplate):
 		*p = PType(s)
 		return nil
 	default:
@@ -55,8 +59,16 @@ func (p *PType) Type() string {
 const (
 	// PrinterTypeTable is a table printer PType.
 	PrinterTypeTable = PType("table")
+	// PrinterTypeWide is a table printer PType for tables with additional columns.
+	PrinterTypeWide = PType("wide")
 	// PrinterTypeJSON is a json printer PType.
 	PrinterTypeJSON = PType("json")
+	// PrinterTypeYAML is a yaml printer PType.
+	PrinterTypeYAML = PType("yaml")
+	// PrinterTypeCSV is a csv printer PType.
+	PrinterTypeCSV = PType("csv")
+	// PrinterTypeTemplate is a Go template printer PType.
+	PrinterTypeTemplate = PType("template")
 )
 
 var (
@@ -65,8 +77,20 @@ var (
 	// ErrTableRequired is an error if the object being printed
 	// isn't a metav1.Table.
 	ErrTableRequired = errors.New("metav1.Table is required")
+	// ErrIDListerRequired is an error if the object being printed
+	// in quiet mode doesn't implement IDLister.
+	ErrIDListerRequired = errors.New("IDLister is required")
+	// ErrTemplateRequired is an error if the template printer
+	// is created without a template.
+	ErrTemplateRequired = errors.New("template is required")
 )
 
+// IDLister is an interface for lists whose resource IDs can be printed in quiet mode.
+type IDLister interface {
+	// IDs returns the IDs of the resources in the list.
+	IDs() []string
+}
+
 // Printer is an interface for a printer.
 type Printer interface {
 	// Print is a method to print an object.
@@ -76,15 +100,39 @@ type Printer interface {
 // New creates a new printer.
 func New(printerType PType, writer io.Writer) (Printer, error) {
 	switch printerType {
-	case PrinterTypeTable:
+	case PrinterTypeTable, PrinterTypeWide:
 		return &tablePrinter{writer: writer}, nil
 	case PrinterTypeJSON:
 		return &jsonPrinter{writer: writer}, nil
+	case PrinterTypeYAML:
+		return &yamlPrinter{writer: writer}, nil
+	case PrinterTypeCSV:
+		return &csvPrinter{writer: writer}, nil
+	case PrinterTypeTemplate:
+		return nil, ErrTemplateRequired
 	default:
 		return nil, ErrUnknowPrinterType
 	}
 }
 
+// NewTemplate creates a new printer which executes the Go template text against the object being printed.
+// The template is parsed upfront so that a malformed template is reported before anything is printed.
+func NewTemplate(text string, writer io.Writer) (Printer, error) {
+	if text == "" {
+		return nil, ErrTemplateRequired
+	}
+	tmpl, err := template.New("output").Parse(text)
+	if err != nil {
+		return nil, fmt.Errorf("parsing template: %w", err)
+	}
+	return &templatePrinter{writer: writer, template: tmpl}, nil
+}
+
+// NewQuiet creates a new printer which prints only the IDs of an IDLister, one per line.
+func NewQuiet(writer io.Writer) Printer {
+	return &quietPrinter{writer: writer}
+}
+
 type tablePrinter struct {
 	writer io.Writer
 }
@@ -118,3 +166,83 @@ func (p *jsonPrinter) Print(in interface{}) error {
 	_, err = p.writer.Write(data)
 	return err
 }
+
+type yamlPrinter struct {
+	writer io.Writer
+}
+
+// Print marshals the object through its json tags so that the yaml field names match the json output.
+func (p *yamlPrinter) Print(in interface{}) error {
+	data, err := yaml.Marshal(in)
+	if err != nil {
+		return fmt.Errorf("marshalling object as yaml: %w", err)
+	}
+	_, err = p.writer.Write(data)
+	return err
+}
+
+type csvPrinter struct {
+	writer io.Writer
+}
+
+// Print writes the table header and rows as RFC 4180 CSV records.
+func (p *csvPrinter) Print(in interface{}) error {
+	table, ok := in.(*metav1.Table)
+	if !ok {
+		return ErrTableRequired
+	}
+
+	w := csv.NewWriter(p.writer)
+	header := make([]string, len(table.ColumnDefinitions))
+	for i, column := range table.ColumnDefinitions {
+		header[i] = column.Name
+	}
+	if err := w.Write(header); err != nil {
+		return err
+	}
+
+	for _, row := range table.Rows {
+		record := make([]string, len(row.Cells))
+		for i, cell := range row.Cells {
+			record[i] = fmt.Sprint(cell)
+		}
+		if err := w.Write(record); err != nil {
+			return err
+		}
+	}
+
+	w.Flush()
+	return w.Error()
+}
+
+type templatePrinter struct {
+	writer   io.Writer
+	template *template.Template
+}
+
+// Print executes the template against the object.
+func (p *templatePrinter) Print(in interface{}) error {
+	if err := p.template.Execute(p.writer, in); err != nil {
+		return fmt.Errorf("executing template: %w", err)
+	}
+	return nil
+}
+
+type quietPrinter struct {
+	writer io.Writer
+}
+
+// Print writes the IDs of the list one per line, without any header.
+func (p *quietPrinter) Print(in interface{}) error {
+	list, ok := in.(IDLister)
+	if !ok {
+		return ErrIDListerRequired
+	}
+
+	for _, id := range list.IDs() {
+		if _, err := fmt.Fprintln(p.writer, id); err != nil {
+			return err
+		}
+	}
+	return nil
+}
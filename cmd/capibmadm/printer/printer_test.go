@@ -0,0 +1,250 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package printer
+
+import (
+	"bytes"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	. "github.com/onsi/gomega"
+)
+
+type testItem struct {
+	ID        string `json:"id"`
+	Name      string `json:"name"`
+	CreatedAt string `json:"createdAt,omitempty"`
+}
+
+func TestNew(t *testing.T) {
+	testCases := []struct {
+		name        string
+		printerType PType
+		expectErr   error
+	}{
+		{
+			name:        "Should create table printer",
+			printerType: PrinterTypeTable,
+		},
+		{
+			name:        "Should create wide table printer",
+			printerType: PrinterTypeWide,
+		},
+		{
+			name:        "Should create json printer",
+			printerType: PrinterTypeJSON,
+		},
+		{
+			name:        "Should create yaml printer",
+			printerType: PrinterTypeYAML,
+		},
+		{
+			name:        "Should create csv printer",
+			printerType: PrinterTypeCSV,
+		},
+		{
+			name:        "Should fail for unknown printer type",
+			printerType: PType("xml"),
+			expectErr:   ErrUnknowPrinterType,
+		},
+		{
+			name:        "Should fail for template printer type without template",
+			printerType: PrinterTypeTemplate,
+			expectErr:   ErrTemplateRequired,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			_, err := New(tc.printerType, &bytes.Buffer{})
+			if tc.expectErr != nil {
+				g.Expect(err).To(MatchError(tc.expectErr))
+			} else {
+				g.Expect(err).To(BeNil())
+			}
+		})
+	}
+}
+
+func TestPTypeSet(t *testing.T) {
+	g := NewWithT(t)
+	var p PType
+	g.Expect(p.Set("yaml")).To(Succeed())
+	g.Expect(p).To(Equal(PrinterTypeYAML))
+	g.Expect(p.Set("csv")).To(Succeed())
+	g.Expect(p).To(Equal(PrinterTypeCSV))
+	g.Expect(p.Set("template")).To(Succeed())
+	g.Expect(p).To(Equal(PrinterTypeTemplate))
+	g.Expect(p.Set("xml")).To(MatchError(ErrUnknowPrinterType))
+}
+
+func TestYAMLPrinter(t *testing.T) {
+	testCases := []struct {
+		name     string
+		in       interface{}
+		expected string
+	}{
+		{
+			name: "Should print list as yaml",
+			in: []testItem{
+				{ID: "foo-id", Name: "foo", CreatedAt: "2023-01-01T00:00:00Z"},
+				{ID: "bar-id", Name: "bar"},
+			},
+			expected: "- createdAt: \"2023-01-01T00:00:00Z\"\n  id: foo-id\n  name: foo\n- id: bar-id\n  name: bar\n",
+		},
+		{
+			name:     "Should print empty list as yaml",
+			in:       []testItem{},
+			expected: "[]\n",
+		},
+		{
+			name:     "Should print nil list as yaml",
+			in:       []testItem(nil),
+			expected: "null\n",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			buf := &bytes.Buffer{}
+			p, err := New(PrinterTypeYAML, buf)
+			g.Expect(err).To(BeNil())
+			g.Expect(p.Print(tc.in)).To(Succeed())
+			g.Expect(buf.String()).To(Equal(tc.expected))
+		})
+	}
+}
+
+func testTable(rows ...metav1.TableRow) *metav1.Table {
+	return &metav1.Table{
+		ColumnDefinitions: []metav1.TableColumnDefinition{
+			{Name: "ID", Type: "string"},
+			{Name: "NAME", Type: "string"},
+			{Name: "SIZE", Type: "integer"},
+		},
+		Rows: rows,
+	}
+}
+
+func TestCSVPrinter(t *testing.T) {
+	testCases := []struct {
+		name     string
+		in       interface{}
+		expected string
+	}{
+		{
+			name: "Should print table rows as csv",
+			in: testTable(
+				metav1.TableRow{Cells: []interface{}{"foo-id", "foo", int64(10)}},
+				metav1.TableRow{Cells: []interface{}{"bar-id", "bar", int64(20)}},
+			),
+			expected: "ID,NAME,SIZE\nfoo-id,foo,10\nbar-id,bar,20\n",
+		},
+		{
+			name: "Should quote fields containing commas and quotes",
+			in: testTable(
+				metav1.TableRow{Cells: []interface{}{"foo-id", "foo, bar", int64(10)}},
+				metav1.TableRow{Cells: []interface{}{"bar-id", `bar "baz"`, int64(20)}},
+			),
+			expected: "ID,NAME,SIZE\nfoo-id,\"foo, bar\",10\nbar-id,\"bar \"\"baz\"\"\",20\n",
+		},
+		{
+			name:     "Should print only the header for an empty table",
+			in:       testTable(),
+			expected: "ID,NAME,SIZE\n",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			buf := &bytes.Buffer{}
+			p, err := New(PrinterTypeCSV, buf)
+			g.Expect(err).To(BeNil())
+			g.Expect(p.Print(tc.in)).To(Succeed())
+			g.Expect(buf.String()).To(Equal(tc.expected))
+		})
+	}
+
+	t.Run("Error when object is not a table", func(t *testing.T) {
+		g := NewWithT(t)
+		p, err := New(PrinterTypeCSV, &bytes.Buffer{})
+		g.Expect(err).To(BeNil())
+		g.Expect(p.Print([]testItem{})).To(MatchError(ErrTableRequired))
+	})
+}
+
+type testList []testItem
+
+func (l *testList) IDs() []string {
+	ids := make([]string, 0, len(*l))
+	for _, item := range *l {
+		ids = append(ids, item.ID)
+	}
+	return ids
+}
+
+func TestQuietPrinter(t *testing.T) {
+	t.Run("Should print one ID per line", func(t *testing.T) {
+		g := NewWithT(t)
+		buf := &bytes.Buffer{}
+		err := NewQuiet(buf).Print(&testList{{ID: "foo-id", Name: "foo"}, {ID: "bar-id", Name: "bar"}})
+		g.Expect(err).To(BeNil())
+		g.Expect(buf.String()).To(Equal("foo-id\nbar-id\n"))
+	})
+	t.Run("Should print nothing for an empty list", func(t *testing.T) {
+		g := NewWithT(t)
+		buf := &bytes.Buffer{}
+		g.Expect(NewQuiet(buf).Print(&testList{})).To(Succeed())
+		g.Expect(buf.String()).To(BeEmpty())
+	})
+	t.Run("Should fail when object is not an IDLister", func(t *testing.T) {
+		g := NewWithT(t)
+		err := NewQuiet(&bytes.Buffer{}).Print(testTable())
+		g.Expect(err).To(MatchError(ErrIDListerRequired))
+	})
+}
+
+func TestTemplatePrinter(t *testing.T) {
+	t.Run("Should print the fields extracted by the template", func(t *testing.T) {
+		g := NewWithT(t)
+		buf := &bytes.Buffer{}
+		p, err := NewTemplate(`{{range .}}{{.ID}}={{.Name}}{{"\n"}}{{end}}`, buf)
+		g.Expect(err).To(BeNil())
+		g.Expect(p.Print(testList{{ID: "foo-id", Name: "foo"}, {ID: "bar-id", Name: "bar"}})).To(Succeed())
+		g.Expect(buf.String()).To(Equal("foo-id=foo\nbar-id=bar\n"))
+	})
+	t.Run("Should fail before printing a malformed template", func(t *testing.T) {
+		g := NewWithT(t)
+		_, err := NewTemplate(`{{range .}}{{.ID}}`, &bytes.Buffer{})
+		g.Expect(err).To(MatchError(ContainSubstring("parsing template")))
+	})
+	t.Run("Should fail without template", func(t *testing.T) {
+		g := NewWithT(t)
+		_, err := NewTemplate("", &bytes.Buffer{})
+		g.Expect(err).To(MatchError(ErrTemplateRequired))
+	})
+	t.Run("Should fail when the template does not match the object", func(t *testing.T) {
+		g := NewWithT(t)
+		p, err := NewTemplate(`{{range .}}{{.Zone}}{{end}}`, &bytes.Buffer{})
+		g.Expect(err).To(BeNil())
+		g.Expect(p.Print(testList{{ID: "foo-id", Name: "foo"}})).To(MatchError(ContainSubstring("executing template")))
+	})
+}
@@ -23,11 +23,22 @@ import (
 
 	"github.com/go-openapi/strfmt"
 
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/resourcecontrollerv2"
 	"github.com/IBM/platform-services-go-sdk/resourcemanagerv2"
 
+	"k8s.io/utils/ptr"
+
 	"sigs.k8s.io/cluster-api-provider-ibmcloud/cmd/capibmadm/clients/platformservices"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/resourcecontroller"
+	pkgUtils "sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/utils"
 )
 
+// resourceInstanceLister is the subset of the resource controller client used to look up service instances.
+type resourceInstanceLister interface {
+	ListResourceInstancesWithContext(ctx context.Context, listResourceInstancesOptions *resourcecontrollerv2.ListResourceInstancesOptions) (*resourcecontrollerv2.ResourceInstancesList, *core.DetailedResponse, error)
+}
+
 // GetResourceGroupID returns ID of given resource group name.
 func GetResourceGroupID(ctx context.Context, resourceGroup string, accountID string) (string, error) {
 	rmv2, err := platformservices.NewResourceManagerV2Client()
@@ -56,6 +67,78 @@ func GetResourceGroupID(ctx context.Context, resourceGroup string, accountID str
 	return "", err
 }
 
+// ResolveServiceInstanceID returns the given PowerVS service instance ID or, when only the name is given, the GUID of the service instance with that name.
+func ResolveServiceInstanceID(ctx context.Context, serviceInstanceID string, serviceInstanceName string) (string, error) {
+	if (serviceInstanceID == "") == (serviceInstanceName == "") {
+		return "", fmt.Errorf("exactly one of the flags service-instance-id or service-instance-name is required")
+	}
+	if serviceInstanceID != "" {
+		return serviceInstanceID, nil
+	}
+	return GetServiceInstanceID(ctx, serviceInstanceName)
+}
+
+// GetServiceInstanceID returns GUID of given PowerVS service instance name.
+func GetServiceInstanceID(ctx context.Context, serviceInstanceName string) (string, error) {
+	rcv2, err := platformservices.NewResourceControllerV2Client()
+	if err != nil {
+		return "", err
+	}
+
+	if rcv2 == nil {
+		return "", fmt.Errorf("unable to get resource controller")
+	}
+
+	return getServiceInstanceID(ctx, rcv2, serviceInstanceName)
+}
+
+func getServiceInstanceID(ctx context.Context, rcv2 resourceInstanceLister, serviceInstanceName string) (string, error) {
+	var serviceInstances []resourcecontrollerv2.ResourceInstance
+	f := func(start string) (bool, string, error) {
+		listResourceInstancesOpt := &resourcecontrollerv2.ListResourceInstancesOptions{
+			Name:       &serviceInstanceName,
+			ResourceID: ptr.To(resourcecontroller.PowerVSResourceID),
+		}
+		if start != "" {
+			listResourceInstancesOpt.Start = &start
+		}
+
+		resourceInstanceL, _, err := rcv2.ListResourceInstancesWithContext(ctx, listResourceInstancesOpt)
+		if err != nil {
+			return false, "", err
+		}
+		if resourceInstanceL == nil {
+			return true, "", nil
+		}
+		serviceInstances = append(serviceInstances, resourceInstanceL.Resources...)
+
+		nextStart, err := resourceInstanceL.GetNextStart()
+		if err != nil {
+			return false, "", err
+		}
+		if nextStart == nil {
+			return true, "", nil
+		}
+		return false, *nextStart, nil
+	}
+
+	if err := pkgUtils.PagingHelper(ctx, f); err != nil {
+		return "", fmt.Errorf("error listing service instances with name %s: %w", serviceInstanceName, err)
+	}
+
+	switch len(serviceInstances) {
+	case 0:
+		return "", fmt.Errorf("could not retrieve service instance id for %s", serviceInstanceName)
+	case 1:
+		if serviceInstances[0].GUID == nil {
+			return "", fmt.Errorf("could not retrieve service instance id for %s", serviceInstanceName)
+		}
+		return *serviceInstances[0].GUID, nil
+	default:
+		return "", fmt.Errorf("there exist more than one service instance with name %s, use the flag service-instance-id instead", serviceInstanceName)
+	}
+}
+
 // DereferencePointer dereferences pointer.
 func DereferencePointer(value interface{}) interface{} {
 	switch v := value.(type) {
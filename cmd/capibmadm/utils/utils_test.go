@@ -0,0 +1,99 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package utils
+
+import (
+	"context"
+	"testing"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/resourcecontrollerv2"
+
+	"k8s.io/utils/ptr"
+
+	. "github.com/onsi/gomega"
+
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/resourcecontroller"
+)
+
+// fakeResourceInstanceLister returns the service instances matching the requested name and records the requests.
+type fakeResourceInstanceLister struct {
+	instances []resourcecontrollerv2.ResourceInstance
+	requests  []resourcecontrollerv2.ListResourceInstancesOptions
+}
+
+func (f *fakeResourceInstanceLister) ListResourceInstancesWithContext(_ context.Context, options *resourcecontrollerv2.ListResourceInstancesOptions) (*resourcecontrollerv2.ResourceInstancesList, *core.DetailedResponse, error) {
+	f.requests = append(f.requests, *options)
+	resourceInstanceL := &resourcecontrollerv2.ResourceInstancesList{}
+	for _, instance := range f.instances {
+		if *instance.Name == *options.Name {
+			resourceInstanceL.Resources = append(resourceInstanceL.Resources, instance)
+		}
+	}
+	return resourceInstanceL, &core.DetailedResponse{}, nil
+}
+
+func TestGetServiceInstanceID(t *testing.T) {
+	newFakeResourceInstanceLister := func() *fakeResourceInstanceLister {
+		return &fakeResourceInstanceLister{
+			instances: []resourcecontrollerv2.ResourceInstance{
+				{Name: ptr.To("foo-workspace"), GUID: ptr.To("foo-guid")},
+				{Name: ptr.To("bar-workspace"), GUID: ptr.To("bar-guid-1")},
+				{Name: ptr.To("bar-workspace"), GUID: ptr.To("bar-guid-2")},
+			},
+		}
+	}
+
+	t.Run("Should resolve service instance name to its GUID", func(t *testing.T) {
+		g := NewWithT(t)
+		client := newFakeResourceInstanceLister()
+		serviceInstanceID, err := getServiceInstanceID(context.TODO(), client, "foo-workspace")
+		g.Expect(err).To(BeNil())
+		g.Expect(serviceInstanceID).To(Equal("foo-guid"))
+		g.Expect(client.requests).To(HaveLen(1))
+		g.Expect(*client.requests[0].ResourceID).To(Equal(resourcecontroller.PowerVSResourceID))
+	})
+	t.Run("Error when service instance name is not found", func(t *testing.T) {
+		g := NewWithT(t)
+		_, err := getServiceInstanceID(context.TODO(), newFakeResourceInstanceLister(), "missing-workspace")
+		g.Expect(err).To(MatchError(ContainSubstring("could not retrieve service instance id")))
+	})
+	t.Run("Error when service instance name is ambiguous", func(t *testing.T) {
+		g := NewWithT(t)
+		_, err := getServiceInstanceID(context.TODO(), newFakeResourceInstanceLister(), "bar-workspace")
+		g.Expect(err).To(MatchError(ContainSubstring("more than one service instance")))
+	})
+}
+
+func TestResolveServiceInstanceID(t *testing.T) {
+	t.Run("Should pass the service instance ID through", func(t *testing.T) {
+		g := NewWithT(t)
+		serviceInstanceID, err := ResolveServiceInstanceID(context.TODO(), "foo-guid", "")
+		g.Expect(err).To(BeNil())
+		g.Expect(serviceInstanceID).To(Equal("foo-guid"))
+	})
+	t.Run("Error when both service instance ID and name are set", func(t *testing.T) {
+		g := NewWithT(t)
+		_, err := ResolveServiceInstanceID(context.TODO(), "foo-guid", "foo-workspace")
+		g.Expect(err).To(Not(BeNil()))
+	})
+	t.Run("Error when neither service instance ID nor name is set", func(t *testing.T) {
+		g := NewWithT(t)
+		_, err := ResolveServiceInstanceID(context.TODO(), "", "")
+		g.Expect(err).To(Not(BeNil()))
+	})
+}
@@ -138,6 +138,14 @@ func (r *IBMVPCClusterReconciler) reconcile(clusterScope *scope.ClusterScope) (c
 		}
 	}
 
+	if err := clusterScope.ReconcileAddressPrefixes(); err != nil {
+		return ctrl.Result{}, fmt.Errorf("failed to reconcile address prefixes for IBMVPCCluster %s/%s: %w", clusterScope.IBMVPCCluster.Namespace, clusterScope.IBMVPCCluster.Name, err)
+	}
+
+	if err := clusterScope.ReconcileSecurityGroupRules(); err != nil {
+		return ctrl.Result{}, fmt.Errorf("failed to reconcile security group rules for IBMVPCCluster %s/%s: %w", clusterScope.IBMVPCCluster.Namespace, clusterScope.IBMVPCCluster.Name, err)
+	}
+
 	if clusterScope.IBMVPCCluster.Status.Subnet.ID == nil {
 		subnet, err := clusterScope.CreateSubnet()
 		if err != nil {
@@ -152,6 +160,20 @@ func (r *IBMVPCClusterReconciler) reconcile(clusterScope *scope.ClusterScope) (c
 			}
 		}
 	}
+	clusterScope.SetFailureDomains()
+	clusterScope.SetControlPlaneSubnets()
+
+	if err := clusterScope.EnsurePublicGateway(); err != nil {
+		return ctrl.Result{}, fmt.Errorf("failed to reconcile public gateway for IBMVPCCluster %s/%s: %w", clusterScope.IBMVPCCluster.Namespace, clusterScope.IBMVPCCluster.Name, err)
+	}
+
+	if err := clusterScope.EnsureNetworkACL(); err != nil {
+		return ctrl.Result{}, fmt.Errorf("failed to reconcile network ACL for IBMVPCCluster %s/%s: %w", clusterScope.IBMVPCCluster.Namespace, clusterScope.IBMVPCCluster.Name, err)
+	}
+
+	if err := clusterScope.EnsureFlowLogs(); err != nil {
+		return ctrl.Result{}, fmt.Errorf("failed to reconcile flow logs for IBMVPCCluster %s/%s: %w", clusterScope.IBMVPCCluster.Namespace, clusterScope.IBMVPCCluster.Name, err)
+	}
 
 	if clusterScope.IBMVPCCluster.Spec.ControlPlaneLoadBalancer != nil && clusterScope.IBMVPCCluster.Spec.ControlPlaneEndpoint.Host == "" {
 		loadBalancer, err := r.getOrCreate(clusterScope)
@@ -163,6 +185,14 @@ func (r *IBMVPCClusterReconciler) reconcile(clusterScope *scope.ClusterScope) (c
 			clusterScope.IBMVPCCluster.Spec.ControlPlaneEndpoint.Host = *loadBalancer.Hostname
 			r.reconcileLBState(clusterScope, loadBalancer)
 		}
+	} else if clusterScope.IBMVPCCluster.Spec.ControlPlaneLoadBalancer != nil && clusterScope.IBMVPCCluster.Spec.ControlPlaneLoadBalancer.Pool != nil {
+		loadBalancer, err := clusterScope.GetLoadBalancerByHostname(clusterScope.IBMVPCCluster.Spec.ControlPlaneEndpoint.Host)
+		if err != nil {
+			return ctrl.Result{}, fmt.Errorf("error when retrieving load balancer with specified hostname: %w", err)
+		}
+		if err := clusterScope.ReconcileLoadBalancerPool(loadBalancer); err != nil {
+			return ctrl.Result{}, fmt.Errorf("failed to reconcile Control Plane LoadBalancer pool for IBMVPCCluster %s/%s: %w", clusterScope.IBMVPCCluster.Namespace, clusterScope.IBMVPCCluster.Name, err)
+		}
 	}
 
 	// Requeue after 1 minute if cluster is not ready to update status of the cluster properly.
@@ -217,6 +247,14 @@ func (r *IBMVPCClusterReconciler) reconcileDelete(clusterScope *scope.ClusterSco
 		}
 	}
 
+	if err := clusterScope.DeleteFlowLogs(); err != nil {
+		return ctrl.Result{}, fmt.Errorf("failed to delete flow logs: %w", err)
+	}
+
+	if err := clusterScope.DeleteNetworkACL(); err != nil {
+		return ctrl.Result{}, fmt.Errorf("failed to delete network ACL: %w", err)
+	}
+
 	if err := clusterScope.DeleteSubnet(); err != nil {
 		return ctrl.Result{}, fmt.Errorf("failed to delete subnet: %w", err)
 	}
@@ -246,6 +246,7 @@ func TestIBMVPCClusterReconciler_reconcile(t *testing.T) {
 			clusterScope.IBMVPCCluster.Finalizers = []string{infrav1beta2.ClusterFinalizer}
 			mockvpc.EXPECT().ListVpcs(listVpcsOptions).Return(vpclist, response, nil)
 			mockvpc.EXPECT().ListSubnets(subnetOptions).Return(subnets, response, nil)
+			mockvpc.EXPECT().GetSubnetPublicGateway(&vpcv1.GetSubnetPublicGatewayOptions{ID: ptr.To("capi-subnet-id")}).Return(&vpcv1.PublicGateway{ID: ptr.To("capi-pgw-id")}, response, nil)
 			mockvpc.EXPECT().ListLoadBalancers(loadBalancerOptions).Return(loadBalancers, response, nil)
 			_, err := reconciler.reconcile(clusterScope)
 			g.Expect(err).To(BeNil())
@@ -261,6 +262,7 @@ func TestIBMVPCClusterReconciler_reconcile(t *testing.T) {
 			clusterScope.Cluster.Spec.ClusterNetwork = &capiv1beta1.ClusterNetwork{APIServerPort: &port}
 			mockvpc.EXPECT().ListVpcs(listVpcsOptions).Return(vpclist, response, nil)
 			mockvpc.EXPECT().ListSubnets(subnetOptions).Return(subnets, response, nil)
+			mockvpc.EXPECT().GetSubnetPublicGateway(&vpcv1.GetSubnetPublicGatewayOptions{ID: ptr.To("capi-subnet-id")}).Return(&vpcv1.PublicGateway{ID: ptr.To("capi-pgw-id")}, response, nil)
 			mockvpc.EXPECT().ListLoadBalancers(loadBalancerOptions).Return(loadBalancers, response, nil)
 			_, err := reconciler.reconcile(clusterScope)
 			g.Expect(err).To(BeNil())
@@ -275,6 +277,7 @@ func TestIBMVPCClusterReconciler_reconcile(t *testing.T) {
 			clusterScope.IBMVPCCluster.Finalizers = []string{infrav1beta2.ClusterFinalizer}
 			mockvpc.EXPECT().ListVpcs(listVpcsOptions).Return(vpclist, response, nil)
 			mockvpc.EXPECT().ListSubnets(subnetOptions).Return(subnets, response, nil)
+			mockvpc.EXPECT().GetSubnetPublicGateway(&vpcv1.GetSubnetPublicGatewayOptions{ID: ptr.To("capi-subnet-id")}).Return(&vpcv1.PublicGateway{ID: ptr.To("capi-pgw-id")}, response, nil)
 			mockvpc.EXPECT().ListLoadBalancers(loadBalancerOptions).Return(loadBalancers, response, nil)
 			_, err := reconciler.reconcile(clusterScope)
 			g.Expect(err).To(BeNil())
@@ -351,6 +354,7 @@ func TestIBMVPCClusterLBReconciler_reconcile(t *testing.T) {
 			clusterScope.IBMVPCCluster.Finalizers = []string{infrav1beta2.ClusterFinalizer}
 			mockvpc.EXPECT().ListVpcs(&vpcv1.ListVpcsOptions{}).Return(vpclist, &core.DetailedResponse{}, nil)
 			mockvpc.EXPECT().ListSubnets(&vpcv1.ListSubnetsOptions{}).Return(subnets, &core.DetailedResponse{}, nil)
+			mockvpc.EXPECT().GetSubnetPublicGateway(&vpcv1.GetSubnetPublicGatewayOptions{ID: ptr.To("capi-subnet-id")}).Return(&vpcv1.PublicGateway{ID: ptr.To("capi-pgw-id")}, &core.DetailedResponse{}, nil)
 			mockvpc.EXPECT().ListLoadBalancers(&vpcv1.ListLoadBalancersOptions{}).Return(&vpcv1.LoadBalancerCollection{}, &core.DetailedResponse{}, errors.New("Failed to list the LoadBalancers"))
 			_, err := reconciler.reconcile(clusterScope)
 			g.Expect(err).To(Not(BeNil()))
@@ -363,6 +367,7 @@ func TestIBMVPCClusterLBReconciler_reconcile(t *testing.T) {
 			clusterScope.IBMVPCCluster.Finalizers = []string{infrav1beta2.ClusterFinalizer}
 			mockvpc.EXPECT().ListVpcs(&vpcv1.ListVpcsOptions{}).Return(vpclist, &core.DetailedResponse{}, nil)
 			mockvpc.EXPECT().ListSubnets(&vpcv1.ListSubnetsOptions{}).Return(subnets, &core.DetailedResponse{}, nil)
+			mockvpc.EXPECT().GetSubnetPublicGateway(&vpcv1.GetSubnetPublicGatewayOptions{ID: ptr.To("capi-subnet-id")}).Return(&vpcv1.PublicGateway{ID: ptr.To("capi-pgw-id")}, &core.DetailedResponse{}, nil)
 			mockvpc.EXPECT().ListLoadBalancers(&vpcv1.ListLoadBalancersOptions{}).Return(loadBalancerCollection, &core.DetailedResponse{}, nil)
 			_, err := reconciler.reconcile(clusterScope)
 			g.Expect(err).To(BeNil())
@@ -379,6 +384,7 @@ func TestIBMVPCClusterLBReconciler_reconcile(t *testing.T) {
 			clusterScope.Cluster.Spec.ClusterNetwork = &capiv1beta1.ClusterNetwork{APIServerPort: &port}
 			mockvpc.EXPECT().ListVpcs(&vpcv1.ListVpcsOptions{}).Return(vpclist, &core.DetailedResponse{}, nil)
 			mockvpc.EXPECT().ListSubnets(&vpcv1.ListSubnetsOptions{}).Return(subnets, &core.DetailedResponse{}, nil)
+			mockvpc.EXPECT().GetSubnetPublicGateway(&vpcv1.GetSubnetPublicGatewayOptions{ID: ptr.To("capi-subnet-id")}).Return(&vpcv1.PublicGateway{ID: ptr.To("capi-pgw-id")}, &core.DetailedResponse{}, nil)
 			mockvpc.EXPECT().ListLoadBalancers(&vpcv1.ListLoadBalancersOptions{}).Return(loadBalancerCollection, &core.DetailedResponse{}, nil)
 			_, err := reconciler.reconcile(clusterScope)
 			g.Expect(err).To(BeNil())
@@ -396,6 +402,7 @@ func TestIBMVPCClusterLBReconciler_reconcile(t *testing.T) {
 			}
 			mockvpc.EXPECT().ListVpcs(&vpcv1.ListVpcsOptions{}).Return(vpclist, &core.DetailedResponse{}, nil)
 			mockvpc.EXPECT().ListSubnets(&vpcv1.ListSubnetsOptions{}).Return(subnets, &core.DetailedResponse{}, nil)
+			mockvpc.EXPECT().GetSubnetPublicGateway(&vpcv1.GetSubnetPublicGatewayOptions{ID: ptr.To("capi-subnet-id")}).Return(&vpcv1.PublicGateway{ID: ptr.To("capi-pgw-id")}, &core.DetailedResponse{}, nil)
 			mockvpc.EXPECT().ListLoadBalancers(&vpcv1.ListLoadBalancersOptions{}).Return(loadBalancerCollection, &core.DetailedResponse{}, nil)
 			_, err := reconciler.reconcile(clusterScope)
 			g.Expect(err).To(BeNil())
@@ -411,6 +418,7 @@ func TestIBMVPCClusterLBReconciler_reconcile(t *testing.T) {
 			loadBalancerCollection.LoadBalancers[0].ProvisioningStatus = core.StringPtr("create_pending")
 			mockvpc.EXPECT().ListVpcs(&vpcv1.ListVpcsOptions{}).Return(vpclist, &core.DetailedResponse{}, nil)
 			mockvpc.EXPECT().ListSubnets(&vpcv1.ListSubnetsOptions{}).Return(subnets, &core.DetailedResponse{}, nil)
+			mockvpc.EXPECT().GetSubnetPublicGateway(&vpcv1.GetSubnetPublicGatewayOptions{ID: ptr.To("capi-subnet-id")}).Return(&vpcv1.PublicGateway{ID: ptr.To("capi-pgw-id")}, &core.DetailedResponse{}, nil)
 			mockvpc.EXPECT().ListLoadBalancers(&vpcv1.ListLoadBalancersOptions{}).Return(loadBalancerCollection, &core.DetailedResponse{}, nil)
 			_, err := reconciler.reconcile(clusterScope)
 			g.Expect(err).To(BeNil())
@@ -425,6 +433,7 @@ func TestIBMVPCClusterLBReconciler_reconcile(t *testing.T) {
 			loadBalancerCollection.LoadBalancers[0].ProvisioningStatus = core.StringPtr("update_pending")
 			mockvpc.EXPECT().ListVpcs(&vpcv1.ListVpcsOptions{}).Return(vpclist, &core.DetailedResponse{}, nil)
 			mockvpc.EXPECT().ListSubnets(&vpcv1.ListSubnetsOptions{}).Return(subnets, &core.DetailedResponse{}, nil)
+			mockvpc.EXPECT().GetSubnetPublicGateway(&vpcv1.GetSubnetPublicGatewayOptions{ID: ptr.To("capi-subnet-id")}).Return(&vpcv1.PublicGateway{ID: ptr.To("capi-pgw-id")}, &core.DetailedResponse{}, nil)
 			mockvpc.EXPECT().ListLoadBalancers(&vpcv1.ListLoadBalancersOptions{}).Return(loadBalancerCollection, &core.DetailedResponse{}, nil)
 			_, err := reconciler.reconcile(clusterScope)
 			g.Expect(err).To(BeNil())
@@ -464,6 +473,14 @@ func TestIBMVPCClusterReconciler_delete(t *testing.T) {
 					VPC: infrav1beta2.VPC{
 						ID: "capi-vpc-id",
 					},
+					Network: &infrav1beta2.VPCNetworkStatus{
+						PublicGateways: map[string]infrav1beta2.ResourceReference{
+							"capi-zone": {
+								ID:                ptr.To("capi-pgw-id"),
+								ControllerCreated: ptr.To(true),
+							},
+						},
+					},
 				},
 			},
 		}
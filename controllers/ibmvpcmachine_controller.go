@@ -18,6 +18,7 @@ package controllers
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"time"
 
@@ -36,6 +37,7 @@ import (
 
 	capiv1beta1 "sigs.k8s.io/cluster-api/api/v1beta1"
 	"sigs.k8s.io/cluster-api/util"
+	"sigs.k8s.io/cluster-api/util/conditions"
 
 	infrav1beta2 "sigs.k8s.io/cluster-api-provider-ibmcloud/api/v1beta2"
 	"sigs.k8s.io/cluster-api-provider-ibmcloud/cloud/scope"
@@ -49,6 +51,11 @@ type IBMVPCMachineReconciler struct {
 	Recorder        record.EventRecorder
 	ServiceEndpoint []endpoints.ServiceEndpoint
 	Scheme          *runtime.Scheme
+
+	// WaitForRunning makes the controller requeue until the created instance is running before configuring it.
+	WaitForRunning bool
+	// WaitForRunningTimeout is the maximum time since its creation for the instance to be running.
+	WaitForRunningTimeout time.Duration
 }
 
 // +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=ibmvpcmachines,verbs=get;list;watch;create;update;patch;delete
@@ -109,6 +116,9 @@ func (r *IBMVPCMachineReconciler) Reconcile(ctx context.Context, req ctrl.Reques
 		Machine:         machine,
 		IBMVPCMachine:   ibmVpcMachine,
 		ServiceEndpoint: r.ServiceEndpoint,
+
+		WaitForRunning:        r.WaitForRunning,
+		WaitForRunningTimeout: r.WaitForRunningTimeout,
 	})
 	if err != nil {
 		return ctrl.Result{}, fmt.Errorf("failed to create scope: %w", err)
@@ -129,7 +139,7 @@ func (r *IBMVPCMachineReconciler) Reconcile(ctx context.Context, req ctrl.Reques
 	}
 
 	// Handle non-deleted machines.
-	return r.reconcileNormal(machineScope)
+	return r.reconcileNormal(ctx, machineScope)
 }
 
 // SetupWithManager creates a new IBMVPCMachine controller for a manager.
@@ -139,7 +149,7 @@ func (r *IBMVPCMachineReconciler) SetupWithManager(mgr ctrl.Manager) error {
 		Complete(r)
 }
 
-func (r *IBMVPCMachineReconciler) reconcileNormal(machineScope *scope.MachineScope) (ctrl.Result, error) {
+func (r *IBMVPCMachineReconciler) reconcileNormal(ctx context.Context, machineScope *scope.MachineScope) (ctrl.Result, error) {
 	if controllerutil.AddFinalizer(machineScope.IBMVPCMachine, infrav1beta2.MachineFinalizer) {
 		return ctrl.Result{}, nil
 	}
@@ -147,77 +157,196 @@ func (r *IBMVPCMachineReconciler) reconcileNormal(machineScope *scope.MachineSco
 	// Make sure bootstrap data is available and populated.
 	if machineScope.Machine.Spec.Bootstrap.DataSecretName == nil {
 		machineScope.Info("Bootstrap data secret reference is not yet available")
+		conditions.MarkFalse(machineScope.IBMVPCMachine, infrav1beta2.BootstrapDataReadyCondition, infrav1beta2.WaitingForBootstrapDataReason, capiv1beta1.ConditionSeverityInfo, "")
 		return ctrl.Result{RequeueAfter: 1 * time.Minute}, nil
 	}
-
-	if machineScope.IBMVPCCluster.Status.Subnet.ID != nil {
-		machineScope.IBMVPCMachine.Spec.PrimaryNetworkInterface = infrav1beta2.NetworkInterface{
-			Subnet: *machineScope.IBMVPCCluster.Status.Subnet.ID,
+	conditions.MarkTrue(machineScope.IBMVPCMachine, infrav1beta2.BootstrapDataReadyCondition)
+
+	// The webhook defaults the primary network interface subnet from the network status of the cluster, a machine admitted
+	// before the cluster network was ready or spread across several control plane subnets is given one of them here.
+	if machineScope.IBMVPCMachine.Spec.PrimaryNetworkInterface.Subnet == "" {
+		subnetID, err := machineScope.SelectSubnet()
+		if err != nil {
+			return ctrl.Result{}, fmt.Errorf("failed to select subnet for IBMVPCMachine %s/%s: %w", machineScope.IBMVPCMachine.Namespace, machineScope.IBMVPCMachine.Name, err)
 		}
+		machineScope.IBMVPCMachine.Spec.PrimaryNetworkInterface.Subnet = subnetID
 	}
 
 	instance, err := r.getOrCreate(machineScope)
 	if err != nil {
+		conditions.MarkFalse(machineScope.IBMVPCMachine, infrav1beta2.InstanceProvisionedCondition, infrav1beta2.InstanceProvisionFailedReason, capiv1beta1.ConditionSeverityError, err.Error())
 		return ctrl.Result{}, fmt.Errorf("failed to reconcile VSI for IBMVPCMachine %s/%s: %w", machineScope.IBMVPCMachine.Namespace, machineScope.IBMVPCMachine.Name, err)
 	}
 
 	if instance != nil {
+		conditions.MarkTrue(machineScope.IBMVPCMachine, infrav1beta2.InstanceProvisionedCondition)
 		machineScope.IBMVPCMachine.Status.InstanceID = *instance.ID
+		if instance.Zone != nil && instance.Zone.Name != nil {
+			machineScope.IBMVPCMachine.Status.Zone = *instance.Zone.Name
+			machineScope.IBMVPCMachine.Spec.FailureDomain = instance.Zone.Name
+		}
+		if instance.Profile != nil && instance.Profile.Name != nil {
+			machineScope.IBMVPCMachine.Status.Profile = *instance.Profile.Name
+		}
+		if err := machineScope.CheckInstanceRunning(instance); err != nil {
+			if errors.Is(err, scope.ErrInstanceNotRunning) {
+				machineScope.Info("Instance is not running yet, requeuing", "error", err.Error())
+				conditions.MarkFalse(machineScope.IBMVPCMachine, infrav1beta2.InstanceReadyCondition, infrav1beta2.InstanceNotReadyReason, capiv1beta1.ConditionSeverityInfo, err.Error())
+				return ctrl.Result{RequeueAfter: 1 * time.Minute}, nil
+			}
+			conditions.MarkFalse(machineScope.IBMVPCMachine, infrav1beta2.InstanceReadyCondition, infrav1beta2.InstanceNotReadyReason, capiv1beta1.ConditionSeverityError, err.Error())
+			return ctrl.Result{}, fmt.Errorf("failed to wait for instance of IBMVPCMachine %s/%s to be running: %w", machineScope.IBMVPCMachine.Namespace, machineScope.IBMVPCMachine.Name, err)
+		}
+		if machineScope.WaitForRunning && conditions.Has(machineScope.IBMVPCMachine, infrav1beta2.InstanceReadyCondition) {
+			conditions.MarkTrue(machineScope.IBMVPCMachine, infrav1beta2.InstanceReadyCondition)
+		}
 		machineScope.IBMVPCMachine.Status.Addresses = []corev1.NodeAddress{
 			{
 				Type:    corev1.NodeInternalIP,
 				Address: *instance.PrimaryNetworkInterface.PrimaryIP.Address,
 			},
 		}
-		_, ok := machineScope.IBMVPCMachine.Labels[capiv1beta1.MachineControlPlaneNameLabel]
-		if err = machineScope.SetProviderID(instance.ID); err != nil {
+		if err := machineScope.EnsureFloatingIP(instance); err != nil {
+			return ctrl.Result{}, fmt.Errorf("failed to reconcile floating IP for IBMVPCMachine %s/%s: %w", machineScope.IBMVPCMachine.Namespace, machineScope.IBMVPCMachine.Name, err)
+		}
+		// Tagging is eventually consistent, so failures are retried on a later reconcile instead of failing this one.
+		requeueTags, requeueHealth := false, false
+		if err := machineScope.ReconcileTags(instance); err != nil {
+			machineScope.Error(err, "failed to reconcile tags, will retry")
+			requeueTags = true
+		}
+		if err = machineScope.SetProviderID(ctx, instance); err != nil {
 			return ctrl.Result{}, fmt.Errorf("failed to set provider id IBMVPCMachine %s/%s: %w", machineScope.IBMVPCMachine.Namespace, machineScope.IBMVPCMachine.Name, err)
 		}
-		if ok {
+		if targets := machineScope.LoadBalancerPoolMemberTargets(); len(targets) > 0 {
 			if instance.PrimaryNetworkInterface.PrimaryIP.Address == nil || *instance.PrimaryNetworkInterface.PrimaryIP.Address == "0.0.0.0" {
 				return ctrl.Result{}, fmt.Errorf("invalid primary ip address")
 			}
 			internalIP := instance.PrimaryNetworkInterface.PrimaryIP.Address
-			port := int64(machineScope.APIServerPort())
-			poolMember, err := machineScope.CreateVPCLoadBalancerPoolMember(internalIP, port)
+			poolMembers, err := machineScope.CreateVPCLoadBalancerPoolMembers(internalIP, targets)
+			if errors.Is(err, scope.ErrLoadBalancerNotReady) {
+				machineScope.Info("Load balancer is not ready, requeuing", "error", err.Error())
+				conditions.MarkFalse(machineScope.IBMVPCMachine, infrav1beta2.LoadBalancerPoolMemberReadyCondition, infrav1beta2.LoadBalancerNotReadyReason, capiv1beta1.ConditionSeverityInfo, err.Error())
+				return ctrl.Result{RequeueAfter: 1 * time.Minute}, nil
+			}
 			if err != nil {
-				return ctrl.Result{}, fmt.Errorf("failed to bind port %d to control plane %s/%s: %w", port, machineScope.IBMVPCMachine.Namespace, machineScope.IBMVPCMachine.Name, err)
+				conditions.MarkFalse(machineScope.IBMVPCMachine, infrav1beta2.LoadBalancerPoolMemberReadyCondition, infrav1beta2.LoadBalancerPoolMemberCreationFailedReason, capiv1beta1.ConditionSeverityError, err.Error())
+				return ctrl.Result{}, fmt.Errorf("failed to bind load balancer pool members to %s/%s: %w", machineScope.IBMVPCMachine.Namespace, machineScope.IBMVPCMachine.Name, err)
 			}
-			if poolMember != nil && *poolMember.ProvisioningStatus != string(infrav1beta2.VPCLoadBalancerStateActive) {
-				return ctrl.Result{RequeueAfter: 1 * time.Minute}, nil
+			for _, poolMember := range poolMembers {
+				if *poolMember.ProvisioningStatus != string(infrav1beta2.VPCLoadBalancerStateActive) {
+					conditions.MarkFalse(machineScope.IBMVPCMachine, infrav1beta2.LoadBalancerPoolMemberReadyCondition, infrav1beta2.LoadBalancerPoolMemberNotReadyReason, capiv1beta1.ConditionSeverityInfo, "pool member is in %s state", *poolMember.ProvisioningStatus)
+					return ctrl.Result{RequeueAfter: 1 * time.Minute}, nil
+				}
+			}
+			conditions.MarkTrue(machineScope.IBMVPCMachine, infrav1beta2.LoadBalancerPoolMemberReadyCondition)
+			if requeueHealth, err = r.reconcileLoadBalancerPoolMemberHealth(machineScope); err != nil {
+				return ctrl.Result{}, err
 			}
 		}
 		machineScope.IBMVPCMachine.Status.Ready = true
+		if requeueTags || requeueHealth {
+			return ctrl.Result{RequeueAfter: 1 * time.Minute}, nil
+		}
 	}
 
 	return ctrl.Result{}, nil
 }
 
+// reconcileLoadBalancerPoolMemberHealth reports the health of the load balancer pool member of the machine as a condition.
+// A member failing its health checks is left in the pool, it returns true while the member is not healthy to recheck it later.
+func (r *IBMVPCMachineReconciler) reconcileLoadBalancerPoolMemberHealth(machineScope *scope.MachineScope) (bool, error) {
+	member, err := machineScope.GetVPCLoadBalancerPoolMember("")
+	if err != nil {
+		return false, fmt.Errorf("failed to get load balancer pool member of IBMVPCMachine %s/%s: %w", machineScope.IBMVPCMachine.Namespace, machineScope.IBMVPCMachine.Name, err)
+	}
+	if member == nil || member.Health == nil {
+		return false, nil
+	}
+
+	switch *member.Health {
+	case vpcv1.LoadBalancerPoolMemberHealthOkConst:
+		conditions.MarkTrue(machineScope.IBMVPCMachine, infrav1beta2.LoadBalancerPoolMemberHealthyCondition)
+		return false, nil
+	case vpcv1.LoadBalancerPoolMemberHealthFaultedConst:
+		machineScope.Info("Load balancer pool member is unhealthy", "memberID", *member.ID)
+		conditions.MarkFalse(machineScope.IBMVPCMachine, infrav1beta2.LoadBalancerPoolMemberHealthyCondition, infrav1beta2.LoadBalancerPoolMemberUnhealthyReason, capiv1beta1.ConditionSeverityWarning, "pool member is failing its health checks")
+	default:
+		conditions.MarkFalse(machineScope.IBMVPCMachine, infrav1beta2.LoadBalancerPoolMemberHealthyCondition, infrav1beta2.LoadBalancerPoolMemberHealthUnknownReason, capiv1beta1.ConditionSeverityInfo, "pool member health is %s", *member.Health)
+	}
+	return true, nil
+}
+
 func (r *IBMVPCMachineReconciler) getOrCreate(scope *scope.MachineScope) (*vpcv1.Instance, error) {
 	instance, err := scope.CreateMachine()
 	return instance, err
 }
 
-func (r *IBMVPCMachineReconciler) reconcileDelete(scope *scope.MachineScope) (_ ctrl.Result, reterr error) {
-	scope.Info("Handling deleted IBMVPCMachine")
+func (r *IBMVPCMachineReconciler) reconcileDelete(machineScope *scope.MachineScope) (_ ctrl.Result, reterr error) {
+	machineScope.Info("Handling deleted IBMVPCMachine")
 
-	if _, ok := scope.IBMVPCMachine.Labels[capiv1beta1.MachineControlPlaneNameLabel]; ok {
-		if err := scope.DeleteVPCLoadBalancerPoolMember(); err != nil {
+	_, controlPlane := machineScope.IBMVPCMachine.Labels[capiv1beta1.MachineControlPlaneNameLabel]
+	if controlPlane || len(machineScope.IBMVPCMachine.Spec.LoadBalancerPoolMembers) > 0 || len(machineScope.IBMVPCMachine.Status.LoadBalancerPoolMembers) > 0 {
+		if err := machineScope.DeleteVPCLoadBalancerPoolMember(); err != nil {
+			if errors.Is(err, scope.ErrDeletionProtected) {
+				return r.handleDeletionProtected(machineScope, err)
+			}
+			if errors.Is(err, scope.ErrLoadBalancerNotReady) {
+				machineScope.Info("Load balancer is not ready, requeuing", "error", err.Error())
+				return ctrl.Result{RequeueAfter: 1 * time.Minute}, nil
+			}
 			return ctrl.Result{}, fmt.Errorf("failed to delete loadBalancer pool member: %w", err)
 		}
 	}
 
-	if err := scope.DeleteMachine(); err != nil {
-		scope.Info("error deleting IBMVPCMachine")
-		return ctrl.Result{}, fmt.Errorf("error deleting IBMVPCMachine %s/%s: %w", scope.IBMVPCMachine.Namespace, scope.IBMVPCMachine.Spec.Name, err)
+	if err := machineScope.DeleteMachine(); err != nil {
+		if errors.Is(err, scope.ErrDeletionProtected) {
+			return r.handleDeletionProtected(machineScope, err)
+		}
+		if errors.Is(err, scope.ErrInstanceStopping) {
+			return r.handleInstanceStopping(machineScope, err)
+		}
+		if errors.Is(err, scope.ErrInstanceDeleting) {
+			return r.handleInstanceDeleting(machineScope, err)
+		}
+		machineScope.Info("error deleting IBMVPCMachine")
+		return ctrl.Result{}, fmt.Errorf("error deleting IBMVPCMachine %s/%s: %w", machineScope.IBMVPCMachine.Namespace, machineScope.IBMVPCMachine.Spec.Name, err)
 	}
 
 	defer func() {
 		if reterr == nil {
 			// VSI is deleted so remove the finalizer.
-			controllerutil.RemoveFinalizer(scope.IBMVPCMachine, infrav1beta2.MachineFinalizer)
+			controllerutil.RemoveFinalizer(machineScope.IBMVPCMachine, infrav1beta2.MachineFinalizer)
 		}
 	}()
 
 	return ctrl.Result{}, nil
 }
+
+// handleDeletionProtected reports on the machine that its instance is not deleted because deletion protection is
+// enabled, and requeues so that the deletion resumes once deletion protection is unset.
+func (r *IBMVPCMachineReconciler) handleDeletionProtected(machineScope *scope.MachineScope, err error) (ctrl.Result, error) {
+	machineScope.Info("Instance is protected from deletion, requeuing", "error", err.Error())
+	conditions.MarkFalse(machineScope.IBMVPCMachine, infrav1beta2.InstanceDeletedCondition, infrav1beta2.InstanceDeletionProtectedReason, capiv1beta1.ConditionSeverityWarning, "deletion protection is enabled, unset it to delete the instance")
+	return ctrl.Result{RequeueAfter: 1 * time.Minute}, nil
+}
+
+// handleInstanceStopping reports on the machine that its instance is stopped before it is deleted, and requeues so
+// that the deletion resumes once the instance is stopped. The last transition of the condition records when the stop
+// was requested, so it is reset when the condition is set for another reason.
+func (r *IBMVPCMachineReconciler) handleInstanceStopping(machineScope *scope.MachineScope, err error) (ctrl.Result, error) {
+	machineScope.Info("Instance is stopping, requeuing", "error", err.Error())
+	if conditions.GetReason(machineScope.IBMVPCMachine, infrav1beta2.InstanceDeletedCondition) != infrav1beta2.InstanceStoppingReason {
+		conditions.Delete(machineScope.IBMVPCMachine, infrav1beta2.InstanceDeletedCondition)
+	}
+	conditions.MarkFalse(machineScope.IBMVPCMachine, infrav1beta2.InstanceDeletedCondition, infrav1beta2.InstanceStoppingReason, capiv1beta1.ConditionSeverityInfo, "waiting for the instance to stop before deleting it")
+	return ctrl.Result{RequeueAfter: 1 * time.Minute}, nil
+}
+
+// handleInstanceDeleting reports on the machine that its instance is being deleted, and requeues so that the reserved
+// IPs of the machine are cleaned up and the finalizer is removed once the instance is gone.
+func (r *IBMVPCMachineReconciler) handleInstanceDeleting(machineScope *scope.MachineScope, err error) (ctrl.Result, error) {
+	machineScope.Info("Instance is deleting, requeuing", "error", err.Error())
+	conditions.MarkFalse(machineScope.IBMVPCMachine, infrav1beta2.InstanceDeletedCondition, infrav1beta2.InstanceDeletingReason, capiv1beta1.ConditionSeverityInfo, "waiting for the instance to be deleted")
+	return ctrl.Result{RequeueAfter: 1 * time.Minute}, nil
+}
@@ -19,11 +19,13 @@ package controllers
 import (
 	"errors"
 	"fmt"
+	"net/http"
 	"testing"
 	"time"
 
 	"github.com/IBM/go-sdk-core/v5/core"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/go-openapi/strfmt"
 	"go.uber.org/mock/gomock"
 
 	corev1 "k8s.io/api/core/v1"
@@ -32,6 +34,7 @@ import (
 	"k8s.io/utils/ptr"
 	capiv1beta1 "sigs.k8s.io/cluster-api/api/v1beta1"
 	"sigs.k8s.io/cluster-api/util"
+	"sigs.k8s.io/cluster-api/util/conditions"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
@@ -62,7 +65,9 @@ func TestIBMVPCMachineReconciler_Reconcile(t *testing.T) {
 					Name: "vpc-test-1",
 				},
 				Spec: infrav1beta2.IBMVPCMachineSpec{
-					Image: &infrav1beta2.IBMVPCResourceReference{},
+					Image: &infrav1beta2.IBMVPCResourceReference{
+						ID: ptr.To("capi-image"),
+					},
 				},
 			},
 			expectError: false,
@@ -82,7 +87,9 @@ func TestIBMVPCMachineReconciler_Reconcile(t *testing.T) {
 					},
 				},
 				Spec: infrav1beta2.IBMVPCMachineSpec{
-					Image: &infrav1beta2.IBMVPCResourceReference{},
+					Image: &infrav1beta2.IBMVPCResourceReference{
+						ID: ptr.To("capi-image"),
+					},
 				},
 			},
 			expectError: true,
@@ -101,7 +108,9 @@ func TestIBMVPCMachineReconciler_Reconcile(t *testing.T) {
 					},
 				},
 				Spec: infrav1beta2.IBMVPCMachineSpec{
-					Image: &infrav1beta2.IBMVPCResourceReference{},
+					Image: &infrav1beta2.IBMVPCResourceReference{
+						ID: ptr.To("capi-image"),
+					},
 				},
 			},
 			ownerMachine: &capiv1beta1.Machine{
@@ -134,7 +143,9 @@ func TestIBMVPCMachineReconciler_Reconcile(t *testing.T) {
 					},
 				},
 				Spec: infrav1beta2.IBMVPCMachineSpec{
-					Image: &infrav1beta2.IBMVPCResourceReference{},
+					Image: &infrav1beta2.IBMVPCResourceReference{
+						ID: ptr.To("capi-image"),
+					},
 				},
 			},
 			ownerMachine: &capiv1beta1.Machine{
@@ -254,11 +265,12 @@ func TestIBMVPCMachineReconciler_reconcile(t *testing.T) {
 			g := NewWithT(t)
 			setup(t)
 			t.Cleanup(teardown)
-			_, err := reconciler.reconcileNormal(machineScope)
+			_, err := reconciler.reconcileNormal(ctx, machineScope)
 			g.Expect(err).To(BeNil())
 			g.Expect(machineScope.IBMVPCMachine.Finalizers).To(ContainElement(infrav1beta2.MachineFinalizer))
+			expectConditionsVPCMachine(g, machineScope.IBMVPCMachine, []conditionAssertion{{infrav1beta2.BootstrapDataReadyCondition, corev1.ConditionFalse, capiv1beta1.ConditionSeverityInfo, infrav1beta2.WaitingForBootstrapDataReason}})
 		})
-		options := &vpcv1.ListInstancesOptions{}
+		options := &vpcv1.ListInstancesOptions{Name: ptr.To("capi-machine")}
 		response := &core.DetailedResponse{}
 		instancelist := &vpcv1.InstanceCollection{}
 		t.Run("Should fail reconcile IBMVPCMachine", func(t *testing.T) {
@@ -268,9 +280,101 @@ func TestIBMVPCMachineReconciler_reconcile(t *testing.T) {
 			machineScope.Machine.Spec.Bootstrap.DataSecretName = ptr.To("capi-machine")
 			machineScope.IBMVPCCluster.Status.Subnet.ID = ptr.To("capi-subnet-id")
 			mockvpc.EXPECT().ListInstances(options).Return(instancelist, response, errors.New("Failed to create or fetch instance"))
-			_, err := reconciler.reconcileNormal(machineScope)
+			_, err := reconciler.reconcileNormal(ctx, machineScope)
 			g.Expect(err).To(Not(BeNil()))
 			g.Expect(machineScope.IBMVPCMachine.Finalizers).To(ContainElement(infrav1beta2.MachineFinalizer))
+			expectConditionsVPCMachine(g, machineScope.IBMVPCMachine, []conditionAssertion{
+				{conditionType: infrav1beta2.BootstrapDataReadyCondition, status: corev1.ConditionTrue},
+				{infrav1beta2.InstanceProvisionedCondition, corev1.ConditionFalse, capiv1beta1.ConditionSeverityError, infrav1beta2.InstanceProvisionFailedReason},
+			})
+		})
+		t.Run("Should select the cluster subnet for a control-plane machine admitted without a subnet", func(t *testing.T) {
+			g := NewWithT(t)
+			setup(t)
+			t.Cleanup(teardown)
+			machineScope.Machine.Spec.Bootstrap.DataSecretName = ptr.To("capi-machine")
+			machineScope.IBMVPCCluster.Status.Subnet.ID = ptr.To("capi-subnet-id")
+			mockvpc.EXPECT().ListInstances(options).Return(instancelist, response, errors.New("Failed to create or fetch instance"))
+			_, err := reconciler.reconcileNormal(ctx, machineScope)
+			g.Expect(err).To(Not(BeNil()))
+			g.Expect(machineScope.IBMVPCMachine.Spec.PrimaryNetworkInterface.Subnet).To(Equal("capi-subnet-id"))
+		})
+		t.Run("Should select the cluster subnet for a worker machine admitted without a subnet", func(t *testing.T) {
+			g := NewWithT(t)
+			setup(t)
+			t.Cleanup(teardown)
+			delete(machineScope.IBMVPCMachine.Labels, capiv1beta1.MachineControlPlaneNameLabel)
+			machineScope.Machine.Spec.Bootstrap.DataSecretName = ptr.To("capi-machine")
+			machineScope.IBMVPCCluster.Status.Subnet.ID = ptr.To("capi-subnet-id")
+			mockvpc.EXPECT().ListInstances(options).Return(instancelist, response, errors.New("Failed to create or fetch instance"))
+			_, err := reconciler.reconcileNormal(ctx, machineScope)
+			g.Expect(err).To(Not(BeNil()))
+			g.Expect(machineScope.IBMVPCMachine.Spec.PrimaryNetworkInterface.Subnet).To(Equal("capi-subnet-id"))
+		})
+		t.Run("Should not select a subnet for a machine with a subnet", func(t *testing.T) {
+			g := NewWithT(t)
+			setup(t)
+			t.Cleanup(teardown)
+			machineScope.Machine.Spec.Bootstrap.DataSecretName = ptr.To("capi-machine")
+			machineScope.IBMVPCMachine.Spec.PrimaryNetworkInterface.Subnet = "capi-machine-subnet-id"
+			machineScope.IBMVPCCluster.Status.Subnet.ID = ptr.To("capi-subnet-id")
+			mockvpc.EXPECT().ListInstances(options).Return(instancelist, response, errors.New("Failed to create or fetch instance"))
+			_, err := reconciler.reconcileNormal(ctx, machineScope)
+			g.Expect(err).To(Not(BeNil()))
+			g.Expect(machineScope.IBMVPCMachine.Spec.PrimaryNetworkInterface.Subnet).To(Equal("capi-machine-subnet-id"))
+		})
+		t.Run("Should requeue while waiting for the instance to be running", func(t *testing.T) {
+			g := NewWithT(t)
+			setup(t)
+			t.Cleanup(teardown)
+			machineScope.Machine.Spec.Bootstrap.DataSecretName = ptr.To("capi-machine")
+			machineScope.IBMVPCCluster.Status.Subnet.ID = ptr.To("capi-subnet-id")
+			machineScope.WaitForRunning = true
+			instancelist := &vpcv1.InstanceCollection{
+				Instances: []vpcv1.Instance{
+					{
+						Name:      ptr.To("capi-machine"),
+						ID:        ptr.To("capi-machine-id"),
+						Status:    ptr.To(vpcv1.InstanceStatusStartingConst),
+						CreatedAt: ptr.To(strfmt.DateTime(time.Now())),
+					},
+				},
+			}
+			mockvpc.EXPECT().ListInstances(options).Return(instancelist, response, nil)
+			result, err := reconciler.reconcileNormal(ctx, machineScope)
+			g.Expect(err).To(BeNil())
+			g.Expect(result.RequeueAfter).To(Not(BeZero()))
+			g.Expect(machineScope.IBMVPCMachine.Status.InstanceID).To(Equal("capi-machine-id"))
+			g.Expect(machineScope.IBMVPCMachine.Status.Ready).To(BeFalse())
+			expectConditionsVPCMachine(g, machineScope.IBMVPCMachine, []conditionAssertion{
+				{conditionType: infrav1beta2.InstanceProvisionedCondition, status: corev1.ConditionTrue},
+				{infrav1beta2.InstanceReadyCondition, corev1.ConditionFalse, capiv1beta1.ConditionSeverityInfo, infrav1beta2.InstanceNotReadyReason},
+			})
+		})
+		t.Run("Should fail when the instance is not running before timeout", func(t *testing.T) {
+			g := NewWithT(t)
+			setup(t)
+			t.Cleanup(teardown)
+			machineScope.Machine.Spec.Bootstrap.DataSecretName = ptr.To("capi-machine")
+			machineScope.IBMVPCCluster.Status.Subnet.ID = ptr.To("capi-subnet-id")
+			machineScope.WaitForRunning = true
+			machineScope.WaitForRunningTimeout = time.Minute
+			instancelist := &vpcv1.InstanceCollection{
+				Instances: []vpcv1.Instance{
+					{
+						Name:      ptr.To("capi-machine"),
+						ID:        ptr.To("capi-machine-id"),
+						Status:    ptr.To(vpcv1.InstanceStatusStartingConst),
+						CreatedAt: ptr.To(strfmt.DateTime(time.Now().Add(-2 * time.Minute))),
+					},
+				},
+			}
+			mockvpc.EXPECT().ListInstances(options).Return(instancelist, response, nil)
+			_, err := reconciler.reconcileNormal(ctx, machineScope)
+			g.Expect(err).To(Not(BeNil()))
+			expectConditionsVPCMachine(g, machineScope.IBMVPCMachine, []conditionAssertion{
+				{infrav1beta2.InstanceReadyCondition, corev1.ConditionFalse, capiv1beta1.ConditionSeverityError, infrav1beta2.InstanceNotReadyReason},
+			})
 		})
 	})
 }
@@ -335,6 +439,12 @@ func TestIBMVPCMachineLBReconciler_reconcile(t *testing.T) {
 						},
 						ID: ptr.To("capi-net"),
 					},
+					Zone: &vpcv1.ZoneReference{
+						Name: ptr.To("us-south-1"),
+					},
+					Profile: &vpcv1.InstanceProfileReference{
+						Name: ptr.To("bx2-2x8"),
+					},
 				},
 			},
 		}
@@ -367,7 +477,7 @@ func TestIBMVPCMachineLBReconciler_reconcile(t *testing.T) {
 				},
 			}
 			mockvpc.EXPECT().ListInstances(gomock.AssignableToTypeOf(&vpcv1.ListInstancesOptions{})).Return(customInstancelist, &core.DetailedResponse{}, nil)
-			_, err := reconciler.reconcileNormal(machineScope)
+			_, err := reconciler.reconcileNormal(ctx, machineScope)
 			g.Expect(err).To((Not(BeNil())))
 			g.Expect(machineScope.IBMVPCMachine.Finalizers).To(ContainElement(infrav1beta2.MachineFinalizer))
 		})
@@ -378,9 +488,13 @@ func TestIBMVPCMachineLBReconciler_reconcile(t *testing.T) {
 			mockvpc.EXPECT().ListInstances(gomock.AssignableToTypeOf(&vpcv1.ListInstancesOptions{})).Return(instancelist, &core.DetailedResponse{}, nil)
 			mockvpc.EXPECT().GetLoadBalancer(gomock.AssignableToTypeOf(&vpcv1.GetLoadBalancerOptions{})).Return(loadBalancer, &core.DetailedResponse{}, nil)
 			mockvpc.EXPECT().ListLoadBalancerPoolMembers(gomock.AssignableToTypeOf(&vpcv1.ListLoadBalancerPoolMembersOptions{})).Return(&vpcv1.LoadBalancerPoolMemberCollection{}, &core.DetailedResponse{}, errors.New("failed to list loadBalancerPoolMembers"))
-			_, err := reconciler.reconcileNormal(machineScope)
+			_, err := reconciler.reconcileNormal(ctx, machineScope)
 			g.Expect(err).To(Not(BeNil()))
 			g.Expect(machineScope.IBMVPCMachine.Finalizers).To(ContainElement(infrav1beta2.MachineFinalizer))
+			expectConditionsVPCMachine(g, machineScope.IBMVPCMachine, []conditionAssertion{
+				{conditionType: infrav1beta2.InstanceProvisionedCondition, status: corev1.ConditionTrue},
+				{infrav1beta2.LoadBalancerPoolMemberReadyCondition, corev1.ConditionFalse, capiv1beta1.ConditionSeverityError, infrav1beta2.LoadBalancerPoolMemberCreationFailedReason},
+			})
 		})
 		t.Run("Should successfully reconcile IBMVPCMachine and set machine status as NotReady when PoolMember is not in active state", func(t *testing.T) {
 			g := NewWithT(t)
@@ -394,10 +508,11 @@ func TestIBMVPCMachineLBReconciler_reconcile(t *testing.T) {
 			mockvpc.EXPECT().GetLoadBalancer(gomock.AssignableToTypeOf(&vpcv1.GetLoadBalancerOptions{})).Return(loadBalancer, &core.DetailedResponse{}, nil)
 			mockvpc.EXPECT().ListLoadBalancerPoolMembers(gomock.AssignableToTypeOf(&vpcv1.ListLoadBalancerPoolMembersOptions{})).Return(&vpcv1.LoadBalancerPoolMemberCollection{}, &core.DetailedResponse{}, nil)
 			mockvpc.EXPECT().CreateLoadBalancerPoolMember(gomock.AssignableToTypeOf(&vpcv1.CreateLoadBalancerPoolMemberOptions{})).Return(customloadBalancerPoolMember, &core.DetailedResponse{}, nil)
-			_, err := reconciler.reconcileNormal(machineScope)
+			_, err := reconciler.reconcileNormal(ctx, machineScope)
 			g.Expect(err).To(BeNil())
 			g.Expect(machineScope.IBMVPCMachine.Finalizers).To(ContainElement(infrav1beta2.MachineFinalizer))
 			g.Expect(machineScope.IBMVPCMachine.Status.Ready).To(Equal(false))
+			expectConditionsVPCMachine(g, machineScope.IBMVPCMachine, []conditionAssertion{{infrav1beta2.LoadBalancerPoolMemberReadyCondition, corev1.ConditionFalse, capiv1beta1.ConditionSeverityInfo, infrav1beta2.LoadBalancerPoolMemberNotReadyReason}})
 		})
 		t.Run("Should successfully reconcile IBMVPCMachine", func(t *testing.T) {
 			g := NewWithT(t)
@@ -408,13 +523,110 @@ func TestIBMVPCMachineLBReconciler_reconcile(t *testing.T) {
 				ProvisioningStatus: core.StringPtr("active"),
 			}
 			mockvpc.EXPECT().ListInstances(gomock.AssignableToTypeOf(&vpcv1.ListInstancesOptions{})).Return(instancelist, &core.DetailedResponse{}, nil)
-			mockvpc.EXPECT().GetLoadBalancer(gomock.AssignableToTypeOf(&vpcv1.GetLoadBalancerOptions{})).Return(loadBalancer, &core.DetailedResponse{}, nil)
+			mockvpc.EXPECT().GetLoadBalancer(gomock.AssignableToTypeOf(&vpcv1.GetLoadBalancerOptions{})).Return(loadBalancer, &core.DetailedResponse{}, nil).Times(2)
 			mockvpc.EXPECT().ListLoadBalancerPoolMembers(gomock.AssignableToTypeOf(&vpcv1.ListLoadBalancerPoolMembersOptions{})).Return(&vpcv1.LoadBalancerPoolMemberCollection{}, &core.DetailedResponse{}, nil)
 			mockvpc.EXPECT().CreateLoadBalancerPoolMember(gomock.AssignableToTypeOf(&vpcv1.CreateLoadBalancerPoolMemberOptions{})).Return(loadBalancerPoolMember, &core.DetailedResponse{}, nil)
-			_, err := reconciler.reconcileNormal(machineScope)
+			mockvpc.EXPECT().GetLoadBalancerPoolMember(&vpcv1.GetLoadBalancerPoolMemberOptions{
+				LoadBalancerID: core.StringPtr("vpc-load-balancer-id"),
+				PoolID:         core.StringPtr("foo-pool-id"),
+				ID:             core.StringPtr("foo-member-id"),
+			}).Return(&vpcv1.LoadBalancerPoolMember{ID: core.StringPtr("foo-member-id"), Health: core.StringPtr(vpcv1.LoadBalancerPoolMemberHealthOkConst)}, &core.DetailedResponse{}, nil)
+			result, err := reconciler.reconcileNormal(ctx, machineScope)
 			g.Expect(err).To(BeNil())
+			g.Expect(result.RequeueAfter).To(BeZero())
 			g.Expect(machineScope.IBMVPCMachine.Finalizers).To(ContainElement(infrav1beta2.MachineFinalizer))
 			g.Expect(machineScope.IBMVPCMachine.Status.Ready).To(Equal(true))
+			g.Expect(machineScope.IBMVPCMachine.Status.Zone).To(Equal("us-south-1"))
+			g.Expect(machineScope.IBMVPCMachine.Spec.FailureDomain).To(Equal(ptr.To("us-south-1")))
+			g.Expect(machineScope.IBMVPCMachine.Status.Profile).To(Equal("bx2-2x8"))
+			expectConditionsVPCMachine(g, machineScope.IBMVPCMachine, []conditionAssertion{
+				{conditionType: infrav1beta2.BootstrapDataReadyCondition, status: corev1.ConditionTrue},
+				{conditionType: infrav1beta2.InstanceProvisionedCondition, status: corev1.ConditionTrue},
+				{conditionType: infrav1beta2.LoadBalancerPoolMemberReadyCondition, status: corev1.ConditionTrue},
+				{conditionType: infrav1beta2.LoadBalancerPoolMemberHealthyCondition, status: corev1.ConditionTrue},
+			})
+		})
+		t.Run("Should bind PoolMember on the custom API server port", func(t *testing.T) {
+			g := NewWithT(t)
+			mockController, mockvpc, machineScope, reconciler := setup(t)
+			t.Cleanup(mockController.Finish)
+			machineScope.Cluster.Spec.ClusterNetwork = &capiv1beta1.ClusterNetwork{APIServerPort: ptr.To(int32(7443))}
+			mockvpc.EXPECT().ListInstances(gomock.AssignableToTypeOf(&vpcv1.ListInstancesOptions{})).Return(instancelist, &core.DetailedResponse{}, nil)
+			mockvpc.EXPECT().GetLoadBalancer(gomock.AssignableToTypeOf(&vpcv1.GetLoadBalancerOptions{})).Return(loadBalancer, &core.DetailedResponse{}, nil).Times(2)
+			mockvpc.EXPECT().ListLoadBalancerPoolMembers(gomock.AssignableToTypeOf(&vpcv1.ListLoadBalancerPoolMembersOptions{})).Return(&vpcv1.LoadBalancerPoolMemberCollection{}, &core.DetailedResponse{}, nil)
+			mockvpc.EXPECT().CreateLoadBalancerPoolMember(gomock.AssignableToTypeOf(&vpcv1.CreateLoadBalancerPoolMemberOptions{})).DoAndReturn(func(options *vpcv1.CreateLoadBalancerPoolMemberOptions) (*vpcv1.LoadBalancerPoolMember, *core.DetailedResponse, error) {
+				g.Expect(*options.Port).To(Equal(int64(7443)))
+				return &vpcv1.LoadBalancerPoolMember{ID: core.StringPtr("foo-member-id"), ProvisioningStatus: core.StringPtr("active")}, &core.DetailedResponse{}, nil
+			})
+			mockvpc.EXPECT().GetLoadBalancerPoolMember(gomock.AssignableToTypeOf(&vpcv1.GetLoadBalancerPoolMemberOptions{})).Return(&vpcv1.LoadBalancerPoolMember{ID: core.StringPtr("foo-member-id"), Health: core.StringPtr(vpcv1.LoadBalancerPoolMemberHealthOkConst)}, &core.DetailedResponse{}, nil)
+			_, err := reconciler.reconcileNormal(ctx, machineScope)
+			g.Expect(err).To(BeNil())
+			g.Expect(machineScope.IBMVPCMachine.Status.Ready).To(Equal(true))
+		})
+		t.Run("Should bind PoolMember with the weight of the pool member target", func(t *testing.T) {
+			g := NewWithT(t)
+			mockController, mockvpc, machineScope, reconciler := setup(t)
+			t.Cleanup(mockController.Finish)
+			machineScope.IBMVPCMachine.Spec.LoadBalancerPoolMembers = []infrav1beta2.VPCLoadBalancerPoolMemberTarget{
+				{
+					Port:   int64(infrav1beta2.DefaultAPIServerPort),
+					Weight: ptr.To(int64(20)),
+				},
+			}
+			mockvpc.EXPECT().ListInstances(gomock.AssignableToTypeOf(&vpcv1.ListInstancesOptions{})).Return(instancelist, &core.DetailedResponse{}, nil)
+			mockvpc.EXPECT().GetLoadBalancer(gomock.AssignableToTypeOf(&vpcv1.GetLoadBalancerOptions{})).Return(loadBalancer, &core.DetailedResponse{}, nil).Times(2)
+			mockvpc.EXPECT().ListLoadBalancerPoolMembers(gomock.AssignableToTypeOf(&vpcv1.ListLoadBalancerPoolMembersOptions{})).Return(&vpcv1.LoadBalancerPoolMemberCollection{}, &core.DetailedResponse{}, nil)
+			mockvpc.EXPECT().CreateLoadBalancerPoolMember(gomock.AssignableToTypeOf(&vpcv1.CreateLoadBalancerPoolMemberOptions{})).DoAndReturn(func(options *vpcv1.CreateLoadBalancerPoolMemberOptions) (*vpcv1.LoadBalancerPoolMember, *core.DetailedResponse, error) {
+				g.Expect(*options.Weight).To(Equal(int64(20)))
+				return &vpcv1.LoadBalancerPoolMember{ID: core.StringPtr("foo-member-id"), ProvisioningStatus: core.StringPtr("active")}, &core.DetailedResponse{}, nil
+			})
+			mockvpc.EXPECT().GetLoadBalancerPoolMember(gomock.AssignableToTypeOf(&vpcv1.GetLoadBalancerPoolMemberOptions{})).Return(&vpcv1.LoadBalancerPoolMember{ID: core.StringPtr("foo-member-id"), Health: core.StringPtr(vpcv1.LoadBalancerPoolMemberHealthOkConst)}, &core.DetailedResponse{}, nil)
+			_, err := reconciler.reconcileNormal(ctx, machineScope)
+			g.Expect(err).To(BeNil())
+			g.Expect(machineScope.IBMVPCMachine.Status.Ready).To(Equal(true))
+		})
+		t.Run("Should requeue and report unhealthy PoolMember without deleting it", func(t *testing.T) {
+			g := NewWithT(t)
+			mockController, mockvpc, machineScope, reconciler := setup(t)
+			t.Cleanup(mockController.Finish)
+			loadBalancerPoolMember := &vpcv1.LoadBalancerPoolMember{
+				ID:                 core.StringPtr("foo-member-id"),
+				ProvisioningStatus: core.StringPtr("active"),
+			}
+			mockvpc.EXPECT().ListInstances(gomock.AssignableToTypeOf(&vpcv1.ListInstancesOptions{})).Return(instancelist, &core.DetailedResponse{}, nil)
+			mockvpc.EXPECT().GetLoadBalancer(gomock.AssignableToTypeOf(&vpcv1.GetLoadBalancerOptions{})).Return(loadBalancer, &core.DetailedResponse{}, nil).Times(2)
+			mockvpc.EXPECT().ListLoadBalancerPoolMembers(gomock.AssignableToTypeOf(&vpcv1.ListLoadBalancerPoolMembersOptions{})).Return(&vpcv1.LoadBalancerPoolMemberCollection{}, &core.DetailedResponse{}, nil)
+			mockvpc.EXPECT().CreateLoadBalancerPoolMember(gomock.AssignableToTypeOf(&vpcv1.CreateLoadBalancerPoolMemberOptions{})).Return(loadBalancerPoolMember, &core.DetailedResponse{}, nil)
+			mockvpc.EXPECT().GetLoadBalancerPoolMember(gomock.AssignableToTypeOf(&vpcv1.GetLoadBalancerPoolMemberOptions{})).Return(&vpcv1.LoadBalancerPoolMember{ID: core.StringPtr("foo-member-id"), Health: core.StringPtr(vpcv1.LoadBalancerPoolMemberHealthFaultedConst)}, &core.DetailedResponse{}, nil)
+			result, err := reconciler.reconcileNormal(ctx, machineScope)
+			g.Expect(err).To(BeNil())
+			g.Expect(result.RequeueAfter).To(Equal(1 * time.Minute))
+			g.Expect(machineScope.IBMVPCMachine.Status.Ready).To(Equal(true))
+			g.Expect(machineScope.IBMVPCMachine.Status.LoadBalancerPoolMemberID).To(Equal("foo-member-id"))
+			expectConditionsVPCMachine(g, machineScope.IBMVPCMachine, []conditionAssertion{
+				{conditionType: infrav1beta2.LoadBalancerPoolMemberReadyCondition, status: corev1.ConditionTrue},
+				{infrav1beta2.LoadBalancerPoolMemberHealthyCondition, corev1.ConditionFalse, capiv1beta1.ConditionSeverityWarning, infrav1beta2.LoadBalancerPoolMemberUnhealthyReason},
+			})
+		})
+		t.Run("Should requeue while PoolMember health is still unknown", func(t *testing.T) {
+			g := NewWithT(t)
+			mockController, mockvpc, machineScope, reconciler := setup(t)
+			t.Cleanup(mockController.Finish)
+			loadBalancerPoolMember := &vpcv1.LoadBalancerPoolMember{
+				ID:                 core.StringPtr("foo-member-id"),
+				ProvisioningStatus: core.StringPtr("active"),
+			}
+			mockvpc.EXPECT().ListInstances(gomock.AssignableToTypeOf(&vpcv1.ListInstancesOptions{})).Return(instancelist, &core.DetailedResponse{}, nil)
+			mockvpc.EXPECT().GetLoadBalancer(gomock.AssignableToTypeOf(&vpcv1.GetLoadBalancerOptions{})).Return(loadBalancer, &core.DetailedResponse{}, nil).Times(2)
+			mockvpc.EXPECT().ListLoadBalancerPoolMembers(gomock.AssignableToTypeOf(&vpcv1.ListLoadBalancerPoolMembersOptions{})).Return(&vpcv1.LoadBalancerPoolMemberCollection{}, &core.DetailedResponse{}, nil)
+			mockvpc.EXPECT().CreateLoadBalancerPoolMember(gomock.AssignableToTypeOf(&vpcv1.CreateLoadBalancerPoolMemberOptions{})).Return(loadBalancerPoolMember, &core.DetailedResponse{}, nil)
+			mockvpc.EXPECT().GetLoadBalancerPoolMember(gomock.AssignableToTypeOf(&vpcv1.GetLoadBalancerPoolMemberOptions{})).Return(&vpcv1.LoadBalancerPoolMember{ID: core.StringPtr("foo-member-id"), Health: core.StringPtr(vpcv1.LoadBalancerPoolMemberHealthUnknownConst)}, &core.DetailedResponse{}, nil)
+			result, err := reconciler.reconcileNormal(ctx, machineScope)
+			g.Expect(err).To(BeNil())
+			g.Expect(result.RequeueAfter).To(Equal(1 * time.Minute))
+			expectConditionsVPCMachine(g, machineScope.IBMVPCMachine, []conditionAssertion{
+				{infrav1beta2.LoadBalancerPoolMemberHealthyCondition, corev1.ConditionFalse, capiv1beta1.ConditionSeverityInfo, infrav1beta2.LoadBalancerPoolMemberHealthUnknownReason},
+			})
 		})
 	})
 }
@@ -454,26 +666,123 @@ func TestIBMVPCMachineReconciler_Delete(t *testing.T) {
 	}
 
 	options := &vpcv1.DeleteInstanceOptions{ID: ptr.To("capi-instance-id")}
+	runningInstance := &vpcv1.Instance{Status: ptr.To(vpcv1.InstanceStatusRunningConst)}
+	notFound := &core.DetailedResponse{StatusCode: http.StatusNotFound}
 	t.Run("Reconciling deleting IBMVPCMachine", func(t *testing.T) {
 		t.Run("Should fail to delete VPC machine", func(t *testing.T) {
 			g := NewWithT(t)
 			setup(t)
 			t.Cleanup(teardown)
+			mockvpc.EXPECT().GetInstance(gomock.AssignableToTypeOf(&vpcv1.GetInstanceOptions{})).Return(runningInstance, &core.DetailedResponse{}, nil)
 			mockvpc.EXPECT().DeleteInstance(gomock.AssignableToTypeOf(options)).Return(nil, errors.New("Failed to delete the VPC instance"))
 			_, err := reconciler.reconcileDelete(machineScope)
 			g.Expect(err).To(Not(BeNil()))
 			g.Expect(machineScope.IBMVPCMachine.Finalizers).To(ContainElement(infrav1beta2.MachineFinalizer))
 		})
-		t.Run("Should successfully delete VPC machine and remove the finalizer", func(t *testing.T) {
+		t.Run("Should requeue deletion until VPC machine is gone and then remove the finalizer", func(t *testing.T) {
+			g := NewWithT(t)
+			setup(t)
+			t.Cleanup(teardown)
+			gomock.InOrder(
+				mockvpc.EXPECT().GetInstance(gomock.AssignableToTypeOf(&vpcv1.GetInstanceOptions{})).Return(runningInstance, &core.DetailedResponse{}, nil),
+				mockvpc.EXPECT().DeleteInstance(gomock.AssignableToTypeOf(options)).Return(&core.DetailedResponse{}, nil),
+				mockvpc.EXPECT().GetInstance(gomock.AssignableToTypeOf(&vpcv1.GetInstanceOptions{})).Return(&vpcv1.Instance{Status: ptr.To(vpcv1.InstanceStatusDeletingConst)}, &core.DetailedResponse{}, nil),
+				mockvpc.EXPECT().GetInstance(gomock.AssignableToTypeOf(&vpcv1.GetInstanceOptions{})).Return(nil, notFound, errors.New("Instance not found")),
+			)
+
+			for i := 0; i < 2; i++ {
+				result, err := reconciler.reconcileDelete(machineScope)
+				g.Expect(err).To(BeNil())
+				g.Expect(result.RequeueAfter).To(Not(BeZero()))
+				g.Expect(machineScope.IBMVPCMachine.Finalizers).To(ContainElement(infrav1beta2.MachineFinalizer))
+				g.Expect(conditions.GetReason(machineScope.IBMVPCMachine, infrav1beta2.InstanceDeletedCondition)).To(Equal(infrav1beta2.InstanceDeletingReason))
+			}
+
+			_, err := reconciler.reconcileDelete(machineScope)
+			g.Expect(err).To(BeNil())
+			g.Expect(machineScope.IBMVPCMachine.Finalizers).To(Not(ContainElement(infrav1beta2.MachineFinalizer)))
+		})
+		t.Run("Should remove the finalizer when VPC machine is already deleted", func(t *testing.T) {
+			g := NewWithT(t)
+			setup(t)
+			t.Cleanup(teardown)
+			mockvpc.EXPECT().GetInstance(gomock.AssignableToTypeOf(&vpcv1.GetInstanceOptions{})).Return(nil, notFound, errors.New("Instance not found"))
+			_, err := reconciler.reconcileDelete(machineScope)
+			g.Expect(err).To(BeNil())
+			g.Expect(machineScope.IBMVPCMachine.Finalizers).To(Not(ContainElement(infrav1beta2.MachineFinalizer)))
+		})
+		t.Run("Should keep VPC machine and its finalizer when deletion protection is enabled", func(t *testing.T) {
+			g := NewWithT(t)
+			setup(t)
+			t.Cleanup(teardown)
+			machineScope.IBMVPCMachine.Spec.DeletionProtection = true
+			mockvpc.EXPECT().DeleteInstance(gomock.Any()).Times(0)
+			result, err := reconciler.reconcileDelete(machineScope)
+			g.Expect(err).To(BeNil())
+			g.Expect(result.RequeueAfter).To(Not(BeZero()))
+			g.Expect(machineScope.IBMVPCMachine.Finalizers).To(ContainElement(infrav1beta2.MachineFinalizer))
+			g.Expect(conditions.GetReason(machineScope.IBMVPCMachine, infrav1beta2.InstanceDeletedCondition)).To(Equal(infrav1beta2.InstanceDeletionProtectedReason))
+		})
+		t.Run("Should delete VPC machine once deletion protection is unset", func(t *testing.T) {
 			g := NewWithT(t)
 			setup(t)
 			t.Cleanup(teardown)
-			response := &core.DetailedResponse{}
-			mockvpc.EXPECT().DeleteInstance(gomock.AssignableToTypeOf(options)).Return(response, nil)
+			machineScope.IBMVPCMachine.Spec.DeletionProtection = true
 			_, err := reconciler.reconcileDelete(machineScope)
 			g.Expect(err).To(BeNil())
+			g.Expect(machineScope.IBMVPCMachine.Finalizers).To(ContainElement(infrav1beta2.MachineFinalizer))
+
+			machineScope.IBMVPCMachine.Spec.DeletionProtection = false
+			mockvpc.EXPECT().GetInstance(gomock.AssignableToTypeOf(&vpcv1.GetInstanceOptions{})).Return(nil, notFound, errors.New("Instance not found"))
+			_, err = reconciler.reconcileDelete(machineScope)
+			g.Expect(err).To(BeNil())
+			g.Expect(machineScope.IBMVPCMachine.Finalizers).To(Not(ContainElement(infrav1beta2.MachineFinalizer)))
+		})
+		t.Run("Should requeue deletion while stopping VPC machine and delete it once stopped", func(t *testing.T) {
+			g := NewWithT(t)
+			setup(t)
+			t.Cleanup(teardown)
+			machineScope.IBMVPCMachine.Spec.StopBeforeDelete = true
+			// A condition left by deletion protection must not count as the start of the stop.
+			conditions.MarkFalse(machineScope.IBMVPCMachine, infrav1beta2.InstanceDeletedCondition, infrav1beta2.InstanceDeletionProtectedReason, capiv1beta1.ConditionSeverityWarning, "")
+			conditions.Get(machineScope.IBMVPCMachine, infrav1beta2.InstanceDeletedCondition).LastTransitionTime = metav1.NewTime(time.Now().Add(-time.Hour))
+			gomock.InOrder(
+				mockvpc.EXPECT().GetInstance(gomock.AssignableToTypeOf(&vpcv1.GetInstanceOptions{})).Return(&vpcv1.Instance{Status: ptr.To(vpcv1.InstanceStatusRunningConst)}, &core.DetailedResponse{}, nil),
+				mockvpc.EXPECT().CreateInstanceAction(gomock.AssignableToTypeOf(&vpcv1.CreateInstanceActionOptions{})).Return(&vpcv1.InstanceAction{}, &core.DetailedResponse{}, nil),
+				mockvpc.EXPECT().GetInstance(gomock.AssignableToTypeOf(&vpcv1.GetInstanceOptions{})).Return(&vpcv1.Instance{Status: ptr.To(vpcv1.InstanceStatusStoppingConst)}, &core.DetailedResponse{}, nil),
+				mockvpc.EXPECT().GetInstance(gomock.AssignableToTypeOf(&vpcv1.GetInstanceOptions{})).Return(&vpcv1.Instance{Status: ptr.To(vpcv1.InstanceStatusStoppedConst)}, &core.DetailedResponse{}, nil),
+				mockvpc.EXPECT().DeleteInstance(gomock.AssignableToTypeOf(options)).Return(&core.DetailedResponse{}, nil),
+				mockvpc.EXPECT().GetInstance(gomock.AssignableToTypeOf(&vpcv1.GetInstanceOptions{})).Return(nil, notFound, errors.New("Instance not found")),
+			)
+
+			for i := 0; i < 2; i++ {
+				result, err := reconciler.reconcileDelete(machineScope)
+				g.Expect(err).To(BeNil())
+				g.Expect(result.RequeueAfter).To(Not(BeZero()))
+				g.Expect(machineScope.IBMVPCMachine.Finalizers).To(ContainElement(infrav1beta2.MachineFinalizer))
+				g.Expect(conditions.GetReason(machineScope.IBMVPCMachine, infrav1beta2.InstanceDeletedCondition)).To(Equal(infrav1beta2.InstanceStoppingReason))
+			}
+
+			result, err := reconciler.reconcileDelete(machineScope)
+			g.Expect(err).To(BeNil())
+			g.Expect(result.RequeueAfter).To(Not(BeZero()))
+			g.Expect(conditions.GetReason(machineScope.IBMVPCMachine, infrav1beta2.InstanceDeletedCondition)).To(Equal(infrav1beta2.InstanceDeletingReason))
+
+			_, err = reconciler.reconcileDelete(machineScope)
+			g.Expect(err).To(BeNil())
 			g.Expect(machineScope.IBMVPCMachine.Finalizers).To(Not(ContainElement(infrav1beta2.MachineFinalizer)))
 		})
+		t.Run("Should fail to delete VPC machine on server error", func(t *testing.T) {
+			g := NewWithT(t)
+			setup(t)
+			t.Cleanup(teardown)
+			mockvpc.EXPECT().GetInstance(gomock.AssignableToTypeOf(&vpcv1.GetInstanceOptions{})).Return(runningInstance, &core.DetailedResponse{}, nil)
+			response := &core.DetailedResponse{StatusCode: http.StatusInternalServerError}
+			mockvpc.EXPECT().DeleteInstance(gomock.AssignableToTypeOf(options)).Return(response, errors.New("Internal error"))
+			_, err := reconciler.reconcileDelete(machineScope)
+			g.Expect(err).To(Not(BeNil()))
+			g.Expect(machineScope.IBMVPCMachine.Finalizers).To(ContainElement(infrav1beta2.MachineFinalizer))
+		})
 	})
 }
 
@@ -526,13 +835,20 @@ func TestIBMVPCMachineLBReconciler_Delete(t *testing.T) {
 				},
 			},
 		}
+		instance := &vpcv1.Instance{
+			PrimaryNetworkInterface: &vpcv1.NetworkInterfaceInstanceContextReference{
+				PrimaryIP: &vpcv1.ReservedIPReference{
+					Address: core.StringPtr("192.168.1.1"),
+				},
+			},
+		}
 
 		t.Run("Should fail to delete VPC LoadBalancerPoolMember", func(t *testing.T) {
 			g := NewWithT(t)
 			mockController, mockvpc, machineScope, reconciler := setup(t)
 			t.Cleanup(mockController.Finish)
 			mockvpc.EXPECT().GetLoadBalancer(gomock.AssignableToTypeOf(&vpcv1.GetLoadBalancerOptions{})).Return(loadBalancer, &core.DetailedResponse{}, nil)
-			mockvpc.EXPECT().GetInstance(gomock.AssignableToTypeOf(&vpcv1.GetInstanceOptions{})).Return(&vpcv1.Instance{}, &core.DetailedResponse{}, nil)
+			mockvpc.EXPECT().GetInstance(gomock.AssignableToTypeOf(&vpcv1.GetInstanceOptions{})).Return(instance, &core.DetailedResponse{}, nil)
 			mockvpc.EXPECT().ListLoadBalancerPoolMembers(gomock.AssignableToTypeOf(&vpcv1.ListLoadBalancerPoolMembersOptions{})).Return(&vpcv1.LoadBalancerPoolMemberCollection{}, &core.DetailedResponse{}, errors.New("failed to list LoadBalancerPoolMembers"))
 			_, err := reconciler.reconcileDelete(machineScope)
 			g.Expect(err).To((Not(BeNil())))
@@ -543,12 +859,24 @@ func TestIBMVPCMachineLBReconciler_Delete(t *testing.T) {
 			mockController, mockvpc, machineScope, reconciler := setup(t)
 			t.Cleanup(mockController.Finish)
 			mockvpc.EXPECT().GetLoadBalancer(gomock.AssignableToTypeOf(&vpcv1.GetLoadBalancerOptions{})).Return(loadBalancer, &core.DetailedResponse{}, nil)
-			mockvpc.EXPECT().GetInstance(gomock.AssignableToTypeOf(&vpcv1.GetInstanceOptions{})).Return(&vpcv1.Instance{}, &core.DetailedResponse{}, nil)
+			mockvpc.EXPECT().GetInstance(gomock.AssignableToTypeOf(&vpcv1.GetInstanceOptions{})).Return(instance, &core.DetailedResponse{}, nil)
 			mockvpc.EXPECT().ListLoadBalancerPoolMembers(gomock.AssignableToTypeOf(&vpcv1.ListLoadBalancerPoolMembersOptions{})).Return(&vpcv1.LoadBalancerPoolMemberCollection{}, &core.DetailedResponse{}, nil)
-			mockvpc.EXPECT().DeleteInstance(gomock.AssignableToTypeOf(&vpcv1.DeleteInstanceOptions{})).Return(&core.DetailedResponse{}, nil)
+			mockvpc.EXPECT().GetInstance(gomock.AssignableToTypeOf(&vpcv1.GetInstanceOptions{})).Return(nil, &core.DetailedResponse{StatusCode: http.StatusNotFound}, errors.New("Instance not found"))
 			_, err := reconciler.reconcileDelete(machineScope)
 			g.Expect(err).To(BeNil())
 			g.Expect(machineScope.IBMVPCMachine.Finalizers).To(Not(ContainElement(infrav1beta2.MachineFinalizer)))
 		})
 	})
 }
+
+func expectConditionsVPCMachine(g *WithT, m *infrav1beta2.IBMVPCMachine, expected []conditionAssertion) {
+	g.Expect(len(m.Status.Conditions)).To(BeNumerically(">=", len(expected)))
+	for _, c := range expected {
+		actual := conditions.Get(m, c.conditionType)
+		g.Expect(actual).To(Not(BeNil()))
+		g.Expect(actual.Type).To(Equal(c.conditionType))
+		g.Expect(actual.Status).To(Equal(c.status))
+		g.Expect(actual.Severity).To(Equal(c.severity))
+		g.Expect(actual.Reason).To(Equal(c.reason))
+	}
+}
@@ -42,7 +42,9 @@ import (
 
 	infrav1beta1 "sigs.k8s.io/cluster-api-provider-ibmcloud/api/v1beta1"
 	infrav1beta2 "sigs.k8s.io/cluster-api-provider-ibmcloud/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/cloud/scope"
 	"sigs.k8s.io/cluster-api-provider-ibmcloud/controllers"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/vpc"
 	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/endpoints"
 	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/options"
 	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/record"
@@ -59,6 +61,9 @@ var (
 	webhookPort          int
 	webhookCertDir       string
 
+	vpcMachineWaitForRunning        bool
+	vpcMachineWaitForRunningTimeout time.Duration
+
 	scheme   = runtime.NewScheme()
 	setupLog = ctrl.Log.WithName("setup")
 )
@@ -111,6 +116,13 @@ func initFlags(fs *pflag.FlagSet) {
 		"ProviderID format is used set the Provider ID format for Machine",
 	)
 
+	fs.BoolVar(
+		&options.ProviderIDAccountFromCRN,
+		"provider-id-account-from-crn",
+		false,
+		"Read the account ID of the v2 Provider ID format from the CRN of the VPC instance instead of looking it up with the configured credentials.",
+	)
+
 	fs.StringVar(
 		&endpoints.ServiceEndpointFormat,
 		"service-endpoint",
@@ -118,6 +130,41 @@ func initFlags(fs *pflag.FlagSet) {
 		"Set custom service endpoint in semi-colon separated format: ${ServiceRegion1}:${ServiceID1}=${URL1},${ServiceID2}=${URL2};${ServiceRegion2}:${ServiceID1}=${URL1}",
 	)
 
+	fs.DurationVar(
+		&vpc.SubnetCacheTTL,
+		"vpc-subnet-cache-ttl",
+		30*time.Second,
+		"The duration for which VPC subnets looked up by name are cached.",
+	)
+
+	fs.Float64Var(
+		&vpc.APIRateLimit,
+		"vpc-api-rate-limit",
+		10,
+		"The maximum number of requests per second sent to the VPC API, shared by all the controllers.",
+	)
+
+	fs.DurationVar(
+		&scope.LoadBalancerActiveTimeout,
+		"vpc-load-balancer-active-timeout",
+		30*time.Second,
+		"The maximum time to wait for a pending VPC load balancer to become active before changing its pool members, 0 disables waiting.",
+	)
+
+	fs.BoolVar(
+		&vpcMachineWaitForRunning,
+		"vpc-machine-wait-for-running",
+		false,
+		"Wait for VPC machine instances to be running before adding them to the load balancer pools, the machines are requeued until then.",
+	)
+
+	fs.DurationVar(
+		&vpcMachineWaitForRunningTimeout,
+		"vpc-machine-wait-for-running-timeout",
+		5*time.Minute,
+		"The maximum time since its creation for a VPC machine instance to be running when waiting for it, the machine reports an error afterwards.",
+	)
+
 	fs.IntVar(&webhookPort,
 		"webhook-port",
 		9443,
@@ -136,8 +183,22 @@ func validateFlags() error {
 		setupLog.Info("Using v1 version of ProviderID format")
 	case options.ProviderIDFormatV2:
 		setupLog.Info("Using v2 version of ProviderID format")
+	case options.ProviderIDFormatV3:
+		setupLog.Info("Using v3 version of ProviderID format")
 	default:
-		return fmt.Errorf("invalid value for flag provider-id-fmt: %s, Supported values: %s, %s ", options.ProviderIDFormat, options.ProviderIDFormatV1, options.ProviderIDFormatV2)
+		return fmt.Errorf("invalid value for flag provider-id-fmt: %s, Supported values: %s, %s, %s ", options.ProviderIDFormat, options.ProviderIDFormatV1, options.ProviderIDFormatV2, options.ProviderIDFormatV3)
+	}
+	if vpc.SubnetCacheTTL <= 0 {
+		return fmt.Errorf("invalid value for flag vpc-subnet-cache-ttl: %s, must be greater than 0", vpc.SubnetCacheTTL)
+	}
+	if vpc.APIRateLimit <= 0 {
+		return fmt.Errorf("invalid value for flag vpc-api-rate-limit: %v, must be greater than 0", vpc.APIRateLimit)
+	}
+	if scope.LoadBalancerActiveTimeout < 0 {
+		return fmt.Errorf("invalid value for flag vpc-load-balancer-active-timeout: %s, must not be negative", scope.LoadBalancerActiveTimeout)
+	}
+	if vpcMachineWaitForRunningTimeout <= 0 {
+		return fmt.Errorf("invalid value for flag vpc-machine-wait-for-running-timeout: %s, must be greater than 0", vpcMachineWaitForRunningTimeout)
 	}
 	return nil
 }
@@ -250,6 +311,9 @@ func setupReconcilers(ctx context.Context, mgr ctrl.Manager, serviceEndpoint []e
 		Recorder:        mgr.GetEventRecorderFor("ibmvpcmachine-controller"),
 		ServiceEndpoint: serviceEndpoint,
 		Scheme:          mgr.GetScheme(),
+
+		WaitForRunning:        vpcMachineWaitForRunning,
+		WaitForRunningTimeout: vpcMachineWaitForRunningTimeout,
 	}).SetupWithManager(mgr); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "IBMVPCMachine")
 		os.Exit(1)
@@ -0,0 +1,65 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package globaltagging
+
+import (
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/globaltaggingv1"
+
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/authenticator"
+)
+
+// Service holds the IBM Cloud Global Tagging Service specific information.
+type Service struct {
+	client *globaltaggingv1.GlobalTaggingV1
+}
+
+// NewService returns a new service for the global tagging.
+func NewService(options *globaltaggingv1.GlobalTaggingV1Options) (GlobalTagging, error) {
+	if options == nil {
+		options = &globaltaggingv1.GlobalTaggingV1Options{}
+	}
+	if options.Authenticator == nil {
+		auth, err := authenticator.GetAuthenticator()
+		if err != nil {
+			return nil, err
+		}
+		options.Authenticator = auth
+	}
+	gtClient, err := globaltaggingv1.NewGlobalTaggingV1(options)
+	if err != nil {
+		return nil, err
+	}
+	return &Service{
+		client: gtClient,
+	}, nil
+}
+
+// AttachTag attaches tags to the resources.
+func (s *Service) AttachTag(attachTagOptions *globaltaggingv1.AttachTagOptions) (*globaltaggingv1.TagResults, *core.DetailedResponse, error) {
+	return s.client.AttachTag(attachTagOptions)
+}
+
+// DetachTag detaches tags from the resources.
+func (s *Service) DetachTag(detachTagOptions *globaltaggingv1.DetachTagOptions) (*globaltaggingv1.TagResults, *core.DetailedResponse, error) {
+	return s.client.DetachTag(detachTagOptions)
+}
+
+// ListTags lists the tags.
+func (s *Service) ListTags(listTagsOptions *globaltaggingv1.ListTagsOptions) (*globaltaggingv1.TagList, *core.DetailedResponse, error) {
+	return s.client.ListTags(listTagsOptions)
+}
@@ -216,6 +216,21 @@ func (mr *MockPowerVSMockRecorder) GetAllNetwork() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllNetwork", reflect.TypeOf((*MockPowerVS)(nil).GetAllNetwork))
 }
 
+// GetAllPlacementGroups mocks base method.
+func (m *MockPowerVS) GetAllPlacementGroups() (*models.PlacementGroups, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllPlacementGroups")
+	ret0, _ := ret[0].(*models.PlacementGroups)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAllPlacementGroups indicates an expected call of GetAllPlacementGroups.
+func (mr *MockPowerVSMockRecorder) GetAllPlacementGroups() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllPlacementGroups", reflect.TypeOf((*MockPowerVS)(nil).GetAllPlacementGroups))
+}
+
 // GetCosImages mocks base method.
 func (m *MockPowerVS) GetCosImages(id string) (*models.Job, error) {
 	m.ctrl.T.Helper()
@@ -336,6 +351,21 @@ func (mr *MockPowerVSMockRecorder) GetNetworkByName(networkName any) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNetworkByName", reflect.TypeOf((*MockPowerVS)(nil).GetNetworkByName), networkName)
 }
 
+// GetPlacementGroupByName mocks base method.
+func (m *MockPowerVS) GetPlacementGroupByName(name string) (*models.PlacementGroup, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPlacementGroupByName", name)
+	ret0, _ := ret[0].(*models.PlacementGroup)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPlacementGroupByName indicates an expected call of GetPlacementGroupByName.
+func (mr *MockPowerVSMockRecorder) GetPlacementGroupByName(name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPlacementGroupByName", reflect.TypeOf((*MockPowerVS)(nil).GetPlacementGroupByName), name)
+}
+
 // WithClients mocks base method.
 func (m *MockPowerVS) WithClients(options powervs.ServiceOptions) *powervs.Service {
 	m.ctrl.T.Helper()
@@ -45,4 +45,6 @@ type PowerVS interface {
 	WithClients(options ServiceOptions) *Service
 	GetNetworkByName(networkName string) (*models.NetworkReference, error)
 	GetDatacenterCapabilities(zone string) (map[string]bool, error)
+	GetAllPlacementGroups() (*models.PlacementGroups, error)
+	GetPlacementGroupByName(name string) (*models.PlacementGroup, error)
 }
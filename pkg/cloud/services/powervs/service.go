@@ -40,6 +40,8 @@ type Service struct {
 	imageClient    *instance.IBMPIImageClient
 	jobClient      *instance.IBMPIJobClient
 	dhcpClient     *instance.IBMPIDhcpClient
+
+	placementGroupClient *instance.IBMPIPlacementGroupClient
 }
 
 // ServiceOptions holds the PowerVS Service Options specific information.
@@ -56,7 +58,7 @@ func NewService(options ServiceOptions) (PowerVS, error) {
 		return nil, err
 	}
 	options.Authenticator = auth
-	account, err := utils.GetAccount(auth)
+	account, err := utils.GetAccount(context.TODO(), auth)
 	if err != nil {
 		return nil, err
 	}
@@ -79,6 +81,7 @@ func (s *Service) WithClients(options ServiceOptions) *Service {
 	s.imageClient = instance.NewIBMPIImageClient(ctx, s.session, options.CloudInstanceID)
 	s.jobClient = instance.NewIBMPIJobClient(ctx, s.session, options.CloudInstanceID)
 	s.dhcpClient = instance.NewIBMPIDhcpClient(ctx, s.session, options.CloudInstanceID)
+	s.placementGroupClient = instance.NewIBMPIPlacementGroupClient(ctx, s.session, options.CloudInstanceID)
 	return s
 }
 
@@ -201,3 +204,23 @@ func (s *Service) GetDatacenterCapabilities(zone string) (map[string]bool, error
 	}
 	return datacenter.Payload.Capabilities, nil
 }
+
+// GetAllPlacementGroups returns all the placement groups in the Power VS service instance.
+func (s *Service) GetAllPlacementGroups() (*models.PlacementGroups, error) {
+	return s.placementGroupClient.GetAll()
+}
+
+// GetPlacementGroupByName fetches the placement group with name. If not found, returns nil.
+func (s *Service) GetPlacementGroupByName(name string) (*models.PlacementGroup, error) {
+	placementGroups, err := s.GetAllPlacementGroups()
+	if err != nil {
+		return nil, err
+	}
+	for _, placementGroup := range placementGroups.PlacementGroups {
+		if placementGroup.Name != nil && *placementGroup.Name == name {
+			return placementGroup, nil
+		}
+	}
+
+	return nil, nil
+}
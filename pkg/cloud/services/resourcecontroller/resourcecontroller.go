@@ -14,6 +14,9 @@ See the License for the specific language governing permissions and
 limitations under the License.
 */
 
+//go:generate ../../../../hack/tools/bin/mockgen -source=./resourcecontroller.go -destination=./mock/resourcecontroller_generated.go -package=mock
+//go:generate /usr/bin/env bash -c "cat ../../../../hack/boilerplate/boilerplate.generatego.txt ./mock/resourcecontroller_generated.go > ./mock/_resourcecontroller_generated.go && mv ./mock/_resourcecontroller_generated.go ./mock/resourcecontroller_generated.go"
+
 package resourcecontroller
 
 import (
@@ -17,6 +17,7 @@ limitations under the License.
 package resourcecontroller
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/IBM/go-sdk-core/v5/core"
@@ -123,7 +124,7 @@ func (s *Service) GetServiceInstance(id, name string) (*resourcecontrollerv2.Res
 		return true, "", nil
 	}
 
-	if err := utils.PagingHelper(f); err != nil {
+	if err := utils.PagingHelper(context.TODO(), f); err != nil {
 		return nil, fmt.Errorf("error listing service instances %v", err)
 	}
 	switch len(serviceInstancesList) {
@@ -168,7 +169,7 @@ func (s *Service) GetInstanceByName(name, resourceID, planID string) (*resourcec
 		return true, "", nil
 	}
 
-	if err := utils.PagingHelper(f); err != nil {
+	if err := utils.PagingHelper(context.TODO(), f); err != nil {
 		return nil, fmt.Errorf("error listing COS instances %v", err)
 	}
 	switch len(serviceInstancesList) {
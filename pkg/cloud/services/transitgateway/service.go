@@ -17,6 +17,7 @@ limitations under the License.
 package transitgateway
 
 import (
+	"context"
 	"fmt"
 	"time"
 
@@ -94,7 +95,7 @@ func (s *Service) GetTransitGatewayByName(name string) (*tgapiv1.TransitGateway,
 		return true, "", nil
 	}
 
-	if err := utils.PagingHelper(f); err != nil {
+	if err := utils.PagingHelper(context.TODO(), f); err != nil {
 		return nil, err
 	}
 	return &transitGateway, nil
@@ -14,6 +14,9 @@ See the License for the specific language governing permissions and
 limitations under the License.
 */
 
+//go:generate ../../../../hack/tools/bin/mockgen -source=./transitgateway.go -destination=./mock/transitgateway_generated.go -package=mock
+//go:generate /usr/bin/env bash -c "cat ../../../../hack/boilerplate/boilerplate.generatego.txt ./mock/transitgateway_generated.go > ./mock/_transitgateway_generated.go && mv ./mock/_transitgateway_generated.go ./mock/transitgateway_generated.go"
+
 package transitgateway
 
 import (
@@ -18,8 +18,11 @@ package utils
 
 import (
 	"context"
+	"fmt"
 	"net/http"
 	"strings"
+	"sync"
+	"time"
 
 	"github.com/golang-jwt/jwt"
 
@@ -28,15 +31,31 @@ import (
 	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/authenticator"
 )
 
+// AccountLookupTimeout is the maximum duration of the IAM token request made to look up the account.
+// It keeps a slow or unreachable IAM endpoint from blocking reconciles and commands indefinitely.
+var AccountLookupTimeout = 30 * time.Second
+
 // GetAccount is function parses the account number from the token and returns it.
-func GetAccount(auth core.Authenticator) (string, error) {
+// The token request is abandoned when ctx is done or AccountLookupTimeout expires.
+func GetAccount(ctx context.Context, auth core.Authenticator) (string, error) {
+	ctx, cancel := context.WithTimeout(ctx, AccountLookupTimeout)
+	defer cancel()
+
 	// fake request to get a barer token from the request header
-	ctx := context.TODO()
 	req, err := http.NewRequestWithContext(ctx, http.MethodGet, "http://example.com", http.NoBody)
 	if err != nil {
 		return "", err
 	}
-	err = auth.Authenticate(req)
+	// The authenticators do not take a context, so the token request is waited for in the background.
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- auth.Authenticate(req)
+	}()
+	select {
+	case <-ctx.Done():
+		return "", fmt.Errorf("failed to authenticate: %w", ctx.Err())
+	case err = <-errCh:
+	}
 	if err != nil {
 		return "", err
 	}
@@ -54,11 +73,43 @@ func GetAccount(auth core.Authenticator) (string, error) {
 	return token.Claims.(jwt.MapClaims)["account"].(map[string]interface{})["bss"].(string), nil
 }
 
+// GetAccountIDFunc looks up the account ID of the credentials of the process with IAM.
+// It is a variable so that tests can replace the lookup.
+var GetAccountIDFunc = getAccountID
+
+var (
+	accountIDMu sync.Mutex
+	accountID   string
+)
+
 // GetAccountID will parse and returns user cloud account ID.
-func GetAccountID() (string, error) {
+// The account ID never changes for the credentials of the process, so it is looked up once and then reused.
+func GetAccountID(ctx context.Context) (string, error) {
+	accountIDMu.Lock()
+	defer accountIDMu.Unlock()
+
+	if accountID != "" {
+		return accountID, nil
+	}
+	id, err := GetAccountIDFunc(ctx)
+	if err != nil {
+		return "", err
+	}
+	accountID = id
+	return accountID, nil
+}
+
+// ResetAccountID clears the account ID cached by GetAccountID so that it is looked up again on the next call.
+func ResetAccountID() {
+	accountIDMu.Lock()
+	defer accountIDMu.Unlock()
+	accountID = ""
+}
+
+func getAccountID(ctx context.Context) (string, error) {
 	auth, err := authenticator.GetAuthenticator()
 	if err != nil {
 		return "", err
 	}
-	return GetAccount(auth)
+	return GetAccount(ctx, auth)
 }
@@ -0,0 +1,139 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package utils
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+)
+
+// countingAccountIDFunc replaces GetAccountIDFunc with a lookup counting its calls, restoring it when the test ends.
+func countingAccountIDFunc(t *testing.T, id string, err error) *int {
+	t.Helper()
+	calls := 0
+	getAccountIDFunc := GetAccountIDFunc
+	GetAccountIDFunc = func(_ context.Context) (string, error) {
+		calls++
+		return id, err
+	}
+	ResetAccountID()
+	t.Cleanup(func() {
+		GetAccountIDFunc = getAccountIDFunc
+		ResetAccountID()
+	})
+	return &calls
+}
+
+func TestGetAccountID(t *testing.T) {
+	t.Run("Should look up the account ID only once", func(t *testing.T) {
+		g := NewWithT(t)
+		calls := countingAccountIDFunc(t, "foo-account-id", nil)
+
+		ids := make([]string, 10)
+		errs := make([]error, 10)
+		var wg sync.WaitGroup
+		for i := range ids {
+			wg.Add(1)
+			go func(i int) {
+				defer wg.Done()
+				ids[i], errs[i] = GetAccountID(context.Background())
+			}(i)
+		}
+		wg.Wait()
+		for i := range ids {
+			g.Expect(errs[i]).To(BeNil())
+			g.Expect(ids[i]).To(Equal("foo-account-id"))
+		}
+		g.Expect(*calls).To(Equal(1))
+	})
+
+	t.Run("Should look up the account ID again after reset", func(t *testing.T) {
+		g := NewWithT(t)
+		calls := countingAccountIDFunc(t, "foo-account-id", nil)
+
+		_, err := GetAccountID(context.Background())
+		g.Expect(err).To(BeNil())
+		ResetAccountID()
+		_, err = GetAccountID(context.Background())
+		g.Expect(err).To(BeNil())
+		g.Expect(*calls).To(Equal(2))
+	})
+
+	t.Run("Should not cache a failed lookup", func(t *testing.T) {
+		g := NewWithT(t)
+		calls := countingAccountIDFunc(t, "", errors.New("failed to authenticate"))
+
+		_, err := GetAccountID(context.Background())
+		g.Expect(err).To(Not(BeNil()))
+		_, err = GetAccountID(context.Background())
+		g.Expect(err).To(Not(BeNil()))
+		g.Expect(*calls).To(Equal(2))
+	})
+}
+
+// blockingAuthenticator is a core.Authenticator whose token request never completes until it is released.
+type blockingAuthenticator struct {
+	release chan struct{}
+}
+
+func (a *blockingAuthenticator) AuthenticationType() string {
+	return "blocking"
+}
+
+func (a *blockingAuthenticator) Authenticate(_ *http.Request) error {
+	<-a.release
+	return errors.New("released")
+}
+
+func (a *blockingAuthenticator) Validate() error {
+	return nil
+}
+
+func TestGetAccount(t *testing.T) {
+	t.Run("Should return an error when the context is cancelled", func(t *testing.T) {
+		g := NewWithT(t)
+		auth := &blockingAuthenticator{release: make(chan struct{})}
+		defer close(auth.release)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		start := time.Now()
+		_, err := GetAccount(ctx, auth)
+		g.Expect(err).To(MatchError(context.Canceled))
+		g.Expect(time.Since(start)).To(BeNumerically("<", time.Second))
+	})
+
+	t.Run("Should return an error when the lookup times out", func(t *testing.T) {
+		g := NewWithT(t)
+		auth := &blockingAuthenticator{release: make(chan struct{})}
+		defer close(auth.release)
+
+		accountLookupTimeout := AccountLookupTimeout
+		AccountLookupTimeout = 10 * time.Millisecond
+		defer func() {
+			AccountLookupTimeout = accountLookupTimeout
+		}()
+		_, err := GetAccount(context.Background(), auth)
+		g.Expect(err).To(MatchError(context.DeadlineExceeded))
+	})
+}
@@ -0,0 +1,73 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package utils
+
+import (
+	"time"
+
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/utils/clock"
+)
+
+// TTLCache caches values by key, each entry expires once the TTL has elapsed since it was added.
+// A nil TTLCache is valid and caches nothing.
+type TTLCache[T any] struct {
+	store cache.Store
+}
+
+// ttlCacheEntry holds a cached value along with the key it is stored under.
+type ttlCacheEntry[T any] struct {
+	key   string
+	value T
+}
+
+// NewTTLCache returns a cache whose entries expire after ttl.
+func NewTTLCache[T any](ttl time.Duration) *TTLCache[T] {
+	return NewTTLCacheWithClock[T](ttl, clock.RealClock{})
+}
+
+// NewTTLCacheWithClock returns a cache whose entries expire after ttl as measured by the given clock.
+// Entries are timestamped with the current time when they are added, so the clock must start at the current time.
+func NewTTLCacheWithClock[T any](ttl time.Duration, clock clock.Clock) *TTLCache[T] {
+	keyFunc := func(obj interface{}) (string, error) {
+		return obj.(ttlCacheEntry[T]).key, nil
+	}
+	return &TTLCache[T]{
+		store: cache.NewExpirationStore(keyFunc, &cache.TTLPolicy{TTL: ttl, Clock: clock}),
+	}
+}
+
+// Get returns the value cached for the key, the second value reports whether an unexpired entry was found.
+func (c *TTLCache[T]) Get(key string) (T, bool) {
+	var value T
+	if c == nil {
+		return value, false
+	}
+	obj, exists, err := c.store.GetByKey(key)
+	if err != nil || !exists {
+		return value, false
+	}
+	return obj.(ttlCacheEntry[T]).value, true
+}
+
+// Add caches the value for the key, replacing any existing entry.
+func (c *TTLCache[T]) Add(key string, value T) error {
+	if c == nil {
+		return nil
+	}
+	return c.store.Add(ttlCacheEntry[T]{key: key, value: value})
+}
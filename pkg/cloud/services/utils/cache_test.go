@@ -0,0 +1,61 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package utils
+
+import (
+	"testing"
+	"time"
+
+	clocktesting "k8s.io/utils/clock/testing"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestTTLCache(t *testing.T) {
+	t.Run("Should return cached value within the TTL", func(t *testing.T) {
+		g := NewWithT(t)
+		fakeClock := clocktesting.NewFakeClock(time.Now())
+		c := NewTTLCacheWithClock[string](time.Minute, fakeClock)
+		g.Expect(c.Add("foo", "foo-value")).To(Succeed())
+		fakeClock.Step(30 * time.Second)
+		value, found := c.Get("foo")
+		g.Expect(found).To(BeTrue())
+		g.Expect(value).To(Equal("foo-value"))
+	})
+	t.Run("Should not return value after the TTL expires", func(t *testing.T) {
+		g := NewWithT(t)
+		fakeClock := clocktesting.NewFakeClock(time.Now())
+		c := NewTTLCacheWithClock[string](time.Minute, fakeClock)
+		g.Expect(c.Add("foo", "foo-value")).To(Succeed())
+		fakeClock.Step(2 * time.Minute)
+		_, found := c.Get("foo")
+		g.Expect(found).To(BeFalse())
+	})
+	t.Run("Should not return value for unknown key", func(t *testing.T) {
+		g := NewWithT(t)
+		c := NewTTLCache[string](time.Minute)
+		_, found := c.Get("foo")
+		g.Expect(found).To(BeFalse())
+	})
+	t.Run("Should cache nothing when nil", func(t *testing.T) {
+		g := NewWithT(t)
+		var c *TTLCache[string]
+		g.Expect(c.Add("foo", "foo-value")).To(Succeed())
+		_, found := c.Get("foo")
+		g.Expect(found).To(BeFalse())
+	})
+}
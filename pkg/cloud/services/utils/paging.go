@@ -17,6 +17,7 @@ limitations under the License.
 package utils
 
 import (
+	"context"
 	"fmt"
 	"net/url"
 )
@@ -38,10 +39,15 @@ func getStartToken(nextURLS string) (string, error) {
 // isDone  - represents no need to iterate for getting next set of resources.
 // nextURL - if nextURL is present, will try to get the start token and pass it to f for next set of resource processing.
 // e       - if e is not nil, will break and return the error.
-func PagingHelper(f func(string) (bool, string, error)) error {
+// If ctx is cancelled, no further pages are fetched and the context error is returned.
+func PagingHelper(ctx context.Context, f func(string) (bool, string, error)) error {
 	start := ""
 	var err error
 	for {
+		if err = ctx.Err(); err != nil {
+			break
+		}
+
 		isDone, nextURL, e := f(start)
 
 		if e != nil {
@@ -0,0 +1,79 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package utils
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestPagingHelper(t *testing.T) {
+	nextURL := func(start int) string {
+		return fmt.Sprintf("https://us-south.iaas.cloud.ibm.com/v1/vpcs?start=%d", start)
+	}
+
+	t.Run("Should fetch all pages", func(t *testing.T) {
+		g := NewWithT(t)
+		var starts []string
+		f := func(start string) (bool, string, error) {
+			starts = append(starts, start)
+			if len(starts) == 3 {
+				return true, "", nil
+			}
+			return false, nextURL(len(starts)), nil
+		}
+		g.Expect(PagingHelper(context.TODO(), f)).To(Succeed())
+		g.Expect(starts).To(Equal([]string{"", "1", "2"}))
+	})
+	t.Run("Should stop fetching pages once the context is cancelled", func(t *testing.T) {
+		g := NewWithT(t)
+		ctx, cancel := context.WithCancel(context.TODO())
+		defer cancel()
+		var calls int
+		f := func(_ string) (bool, string, error) {
+			calls++
+			cancel()
+			return false, nextURL(calls), nil
+		}
+		err := PagingHelper(ctx, f)
+		g.Expect(err).To(MatchError(context.Canceled))
+		g.Expect(calls).To(Equal(1))
+	})
+	t.Run("Should not fetch any page when the context is already cancelled", func(t *testing.T) {
+		g := NewWithT(t)
+		ctx, cancel := context.WithCancel(context.TODO())
+		cancel()
+		var calls int
+		f := func(_ string) (bool, string, error) {
+			calls++
+			return true, "", nil
+		}
+		g.Expect(PagingHelper(ctx, f)).To(MatchError(context.Canceled))
+		g.Expect(calls).To(Equal(0))
+	})
+	t.Run("Error when fetching a page fails", func(t *testing.T) {
+		g := NewWithT(t)
+		f := func(_ string) (bool, string, error) {
+			return false, "", errors.New("failed to list resources")
+		}
+		g.Expect(PagingHelper(context.TODO(), f)).To(MatchError("failed to list resources"))
+	})
+}
@@ -0,0 +1,49 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vpc
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/utils"
+)
+
+// SubnetCacheTTL is duration of time to store the subnets looked up by name in cache.
+// It is kept short so that bursts of machine creation share a single lookup while changes to the subnet are picked up quickly.
+var SubnetCacheTTL = 30 * time.Second
+
+var (
+	subnetCacheStore     *utils.TTLCache[*vpcv1.Subnet]
+	subnetCacheStoreOnce sync.Once
+)
+
+// subnetCacheKey returns the cache key of a subnet, the service URL identifies the region the subnet belongs to.
+func subnetCacheKey(serviceURL, subnetName string) string {
+	return fmt.Sprintf("%s/%s", serviceURL, subnetName)
+}
+
+// subnetCache returns the subnet cache shared by all the services, it is initialised with SubnetCacheTTL on first use.
+func subnetCache() *utils.TTLCache[*vpcv1.Subnet] {
+	subnetCacheStoreOnce.Do(func() {
+		subnetCacheStore = utils.NewTTLCache[*vpcv1.Subnet](SubnetCacheTTL)
+	})
+	return subnetCacheStore
+}
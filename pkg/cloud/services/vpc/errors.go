@@ -0,0 +1,83 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vpc
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+
+	"k8s.io/klog/v2"
+)
+
+// requestIDHeader is the response header holding the ID the VPC API assigned to the request.
+const requestIDHeader = "X-Request-Id"
+
+var (
+	// ErrNotFound is returned when the VPC resource does not exist, it is safe to treat the resource as deleted.
+	ErrNotFound = errors.New("not found")
+	// ErrForbidden is returned when the credentials are not permitted to perform the VPC operation.
+	ErrForbidden = errors.New("forbidden")
+	// ErrRateLimited is returned when the VPC API rate limit is exceeded and the operation should be retried later.
+	ErrRateLimited = errors.New("rate limited")
+	// ErrConflict is returned when the VPC resource is in a state that conflicts with the operation.
+	ErrConflict = errors.New("conflict")
+)
+
+// ClassifyError wraps the error of a VPC API call with the typed error matching the status code of the response,
+// so that callers can check it with errors.Is. The error is returned unchanged when the status code is not classified.
+func ClassifyError(response *core.DetailedResponse, err error) error {
+	if err == nil || response == nil {
+		return err
+	}
+	switch response.StatusCode {
+	case http.StatusNotFound:
+		return fmt.Errorf("%w: %w", ErrNotFound, err)
+	case http.StatusForbidden:
+		return fmt.Errorf("%w: %w", ErrForbidden, err)
+	case http.StatusTooManyRequests:
+		return fmt.Errorf("%w: %w", ErrRateLimited, err)
+	case http.StatusConflict:
+		return fmt.Errorf("%w: %w", ErrConflict, err)
+	}
+	return err
+}
+
+// requestID returns the ID the VPC API assigned to the request of the response, empty when it is not known.
+func requestID(response *core.DetailedResponse) string {
+	if response == nil || response.Headers == nil {
+		return ""
+	}
+	return response.Headers.Get(requestIDHeader)
+}
+
+// withRequestID logs the request ID and status code of a VPC API call at debug level and adds the request ID to
+// its error, so that a failure can be traced by IBM Cloud support.
+func withRequestID(operation string, response *core.DetailedResponse, err error) error {
+	id := requestID(response)
+	var statusCode int
+	if response != nil {
+		statusCode = response.StatusCode
+	}
+	klog.V(5).InfoS("VPC API call", "operation", operation, "statusCode", statusCode, "requestID", id)
+	if err == nil || id == "" {
+		return err
+	}
+	return fmt.Errorf("%w (request ID: %s)", err, id)
+}
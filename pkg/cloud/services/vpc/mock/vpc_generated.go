@@ -55,6 +55,38 @@ func (m *MockVpc) EXPECT() *MockVpcMockRecorder {
 	return m.recorder
 }
 
+// CreateFloatingIP mocks base method.
+func (m *MockVpc) CreateFloatingIP(options *vpcv1.CreateFloatingIPOptions) (*vpcv1.FloatingIP, *core.DetailedResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateFloatingIP", options)
+	ret0, _ := ret[0].(*vpcv1.FloatingIP)
+	ret1, _ := ret[1].(*core.DetailedResponse)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// CreateFloatingIP indicates an expected call of CreateFloatingIP.
+func (mr *MockVpcMockRecorder) CreateFloatingIP(options any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateFloatingIP", reflect.TypeOf((*MockVpc)(nil).CreateFloatingIP), options)
+}
+
+// CreateFlowLogCollector mocks base method.
+func (m *MockVpc) CreateFlowLogCollector(options *vpcv1.CreateFlowLogCollectorOptions) (*vpcv1.FlowLogCollector, *core.DetailedResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateFlowLogCollector", options)
+	ret0, _ := ret[0].(*vpcv1.FlowLogCollector)
+	ret1, _ := ret[1].(*core.DetailedResponse)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// CreateFlowLogCollector indicates an expected call of CreateFlowLogCollector.
+func (mr *MockVpcMockRecorder) CreateFlowLogCollector(options any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateFlowLogCollector", reflect.TypeOf((*MockVpc)(nil).CreateFlowLogCollector), options)
+}
+
 // CreateInstance mocks base method.
 func (m *MockVpc) CreateInstance(options *vpcv1.CreateInstanceOptions) (*vpcv1.Instance, *core.DetailedResponse, error) {
 	m.ctrl.T.Helper()
@@ -71,6 +103,22 @@ func (mr *MockVpcMockRecorder) CreateInstance(options any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateInstance", reflect.TypeOf((*MockVpc)(nil).CreateInstance), options)
 }
 
+// CreateInstanceAction mocks base method.
+func (m *MockVpc) CreateInstanceAction(options *vpcv1.CreateInstanceActionOptions) (*vpcv1.InstanceAction, *core.DetailedResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateInstanceAction", options)
+	ret0, _ := ret[0].(*vpcv1.InstanceAction)
+	ret1, _ := ret[1].(*core.DetailedResponse)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// CreateInstanceAction indicates an expected call of CreateInstanceAction.
+func (mr *MockVpcMockRecorder) CreateInstanceAction(options any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateInstanceAction", reflect.TypeOf((*MockVpc)(nil).CreateInstanceAction), options)
+}
+
 // CreateLoadBalancer mocks base method.
 func (m *MockVpc) CreateLoadBalancer(options *vpcv1.CreateLoadBalancerOptions) (*vpcv1.LoadBalancer, *core.DetailedResponse, error) {
 	m.ctrl.T.Helper()
@@ -103,6 +151,38 @@ func (mr *MockVpcMockRecorder) CreateLoadBalancerPoolMember(options any) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateLoadBalancerPoolMember", reflect.TypeOf((*MockVpc)(nil).CreateLoadBalancerPoolMember), options)
 }
 
+// CreateNetworkACL mocks base method.
+func (m *MockVpc) CreateNetworkACL(options *vpcv1.CreateNetworkACLOptions) (*vpcv1.NetworkACL, *core.DetailedResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateNetworkACL", options)
+	ret0, _ := ret[0].(*vpcv1.NetworkACL)
+	ret1, _ := ret[1].(*core.DetailedResponse)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// CreateNetworkACL indicates an expected call of CreateNetworkACL.
+func (mr *MockVpcMockRecorder) CreateNetworkACL(options any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateNetworkACL", reflect.TypeOf((*MockVpc)(nil).CreateNetworkACL), options)
+}
+
+// CreateNetworkACLRule mocks base method.
+func (m *MockVpc) CreateNetworkACLRule(options *vpcv1.CreateNetworkACLRuleOptions) (vpcv1.NetworkACLRuleIntf, *core.DetailedResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateNetworkACLRule", options)
+	ret0, _ := ret[0].(vpcv1.NetworkACLRuleIntf)
+	ret1, _ := ret[1].(*core.DetailedResponse)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// CreateNetworkACLRule indicates an expected call of CreateNetworkACLRule.
+func (mr *MockVpcMockRecorder) CreateNetworkACLRule(options any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateNetworkACLRule", reflect.TypeOf((*MockVpc)(nil).CreateNetworkACLRule), options)
+}
+
 // CreatePublicGateway mocks base method.
 func (m *MockVpc) CreatePublicGateway(options *vpcv1.CreatePublicGatewayOptions) (*vpcv1.PublicGateway, *core.DetailedResponse, error) {
 	m.ctrl.T.Helper()
@@ -183,6 +263,52 @@ func (mr *MockVpcMockRecorder) CreateVPC(options any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateVPC", reflect.TypeOf((*MockVpc)(nil).CreateVPC), options)
 }
 
+// CreateVPCAddressPrefix mocks base method.
+func (m *MockVpc) CreateVPCAddressPrefix(options *vpcv1.CreateVPCAddressPrefixOptions) (*vpcv1.AddressPrefix, *core.DetailedResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateVPCAddressPrefix", options)
+	ret0, _ := ret[0].(*vpcv1.AddressPrefix)
+	ret1, _ := ret[1].(*core.DetailedResponse)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// CreateVPCAddressPrefix indicates an expected call of CreateVPCAddressPrefix.
+func (mr *MockVpcMockRecorder) CreateVPCAddressPrefix(options any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateVPCAddressPrefix", reflect.TypeOf((*MockVpc)(nil).CreateVPCAddressPrefix), options)
+}
+
+// DeleteFloatingIP mocks base method.
+func (m *MockVpc) DeleteFloatingIP(options *vpcv1.DeleteFloatingIPOptions) (*core.DetailedResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteFloatingIP", options)
+	ret0, _ := ret[0].(*core.DetailedResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteFloatingIP indicates an expected call of DeleteFloatingIP.
+func (mr *MockVpcMockRecorder) DeleteFloatingIP(options any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteFloatingIP", reflect.TypeOf((*MockVpc)(nil).DeleteFloatingIP), options)
+}
+
+// DeleteFlowLogCollector mocks base method.
+func (m *MockVpc) DeleteFlowLogCollector(options *vpcv1.DeleteFlowLogCollectorOptions) (*core.DetailedResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteFlowLogCollector", options)
+	ret0, _ := ret[0].(*core.DetailedResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteFlowLogCollector indicates an expected call of DeleteFlowLogCollector.
+func (mr *MockVpcMockRecorder) DeleteFlowLogCollector(options any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteFlowLogCollector", reflect.TypeOf((*MockVpc)(nil).DeleteFlowLogCollector), options)
+}
+
 // DeleteInstance mocks base method.
 func (m *MockVpc) DeleteInstance(options *vpcv1.DeleteInstanceOptions) (*core.DetailedResponse, error) {
 	m.ctrl.T.Helper()
@@ -228,6 +354,36 @@ func (mr *MockVpcMockRecorder) DeleteLoadBalancerPoolMember(options any) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteLoadBalancerPoolMember", reflect.TypeOf((*MockVpc)(nil).DeleteLoadBalancerPoolMember), options)
 }
 
+// DeleteNetworkACL mocks base method.
+func (m *MockVpc) DeleteNetworkACL(options *vpcv1.DeleteNetworkACLOptions) (*core.DetailedResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteNetworkACL", options)
+	ret0, _ := ret[0].(*core.DetailedResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteNetworkACL indicates an expected call of DeleteNetworkACL.
+func (mr *MockVpcMockRecorder) DeleteNetworkACL(options any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteNetworkACL", reflect.TypeOf((*MockVpc)(nil).DeleteNetworkACL), options)
+}
+
+// DeleteNetworkACLRule mocks base method.
+func (m *MockVpc) DeleteNetworkACLRule(options *vpcv1.DeleteNetworkACLRuleOptions) (*core.DetailedResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteNetworkACLRule", options)
+	ret0, _ := ret[0].(*core.DetailedResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteNetworkACLRule indicates an expected call of DeleteNetworkACLRule.
+func (mr *MockVpcMockRecorder) DeleteNetworkACLRule(options any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteNetworkACLRule", reflect.TypeOf((*MockVpc)(nil).DeleteNetworkACLRule), options)
+}
+
 // DeletePublicGateway mocks base method.
 func (m *MockVpc) DeletePublicGateway(options *vpcv1.DeletePublicGatewayOptions) (*core.DetailedResponse, error) {
 	m.ctrl.T.Helper()
@@ -258,6 +414,21 @@ func (mr *MockVpcMockRecorder) DeleteSecurityGroup(options any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSecurityGroup", reflect.TypeOf((*MockVpc)(nil).DeleteSecurityGroup), options)
 }
 
+// DeleteSecurityGroupRule mocks base method.
+func (m *MockVpc) DeleteSecurityGroupRule(options *vpcv1.DeleteSecurityGroupRuleOptions) (*core.DetailedResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteSecurityGroupRule", options)
+	ret0, _ := ret[0].(*core.DetailedResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteSecurityGroupRule indicates an expected call of DeleteSecurityGroupRule.
+func (mr *MockVpcMockRecorder) DeleteSecurityGroupRule(options any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSecurityGroupRule", reflect.TypeOf((*MockVpc)(nil).DeleteSecurityGroupRule), options)
+}
+
 // DeleteSubnet mocks base method.
 func (m *MockVpc) DeleteSubnet(options *vpcv1.DeleteSubnetOptions) (*core.DetailedResponse, error) {
 	m.ctrl.T.Helper()
@@ -273,6 +444,21 @@ func (mr *MockVpcMockRecorder) DeleteSubnet(options any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSubnet", reflect.TypeOf((*MockVpc)(nil).DeleteSubnet), options)
 }
 
+// DeleteSubnetReservedIP mocks base method.
+func (m *MockVpc) DeleteSubnetReservedIP(options *vpcv1.DeleteSubnetReservedIPOptions) (*core.DetailedResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteSubnetReservedIP", options)
+	ret0, _ := ret[0].(*core.DetailedResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteSubnetReservedIP indicates an expected call of DeleteSubnetReservedIP.
+func (mr *MockVpcMockRecorder) DeleteSubnetReservedIP(options any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSubnetReservedIP", reflect.TypeOf((*MockVpc)(nil).DeleteSubnetReservedIP), options)
+}
+
 // DeleteVPC mocks base method.
 func (m *MockVpc) DeleteVPC(options *vpcv1.DeleteVPCOptions) (*core.DetailedResponse, error) {
 	m.ctrl.T.Helper()
@@ -288,6 +474,36 @@ func (mr *MockVpcMockRecorder) DeleteVPC(options any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteVPC", reflect.TypeOf((*MockVpc)(nil).DeleteVPC), options)
 }
 
+// GetDedicatedHostByName mocks base method.
+func (m *MockVpc) GetDedicatedHostByName(name string) (*vpcv1.DedicatedHost, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDedicatedHostByName", name)
+	ret0, _ := ret[0].(*vpcv1.DedicatedHost)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDedicatedHostByName indicates an expected call of GetDedicatedHostByName.
+func (mr *MockVpcMockRecorder) GetDedicatedHostByName(name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDedicatedHostByName", reflect.TypeOf((*MockVpc)(nil).GetDedicatedHostByName), name)
+}
+
+// GetFloatingIPByName mocks base method.
+func (m *MockVpc) GetFloatingIPByName(name string) (*vpcv1.FloatingIP, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFloatingIPByName", name)
+	ret0, _ := ret[0].(*vpcv1.FloatingIP)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFloatingIPByName indicates an expected call of GetFloatingIPByName.
+func (mr *MockVpcMockRecorder) GetFloatingIPByName(name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFloatingIPByName", reflect.TypeOf((*MockVpc)(nil).GetFloatingIPByName), name)
+}
+
 // GetInstance mocks base method.
 func (m *MockVpc) GetInstance(options *vpcv1.GetInstanceOptions) (*vpcv1.Instance, *core.DetailedResponse, error) {
 	m.ctrl.T.Helper()
@@ -304,6 +520,21 @@ func (mr *MockVpcMockRecorder) GetInstance(options any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetInstance", reflect.TypeOf((*MockVpc)(nil).GetInstance), options)
 }
 
+// GetInstanceByProviderID mocks base method.
+func (m *MockVpc) GetInstanceByProviderID(providerID string) (*vpcv1.Instance, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetInstanceByProviderID", providerID)
+	ret0, _ := ret[0].(*vpcv1.Instance)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetInstanceByProviderID indicates an expected call of GetInstanceByProviderID.
+func (mr *MockVpcMockRecorder) GetInstanceByProviderID(providerID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetInstanceByProviderID", reflect.TypeOf((*MockVpc)(nil).GetInstanceByProviderID), providerID)
+}
+
 // GetInstanceProfile mocks base method.
 func (m *MockVpc) GetInstanceProfile(options *vpcv1.GetInstanceProfileOptions) (*vpcv1.InstanceProfile, *core.DetailedResponse, error) {
 	m.ctrl.T.Helper()
@@ -351,6 +582,68 @@ func (mr *MockVpcMockRecorder) GetLoadBalancerByName(loadBalancerName any) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLoadBalancerByName", reflect.TypeOf((*MockVpc)(nil).GetLoadBalancerByName), loadBalancerName)
 }
 
+// GetLoadBalancerPool mocks base method.
+func (m *MockVpc) GetLoadBalancerPool(options *vpcv1.GetLoadBalancerPoolOptions) (*vpcv1.LoadBalancerPool, *core.DetailedResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLoadBalancerPool", options)
+	ret0, _ := ret[0].(*vpcv1.LoadBalancerPool)
+	ret1, _ := ret[1].(*core.DetailedResponse)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetLoadBalancerPool indicates an expected call of GetLoadBalancerPool.
+func (mr *MockVpcMockRecorder) GetLoadBalancerPool(options any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLoadBalancerPool", reflect.TypeOf((*MockVpc)(nil).GetLoadBalancerPool), options)
+}
+
+// GetLoadBalancerPoolMember mocks base method.
+func (m *MockVpc) GetLoadBalancerPoolMember(options *vpcv1.GetLoadBalancerPoolMemberOptions) (*vpcv1.LoadBalancerPoolMember, *core.DetailedResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLoadBalancerPoolMember", options)
+	ret0, _ := ret[0].(*vpcv1.LoadBalancerPoolMember)
+	ret1, _ := ret[1].(*core.DetailedResponse)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetLoadBalancerPoolMember indicates an expected call of GetLoadBalancerPoolMember.
+func (mr *MockVpcMockRecorder) GetLoadBalancerPoolMember(options any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLoadBalancerPoolMember", reflect.TypeOf((*MockVpc)(nil).GetLoadBalancerPoolMember), options)
+}
+
+// GetPlacementGroupByName mocks base method.
+func (m *MockVpc) GetPlacementGroupByName(name string) (*vpcv1.PlacementGroup, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPlacementGroupByName", name)
+	ret0, _ := ret[0].(*vpcv1.PlacementGroup)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPlacementGroupByName indicates an expected call of GetPlacementGroupByName.
+func (mr *MockVpcMockRecorder) GetPlacementGroupByName(name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPlacementGroupByName", reflect.TypeOf((*MockVpc)(nil).GetPlacementGroupByName), name)
+}
+
+// GetReservationByName mocks base method.
+func (m *MockVpc) GetReservationByName(name string) (*vpcv1.Reservation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReservationByName", name)
+	ret0, _ := ret[0].(*vpcv1.Reservation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReservationByName indicates an expected call of GetReservationByName.
+func (mr *MockVpcMockRecorder) GetReservationByName(name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReservationByName", reflect.TypeOf((*MockVpc)(nil).GetReservationByName), name)
+}
+
 // GetSecurityGroup mocks base method.
 func (m *MockVpc) GetSecurityGroup(options *vpcv1.GetSecurityGroupOptions) (*vpcv1.SecurityGroup, *core.DetailedResponse, error) {
 	m.ctrl.T.Helper()
@@ -429,6 +722,22 @@ func (mr *MockVpcMockRecorder) GetSubnetAddrPrefix(vpcID, zone any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSubnetAddrPrefix", reflect.TypeOf((*MockVpc)(nil).GetSubnetAddrPrefix), vpcID, zone)
 }
 
+// GetSubnetNetworkACL mocks base method.
+func (m *MockVpc) GetSubnetNetworkACL(options *vpcv1.GetSubnetNetworkACLOptions) (*vpcv1.NetworkACL, *core.DetailedResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSubnetNetworkACL", options)
+	ret0, _ := ret[0].(*vpcv1.NetworkACL)
+	ret1, _ := ret[1].(*core.DetailedResponse)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetSubnetNetworkACL indicates an expected call of GetSubnetNetworkACL.
+func (mr *MockVpcMockRecorder) GetSubnetNetworkACL(options any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSubnetNetworkACL", reflect.TypeOf((*MockVpc)(nil).GetSubnetNetworkACL), options)
+}
+
 // GetSubnetPublicGateway mocks base method.
 func (m *MockVpc) GetSubnetPublicGateway(options *vpcv1.GetSubnetPublicGatewayOptions) (*vpcv1.PublicGateway, *core.DetailedResponse, error) {
 	m.ctrl.T.Helper()
@@ -491,6 +800,54 @@ func (mr *MockVpcMockRecorder) GetVPCSubnetByName(subnetName any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVPCSubnetByName", reflect.TypeOf((*MockVpc)(nil).GetVPCSubnetByName), subnetName)
 }
 
+// ListDedicatedHosts mocks base method.
+func (m *MockVpc) ListDedicatedHosts(options *vpcv1.ListDedicatedHostsOptions) (*vpcv1.DedicatedHostCollection, *core.DetailedResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDedicatedHosts", options)
+	ret0, _ := ret[0].(*vpcv1.DedicatedHostCollection)
+	ret1, _ := ret[1].(*core.DetailedResponse)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListDedicatedHosts indicates an expected call of ListDedicatedHosts.
+func (mr *MockVpcMockRecorder) ListDedicatedHosts(options any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDedicatedHosts", reflect.TypeOf((*MockVpc)(nil).ListDedicatedHosts), options)
+}
+
+// ListFloatingIPs mocks base method.
+func (m *MockVpc) ListFloatingIPs(options *vpcv1.ListFloatingIpsOptions) (*vpcv1.FloatingIPCollection, *core.DetailedResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListFloatingIPs", options)
+	ret0, _ := ret[0].(*vpcv1.FloatingIPCollection)
+	ret1, _ := ret[1].(*core.DetailedResponse)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListFloatingIPs indicates an expected call of ListFloatingIPs.
+func (mr *MockVpcMockRecorder) ListFloatingIPs(options any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListFloatingIPs", reflect.TypeOf((*MockVpc)(nil).ListFloatingIPs), options)
+}
+
+// ListFlowLogCollectors mocks base method.
+func (m *MockVpc) ListFlowLogCollectors(options *vpcv1.ListFlowLogCollectorsOptions) (*vpcv1.FlowLogCollectorCollection, *core.DetailedResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListFlowLogCollectors", options)
+	ret0, _ := ret[0].(*vpcv1.FlowLogCollectorCollection)
+	ret1, _ := ret[1].(*core.DetailedResponse)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListFlowLogCollectors indicates an expected call of ListFlowLogCollectors.
+func (mr *MockVpcMockRecorder) ListFlowLogCollectors(options any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListFlowLogCollectors", reflect.TypeOf((*MockVpc)(nil).ListFlowLogCollectors), options)
+}
+
 // ListImages mocks base method.
 func (m *MockVpc) ListImages(options *vpcv1.ListImagesOptions) (*vpcv1.ImageCollection, *core.DetailedResponse, error) {
 	m.ctrl.T.Helper()
@@ -507,6 +864,38 @@ func (mr *MockVpcMockRecorder) ListImages(options any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListImages", reflect.TypeOf((*MockVpc)(nil).ListImages), options)
 }
 
+// ListInstanceProfiles mocks base method.
+func (m *MockVpc) ListInstanceProfiles(options *vpcv1.ListInstanceProfilesOptions) (*vpcv1.InstanceProfileCollection, *core.DetailedResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListInstanceProfiles", options)
+	ret0, _ := ret[0].(*vpcv1.InstanceProfileCollection)
+	ret1, _ := ret[1].(*core.DetailedResponse)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListInstanceProfiles indicates an expected call of ListInstanceProfiles.
+func (mr *MockVpcMockRecorder) ListInstanceProfiles(options any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListInstanceProfiles", reflect.TypeOf((*MockVpc)(nil).ListInstanceProfiles), options)
+}
+
+// ListInstanceTemplates mocks base method.
+func (m *MockVpc) ListInstanceTemplates(options *vpcv1.ListInstanceTemplatesOptions) (*vpcv1.InstanceTemplateCollection, *core.DetailedResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListInstanceTemplates", options)
+	ret0, _ := ret[0].(*vpcv1.InstanceTemplateCollection)
+	ret1, _ := ret[1].(*core.DetailedResponse)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListInstanceTemplates indicates an expected call of ListInstanceTemplates.
+func (mr *MockVpcMockRecorder) ListInstanceTemplates(options any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListInstanceTemplates", reflect.TypeOf((*MockVpc)(nil).ListInstanceTemplates), options)
+}
+
 // ListInstances mocks base method.
 func (m *MockVpc) ListInstances(options *vpcv1.ListInstancesOptions) (*vpcv1.InstanceCollection, *core.DetailedResponse, error) {
 	m.ctrl.T.Helper()
@@ -571,6 +960,70 @@ func (mr *MockVpcMockRecorder) ListLoadBalancers(options any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListLoadBalancers", reflect.TypeOf((*MockVpc)(nil).ListLoadBalancers), options)
 }
 
+// ListNetworkACLRules mocks base method.
+func (m *MockVpc) ListNetworkACLRules(options *vpcv1.ListNetworkACLRulesOptions) (*vpcv1.NetworkACLRuleCollection, *core.DetailedResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListNetworkACLRules", options)
+	ret0, _ := ret[0].(*vpcv1.NetworkACLRuleCollection)
+	ret1, _ := ret[1].(*core.DetailedResponse)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListNetworkACLRules indicates an expected call of ListNetworkACLRules.
+func (mr *MockVpcMockRecorder) ListNetworkACLRules(options any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListNetworkACLRules", reflect.TypeOf((*MockVpc)(nil).ListNetworkACLRules), options)
+}
+
+// ListPlacementGroups mocks base method.
+func (m *MockVpc) ListPlacementGroups(options *vpcv1.ListPlacementGroupsOptions) (*vpcv1.PlacementGroupCollection, *core.DetailedResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPlacementGroups", options)
+	ret0, _ := ret[0].(*vpcv1.PlacementGroupCollection)
+	ret1, _ := ret[1].(*core.DetailedResponse)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListPlacementGroups indicates an expected call of ListPlacementGroups.
+func (mr *MockVpcMockRecorder) ListPlacementGroups(options any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPlacementGroups", reflect.TypeOf((*MockVpc)(nil).ListPlacementGroups), options)
+}
+
+// ListRegionZones mocks base method.
+func (m *MockVpc) ListRegionZones(options *vpcv1.ListRegionZonesOptions) (*vpcv1.ZoneCollection, *core.DetailedResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListRegionZones", options)
+	ret0, _ := ret[0].(*vpcv1.ZoneCollection)
+	ret1, _ := ret[1].(*core.DetailedResponse)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListRegionZones indicates an expected call of ListRegionZones.
+func (mr *MockVpcMockRecorder) ListRegionZones(options any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRegionZones", reflect.TypeOf((*MockVpc)(nil).ListRegionZones), options)
+}
+
+// ListReservations mocks base method.
+func (m *MockVpc) ListReservations(options *vpcv1.ListReservationsOptions) (*vpcv1.ReservationCollection, *core.DetailedResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListReservations", options)
+	ret0, _ := ret[0].(*vpcv1.ReservationCollection)
+	ret1, _ := ret[1].(*core.DetailedResponse)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListReservations indicates an expected call of ListReservations.
+func (mr *MockVpcMockRecorder) ListReservations(options any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListReservations", reflect.TypeOf((*MockVpc)(nil).ListReservations), options)
+}
+
 // ListSecurityGroups mocks base method.
 func (m *MockVpc) ListSecurityGroups(options *vpcv1.ListSecurityGroupsOptions) (*vpcv1.SecurityGroupCollection, *core.DetailedResponse, error) {
 	m.ctrl.T.Helper()
@@ -587,6 +1040,22 @@ func (mr *MockVpcMockRecorder) ListSecurityGroups(options any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSecurityGroups", reflect.TypeOf((*MockVpc)(nil).ListSecurityGroups), options)
 }
 
+// ListSubnetReservedIps mocks base method.
+func (m *MockVpc) ListSubnetReservedIps(options *vpcv1.ListSubnetReservedIpsOptions) (*vpcv1.ReservedIPCollection, *core.DetailedResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSubnetReservedIps", options)
+	ret0, _ := ret[0].(*vpcv1.ReservedIPCollection)
+	ret1, _ := ret[1].(*core.DetailedResponse)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListSubnetReservedIps indicates an expected call of ListSubnetReservedIps.
+func (mr *MockVpcMockRecorder) ListSubnetReservedIps(options any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSubnetReservedIps", reflect.TypeOf((*MockVpc)(nil).ListSubnetReservedIps), options)
+}
+
 // ListSubnets mocks base method.
 func (m *MockVpc) ListSubnets(options *vpcv1.ListSubnetsOptions) (*vpcv1.SubnetCollection, *core.DetailedResponse, error) {
 	m.ctrl.T.Helper()
@@ -635,6 +1104,22 @@ func (mr *MockVpcMockRecorder) ListVpcs(options any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVpcs", reflect.TypeOf((*MockVpc)(nil).ListVpcs), options)
 }
 
+// ReplaceSubnetNetworkACL mocks base method.
+func (m *MockVpc) ReplaceSubnetNetworkACL(options *vpcv1.ReplaceSubnetNetworkACLOptions) (*vpcv1.NetworkACL, *core.DetailedResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReplaceSubnetNetworkACL", options)
+	ret0, _ := ret[0].(*vpcv1.NetworkACL)
+	ret1, _ := ret[1].(*core.DetailedResponse)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ReplaceSubnetNetworkACL indicates an expected call of ReplaceSubnetNetworkACL.
+func (mr *MockVpcMockRecorder) ReplaceSubnetNetworkACL(options any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplaceSubnetNetworkACL", reflect.TypeOf((*MockVpc)(nil).ReplaceSubnetNetworkACL), options)
+}
+
 // SetSubnetPublicGateway mocks base method.
 func (m *MockVpc) SetSubnetPublicGateway(options *vpcv1.SetSubnetPublicGatewayOptions) (*vpcv1.PublicGateway, *core.DetailedResponse, error) {
 	m.ctrl.T.Helper()
@@ -665,3 +1150,19 @@ func (mr *MockVpcMockRecorder) UnsetSubnetPublicGateway(options any) *gomock.Cal
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnsetSubnetPublicGateway", reflect.TypeOf((*MockVpc)(nil).UnsetSubnetPublicGateway), options)
 }
+
+// UpdateLoadBalancerPool mocks base method.
+func (m *MockVpc) UpdateLoadBalancerPool(options *vpcv1.UpdateLoadBalancerPoolOptions) (*vpcv1.LoadBalancerPool, *core.DetailedResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateLoadBalancerPool", options)
+	ret0, _ := ret[0].(*vpcv1.LoadBalancerPool)
+	ret1, _ := ret[1].(*core.DetailedResponse)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// UpdateLoadBalancerPool indicates an expected call of UpdateLoadBalancerPool.
+func (mr *MockVpcMockRecorder) UpdateLoadBalancerPool(options any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateLoadBalancerPool", reflect.TypeOf((*MockVpc)(nil).UpdateLoadBalancerPool), options)
+}
@@ -0,0 +1,70 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vpc
+
+import (
+	"net/http"
+	"sync"
+
+	"golang.org/x/time/rate"
+)
+
+// APIRateLimit is the maximum number of requests per second sent to the VPC API by all the services of the process.
+// It keeps large scale-ups within the VPC API rate limits instead of failing many machines at once.
+var APIRateLimit = 10.0
+
+var (
+	apiRateLimiterInstance *rate.Limiter
+	apiRateLimiterOnce     sync.Once
+)
+
+// apiRateLimiter returns the rate limiter shared by all the services, it is initialised with APIRateLimit on first use.
+func apiRateLimiter() *rate.Limiter {
+	apiRateLimiterOnce.Do(func() {
+		apiRateLimiterInstance = newAPIRateLimiter(APIRateLimit)
+	})
+	return apiRateLimiterInstance
+}
+
+// newAPIRateLimiter returns a token bucket rate limiter allowing rps requests per second.
+// The burst is one request so that concurrent calls are spaced out evenly.
+func newAPIRateLimiter(rps float64) *rate.Limiter {
+	return rate.NewLimiter(rate.Limit(rps), 1)
+}
+
+// rateLimitedTransport is a http.RoundTripper waiting on the rate limiter before sending each request.
+type rateLimitedTransport struct {
+	limiter *rate.Limiter
+	next    http.RoundTripper
+}
+
+// RoundTrip waits for the rate limiter to allow the request and sends it with the next round tripper.
+func (t *rateLimitedTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if err := t.limiter.Wait(req.Context()); err != nil {
+		return nil, err
+	}
+	return t.next.RoundTrip(req)
+}
+
+// withRateLimiter wraps the transport of the HTTP client so that its requests are throttled by the rate limiter.
+func withRateLimiter(client *http.Client, limiter *rate.Limiter) {
+	next := client.Transport
+	if next == nil {
+		next = http.DefaultTransport
+	}
+	client.Transport = &rateLimitedTransport{limiter: limiter, next: next}
+}
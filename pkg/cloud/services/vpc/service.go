@@ -17,7 +17,10 @@ limitations under the License.
 package vpc
 
 import (
+	"context"
+	"errors"
 	"fmt"
+	"strings"
 
 	"github.com/IBM/go-sdk-core/v5/core"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
@@ -29,149 +32,257 @@ import (
 // SecurityGroupByNameNotFound returns an appropriate error when security group by name not found.
 var SecurityGroupByNameNotFound = func(name string) error { return fmt.Errorf("failed to find security group by name '%s'", name) }
 
+// ErrInvalidProviderID is returned when a provider ID does not identify a VPC instance.
+var ErrInvalidProviderID = errors.New("invalid provider ID")
+
 // Service holds the VPC Service specific information.
 type Service struct {
-	vpcService *vpcv1.VpcV1
+	vpcService  *vpcv1.VpcV1
+	subnetCache *utils.TTLCache[*vpcv1.Subnet]
 }
 
 // CreateInstance created an virtal server instance.
 func (s *Service) CreateInstance(options *vpcv1.CreateInstanceOptions) (*vpcv1.Instance, *core.DetailedResponse, error) {
-	return s.vpcService.CreateInstance(options)
+	result, response, err := s.vpcService.CreateInstance(options)
+	return result, response, withRequestID("CreateInstance", response, err)
 }
 
 // DeleteInstance deleted a virtal server instance.
 func (s *Service) DeleteInstance(options *vpcv1.DeleteInstanceOptions) (*core.DetailedResponse, error) {
-	return s.vpcService.DeleteInstance(options)
+	response, err := s.vpcService.DeleteInstance(options)
+	return response, withRequestID("DeleteInstance", response, err)
 }
 
 // GetInstance returns the virtal server instance.
 func (s *Service) GetInstance(options *vpcv1.GetInstanceOptions) (*vpcv1.Instance, *core.DetailedResponse, error) {
-	return s.vpcService.GetInstance(options)
+	result, response, err := s.vpcService.GetInstance(options)
+	return result, response, withRequestID("GetInstance", response, err)
+}
+
+// GetInstanceByProviderID returns the virtual server instance identified by the provider ID, nil when it does not exist.
+func (s *Service) GetInstanceByProviderID(providerID string) (*vpcv1.Instance, error) {
+	instanceID, err := instanceIDFromProviderID(providerID)
+	if err != nil {
+		return nil, err
+	}
+	instance, response, err := s.GetInstance(&vpcv1.GetInstanceOptions{ID: &instanceID})
+	if err != nil {
+		if err = ClassifyError(response, err); errors.Is(err, ErrNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return instance, nil
+}
+
+// instanceIDFromProviderID returns the instance ID of a provider ID in the ibmvpc://<region>/<zone>/<instance-id>
+// or ibm://<account-id>///<cluster-name>/<instance-id> format.
+func instanceIDFromProviderID(providerID string) (string, error) {
+	switch {
+	case strings.HasPrefix(providerID, "ibmvpc://"):
+		parts := strings.Split(strings.TrimPrefix(providerID, "ibmvpc://"), "/")
+		if len(parts) == 3 && parts[2] != "" {
+			return parts[2], nil
+		}
+	case strings.HasPrefix(providerID, "ibm://"):
+		parts := strings.Split(strings.TrimPrefix(providerID, "ibm://"), "/")
+		if len(parts) == 5 && parts[4] != "" {
+			return parts[4], nil
+		}
+	}
+	return "", fmt.Errorf("%w: %s", ErrInvalidProviderID, providerID)
+}
+
+// CreateInstanceAction requests an action, like stop, on a virtual server instance.
+func (s *Service) CreateInstanceAction(options *vpcv1.CreateInstanceActionOptions) (*vpcv1.InstanceAction, *core.DetailedResponse, error) {
+	result, response, err := s.vpcService.CreateInstanceAction(options)
+	return result, response, withRequestID("CreateInstanceAction", response, err)
 }
 
 // ListInstances returns list of virtual server instances.
 func (s *Service) ListInstances(options *vpcv1.ListInstancesOptions) (*vpcv1.InstanceCollection, *core.DetailedResponse, error) {
-	return s.vpcService.ListInstances(options)
+	result, response, err := s.vpcService.ListInstances(options)
+	return result, response, withRequestID("ListInstances", response, err)
 }
 
 // CreateVPC creates a new VPC.
 func (s *Service) CreateVPC(options *vpcv1.CreateVPCOptions) (*vpcv1.VPC, *core.DetailedResponse, error) {
-	return s.vpcService.CreateVPC(options)
+	result, response, err := s.vpcService.CreateVPC(options)
+	return result, response, withRequestID("CreateVPC", response, err)
 }
 
 // DeleteVPC deletes a VPC.
 func (s *Service) DeleteVPC(options *vpcv1.DeleteVPCOptions) (*core.DetailedResponse, error) {
-	return s.vpcService.DeleteVPC(options)
+	response, err := s.vpcService.DeleteVPC(options)
+	return response, withRequestID("DeleteVPC", response, err)
 }
 
 // ListVpcs returns list of VPCs in a region.
 func (s *Service) ListVpcs(options *vpcv1.ListVpcsOptions) (*vpcv1.VPCCollection, *core.DetailedResponse, error) {
-	return s.vpcService.ListVpcs(options)
+	result, response, err := s.vpcService.ListVpcs(options)
+	return result, response, withRequestID("ListVpcs", response, err)
 }
 
 // CreateSubnet creates a subnet.
 func (s *Service) CreateSubnet(options *vpcv1.CreateSubnetOptions) (*vpcv1.Subnet, *core.DetailedResponse, error) {
-	return s.vpcService.CreateSubnet(options)
+	result, response, err := s.vpcService.CreateSubnet(options)
+	return result, response, withRequestID("CreateSubnet", response, err)
 }
 
 // DeleteSubnet deletes a subnet.
 func (s *Service) DeleteSubnet(options *vpcv1.DeleteSubnetOptions) (*core.DetailedResponse, error) {
-	return s.vpcService.DeleteSubnet(options)
+	response, err := s.vpcService.DeleteSubnet(options)
+	return response, withRequestID("DeleteSubnet", response, err)
 }
 
 // ListSubnets returns list of subnets in a region.
 func (s *Service) ListSubnets(options *vpcv1.ListSubnetsOptions) (*vpcv1.SubnetCollection, *core.DetailedResponse, error) {
-	return s.vpcService.ListSubnets(options)
+	result, response, err := s.vpcService.ListSubnets(options)
+	return result, response, withRequestID("ListSubnets", response, err)
 }
 
 // GetSubnetPublicGateway returns a public gateway attached to the subnet.
 func (s *Service) GetSubnetPublicGateway(options *vpcv1.GetSubnetPublicGatewayOptions) (*vpcv1.PublicGateway, *core.DetailedResponse, error) {
-	return s.vpcService.GetSubnetPublicGateway(options)
+	result, response, err := s.vpcService.GetSubnetPublicGateway(options)
+	return result, response, withRequestID("GetSubnetPublicGateway", response, err)
 }
 
 // CreatePublicGateway creates a public gateway for the VPC.
 func (s *Service) CreatePublicGateway(options *vpcv1.CreatePublicGatewayOptions) (*vpcv1.PublicGateway, *core.DetailedResponse, error) {
-	return s.vpcService.CreatePublicGateway(options)
+	result, response, err := s.vpcService.CreatePublicGateway(options)
+	return result, response, withRequestID("CreatePublicGateway", response, err)
 }
 
 // DeletePublicGateway deletes a public gateway.
 func (s *Service) DeletePublicGateway(options *vpcv1.DeletePublicGatewayOptions) (*core.DetailedResponse, error) {
-	return s.vpcService.DeletePublicGateway(options)
+	response, err := s.vpcService.DeletePublicGateway(options)
+	return response, withRequestID("DeletePublicGateway", response, err)
 }
 
 // UnsetSubnetPublicGateway detaches a public gateway from the subnet.
 func (s *Service) UnsetSubnetPublicGateway(options *vpcv1.UnsetSubnetPublicGatewayOptions) (*core.DetailedResponse, error) {
-	return s.vpcService.UnsetSubnetPublicGateway(options)
+	response, err := s.vpcService.UnsetSubnetPublicGateway(options)
+	return response, withRequestID("UnsetSubnetPublicGateway", response, err)
 }
 
 // SetSubnetPublicGateway attaches a public gateway to the subnet.
 func (s *Service) SetSubnetPublicGateway(options *vpcv1.SetSubnetPublicGatewayOptions) (*vpcv1.PublicGateway, *core.DetailedResponse, error) {
-	return s.vpcService.SetSubnetPublicGateway(options)
+	result, response, err := s.vpcService.SetSubnetPublicGateway(options)
+	return result, response, withRequestID("SetSubnetPublicGateway", response, err)
 }
 
 // ListVPCAddressPrefixes returns list of all address prefixes for a VPC.
 func (s *Service) ListVPCAddressPrefixes(options *vpcv1.ListVPCAddressPrefixesOptions) (*vpcv1.AddressPrefixCollection, *core.DetailedResponse, error) {
-	return s.vpcService.ListVPCAddressPrefixes(options)
+	result, response, err := s.vpcService.ListVPCAddressPrefixes(options)
+	return result, response, withRequestID("ListVPCAddressPrefixes", response, err)
 }
 
 // CreateSecurityGroupRule creates a rule for a security group.
 func (s *Service) CreateSecurityGroupRule(options *vpcv1.CreateSecurityGroupRuleOptions) (vpcv1.SecurityGroupRuleIntf, *core.DetailedResponse, error) {
-	return s.vpcService.CreateSecurityGroupRule(options)
+	result, response, err := s.vpcService.CreateSecurityGroupRule(options)
+	return result, response, withRequestID("CreateSecurityGroupRule", response, err)
+}
+
+// DeleteSecurityGroupRule deletes a rule from a security group.
+func (s *Service) DeleteSecurityGroupRule(options *vpcv1.DeleteSecurityGroupRuleOptions) (*core.DetailedResponse, error) {
+	response, err := s.vpcService.DeleteSecurityGroupRule(options)
+	return response, withRequestID("DeleteSecurityGroupRule", response, err)
 }
 
 // CreateLoadBalancer creates a new load balancer.
 func (s *Service) CreateLoadBalancer(options *vpcv1.CreateLoadBalancerOptions) (*vpcv1.LoadBalancer, *core.DetailedResponse, error) {
-	return s.vpcService.CreateLoadBalancer(options)
+	result, response, err := s.vpcService.CreateLoadBalancer(options)
+	return result, response, withRequestID("CreateLoadBalancer", response, err)
 }
 
 // DeleteLoadBalancer deletes a load balancer.
 func (s *Service) DeleteLoadBalancer(options *vpcv1.DeleteLoadBalancerOptions) (*core.DetailedResponse, error) {
-	return s.vpcService.DeleteLoadBalancer(options)
+	response, err := s.vpcService.DeleteLoadBalancer(options)
+	return response, withRequestID("DeleteLoadBalancer", response, err)
 }
 
 // ListLoadBalancers returns list of load balancers in a region.
 func (s *Service) ListLoadBalancers(options *vpcv1.ListLoadBalancersOptions) (*vpcv1.LoadBalancerCollection, *core.DetailedResponse, error) {
-	return s.vpcService.ListLoadBalancers(options)
+	result, response, err := s.vpcService.ListLoadBalancers(options)
+	return result, response, withRequestID("ListLoadBalancers", response, err)
 }
 
 // GetLoadBalancer returns a load balancer.
 func (s *Service) GetLoadBalancer(options *vpcv1.GetLoadBalancerOptions) (*vpcv1.LoadBalancer, *core.DetailedResponse, error) {
-	return s.vpcService.GetLoadBalancer(options)
+	result, response, err := s.vpcService.GetLoadBalancer(options)
+	return result, response, withRequestID("GetLoadBalancer", response, err)
 }
 
 // CreateLoadBalancerPoolMember creates a new member and adds the member to the pool.
 func (s *Service) CreateLoadBalancerPoolMember(options *vpcv1.CreateLoadBalancerPoolMemberOptions) (*vpcv1.LoadBalancerPoolMember, *core.DetailedResponse, error) {
-	return s.vpcService.CreateLoadBalancerPoolMember(options)
+	result, response, err := s.vpcService.CreateLoadBalancerPoolMember(options)
+	return result, response, withRequestID("CreateLoadBalancerPoolMember", response, err)
+}
+
+// GetLoadBalancerPool retrieves a single pool specified by the identifier.
+func (s *Service) GetLoadBalancerPool(options *vpcv1.GetLoadBalancerPoolOptions) (*vpcv1.LoadBalancerPool, *core.DetailedResponse, error) {
+	result, response, err := s.vpcService.GetLoadBalancerPool(options)
+	return result, response, withRequestID("GetLoadBalancerPool", response, err)
+}
+
+// UpdateLoadBalancerPool updates a load balancer pool with the information in a provided pool patch.
+func (s *Service) UpdateLoadBalancerPool(options *vpcv1.UpdateLoadBalancerPoolOptions) (*vpcv1.LoadBalancerPool, *core.DetailedResponse, error) {
+	result, response, err := s.vpcService.UpdateLoadBalancerPool(options)
+	return result, response, withRequestID("UpdateLoadBalancerPool", response, err)
 }
 
 // DeleteLoadBalancerPoolMember deletes a member from the load balancer pool.
 func (s *Service) DeleteLoadBalancerPoolMember(options *vpcv1.DeleteLoadBalancerPoolMemberOptions) (*core.DetailedResponse, error) {
-	return s.vpcService.DeleteLoadBalancerPoolMember(options)
+	response, err := s.vpcService.DeleteLoadBalancerPoolMember(options)
+	return response, withRequestID("DeleteLoadBalancerPoolMember", response, err)
 }
 
 // ListLoadBalancerPoolMembers returns members of a load balancer pool.
 func (s *Service) ListLoadBalancerPoolMembers(options *vpcv1.ListLoadBalancerPoolMembersOptions) (*vpcv1.LoadBalancerPoolMemberCollection, *core.DetailedResponse, error) {
-	return s.vpcService.ListLoadBalancerPoolMembers(options)
+	result, response, err := s.vpcService.ListLoadBalancerPoolMembers(options)
+	return result, response, withRequestID("ListLoadBalancerPoolMembers", response, err)
+}
+
+// GetLoadBalancerPoolMember returns a member of a load balancer pool.
+func (s *Service) GetLoadBalancerPoolMember(options *vpcv1.GetLoadBalancerPoolMemberOptions) (*vpcv1.LoadBalancerPoolMember, *core.DetailedResponse, error) {
+	result, response, err := s.vpcService.GetLoadBalancerPoolMember(options)
+	return result, response, withRequestID("GetLoadBalancerPoolMember", response, err)
 }
 
 // ListKeys returns list of keys in a region.
 func (s *Service) ListKeys(options *vpcv1.ListKeysOptions) (*vpcv1.KeyCollection, *core.DetailedResponse, error) {
-	return s.vpcService.ListKeys(options)
+	result, response, err := s.vpcService.ListKeys(options)
+	return result, response, withRequestID("ListKeys", response, err)
 }
 
 // ListImages returns list of images in a region.
 func (s *Service) ListImages(options *vpcv1.ListImagesOptions) (*vpcv1.ImageCollection, *core.DetailedResponse, error) {
-	return s.vpcService.ListImages(options)
+	result, response, err := s.vpcService.ListImages(options)
+	return result, response, withRequestID("ListImages", response, err)
+}
+
+// ListInstanceTemplates returns list of instance templates in a region.
+func (s *Service) ListInstanceTemplates(options *vpcv1.ListInstanceTemplatesOptions) (*vpcv1.InstanceTemplateCollection, *core.DetailedResponse, error) {
+	result, response, err := s.vpcService.ListInstanceTemplates(options)
+	return result, response, withRequestID("ListInstanceTemplates", response, err)
 }
 
 // GetInstanceProfile returns instance profile.
 func (s *Service) GetInstanceProfile(options *vpcv1.GetInstanceProfileOptions) (*vpcv1.InstanceProfile, *core.DetailedResponse, error) {
-	return s.vpcService.GetInstanceProfile(options)
+	result, response, err := s.vpcService.GetInstanceProfile(options)
+	return result, response, withRequestID("GetInstanceProfile", response, err)
+}
+
+// ListInstanceProfiles returns list of instance profiles available in the region.
+func (s *Service) ListInstanceProfiles(options *vpcv1.ListInstanceProfilesOptions) (*vpcv1.InstanceProfileCollection, *core.DetailedResponse, error) {
+	result, response, err := s.vpcService.ListInstanceProfiles(options)
+	return result, response, withRequestID("ListInstanceProfiles", response, err)
 }
 
 // GetVPC returns VPC details.
 func (s *Service) GetVPC(options *vpcv1.GetVPCOptions) (*vpcv1.VPC, *core.DetailedResponse, error) {
-	return s.vpcService.GetVPC(options)
+	result, response, err := s.vpcService.GetVPC(options)
+	return result, response, withRequestID("GetVPC", response, err)
 }
 
 // GetVPCByName returns VPC with given name. If not found, returns nil.
@@ -206,7 +317,7 @@ func (s *Service) GetVPCByName(vpcName string) (*vpcv1.VPC, error) {
 		return true, "", nil
 	}
 
-	if err := utils.PagingHelper(f); err != nil {
+	if err := utils.PagingHelper(context.TODO(), f); err != nil {
 		return nil, err
 	}
 
@@ -215,11 +326,18 @@ func (s *Service) GetVPCByName(vpcName string) (*vpcv1.VPC, error) {
 
 // GetSubnet return subnet.
 func (s *Service) GetSubnet(options *vpcv1.GetSubnetOptions) (*vpcv1.Subnet, *core.DetailedResponse, error) {
-	return s.vpcService.GetSubnet(options)
+	result, response, err := s.vpcService.GetSubnet(options)
+	return result, response, withRequestID("GetSubnet", response, err)
 }
 
 // GetVPCSubnetByName returns subnet with given name. If not found, returns nil.
+// Found subnets are cached for SubnetCacheTTL to avoid listing the subnets on every lookup.
 func (s *Service) GetVPCSubnetByName(subnetName string) (*vpcv1.Subnet, error) {
+	key := subnetCacheKey(s.vpcService.Service.GetServiceURL(), subnetName)
+	if subnet, found := s.subnetCache.Get(key); found {
+		return subnet, nil
+	}
+
 	var subnet *vpcv1.Subnet
 	f := func(start string) (bool, string, error) {
 		// check for existing subnets
@@ -250,10 +368,15 @@ func (s *Service) GetVPCSubnetByName(subnetName string) (*vpcv1.Subnet, error) {
 		return true, "", nil
 	}
 
-	if err := utils.PagingHelper(f); err != nil {
+	if err := utils.PagingHelper(context.TODO(), f); err != nil {
 		return nil, err
 	}
 
+	if subnet != nil {
+		if err := s.subnetCache.Add(key, subnet); err != nil {
+			return nil, err
+		}
+	}
 	return subnet, nil
 }
 
@@ -289,7 +412,7 @@ func (s *Service) GetLoadBalancerByName(loadBalancerName string) (*vpcv1.LoadBal
 		return true, "", nil
 	}
 
-	if err := utils.PagingHelper(f); err != nil {
+	if err := utils.PagingHelper(context.TODO(), f); err != nil {
 		return nil, err
 	}
 
@@ -330,7 +453,7 @@ func (s *Service) GetSubnetAddrPrefix(vpcID, zone string) (string, error) {
 		return true, "", nil
 	}
 
-	if err := utils.PagingHelper(f); err != nil {
+	if err := utils.PagingHelper(context.TODO(), f); err != nil {
 		return "", err
 	}
 
@@ -342,22 +465,26 @@ func (s *Service) GetSubnetAddrPrefix(vpcID, zone string) (string, error) {
 
 // CreateSecurityGroup creates a new security group.
 func (s *Service) CreateSecurityGroup(options *vpcv1.CreateSecurityGroupOptions) (*vpcv1.SecurityGroup, *core.DetailedResponse, error) {
-	return s.vpcService.CreateSecurityGroup(options)
+	result, response, err := s.vpcService.CreateSecurityGroup(options)
+	return result, response, withRequestID("CreateSecurityGroup", response, err)
 }
 
 // DeleteSecurityGroup deletes the security group passed.
 func (s *Service) DeleteSecurityGroup(options *vpcv1.DeleteSecurityGroupOptions) (*core.DetailedResponse, error) {
-	return s.vpcService.DeleteSecurityGroup(options)
+	response, err := s.vpcService.DeleteSecurityGroup(options)
+	return response, withRequestID("DeleteSecurityGroup", response, err)
 }
 
 // ListSecurityGroups lists security group.
 func (s *Service) ListSecurityGroups(options *vpcv1.ListSecurityGroupsOptions) (*vpcv1.SecurityGroupCollection, *core.DetailedResponse, error) {
-	return s.vpcService.ListSecurityGroups(options)
+	result, response, err := s.vpcService.ListSecurityGroups(options)
+	return result, response, withRequestID("ListSecurityGroups", response, err)
 }
 
 // GetSecurityGroup gets a specific security group by id.
 func (s *Service) GetSecurityGroup(options *vpcv1.GetSecurityGroupOptions) (*vpcv1.SecurityGroup, *core.DetailedResponse, error) {
-	return s.vpcService.GetSecurityGroup(options)
+	result, response, err := s.vpcService.GetSecurityGroup(options)
+	return result, response, withRequestID("GetSecurityGroup", response, err)
 }
 
 // GetSecurityGroupByName gets a specific security group by name.
@@ -389,12 +516,207 @@ func (s *Service) GetSecurityGroupByName(name string) (*vpcv1.SecurityGroup, err
 
 // GetSecurityGroupRule gets a specific security group rule.
 func (s *Service) GetSecurityGroupRule(options *vpcv1.GetSecurityGroupRuleOptions) (vpcv1.SecurityGroupRuleIntf, *core.DetailedResponse, error) {
-	return s.vpcService.GetSecurityGroupRule(options)
+	result, response, err := s.vpcService.GetSecurityGroupRule(options)
+	return result, response, withRequestID("GetSecurityGroupRule", response, err)
+}
+
+// CreateFloatingIP reserves a floating IP.
+func (s *Service) CreateFloatingIP(options *vpcv1.CreateFloatingIPOptions) (*vpcv1.FloatingIP, *core.DetailedResponse, error) {
+	result, response, err := s.vpcService.CreateFloatingIP(options)
+	return result, response, withRequestID("CreateFloatingIP", response, err)
+}
+
+// DeleteFloatingIP releases a floating IP.
+func (s *Service) DeleteFloatingIP(options *vpcv1.DeleteFloatingIPOptions) (*core.DetailedResponse, error) {
+	response, err := s.vpcService.DeleteFloatingIP(options)
+	return response, withRequestID("DeleteFloatingIP", response, err)
+}
+
+// ListFloatingIPs returns list of floating IPs in a region.
+func (s *Service) ListFloatingIPs(options *vpcv1.ListFloatingIpsOptions) (*vpcv1.FloatingIPCollection, *core.DetailedResponse, error) {
+	result, response, err := s.vpcService.ListFloatingIps(options)
+	return result, response, withRequestID("ListFloatingIps", response, err)
+}
+
+// GetFloatingIPByName returns floating IP with given name. If not found, returns nil.
+func (s *Service) GetFloatingIPByName(name string) (*vpcv1.FloatingIP, error) {
+	var floatingIP *vpcv1.FloatingIP
+	f := func(start string) (bool, string, error) {
+		// check for existing floating IPs
+		listFloatingIPsOptions := &vpcv1.ListFloatingIpsOptions{}
+		if start != "" {
+			listFloatingIPsOptions.Start = &start
+		}
+
+		floatingIPsList, _, err := s.ListFloatingIPs(listFloatingIPsOptions)
+		if err != nil {
+			return false, "", err
+		}
+
+		if floatingIPsList == nil {
+			return false, "", fmt.Errorf("floating IP list returned is nil")
+		}
+
+		for i, fip := range floatingIPsList.FloatingIps {
+			if (*fip.Name) == name {
+				floatingIP = &floatingIPsList.FloatingIps[i]
+				return true, "", nil
+			}
+		}
+
+		if floatingIPsList.Next != nil && *floatingIPsList.Next.Href != "" {
+			return false, *floatingIPsList.Next.Href, nil
+		}
+		return true, "", nil
+	}
+
+	if err := utils.PagingHelper(context.TODO(), f); err != nil {
+		return nil, err
+	}
+
+	return floatingIP, nil
+}
+
+// ListPlacementGroups returns list of placement groups in a region.
+func (s *Service) ListPlacementGroups(options *vpcv1.ListPlacementGroupsOptions) (*vpcv1.PlacementGroupCollection, *core.DetailedResponse, error) {
+	result, response, err := s.vpcService.ListPlacementGroups(options)
+	return result, response, withRequestID("ListPlacementGroups", response, err)
+}
+
+// GetPlacementGroupByName returns placement group with given name. If not found, returns nil.
+func (s *Service) GetPlacementGroupByName(name string) (*vpcv1.PlacementGroup, error) {
+	var placementGroup *vpcv1.PlacementGroup
+	f := func(start string) (bool, string, error) {
+		// check for existing placement groups
+		listPlacementGroupsOptions := &vpcv1.ListPlacementGroupsOptions{}
+		if start != "" {
+			listPlacementGroupsOptions.Start = &start
+		}
+
+		placementGroupsList, _, err := s.ListPlacementGroups(listPlacementGroupsOptions)
+		if err != nil {
+			return false, "", err
+		}
+
+		if placementGroupsList == nil {
+			return false, "", fmt.Errorf("placement group list returned is nil")
+		}
+
+		for i, pg := range placementGroupsList.PlacementGroups {
+			if (*pg.Name) == name {
+				placementGroup = &placementGroupsList.PlacementGroups[i]
+				return true, "", nil
+			}
+		}
+
+		if placementGroupsList.Next != nil && *placementGroupsList.Next.Href != "" {
+			return false, *placementGroupsList.Next.Href, nil
+		}
+		return true, "", nil
+	}
+
+	if err := utils.PagingHelper(context.TODO(), f); err != nil {
+		return nil, err
+	}
+
+	return placementGroup, nil
+}
+
+// ListDedicatedHosts returns list of dedicated hosts in a region.
+func (s *Service) ListDedicatedHosts(options *vpcv1.ListDedicatedHostsOptions) (*vpcv1.DedicatedHostCollection, *core.DetailedResponse, error) {
+	result, response, err := s.vpcService.ListDedicatedHosts(options)
+	return result, response, withRequestID("ListDedicatedHosts", response, err)
+}
+
+// GetDedicatedHostByName returns dedicated host with given name. If not found, returns nil.
+func (s *Service) GetDedicatedHostByName(name string) (*vpcv1.DedicatedHost, error) {
+	var dedicatedHost *vpcv1.DedicatedHost
+	f := func(start string) (bool, string, error) {
+		// check for existing dedicated hosts
+		listDedicatedHostsOptions := &vpcv1.ListDedicatedHostsOptions{}
+		if start != "" {
+			listDedicatedHostsOptions.Start = &start
+		}
+
+		dedicatedHostsList, _, err := s.ListDedicatedHosts(listDedicatedHostsOptions)
+		if err != nil {
+			return false, "", err
+		}
+
+		if dedicatedHostsList == nil {
+			return false, "", fmt.Errorf("dedicated host list returned is nil")
+		}
+
+		for i, dh := range dedicatedHostsList.DedicatedHosts {
+			if (*dh.Name) == name {
+				dedicatedHost = &dedicatedHostsList.DedicatedHosts[i]
+				return true, "", nil
+			}
+		}
+
+		if dedicatedHostsList.Next != nil && *dedicatedHostsList.Next.Href != "" {
+			return false, *dedicatedHostsList.Next.Href, nil
+		}
+		return true, "", nil
+	}
+
+	if err := utils.PagingHelper(context.TODO(), f); err != nil {
+		return nil, err
+	}
+
+	return dedicatedHost, nil
+}
+
+// ListReservations returns list of reservations in a region.
+func (s *Service) ListReservations(options *vpcv1.ListReservationsOptions) (*vpcv1.ReservationCollection, *core.DetailedResponse, error) {
+	result, response, err := s.vpcService.ListReservations(options)
+	return result, response, withRequestID("ListReservations", response, err)
+}
+
+// GetReservationByName returns reservation with given name. If not found, returns nil.
+func (s *Service) GetReservationByName(name string) (*vpcv1.Reservation, error) {
+	var reservation *vpcv1.Reservation
+	f := func(start string) (bool, string, error) {
+		// check for existing reservations
+		listReservationsOptions := &vpcv1.ListReservationsOptions{}
+		if start != "" {
+			listReservationsOptions.Start = &start
+		}
+
+		reservationsList, _, err := s.ListReservations(listReservationsOptions)
+		if err != nil {
+			return false, "", err
+		}
+
+		if reservationsList == nil {
+			return false, "", fmt.Errorf("reservation list returned is nil")
+		}
+
+		for i, r := range reservationsList.Reservations {
+			if (*r.Name) == name {
+				reservation = &reservationsList.Reservations[i]
+				return true, "", nil
+			}
+		}
+
+		if reservationsList.Next != nil && *reservationsList.Next.Href != "" {
+			return false, *reservationsList.Next.Href, nil
+		}
+		return true, "", nil
+	}
+
+	if err := utils.PagingHelper(context.TODO(), f); err != nil {
+		return nil, err
+	}
+
+	return reservation, nil
 }
 
 // NewService returns a new VPC Service.
 func NewService(svcEndpoint string) (Vpc, error) {
-	service := &Service{}
+	service := &Service{
+		subnetCache: subnetCache(),
+	}
 	auth, err := authenticator.GetAuthenticator()
 	if err != nil {
 		return nil, err
@@ -404,6 +726,94 @@ func NewService(svcEndpoint string) (Vpc, error) {
 		Authenticator: auth,
 		URL:           svcEndpoint,
 	})
+	if err != nil {
+		return nil, err
+	}
+	withRateLimiter(service.vpcService.Service.GetHTTPClient(), apiRateLimiter())
+
+	return service, nil
+}
+
+// CreateNetworkACL creates a network ACL.
+func (s *Service) CreateNetworkACL(options *vpcv1.CreateNetworkACLOptions) (*vpcv1.NetworkACL, *core.DetailedResponse, error) {
+	result, response, err := s.vpcService.CreateNetworkACL(options)
+	return result, response, withRequestID("CreateNetworkACL", response, err)
+}
+
+// DeleteNetworkACL deletes a network ACL.
+func (s *Service) DeleteNetworkACL(options *vpcv1.DeleteNetworkACLOptions) (*core.DetailedResponse, error) {
+	response, err := s.vpcService.DeleteNetworkACL(options)
+	return response, withRequestID("DeleteNetworkACL", response, err)
+}
+
+// ListNetworkACLRules lists the rules of a network ACL.
+func (s *Service) ListNetworkACLRules(options *vpcv1.ListNetworkACLRulesOptions) (*vpcv1.NetworkACLRuleCollection, *core.DetailedResponse, error) {
+	result, response, err := s.vpcService.ListNetworkACLRules(options)
+	return result, response, withRequestID("ListNetworkACLRules", response, err)
+}
+
+// CreateNetworkACLRule creates a rule for a network ACL.
+func (s *Service) CreateNetworkACLRule(options *vpcv1.CreateNetworkACLRuleOptions) (vpcv1.NetworkACLRuleIntf, *core.DetailedResponse, error) {
+	result, response, err := s.vpcService.CreateNetworkACLRule(options)
+	return result, response, withRequestID("CreateNetworkACLRule", response, err)
+}
+
+// DeleteNetworkACLRule deletes a rule from a network ACL.
+func (s *Service) DeleteNetworkACLRule(options *vpcv1.DeleteNetworkACLRuleOptions) (*core.DetailedResponse, error) {
+	response, err := s.vpcService.DeleteNetworkACLRule(options)
+	return response, withRequestID("DeleteNetworkACLRule", response, err)
+}
+
+// GetSubnetNetworkACL gets the network ACL attached to a subnet.
+func (s *Service) GetSubnetNetworkACL(options *vpcv1.GetSubnetNetworkACLOptions) (*vpcv1.NetworkACL, *core.DetailedResponse, error) {
+	result, response, err := s.vpcService.GetSubnetNetworkACL(options)
+	return result, response, withRequestID("GetSubnetNetworkACL", response, err)
+}
+
+// ReplaceSubnetNetworkACL attaches a network ACL to a subnet, replacing the one attached.
+func (s *Service) ReplaceSubnetNetworkACL(options *vpcv1.ReplaceSubnetNetworkACLOptions) (*vpcv1.NetworkACL, *core.DetailedResponse, error) {
+	result, response, err := s.vpcService.ReplaceSubnetNetworkACL(options)
+	return result, response, withRequestID("ReplaceSubnetNetworkACL", response, err)
+}
+
+// CreateVPCAddressPrefix creates an address prefix in a VPC.
+func (s *Service) CreateVPCAddressPrefix(options *vpcv1.CreateVPCAddressPrefixOptions) (*vpcv1.AddressPrefix, *core.DetailedResponse, error) {
+	result, response, err := s.vpcService.CreateVPCAddressPrefix(options)
+	return result, response, withRequestID("CreateVPCAddressPrefix", response, err)
+}
+
+// ListSubnetReservedIps returns the reserved IPs of a subnet.
+func (s *Service) ListSubnetReservedIps(options *vpcv1.ListSubnetReservedIpsOptions) (*vpcv1.ReservedIPCollection, *core.DetailedResponse, error) {
+	result, response, err := s.vpcService.ListSubnetReservedIps(options)
+	return result, response, withRequestID("ListSubnetReservedIps", response, err)
+}
+
+// DeleteSubnetReservedIP deletes a reserved IP of a subnet.
+func (s *Service) DeleteSubnetReservedIP(options *vpcv1.DeleteSubnetReservedIPOptions) (*core.DetailedResponse, error) {
+	response, err := s.vpcService.DeleteSubnetReservedIP(options)
+	return response, withRequestID("DeleteSubnetReservedIP", response, err)
+}
+
+// ListFlowLogCollectors returns the flow log collectors of the region.
+func (s *Service) ListFlowLogCollectors(options *vpcv1.ListFlowLogCollectorsOptions) (*vpcv1.FlowLogCollectorCollection, *core.DetailedResponse, error) {
+	result, response, err := s.vpcService.ListFlowLogCollectors(options)
+	return result, response, withRequestID("ListFlowLogCollectors", response, err)
+}
+
+// CreateFlowLogCollector creates a flow log collector.
+func (s *Service) CreateFlowLogCollector(options *vpcv1.CreateFlowLogCollectorOptions) (*vpcv1.FlowLogCollector, *core.DetailedResponse, error) {
+	result, response, err := s.vpcService.CreateFlowLogCollector(options)
+	return result, response, withRequestID("CreateFlowLogCollector", response, err)
+}
+
+// DeleteFlowLogCollector deletes a flow log collector.
+func (s *Service) DeleteFlowLogCollector(options *vpcv1.DeleteFlowLogCollectorOptions) (*core.DetailedResponse, error) {
+	response, err := s.vpcService.DeleteFlowLogCollector(options)
+	return response, withRequestID("DeleteFlowLogCollector", response, err)
+}
 
-	return service, err
+// ListRegionZones returns the zones of a region.
+func (s *Service) ListRegionZones(options *vpcv1.ListRegionZonesOptions) (*vpcv1.ZoneCollection, *core.DetailedResponse, error) {
+	result, response, err := s.vpcService.ListRegionZones(options)
+	return result, response, withRequestID("ListRegionZones", response, err)
 }
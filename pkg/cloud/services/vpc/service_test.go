@@ -0,0 +1,342 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vpc
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+
+	clocktesting "k8s.io/utils/clock/testing"
+
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/utils"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestGetVPCSubnetByName(t *testing.T) {
+	setup := func(t *testing.T) (*Service, *clocktesting.FakeClock, *int32) {
+		t.Helper()
+		var requests int32
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			atomic.AddInt32(&requests, 1)
+			w.Header().Set("Content-Type", "application/json")
+			fmt.Fprint(w, `{"subnets": [{"id": "foo-subnet-id", "name": "foo-subnet"}], "limit": 50, "total_count": 1}`)
+		}))
+		t.Cleanup(server.Close)
+
+		vpcService, err := vpcv1.NewVpcV1(&vpcv1.VpcV1Options{
+			Authenticator: &core.NoAuthAuthenticator{},
+			URL:           server.URL,
+		})
+		if err != nil {
+			t.Fatalf("failed to create VPC client: %v", err)
+		}
+		fakeClock := clocktesting.NewFakeClock(time.Now())
+		return &Service{
+			vpcService:  vpcService,
+			subnetCache: utils.NewTTLCacheWithClock[*vpcv1.Subnet](time.Minute, fakeClock),
+		}, fakeClock, &requests
+	}
+
+	t.Run("Should return cached subnet within the TTL", func(t *testing.T) {
+		g := NewWithT(t)
+		service, _, requests := setup(t)
+		subnet, err := service.GetVPCSubnetByName("foo-subnet")
+		g.Expect(err).To(BeNil())
+		g.Expect(*subnet.ID).To(Equal("foo-subnet-id"))
+		subnet, err = service.GetVPCSubnetByName("foo-subnet")
+		g.Expect(err).To(BeNil())
+		g.Expect(*subnet.ID).To(Equal("foo-subnet-id"))
+		g.Expect(atomic.LoadInt32(requests)).To(Equal(int32(1)))
+	})
+	t.Run("Should fetch subnet again after the TTL expires", func(t *testing.T) {
+		g := NewWithT(t)
+		service, fakeClock, requests := setup(t)
+		_, err := service.GetVPCSubnetByName("foo-subnet")
+		g.Expect(err).To(BeNil())
+		fakeClock.Step(2 * time.Minute)
+		subnet, err := service.GetVPCSubnetByName("foo-subnet")
+		g.Expect(err).To(BeNil())
+		g.Expect(*subnet.ID).To(Equal("foo-subnet-id"))
+		g.Expect(atomic.LoadInt32(requests)).To(Equal(int32(2)))
+	})
+	t.Run("Should not cache subnet that is not found", func(t *testing.T) {
+		g := NewWithT(t)
+		service, _, requests := setup(t)
+		subnet, err := service.GetVPCSubnetByName("bar-subnet")
+		g.Expect(err).To(BeNil())
+		g.Expect(subnet).To(BeNil())
+		_, err = service.GetVPCSubnetByName("bar-subnet")
+		g.Expect(err).To(BeNil())
+		g.Expect(atomic.LoadInt32(requests)).To(Equal(int32(2)))
+	})
+}
+
+func TestGetInstanceByProviderID(t *testing.T) {
+	setup := func(t *testing.T) *Service {
+		t.Helper()
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			if r.URL.Path != "/instances/foo-instance-id" {
+				w.WriteHeader(http.StatusNotFound)
+				fmt.Fprint(w, `{"errors": [{"code": "not_found", "message": "Instance not found"}]}`)
+				return
+			}
+			fmt.Fprint(w, `{"id": "foo-instance-id", "name": "foo-machine"}`)
+		}))
+		t.Cleanup(server.Close)
+
+		vpcService, err := vpcv1.NewVpcV1(&vpcv1.VpcV1Options{
+			Authenticator: &core.NoAuthAuthenticator{},
+			URL:           server.URL,
+		})
+		if err != nil {
+			t.Fatalf("failed to create VPC client: %v", err)
+		}
+		return &Service{
+			vpcService: vpcService,
+		}
+	}
+
+	testCases := []struct {
+		name          string
+		providerID    string
+		expectedID    string
+		expectedError error
+	}{
+		{
+			name:       "Should get instance by v3 provider ID",
+			providerID: "ibmvpc://us-south/us-south-1/foo-instance-id",
+			expectedID: "foo-instance-id",
+		},
+		{
+			name:       "Should get instance by v2 provider ID",
+			providerID: "ibm://foo-account-id///foo-cluster/foo-instance-id",
+			expectedID: "foo-instance-id",
+		},
+		{
+			name:       "Should return nil when instance does not exist",
+			providerID: "ibmvpc://us-south/us-south-1/bar-instance-id",
+		},
+		{
+			name:          "Error when provider ID does not contain an instance ID",
+			providerID:    "ibmvpc://foo-cluster/foo-machine",
+			expectedError: ErrInvalidProviderID,
+		},
+		{
+			name:          "Error when provider ID is malformed",
+			providerID:    "foo-instance-id",
+			expectedError: ErrInvalidProviderID,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			service := setup(t)
+			instance, err := service.GetInstanceByProviderID(tc.providerID)
+			if tc.expectedError != nil {
+				g.Expect(errors.Is(err, tc.expectedError)).To(BeTrue())
+				return
+			}
+			g.Expect(err).To(BeNil())
+			if tc.expectedID == "" {
+				g.Expect(instance).To(BeNil())
+				return
+			}
+			g.Expect(*instance.ID).To(Equal(tc.expectedID))
+		})
+	}
+}
+
+func TestRateLimiter(t *testing.T) {
+	setup := func(t *testing.T, rps float64) (*Service, *int32) {
+		t.Helper()
+		var requests int32
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			atomic.AddInt32(&requests, 1)
+			w.Header().Set("Content-Type", "application/json")
+			fmt.Fprint(w, `{"id": "foo-instance-id", "name": "foo-machine"}`)
+		}))
+		t.Cleanup(server.Close)
+
+		vpcService, err := vpcv1.NewVpcV1(&vpcv1.VpcV1Options{
+			Authenticator: &core.NoAuthAuthenticator{},
+			URL:           server.URL,
+		})
+		if err != nil {
+			t.Fatalf("failed to create VPC client: %v", err)
+		}
+		withRateLimiter(vpcService.Service.GetHTTPClient(), newAPIRateLimiter(rps))
+		return &Service{
+			vpcService: vpcService,
+		}, &requests
+	}
+
+	testCases := []struct {
+		name  string
+		rps   float64
+		calls int
+	}{
+		{
+			name:  "Should space out calls at 20 requests per second",
+			rps:   20,
+			calls: 5,
+		},
+		{
+			name:  "Should space out calls at 5 requests per second",
+			rps:   5,
+			calls: 3,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			service, requests := setup(t, tc.rps)
+			start := time.Now()
+			var wg sync.WaitGroup
+			for i := 0; i < tc.calls; i++ {
+				wg.Add(1)
+				go func() {
+					defer wg.Done()
+					_, _, err := service.GetInstance(&vpcv1.GetInstanceOptions{ID: core.StringPtr("foo-instance-id")})
+					g.Expect(err).To(BeNil())
+				}()
+			}
+			wg.Wait()
+			// The first call is sent immediately and each following call waits for one interval of the rate.
+			minElapsed := time.Duration(float64(tc.calls-1) / tc.rps * float64(time.Second))
+			g.Expect(time.Since(start)).To(BeNumerically(">=", minElapsed-10*time.Millisecond))
+			g.Expect(atomic.LoadInt32(requests)).To(Equal(int32(tc.calls)))
+		})
+	}
+
+	t.Run("Should share the rate limiter across services", func(t *testing.T) {
+		g := NewWithT(t)
+		g.Expect(apiRateLimiter()).To(BeIdenticalTo(apiRateLimiter()))
+		g.Expect(float64(apiRateLimiter().Limit())).To(Equal(APIRateLimit))
+	})
+}
+
+func TestClassifyError(t *testing.T) {
+	testCases := []struct {
+		name          string
+		response      *core.DetailedResponse
+		err           error
+		expectedError error
+	}{
+		{
+			name:          "Should classify not found",
+			response:      &core.DetailedResponse{StatusCode: http.StatusNotFound},
+			err:           errors.New("Instance not found"),
+			expectedError: ErrNotFound,
+		},
+		{
+			name:          "Should classify forbidden",
+			response:      &core.DetailedResponse{StatusCode: http.StatusForbidden},
+			err:           errors.New("Not authorized"),
+			expectedError: ErrForbidden,
+		},
+		{
+			name:          "Should classify rate limited",
+			response:      &core.DetailedResponse{StatusCode: http.StatusTooManyRequests},
+			err:           errors.New("Too many requests"),
+			expectedError: ErrRateLimited,
+		},
+		{
+			name:          "Should classify conflict",
+			response:      &core.DetailedResponse{StatusCode: http.StatusConflict},
+			err:           errors.New("Instance is busy"),
+			expectedError: ErrConflict,
+		},
+		{
+			name:     "Should not classify other status codes",
+			response: &core.DetailedResponse{StatusCode: http.StatusInternalServerError},
+			err:      errors.New("Internal error"),
+		},
+		{
+			name: "Should not classify error without response",
+			err:  errors.New("Connection refused"),
+		},
+	}
+
+	classifiedErrors := []error{ErrNotFound, ErrForbidden, ErrRateLimited, ErrConflict}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			err := ClassifyError(tc.response, tc.err)
+			g.Expect(errors.Is(err, tc.err)).To(BeTrue())
+			for _, classifiedError := range classifiedErrors {
+				g.Expect(errors.Is(err, classifiedError)).To(Equal(classifiedError == tc.expectedError))
+			}
+		})
+	}
+
+	t.Run("Should return nil without error", func(t *testing.T) {
+		g := NewWithT(t)
+		g.Expect(ClassifyError(&core.DetailedResponse{StatusCode: http.StatusNotFound}, nil)).To(BeNil())
+	})
+}
+
+func TestWithRequestID(t *testing.T) {
+	setup := func(t *testing.T) *Service {
+		t.Helper()
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			w.Header().Set("X-Request-Id", "foo-request-id")
+			w.WriteHeader(http.StatusNotFound)
+			fmt.Fprint(w, `{"errors": [{"code": "not_found", "message": "Instance not found"}]}`)
+		}))
+		t.Cleanup(server.Close)
+
+		vpcService, err := vpcv1.NewVpcV1(&vpcv1.VpcV1Options{
+			Authenticator: &core.NoAuthAuthenticator{},
+			URL:           server.URL,
+		})
+		if err != nil {
+			t.Fatalf("failed to create VPC client: %v", err)
+		}
+		return &Service{
+			vpcService: vpcService,
+		}
+	}
+
+	t.Run("Should add request ID to the error of a failed call", func(t *testing.T) {
+		g := NewWithT(t)
+		service := setup(t)
+		_, response, err := service.GetInstance(&vpcv1.GetInstanceOptions{ID: core.StringPtr("foo-instance-id")})
+		g.Expect(err).To(MatchError(ContainSubstring("request ID: foo-request-id")))
+		g.Expect(err).To(MatchError(ContainSubstring("Instance not found")))
+		g.Expect(errors.Is(ClassifyError(response, err), ErrNotFound)).To(BeTrue())
+	})
+	t.Run("Should return error unchanged without request ID", func(t *testing.T) {
+		g := NewWithT(t)
+		err := errors.New("Connection refused")
+		g.Expect(withRequestID("GetInstance", nil, err)).To(BeIdenticalTo(err))
+		g.Expect(withRequestID("GetInstance", &core.DetailedResponse{StatusCode: http.StatusOK}, nil)).To(BeNil())
+	})
+}
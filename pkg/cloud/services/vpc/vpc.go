@@ -29,7 +29,9 @@ type Vpc interface {
 	CreateInstance(options *vpcv1.CreateInstanceOptions) (*vpcv1.Instance, *core.DetailedResponse, error)
 	DeleteInstance(options *vpcv1.DeleteInstanceOptions) (*core.DetailedResponse, error)
 	GetInstance(options *vpcv1.GetInstanceOptions) (*vpcv1.Instance, *core.DetailedResponse, error)
+	GetInstanceByProviderID(providerID string) (*vpcv1.Instance, error)
 	ListInstances(options *vpcv1.ListInstancesOptions) (*vpcv1.InstanceCollection, *core.DetailedResponse, error)
+	CreateInstanceAction(options *vpcv1.CreateInstanceActionOptions) (*vpcv1.InstanceAction, *core.DetailedResponse, error)
 	CreateVPC(options *vpcv1.CreateVPCOptions) (*vpcv1.VPC, *core.DetailedResponse, error)
 	DeleteVPC(options *vpcv1.DeleteVPCOptions) (response *core.DetailedResponse, err error)
 	ListVpcs(options *vpcv1.ListVpcsOptions) (*vpcv1.VPCCollection, *core.DetailedResponse, error)
@@ -42,7 +44,9 @@ type Vpc interface {
 	CreatePublicGateway(options *vpcv1.CreatePublicGatewayOptions) (*vpcv1.PublicGateway, *core.DetailedResponse, error)
 	DeletePublicGateway(options *vpcv1.DeletePublicGatewayOptions) (*core.DetailedResponse, error)
 	ListVPCAddressPrefixes(options *vpcv1.ListVPCAddressPrefixesOptions) (*vpcv1.AddressPrefixCollection, *core.DetailedResponse, error)
+	CreateVPCAddressPrefix(options *vpcv1.CreateVPCAddressPrefixOptions) (*vpcv1.AddressPrefix, *core.DetailedResponse, error)
 	CreateSecurityGroupRule(options *vpcv1.CreateSecurityGroupRuleOptions) (vpcv1.SecurityGroupRuleIntf, *core.DetailedResponse, error)
+	DeleteSecurityGroupRule(options *vpcv1.DeleteSecurityGroupRuleOptions) (*core.DetailedResponse, error)
 	CreateLoadBalancer(options *vpcv1.CreateLoadBalancerOptions) (*vpcv1.LoadBalancer, *core.DetailedResponse, error)
 	DeleteLoadBalancer(options *vpcv1.DeleteLoadBalancerOptions) (*core.DetailedResponse, error)
 	ListLoadBalancers(options *vpcv1.ListLoadBalancersOptions) (*vpcv1.LoadBalancerCollection, *core.DetailedResponse, error)
@@ -50,9 +54,14 @@ type Vpc interface {
 	CreateLoadBalancerPoolMember(options *vpcv1.CreateLoadBalancerPoolMemberOptions) (*vpcv1.LoadBalancerPoolMember, *core.DetailedResponse, error)
 	DeleteLoadBalancerPoolMember(options *vpcv1.DeleteLoadBalancerPoolMemberOptions) (*core.DetailedResponse, error)
 	ListLoadBalancerPoolMembers(options *vpcv1.ListLoadBalancerPoolMembersOptions) (*vpcv1.LoadBalancerPoolMemberCollection, *core.DetailedResponse, error)
+	GetLoadBalancerPoolMember(options *vpcv1.GetLoadBalancerPoolMemberOptions) (*vpcv1.LoadBalancerPoolMember, *core.DetailedResponse, error)
+	GetLoadBalancerPool(options *vpcv1.GetLoadBalancerPoolOptions) (*vpcv1.LoadBalancerPool, *core.DetailedResponse, error)
+	UpdateLoadBalancerPool(options *vpcv1.UpdateLoadBalancerPoolOptions) (*vpcv1.LoadBalancerPool, *core.DetailedResponse, error)
 	ListKeys(options *vpcv1.ListKeysOptions) (*vpcv1.KeyCollection, *core.DetailedResponse, error)
 	ListImages(options *vpcv1.ListImagesOptions) (*vpcv1.ImageCollection, *core.DetailedResponse, error)
+	ListInstanceTemplates(options *vpcv1.ListInstanceTemplatesOptions) (*vpcv1.InstanceTemplateCollection, *core.DetailedResponse, error)
 	GetInstanceProfile(options *vpcv1.GetInstanceProfileOptions) (*vpcv1.InstanceProfile, *core.DetailedResponse, error)
+	ListInstanceProfiles(options *vpcv1.ListInstanceProfilesOptions) (*vpcv1.InstanceProfileCollection, *core.DetailedResponse, error)
 	GetVPC(*vpcv1.GetVPCOptions) (*vpcv1.VPC, *core.DetailedResponse, error)
 	GetVPCByName(vpcName string) (*vpcv1.VPC, error)
 	GetSubnet(*vpcv1.GetSubnetOptions) (*vpcv1.Subnet, *core.DetailedResponse, error)
@@ -65,4 +74,27 @@ type Vpc interface {
 	GetSecurityGroup(options *vpcv1.GetSecurityGroupOptions) (*vpcv1.SecurityGroup, *core.DetailedResponse, error)
 	GetSecurityGroupByName(name string) (*vpcv1.SecurityGroup, error)
 	GetSecurityGroupRule(options *vpcv1.GetSecurityGroupRuleOptions) (vpcv1.SecurityGroupRuleIntf, *core.DetailedResponse, error)
+	CreateFloatingIP(options *vpcv1.CreateFloatingIPOptions) (*vpcv1.FloatingIP, *core.DetailedResponse, error)
+	DeleteFloatingIP(options *vpcv1.DeleteFloatingIPOptions) (*core.DetailedResponse, error)
+	ListFloatingIPs(options *vpcv1.ListFloatingIpsOptions) (*vpcv1.FloatingIPCollection, *core.DetailedResponse, error)
+	GetFloatingIPByName(name string) (*vpcv1.FloatingIP, error)
+	ListPlacementGroups(options *vpcv1.ListPlacementGroupsOptions) (*vpcv1.PlacementGroupCollection, *core.DetailedResponse, error)
+	GetPlacementGroupByName(name string) (*vpcv1.PlacementGroup, error)
+	ListDedicatedHosts(options *vpcv1.ListDedicatedHostsOptions) (*vpcv1.DedicatedHostCollection, *core.DetailedResponse, error)
+	GetDedicatedHostByName(name string) (*vpcv1.DedicatedHost, error)
+	ListReservations(options *vpcv1.ListReservationsOptions) (*vpcv1.ReservationCollection, *core.DetailedResponse, error)
+	GetReservationByName(name string) (*vpcv1.Reservation, error)
+	CreateNetworkACL(options *vpcv1.CreateNetworkACLOptions) (*vpcv1.NetworkACL, *core.DetailedResponse, error)
+	DeleteNetworkACL(options *vpcv1.DeleteNetworkACLOptions) (*core.DetailedResponse, error)
+	ListNetworkACLRules(options *vpcv1.ListNetworkACLRulesOptions) (*vpcv1.NetworkACLRuleCollection, *core.DetailedResponse, error)
+	CreateNetworkACLRule(options *vpcv1.CreateNetworkACLRuleOptions) (vpcv1.NetworkACLRuleIntf, *core.DetailedResponse, error)
+	DeleteNetworkACLRule(options *vpcv1.DeleteNetworkACLRuleOptions) (*core.DetailedResponse, error)
+	GetSubnetNetworkACL(options *vpcv1.GetSubnetNetworkACLOptions) (*vpcv1.NetworkACL, *core.DetailedResponse, error)
+	ReplaceSubnetNetworkACL(options *vpcv1.ReplaceSubnetNetworkACLOptions) (*vpcv1.NetworkACL, *core.DetailedResponse, error)
+	ListSubnetReservedIps(options *vpcv1.ListSubnetReservedIpsOptions) (*vpcv1.ReservedIPCollection, *core.DetailedResponse, error)
+	DeleteSubnetReservedIP(options *vpcv1.DeleteSubnetReservedIPOptions) (*core.DetailedResponse, error)
+	ListFlowLogCollectors(options *vpcv1.ListFlowLogCollectorsOptions) (*vpcv1.FlowLogCollectorCollection, *core.DetailedResponse, error)
+	CreateFlowLogCollector(options *vpcv1.CreateFlowLogCollectorOptions) (*vpcv1.FlowLogCollector, *core.DetailedResponse, error)
+	DeleteFlowLogCollector(options *vpcv1.DeleteFlowLogCollectorOptions) (*core.DetailedResponse, error)
+	ListRegionZones(options *vpcv1.ListRegionZonesOptions) (*vpcv1.ZoneCollection, *core.DetailedResponse, error)
 }
@@ -29,6 +29,11 @@ const (
 	// For VPC machines: ibm://<account_id>///<cluster_id>/<vpc_machine_id>
 	// For Power VS machines: ibmpowervs://<region>/<zone>/<service_instance_id>/<powervs_machine_id>
 	ProviderIDFormatV2 ProviderIDFormatType = "v2"
+
+	// ProviderIDFormatV3 will set provider id to machine as follows
+	// For VPC machines: ibmvpc://<region>/<zone>/<vpc_machine_id>
+	// For Power VS machines: same as ProviderIDFormatV2
+	ProviderIDFormatV3 ProviderIDFormatType = "v3"
 )
 
 var (
@@ -37,4 +42,7 @@ var (
 	PowerVSProviderIDFormat string
 	// ProviderIDFormat is used to identify the Provider ID format for Machine.
 	ProviderIDFormat string
+	// ProviderIDAccountFromCRN makes the v2 Provider ID format of VPC machines read the account ID from the CRN of
+	// the instance instead of looking it up with the configured credentials.
+	ProviderIDAccountFromCRN bool
 )